@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -14,6 +15,7 @@ import (
 	gwayserver "github.com/indrasaputra/toggle/internal/grpc-gateway/server"
 	"github.com/indrasaputra/toggle/internal/grpc/handler"
 	grpcserver "github.com/indrasaputra/toggle/internal/grpc/server"
+	"github.com/indrasaputra/toggle/internal/messaging"
 	manserver "github.com/indrasaputra/toggle/internal/server"
 	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
@@ -33,23 +35,39 @@ func main() {
 	dep := &builder.Dependency{
 		PgxPool:     postgrePool,
 		RedisClient: redisClient,
+		Broadcaster: messaging.NewBroadcaster(cfg.Watch.HistorySize, cfg.Watch.BufferSize),
 		Config:      cfg,
 	}
+	if cfg.Outbox.Publisher == config.PublisherKafka {
+		dep.KafkaWriter = builder.BuildKafkaWriter(&cfg.Kafka)
+	}
 
 	grpcServer := grpcserver.NewGrpcServer(cfg.Port.Grpc)
 	registerGrpcService(grpcServer, dep)
 
 	gatewayServer := gwayserver.NewGrpcGateway(cfg.Port.GrpcGateway)
 	registerGrpcGatewayService(context.Background(), gatewayServer, fmt.Sprintf(":%s", cfg.Port.Grpc), grpc.WithInsecure())
+	gatewayConn, err := grpc.Dial(fmt.Sprintf(":%s", cfg.Port.Grpc), grpc.WithInsecure())
+	checkError(err)
+	checkError(gatewayServer.EnableToggleStream(togglev1.NewToggleQueryServiceClient(gatewayConn), time.Duration(cfg.Watch.KeepAliveInterval)*time.Second))
+	checkError(gatewayServer.EnableToggleSnapshot(togglev1.NewToggleQueryServiceClient(gatewayConn), togglev1.NewToggleCommandServiceClient(gatewayConn)))
 
 	closer := func() {
 		_ = tracerProvider.Shutdown(context.Background())
 		_ = redisClient.Close()
+		_ = gatewayConn.Close()
+		if dep.KafkaWriter != nil {
+			_ = dep.KafkaWriter.Close()
+		}
 		postgrePool.Close()
 	}
 	defer closer()
 
-	man := manserver.NewManager([]manserver.Server{grpcServer, gatewayServer})
+	scheduler := builder.BuildScheduler(dep)
+	relay := builder.BuildOutboxRelay(dep)
+	fanout := builder.BuildWatchFanout(dep)
+
+	man := manserver.NewManager([]manserver.Server{grpcServer, gatewayServer, scheduler, relay, fanout})
 	man.Serve()
 	man.GracefulStop()
 }
@@ -58,11 +76,27 @@ func registerGrpcService(grpcServer *grpcserver.GrpcServer, dep *builder.Depende
 	// start register all module's gRPC handlers
 	command := builder.BuildToggleCommandHandler(dep)
 	query := builder.BuildToggleQueryHandler(dep)
+	envCommand := builder.BuildEnvironmentCommandHandler(dep)
+	envQuery := builder.BuildEnvironmentQueryHandler(dep)
+	projectCommand := builder.BuildProjectCommandHandler(dep)
+	projectQuery := builder.BuildProjectQueryHandler(dep)
+	segmentCommand := builder.BuildSegmentCommandHandler(dep)
+	segmentQuery := builder.BuildSegmentQueryHandler(dep)
+	scheduleCommand := builder.BuildScheduleCommandHandler(dep)
+	scheduleQuery := builder.BuildScheduleQueryHandler(dep)
 	health := handler.NewHealth()
 
 	grpcServer.AttachService(func(server *grpc.Server) {
 		togglev1.RegisterToggleCommandServiceServer(server, command)
 		togglev1.RegisterToggleQueryServiceServer(server, query)
+		togglev1.RegisterEnvironmentCommandServiceServer(server, envCommand)
+		togglev1.RegisterEnvironmentQueryServiceServer(server, envQuery)
+		togglev1.RegisterProjectCommandServiceServer(server, projectCommand)
+		togglev1.RegisterProjectQueryServiceServer(server, projectQuery)
+		togglev1.RegisterSegmentCommandServiceServer(server, segmentCommand)
+		togglev1.RegisterSegmentQueryServiceServer(server, segmentQuery)
+		togglev1.RegisterScheduleCommandServiceServer(server, scheduleCommand)
+		togglev1.RegisterScheduleQueryServiceServer(server, scheduleQuery)
 		grpc_health_v1.RegisterHealthServer(server, health)
 	})
 	// end of register all module's gRPC handlers
@@ -76,6 +110,30 @@ func registerGrpcGatewayService(ctx context.Context, gatewayServer *gwayserver.G
 		if err := togglev1.RegisterToggleQueryServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
 			return err
 		}
+		if err := togglev1.RegisterEnvironmentCommandServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterEnvironmentQueryServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterProjectCommandServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterProjectQueryServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterSegmentCommandServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterSegmentQueryServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterScheduleCommandServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
+		if err := togglev1.RegisterScheduleQueryServiceHandlerFromEndpoint(ctx, server, grpcPort, options); err != nil {
+			return err
+		}
 		return nil
 	})
 }
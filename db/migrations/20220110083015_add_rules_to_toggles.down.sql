@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE toggles DROP COLUMN IF EXISTS default_value;
+ALTER TABLE toggles DROP COLUMN IF EXISTS rules;
+
+COMMIT;
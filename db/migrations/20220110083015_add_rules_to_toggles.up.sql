@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS rules JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS default_value BOOLEAN NOT NULL DEFAULT TRUE;
+
+COMMIT;
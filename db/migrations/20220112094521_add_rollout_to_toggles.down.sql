@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE toggles DROP COLUMN IF EXISTS rollout;
+
+COMMIT;
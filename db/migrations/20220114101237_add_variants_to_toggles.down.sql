@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE toggles DROP COLUMN IF EXISTS off_variant;
+ALTER TABLE toggles DROP COLUMN IF EXISTS default_variant;
+ALTER TABLE toggles DROP COLUMN IF EXISTS variants;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS variants JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS default_variant TEXT NOT NULL DEFAULT '';
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS off_variant TEXT NOT NULL DEFAULT '';
+
+COMMIT;
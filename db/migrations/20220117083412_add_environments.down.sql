@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS is_enabled BOOLEAN;
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS rules JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS default_value BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS rollout JSONB;
+
+UPDATE toggles SET
+  is_enabled = toggle_states.is_enabled,
+  rules = toggle_states.rules,
+  default_value = toggle_states.default_value,
+  rollout = toggle_states.rollout
+FROM toggle_states
+WHERE toggle_states.toggle_key = toggles.key AND toggle_states.environment = 'production';
+
+DROP TABLE IF EXISTS toggle_states;
+DROP TABLE IF EXISTS environments;
+
+COMMIT;
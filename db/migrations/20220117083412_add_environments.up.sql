@@ -0,0 +1,38 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS environments (
+  id            BIGSERIAL       PRIMARY KEY,
+  name          TEXT            UNIQUE NOT NULL,
+  description   TEXT,
+  created_at    TIMESTAMP
+);
+
+INSERT INTO environments (name, description, created_at) VALUES
+  ('development', 'Development environment', NOW()),
+  ('staging', 'Staging environment', NOW()),
+  ('production', 'Production environment', NOW())
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS toggle_states (
+  id              BIGSERIAL       PRIMARY KEY,
+  toggle_key      TEXT            NOT NULL REFERENCES toggles (key) ON DELETE CASCADE,
+  environment     TEXT            NOT NULL REFERENCES environments (name),
+  is_enabled      BOOLEAN,
+  rules           JSONB           NOT NULL DEFAULT '[]',
+  default_value   BOOLEAN         NOT NULL DEFAULT TRUE,
+  rollout         JSONB,
+  updated_at      TIMESTAMP,
+  UNIQUE (toggle_key, environment)
+);
+
+INSERT INTO toggle_states (toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at)
+  SELECT toggles.key, environments.name, toggles.is_enabled, toggles.rules, toggles.default_value, toggles.rollout, toggles.updated_at
+  FROM toggles CROSS JOIN environments
+ON CONFLICT (toggle_key, environment) DO NOTHING;
+
+ALTER TABLE toggles DROP COLUMN IF EXISTS rollout;
+ALTER TABLE toggles DROP COLUMN IF EXISTS default_value;
+ALTER TABLE toggles DROP COLUMN IF EXISTS rules;
+ALTER TABLE toggles DROP COLUMN IF EXISTS is_enabled;
+
+COMMIT;
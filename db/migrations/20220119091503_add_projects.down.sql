@@ -0,0 +1,21 @@
+BEGIN;
+
+DELETE FROM toggles WHERE project <> 'default';
+
+ALTER TABLE toggle_states DROP CONSTRAINT IF EXISTS toggle_states_project_toggle_key_environment_key;
+ALTER TABLE toggle_states DROP CONSTRAINT IF EXISTS toggle_states_project_toggle_key_fkey;
+ALTER TABLE toggles DROP CONSTRAINT IF EXISTS toggles_project_key_key;
+
+ALTER TABLE toggles ADD CONSTRAINT toggles_key_key UNIQUE (key);
+ALTER TABLE toggle_states ADD CONSTRAINT toggle_states_toggle_key_fkey
+  FOREIGN KEY (toggle_key) REFERENCES toggles (key) ON DELETE CASCADE;
+ALTER TABLE toggle_states ADD CONSTRAINT toggle_states_toggle_key_environment_key
+  UNIQUE (toggle_key, environment);
+
+ALTER TABLE toggle_states DROP COLUMN IF EXISTS project;
+ALTER TABLE toggles DROP CONSTRAINT IF EXISTS toggles_project_fkey;
+ALTER TABLE toggles DROP COLUMN IF EXISTS project;
+
+DROP TABLE IF EXISTS projects;
+
+COMMIT;
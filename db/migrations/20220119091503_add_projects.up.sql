@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS projects (
+  id            BIGSERIAL       PRIMARY KEY,
+  name          TEXT            UNIQUE NOT NULL,
+  description   TEXT,
+  created_at    TIMESTAMP,
+  updated_at    TIMESTAMP
+);
+
+INSERT INTO projects (name, description, created_at, updated_at) VALUES
+  ('default', 'Default project', NOW(), NOW())
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS project TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE toggles ADD CONSTRAINT toggles_project_fkey FOREIGN KEY (project) REFERENCES projects (name);
+ALTER TABLE toggle_states ADD COLUMN IF NOT EXISTS project TEXT NOT NULL DEFAULT 'default';
+
+ALTER TABLE toggle_states DROP CONSTRAINT IF EXISTS toggle_states_toggle_key_fkey;
+ALTER TABLE toggle_states DROP CONSTRAINT IF EXISTS toggle_states_toggle_key_environment_key;
+ALTER TABLE toggles DROP CONSTRAINT IF EXISTS toggles_key_key;
+
+ALTER TABLE toggles ADD CONSTRAINT toggles_project_key_key UNIQUE (project, key);
+ALTER TABLE toggle_states ADD CONSTRAINT toggle_states_project_toggle_key_fkey
+  FOREIGN KEY (project, toggle_key) REFERENCES toggles (project, key) ON DELETE CASCADE;
+ALTER TABLE toggle_states ADD CONSTRAINT toggle_states_project_toggle_key_environment_key
+  UNIQUE (project, toggle_key, environment);
+
+ALTER TABLE toggles ALTER COLUMN project DROP DEFAULT;
+ALTER TABLE toggle_states ALTER COLUMN project DROP DEFAULT;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS segments (
+  id            BIGSERIAL       PRIMARY KEY,
+  project       TEXT            NOT NULL,
+  key           TEXT            NOT NULL,
+  description   TEXT,
+  included      TEXT[]          NOT NULL DEFAULT '{}',
+  excluded      TEXT[]          NOT NULL DEFAULT '{}',
+  rules         JSONB           NOT NULL DEFAULT '[]',
+  created_at    TIMESTAMP,
+  updated_at    TIMESTAMP,
+  CONSTRAINT segments_project_fkey FOREIGN KEY (project) REFERENCES projects (name),
+  CONSTRAINT segments_project_key_key UNIQUE (project, key)
+);
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS toggle_schedules (
+  id            BIGSERIAL       PRIMARY KEY,
+  project       TEXT            NOT NULL,
+  toggle_key    TEXT            NOT NULL,
+  environment   TEXT            NOT NULL,
+  action        TEXT            NOT NULL,
+  status        TEXT            NOT NULL DEFAULT 'PENDING',
+  execute_at    TIMESTAMP       NOT NULL,
+  executed_at   TIMESTAMP,
+  created_at    TIMESTAMP,
+  CONSTRAINT toggle_schedules_project_toggle_key_environment_fkey FOREIGN KEY (project, toggle_key, environment)
+    REFERENCES toggle_states (project, toggle_key, environment) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS index_on_status_execute_at_on_toggle_schedules ON toggle_schedules USING btree (status, execute_at);
+
+COMMIT;
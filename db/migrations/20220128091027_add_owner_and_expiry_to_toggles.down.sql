@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS index_on_expires_at_on_toggles;
+
+ALTER TABLE toggles DROP COLUMN IF EXISTS expiry_notified_at;
+ALTER TABLE toggles DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE toggles DROP COLUMN IF EXISTS owner;
+
+COMMIT;
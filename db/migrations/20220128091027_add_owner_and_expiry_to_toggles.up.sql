@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS owner TEXT NOT NULL DEFAULT '';
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP;
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS expiry_notified_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS index_on_expires_at_on_toggles ON toggles USING btree (expires_at) WHERE expiry_notified_at IS NULL;
+
+COMMIT;
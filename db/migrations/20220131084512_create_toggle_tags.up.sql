@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS toggle_tags (
+  id            BIGSERIAL       PRIMARY KEY,
+  project       TEXT            NOT NULL,
+  toggle_key    TEXT            NOT NULL,
+  tag           TEXT            NOT NULL,
+  CONSTRAINT toggle_tags_project_toggle_key_fkey FOREIGN KEY (project, toggle_key)
+    REFERENCES toggles (project, key) ON DELETE CASCADE,
+  CONSTRAINT toggle_tags_project_toggle_key_tag_key UNIQUE (project, toggle_key, tag)
+);
+
+CREATE INDEX IF NOT EXISTS index_on_project_tag_on_toggle_tags ON toggle_tags USING btree (project, tag);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS index_on_project_environment_updated_at_on_toggle_states;
+DROP INDEX IF EXISTS index_on_description_search_on_toggles;
+DROP INDEX IF EXISTS index_on_project_created_at_key_on_toggles;
+DROP INDEX IF EXISTS index_on_project_key_pattern_on_toggles;
+
+COMMIT;
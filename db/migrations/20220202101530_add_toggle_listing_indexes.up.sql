@@ -0,0 +1,8 @@
+BEGIN;
+
+CREATE INDEX IF NOT EXISTS index_on_project_key_pattern_on_toggles ON toggles USING btree (project, key text_pattern_ops);
+CREATE INDEX IF NOT EXISTS index_on_project_created_at_key_on_toggles ON toggles USING btree (project, created_at, key);
+CREATE INDEX IF NOT EXISTS index_on_description_search_on_toggles ON toggles USING gin (to_tsvector('simple', description));
+CREATE INDEX IF NOT EXISTS index_on_project_environment_updated_at_on_toggle_states ON toggle_states USING btree (project, environment, updated_at, toggle_key);
+
+COMMIT;
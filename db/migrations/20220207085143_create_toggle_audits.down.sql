@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS toggle_audits;
+DROP FUNCTION IF EXISTS reject_toggle_audits_change();
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS toggle_audits (
+  id            BIGSERIAL       PRIMARY KEY,
+  project       TEXT            NOT NULL,
+  toggle_key    TEXT            NOT NULL,
+  environment   TEXT            NOT NULL,
+  action        TEXT            NOT NULL,
+  actor         TEXT            NOT NULL DEFAULT '',
+  reason        TEXT            NOT NULL DEFAULT '',
+  request_id    TEXT            NOT NULL DEFAULT '',
+  before        JSONB,
+  after         JSONB,
+  created_at    TIMESTAMP       NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS index_on_project_toggle_key_id_on_toggle_audits ON toggle_audits USING btree (project, toggle_key, id);
+CREATE INDEX IF NOT EXISTS index_on_project_actor_id_on_toggle_audits ON toggle_audits USING btree (project, actor, id);
+CREATE INDEX IF NOT EXISTS index_on_project_created_at_on_toggle_audits ON toggle_audits USING btree (project, created_at);
+
+-- The audit log is append-only: its entries can never be changed nor removed.
+CREATE OR REPLACE FUNCTION reject_toggle_audits_change() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'toggle_audits is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS toggle_audits_append_only ON toggle_audits;
+CREATE TRIGGER toggle_audits_append_only BEFORE UPDATE OR DELETE OR TRUNCATE ON toggle_audits
+  FOR EACH STATEMENT EXECUTE PROCEDURE reject_toggle_audits_change();
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS index_on_deleted_at_on_toggles;
+
+ALTER TABLE toggles DROP COLUMN IF EXISTS deleted_at;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE toggles ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS index_on_deleted_at_on_toggles ON toggles USING btree (deleted_at) WHERE deleted_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+-- The outbox holds toggle's events which have been committed along with the toggle's change
+-- but haven't been published to the message queue yet.
+CREATE TABLE IF NOT EXISTS toggle_outbox (
+  id            BIGSERIAL       PRIMARY KEY,
+  project       TEXT            NOT NULL,
+  toggle_key    TEXT            NOT NULL,
+  event         JSONB           NOT NULL,
+  attempts      INTEGER         NOT NULL DEFAULT 0,
+  last_error    TEXT            NOT NULL DEFAULT '',
+  available_at  TIMESTAMP       NOT NULL,
+  created_at    TIMESTAMP       NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS index_on_project_toggle_key_id_on_toggle_outbox ON toggle_outbox USING btree (project, toggle_key, id);
+CREATE INDEX IF NOT EXISTS index_on_available_at_on_toggle_outbox ON toggle_outbox USING btree (available_at);
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- The evaluation counts reported by the SDK, aggregated per toggle, served variant, and hour.
+-- The toggle isn't referenced since the SDK may report after the toggle is deleted.
+CREATE TABLE IF NOT EXISTS toggle_evaluations (
+  project            TEXT            NOT NULL,
+  environment        TEXT            NOT NULL,
+  toggle_key         TEXT            NOT NULL,
+  variant            TEXT            NOT NULL,
+  window_start       TIMESTAMP       NOT NULL,
+  count              BIGINT          NOT NULL,
+  last_evaluated_at  TIMESTAMP       NOT NULL,
+  PRIMARY KEY (project, environment, toggle_key, variant, window_start)
+);
+
+CREATE INDEX IF NOT EXISTS index_on_project_environment_window_start_on_toggle_evaluations ON toggle_evaluations USING btree (project, environment, window_start);
+
+COMMIT;
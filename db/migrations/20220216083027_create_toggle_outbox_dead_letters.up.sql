@@ -0,0 +1,16 @@
+BEGIN;
+
+-- The dead letters hold the outbox's events which can't be read after many attempts.
+-- They are moved out of the outbox so that they don't hold back the next events of their toggles.
+CREATE TABLE IF NOT EXISTS toggle_outbox_dead_letters (
+  id            BIGINT          PRIMARY KEY,
+  project       TEXT            NOT NULL,
+  toggle_key    TEXT            NOT NULL,
+  event         JSONB           NOT NULL,
+  attempts      INTEGER         NOT NULL,
+  last_error    TEXT            NOT NULL,
+  created_at    TIMESTAMP       NOT NULL,
+  dead_at       TIMESTAMP       NOT NULL
+);
+
+COMMIT;
@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"context"
+	"time"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// AuditAction defines what was done to a toggle.
+type AuditAction string
+
+const (
+	// AuditActionCreate means the toggle was created.
+	AuditActionCreate AuditAction = "CREATE"
+	// AuditActionEnable means the toggle was enabled.
+	AuditActionEnable AuditAction = "ENABLE"
+	// AuditActionDisable means the toggle was disabled.
+	AuditActionDisable AuditAction = "DISABLE"
+	// AuditActionDelete means the toggle was soft-deleted.
+	AuditActionDelete AuditAction = "DELETE"
+	// AuditActionRestore means the soft-deleted toggle was restored.
+	AuditActionRestore AuditAction = "RESTORE"
+	// AuditActionPurge means the toggle was gone forever.
+	AuditActionPurge AuditAction = "PURGE"
+	// AuditActionUpdate means the toggle's fields, such as its description or prerequisites, were updated.
+	AuditActionUpdate AuditAction = "UPDATE"
+)
+
+var (
+	protoAuditActions = map[AuditAction]togglev1.AuditAction{
+		AuditActionCreate:  togglev1.AuditAction_AUDIT_ACTION_CREATE,
+		AuditActionEnable:  togglev1.AuditAction_AUDIT_ACTION_ENABLE,
+		AuditActionDisable: togglev1.AuditAction_AUDIT_ACTION_DISABLE,
+		AuditActionDelete:  togglev1.AuditAction_AUDIT_ACTION_DELETE,
+		AuditActionRestore: togglev1.AuditAction_AUDIT_ACTION_RESTORE,
+		AuditActionPurge:   togglev1.AuditAction_AUDIT_ACTION_PURGE,
+		AuditActionUpdate:  togglev1.AuditAction_AUDIT_ACTION_UPDATE,
+	}
+)
+
+// auditMetadataKey is the context's key of the audit metadata.
+type auditMetadataKey struct{}
+
+// Audit defines an entry of the toggles' audit log.
+// An entry records a single change of a toggle in an environment.
+type Audit struct {
+	// ID defines the entry's unique identifier.
+	// It increases along with the time the entry is recorded.
+	ID int64
+	// Project defines the name of the project the toggle belongs to.
+	Project string
+	// Key defines the changed toggle's key.
+	Key string
+	// Environment defines the name of the environment where the change happened.
+	Environment string
+	// Action defines what was done to the toggle.
+	Action AuditAction
+	// Actor defines who made the change.
+	Actor string
+	// Reason defines why the change was made.
+	Reason string
+	// RequestID defines the ID of the request which made the change.
+	RequestID string
+	// Before defines the toggle before the change.
+	// It is nil if the toggle was created.
+	Before *Toggle
+	// After defines the toggle after the change.
+	// It is nil if the toggle was purged.
+	After *Toggle
+	// CreatedAt defines the time when the change was made.
+	CreatedAt time.Time
+}
+
+// AuditMetadata defines who changes toggles and why.
+// It travels along with the request's context until the change is recorded.
+type AuditMetadata struct {
+	// Actor defines who makes the change.
+	Actor string
+	// Reason defines why the change is made.
+	Reason string
+	// RequestID defines the ID of the request which makes the change.
+	RequestID string
+}
+
+// AuditFilter defines the criteria of the audit log's entries.
+// Empty field means the criterion is not applied.
+type AuditFilter struct {
+	// Key defines the toggle's key.
+	Key string
+	// Actor defines who made the change.
+	Actor string
+	// From defines the earliest time of the change, inclusive.
+	From time.Time
+	// To defines the latest time of the change, exclusive.
+	To time.Time
+}
+
+// AuditPagination defines the keyset pagination of the audit log.
+// The entries are sorted by their IDs descending, hence the newest entry comes first.
+type AuditPagination struct {
+	// BeforeID defines the ID of the last entry in the previous page.
+	// It is zero for the first page.
+	BeforeID int64
+	// Limit defines the maximum number of entries in the page.
+	Limit uint
+}
+
+// AuditPage defines a page of the audit log.
+type AuditPage struct {
+	// Audits defines the entries in the page.
+	Audits []*Audit
+	// NextPageToken defines the token to retrieve the next page.
+	// It is empty if there isn't any next page.
+	NextPageToken string
+}
+
+// NewAudit creates an entry of the toggle's change using the audit metadata in the context.
+// The toggle is identified by after, or by before if after is nil.
+func NewAudit(ctx context.Context, action AuditAction, before, after *Toggle) *Audit {
+	md := AuditMetadataFromContext(ctx)
+	res := &Audit{
+		Action:    action,
+		Actor:     md.Actor,
+		Reason:    md.Reason,
+		RequestID: md.RequestID,
+		Before:    before,
+		After:     after,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	toggle := after
+	if toggle == nil {
+		toggle = before
+	}
+	if toggle != nil {
+		res.Project = toggle.Project
+		res.Key = toggle.Key
+		res.Environment = toggle.Environment
+	}
+	return res
+}
+
+// ContextWithAuditMetadata returns a copy of the context which carries the audit metadata.
+func ContextWithAuditMetadata(ctx context.Context, md *AuditMetadata) context.Context {
+	return context.WithValue(ctx, auditMetadataKey{}, md)
+}
+
+// AuditMetadataFromContext gets the audit metadata carried by the context.
+// It returns empty metadata if the context doesn't carry any.
+func AuditMetadataFromContext(ctx context.Context) *AuditMetadata {
+	md, ok := ctx.Value(auditMetadataKey{}).(*AuditMetadata)
+	if !ok || md == nil {
+		return &AuditMetadata{}
+	}
+	return md
+}
+
+// AuditActionToProto converts audit action to proto audit action.
+func AuditActionToProto(action AuditAction) togglev1.AuditAction {
+	return protoAuditActions[action]
+}
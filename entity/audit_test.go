@@ -0,0 +1,61 @@
+package entity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestNewAudit(t *testing.T) {
+	toggle := &entity.Toggle{Key: "toggle-1", Project: "checkout", Environment: "production"}
+
+	t.Run("audit takes the metadata from context", func(t *testing.T) {
+		md := &entity.AuditMetadata{Actor: "jane", Reason: "incident", RequestID: "req-1"}
+		ctx := entity.ContextWithAuditMetadata(context.Background(), md)
+
+		res := entity.NewAudit(ctx, entity.AuditActionCreate, nil, toggle)
+
+		assert.Equal(t, entity.AuditActionCreate, res.Action)
+		assert.Equal(t, "jane", res.Actor)
+		assert.Equal(t, "incident", res.Reason)
+		assert.Equal(t, "req-1", res.RequestID)
+		assert.Equal(t, "toggle-1", res.Key)
+		assert.Equal(t, "checkout", res.Project)
+		assert.Equal(t, "production", res.Environment)
+		assert.Nil(t, res.Before)
+		assert.Equal(t, toggle, res.After)
+		assert.False(t, res.CreatedAt.IsZero())
+	})
+
+	t.Run("deleted toggle is identified by its previous value", func(t *testing.T) {
+		res := entity.NewAudit(context.Background(), entity.AuditActionDelete, toggle, nil)
+
+		assert.Empty(t, res.Actor)
+		assert.Equal(t, "toggle-1", res.Key)
+		assert.Equal(t, "checkout", res.Project)
+		assert.Equal(t, "production", res.Environment)
+	})
+}
+
+func TestAuditMetadataFromContext(t *testing.T) {
+	t.Run("context without metadata returns empty metadata", func(t *testing.T) {
+		assert.Equal(t, &entity.AuditMetadata{}, entity.AuditMetadataFromContext(context.Background()))
+		assert.Equal(t, &entity.AuditMetadata{}, entity.AuditMetadataFromContext(entity.ContextWithAuditMetadata(context.Background(), nil)))
+	})
+
+	t.Run("successfully get metadata from context", func(t *testing.T) {
+		md := &entity.AuditMetadata{Actor: "jane"}
+		assert.Equal(t, md, entity.AuditMetadataFromContext(entity.ContextWithAuditMetadata(context.Background(), md)))
+	})
+}
+
+func TestAuditActionToProto(t *testing.T) {
+	t.Run("successfully convert audit action to proto", func(t *testing.T) {
+		assert.Equal(t, togglev1.AuditAction_AUDIT_ACTION_DISABLE, entity.AuditActionToProto(entity.AuditActionDisable))
+		assert.Equal(t, togglev1.AuditAction_AUDIT_ACTION_UNSPECIFIED, entity.AuditActionToProto(""))
+	})
+}
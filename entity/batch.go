@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"google.golang.org/grpc/status"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// MaxBatchSize is the maximum number of items in a batch.
+const MaxBatchSize = 100
+
+// ToggleOperationType defines what an operation in a batch does to its toggle.
+type ToggleOperationType string
+
+const (
+	// ToggleOperationEnable enables the toggle in the environment.
+	ToggleOperationEnable ToggleOperationType = "ENABLE"
+	// ToggleOperationDisable disables the toggle in the environment.
+	ToggleOperationDisable ToggleOperationType = "DISABLE"
+	// ToggleOperationDelete soft-deletes the toggle from all environments.
+	ToggleOperationDelete ToggleOperationType = "DELETE"
+)
+
+var (
+	protoToggleOperationTypes = map[ToggleOperationType]togglev1.ToggleOperationType{
+		ToggleOperationEnable:  togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_ENABLE,
+		ToggleOperationDisable: togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_DISABLE,
+		ToggleOperationDelete:  togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_DELETE,
+	}
+)
+
+// ToggleOperation defines an operation on a toggle in a batch.
+type ToggleOperation struct {
+	// Key defines the toggle's key.
+	Key string
+	// Type defines what the operation does to the toggle.
+	Type ToggleOperationType
+	// Version defines the toggle's expected version. Zero means the version isn't checked.
+	Version int64
+}
+
+// BatchResult defines the result of an item in a batch.
+type BatchResult struct {
+	// Key defines the toggle's key.
+	Key string
+	// Version defines the toggle's version after the change.
+	Version int64
+	// Err defines why the change fails. It is nil if the change succeeds.
+	Err error
+}
+
+// IsValidToggleOperationType checks whether the operation type is known.
+func IsValidToggleOperationType(typ ToggleOperationType) bool {
+	_, ok := protoToggleOperationTypes[typ]
+	return ok
+}
+
+// ToggleOperationsFromProto converts proto toggle operations to toggle operations.
+// Unknown operation type is converted to empty type.
+func ToggleOperationsFromProto(operations []*togglev1.ToggleOperation) []*ToggleOperation {
+	var res []*ToggleOperation
+	for _, operation := range operations {
+		res = append(res, ToggleOperationFromProto(operation))
+	}
+	return res
+}
+
+// ToggleOperationFromProto converts proto toggle operation to toggle operation.
+func ToggleOperationFromProto(operation *togglev1.ToggleOperation) *ToggleOperation {
+	if operation == nil {
+		return nil
+	}
+	res := &ToggleOperation{Key: operation.GetKey(), Version: operation.GetExpectedVersion()}
+	for key, val := range protoToggleOperationTypes {
+		if val == operation.GetType() {
+			res.Type = key
+		}
+	}
+	return res
+}
+
+// BatchResultsToProto converts batch results to proto batch toggle results.
+func BatchResultsToProto(results []*BatchResult) []*togglev1.BatchToggleResult {
+	var res []*togglev1.BatchToggleResult
+	for _, result := range results {
+		tmp := &togglev1.BatchToggleResult{Key: result.Key, Version: result.Version}
+		if result.Err != nil {
+			tmp.ErrorCode = ToggleErrorCodeOf(result.Err)
+			tmp.ErrorMessage = status.Convert(result.Err).Message()
+		}
+		res = append(res, tmp)
+	}
+	return res
+}
+
+// ToggleErrorCodeOf returns the toggle error code in the error's details.
+// It returns TOGGLE_ERROR_CODE_INTERNAL if the error doesn't have any.
+func ToggleErrorCodeOf(err error) togglev1.ToggleErrorCode {
+	for _, detail := range status.Convert(err).Details() {
+		if te, ok := detail.(*togglev1.ToggleError); ok {
+			return te.GetErrorCode()
+		}
+	}
+	return togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INTERNAL
+}
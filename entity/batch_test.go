@@ -0,0 +1,71 @@
+package entity_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestIsValidToggleOperationType(t *testing.T) {
+	t.Run("known operation types are valid", func(t *testing.T) {
+		assert.True(t, entity.IsValidToggleOperationType(entity.ToggleOperationEnable))
+		assert.True(t, entity.IsValidToggleOperationType(entity.ToggleOperationDisable))
+		assert.True(t, entity.IsValidToggleOperationType(entity.ToggleOperationDelete))
+	})
+
+	t.Run("unknown operation type is invalid", func(t *testing.T) {
+		assert.False(t, entity.IsValidToggleOperationType(""))
+		assert.False(t, entity.IsValidToggleOperationType("PURGE"))
+	})
+}
+
+func TestToggleOperationsFromProto(t *testing.T) {
+	t.Run("successfully convert proto operations", func(t *testing.T) {
+		ops := []*togglev1.ToggleOperation{
+			{Key: "toggle-1", Type: togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_DISABLE, ExpectedVersion: 3},
+			{Key: "toggle-1", Type: togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_DELETE},
+			nil,
+		}
+
+		res := entity.ToggleOperationsFromProto(ops)
+
+		assert.Equal(t, []*entity.ToggleOperation{
+			{Key: "toggle-1", Type: entity.ToggleOperationDisable, Version: 3},
+			{Key: "toggle-1", Type: entity.ToggleOperationDelete},
+			nil,
+		}, res)
+	})
+
+	t.Run("unknown operation type is converted to empty type", func(t *testing.T) {
+		res := entity.ToggleOperationFromProto(&togglev1.ToggleOperation{Key: "toggle-1"})
+
+		assert.Equal(t, entity.ToggleOperationType(""), res.Type)
+	})
+}
+
+func TestBatchResultsToProto(t *testing.T) {
+	t.Run("successfully convert results with and without error", func(t *testing.T) {
+		results := []*entity.BatchResult{{Key: "toggle-1", Version: 2}, {Key: "toggle-2", Err: entity.ErrVersionConflict()}}
+
+		res := entity.BatchResultsToProto(results)
+
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, &togglev1.BatchToggleResult{Key: "toggle-1", Version: 2}, res[0])
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_VERSION_CONFLICT, res[1].GetErrorCode())
+		assert.Equal(t, "toggle has been changed, reload it and try again", res[1].GetErrorMessage())
+	})
+}
+
+func TestToggleErrorCodeOf(t *testing.T) {
+	t.Run("toggle error code is taken from the error's details", func(t *testing.T) {
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_NOT_FOUND, entity.ToggleErrorCodeOf(entity.ErrNotFound()))
+	})
+
+	t.Run("error without toggle error code is internal", func(t *testing.T) {
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INTERNAL, entity.ToggleErrorCodeOf(errors.New("unknown")))
+	})
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+)
+
+// Environment defines the logical data of an environment, such as development, staging, or production.
+// Each toggle has an independent state in every environment.
+type Environment struct {
+	// Name defines the environment's identifier.
+	// It must be unique from the rest.
+	Name string
+	// Description defines environment's description.
+	Description string
+	// CreatedAt defines the time when the environment was created.
+	CreatedAt time.Time
+}
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"fmt"
+	"strings"
+
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -114,8 +117,411 @@ func ErrProhibitedToDelete() error {
 	return res.Err()
 }
 
+// ErrInvalidRule returns codes.InvalidArgument explained that the toggle's rule is invalid.
+func ErrInvalidRule(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "rules",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_RULE,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
 func createBadRequest(details ...*errdetails.BadRequest_FieldViolation) *errdetails.BadRequest {
 	return &errdetails.BadRequest{
 		FieldViolations: details,
 	}
 }
+
+// ErrInvalidRollout returns codes.InvalidArgument explained that the toggle's rollout is invalid.
+func ErrInvalidRollout(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "rollout",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_ROLLOUT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidVariant returns codes.InvalidArgument explained that the toggle's variant is invalid.
+func ErrInvalidVariant(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "variants",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_VARIANT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidEnvironment returns codes.InvalidArgument explained that the environment's name is invalid.
+func ErrInvalidEnvironment() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "environment",
+		Description: "empty or contain character outside of alphanumeric and dash",
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_ENVIRONMENT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrEnvironmentNotFound returns codes.NotFound explained that the environment is not found.
+func ErrEnvironmentNotFound() error {
+	st := status.New(codes.NotFound, "environment is not found")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_ENVIRONMENT_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidProject returns codes.InvalidArgument explained that the project's name is invalid.
+func ErrInvalidProject() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "project",
+		Description: "empty or contain character outside of alphanumeric and dash",
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_PROJECT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrProjectNotFound returns codes.NotFound explained that the project is not found.
+func ErrProjectNotFound() error {
+	st := status.New(codes.NotFound, "project is not found")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_PROJECT_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrProjectNotEmpty returns codes.FailedPrecondition explained that the project still owns toggles.
+func ErrProjectNotEmpty() error {
+	st := status.New(codes.FailedPrecondition, "project still owns toggles hence it can't be deleted")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_PROJECT_NOT_EMPTY,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidSegment returns codes.InvalidArgument explained that the segment is invalid.
+func ErrInvalidSegment(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "segment",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_SEGMENT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrSegmentNotFound returns codes.NotFound explained that the segment is not found.
+func ErrSegmentNotFound() error {
+	st := status.New(codes.NotFound, "segment is not found")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_SEGMENT_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrSegmentInUse returns codes.FailedPrecondition explained that the segment is still referenced by toggles.
+func ErrSegmentInUse() error {
+	st := status.New(codes.FailedPrecondition, "segment is still used by toggles hence it can't be deleted")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_SEGMENT_IN_USE,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidPrerequisite returns codes.InvalidArgument explained that the toggle's prerequisite is invalid.
+func ErrInvalidPrerequisite(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "prerequisites",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_PREREQUISITE,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrPrerequisiteCycle returns codes.FailedPrecondition explained that the toggle's prerequisites create a dependency cycle.
+func ErrPrerequisiteCycle(path []string) error {
+	st := status.New(codes.FailedPrecondition, "toggle's prerequisites create a dependency cycle: "+strings.Join(path, " -> "))
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_PREREQUISITE_CYCLE,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrToggleHasDependents returns codes.FailedPrecondition explained that the toggle is still a prerequisite of other toggles.
+func ErrToggleHasDependents(dependents []string) error {
+	st := status.New(codes.FailedPrecondition, "toggle is still a prerequisite of "+strings.Join(dependents, ", ")+" hence it can't be deleted")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_HAS_DEPENDENTS,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidSchedule returns codes.InvalidArgument explained that the toggle's schedule is invalid.
+func ErrInvalidSchedule(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "schedule",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_SCHEDULE,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrScheduleNotFound returns codes.NotFound explained that the pending schedule is not found.
+func ErrScheduleNotFound() error {
+	st := status.New(codes.NotFound, "schedule is not found or it isn't pending anymore")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_SCHEDULE_NOT_FOUND,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidTag returns codes.InvalidArgument explained that the toggle's tag is invalid.
+func ErrInvalidTag() error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "tags",
+		Description: "contain character outside of alphanumeric, dash, underscore, dot, and a single colon",
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_TAG,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidQuery returns codes.InvalidArgument explained that the listing query's field is invalid.
+func ErrInvalidQuery(field, description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_QUERY,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidUpdateMask returns codes.InvalidArgument explained that the update mask's path can't be updated.
+func ErrInvalidUpdateMask(path string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "update_mask",
+		Description: path + " is unknown or immutable, only description, owner, expires_at, and tags can be updated",
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_UPDATE_MASK,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrVersionConflict returns codes.Aborted explained that the toggle's version doesn't match the expected version.
+func ErrVersionConflict() error {
+	st := status.New(codes.Aborted, "toggle has been changed, reload it and try again")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_VERSION_CONFLICT,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrWatchLagging returns codes.Unavailable explained that the watcher has fallen too far behind the toggle's events.
+func ErrWatchLagging() error {
+	st := status.New(codes.Unavailable, "watcher has fallen behind, reconnect with the last resume token")
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_WATCH_LAGGING,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidEvaluationReport returns codes.InvalidArgument explained that the evaluation report is invalid.
+func ErrInvalidEvaluationReport(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "counts",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_EVALUATION_REPORT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrInvalidBatch returns codes.InvalidArgument explained that the batch is invalid.
+func ErrInvalidBatch(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "batch",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_BATCH,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrBatchItemFailed returns the error of the batch's item at the index with the index in its message.
+// The error's code and details are kept, thus the client can still tell why the item fails.
+func ErrBatchItemFailed(index int, err error) error {
+	st := status.Convert(err).Proto()
+	st.Message = strings.TrimSuffix(fmt.Sprintf("item %d: %s", index, st.GetMessage()), ": ")
+	return status.FromProto(st).Err()
+}
+
+// ErrInvalidSnapshot returns codes.InvalidArgument explained that the snapshot is invalid.
+func ErrInvalidSnapshot(description string) error {
+	st := status.New(codes.InvalidArgument, "")
+	br := createBadRequest(&errdetails.BadRequest_FieldViolation{
+		Field:       "snapshot",
+		Description: description,
+	})
+
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_SNAPSHOT,
+	}
+	res, err := st.WithDetails(br, te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
+
+// ErrImportConflict returns codes.AlreadyExists explained that the toggles exist with a different content than the snapshot.
+func ErrImportConflict(description string) error {
+	st := status.New(codes.AlreadyExists, description)
+	te := &togglev1.ToggleError{
+		ErrorCode: togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_IMPORT_CONFLICT,
+	}
+	res, err := st.WithDetails(te)
+	if err != nil {
+		return st.Err()
+	}
+	return res.Err()
+}
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
 func TestErrInternal(t *testing.T) {
@@ -63,3 +66,223 @@ func TestErrProhibitedToDelete(t *testing.T) {
 		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
 	})
 }
+
+func TestErrInvalidRule(t *testing.T) {
+	t.Run("success get invalid rule error", func(t *testing.T) {
+		err := entity.ErrInvalidRule("")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidRollout(t *testing.T) {
+	t.Run("success get invalid rollout error", func(t *testing.T) {
+		err := entity.ErrInvalidRollout("")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidVariant(t *testing.T) {
+	t.Run("success get invalid variant error", func(t *testing.T) {
+		err := entity.ErrInvalidVariant("")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidEnvironment(t *testing.T) {
+	t.Run("success get invalid environment error", func(t *testing.T) {
+		err := entity.ErrInvalidEnvironment()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrEnvironmentNotFound(t *testing.T) {
+	t.Run("success get environment not found error", func(t *testing.T) {
+		err := entity.ErrEnvironmentNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrInvalidProject(t *testing.T) {
+	t.Run("success get invalid project error", func(t *testing.T) {
+		err := entity.ErrInvalidProject()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrProjectNotFound(t *testing.T) {
+	t.Run("success get project not found error", func(t *testing.T) {
+		err := entity.ErrProjectNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrProjectNotEmpty(t *testing.T) {
+	t.Run("success get project not empty error", func(t *testing.T) {
+		err := entity.ErrProjectNotEmpty()
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+	})
+}
+
+func TestErrInvalidSegment(t *testing.T) {
+	t.Run("success get invalid segment error", func(t *testing.T) {
+		err := entity.ErrInvalidSegment("key is empty")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrSegmentNotFound(t *testing.T) {
+	t.Run("success get segment not found error", func(t *testing.T) {
+		err := entity.ErrSegmentNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrSegmentInUse(t *testing.T) {
+	t.Run("success get segment in use error", func(t *testing.T) {
+		err := entity.ErrSegmentInUse()
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+	})
+}
+
+func TestErrInvalidPrerequisite(t *testing.T) {
+	t.Run("success get invalid prerequisite error", func(t *testing.T) {
+		err := entity.ErrInvalidPrerequisite("toggle can't be its own prerequisite")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrPrerequisiteCycle(t *testing.T) {
+	t.Run("success get prerequisite cycle error", func(t *testing.T) {
+		err := entity.ErrPrerequisiteCycle([]string{"toggle-1", "toggle-2", "toggle-1"})
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+		assert.Contains(t, err.Error(), "toggle-1 -> toggle-2 -> toggle-1")
+	})
+}
+
+func TestErrToggleHasDependents(t *testing.T) {
+	t.Run("success get toggle has dependents error", func(t *testing.T) {
+		err := entity.ErrToggleHasDependents([]string{"toggle-2"})
+
+		assert.Contains(t, err.Error(), "rpc error: code = FailedPrecondition")
+		assert.Contains(t, err.Error(), "toggle-2")
+	})
+}
+
+func TestErrInvalidSchedule(t *testing.T) {
+	t.Run("success get invalid schedule error", func(t *testing.T) {
+		err := entity.ErrInvalidSchedule("execution time must be in the future")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrScheduleNotFound(t *testing.T) {
+	t.Run("success get schedule not found error", func(t *testing.T) {
+		err := entity.ErrScheduleNotFound()
+
+		assert.Contains(t, err.Error(), "rpc error: code = NotFound")
+	})
+}
+
+func TestErrInvalidTag(t *testing.T) {
+	t.Run("success get invalid tag error", func(t *testing.T) {
+		err := entity.ErrInvalidTag()
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidQuery(t *testing.T) {
+	t.Run("success get invalid query error", func(t *testing.T) {
+		err := entity.ErrInvalidQuery("order_by", "order_by must be either key, created_at, or updated_at")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidUpdateMask(t *testing.T) {
+	t.Run("success get invalid update mask error", func(t *testing.T) {
+		err := entity.ErrInvalidUpdateMask("key")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrVersionConflict(t *testing.T) {
+	t.Run("success get version conflict error", func(t *testing.T) {
+		err := entity.ErrVersionConflict()
+
+		assert.Contains(t, err.Error(), "rpc error: code = Aborted")
+	})
+}
+
+func TestErrWatchLagging(t *testing.T) {
+	t.Run("success get watch lagging error", func(t *testing.T) {
+		err := entity.ErrWatchLagging()
+
+		assert.Contains(t, err.Error(), "rpc error: code = Unavailable")
+	})
+}
+
+func TestErrInvalidEvaluationReport(t *testing.T) {
+	t.Run("success get invalid evaluation report error", func(t *testing.T) {
+		err := entity.ErrInvalidEvaluationReport("count must be positive")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrInvalidBatch(t *testing.T) {
+	t.Run("success get invalid batch error", func(t *testing.T) {
+		err := entity.ErrInvalidBatch("batch must not be empty")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrBatchItemFailed(t *testing.T) {
+	t.Run("item's index is added to the error's message", func(t *testing.T) {
+		err := entity.ErrBatchItemFailed(2, entity.ErrVersionConflict())
+
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Equal(t, "item 2: toggle has been changed, reload it and try again", status.Convert(err).Message())
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_VERSION_CONFLICT, entity.ToggleErrorCodeOf(err))
+	})
+
+	t.Run("error without message only has the item's index", func(t *testing.T) {
+		err := entity.ErrBatchItemFailed(0, entity.ErrNotFound())
+
+		assert.Equal(t, "item 0", status.Convert(err).Message())
+	})
+}
+
+func TestErrInvalidSnapshot(t *testing.T) {
+	t.Run("success get invalid snapshot error", func(t *testing.T) {
+		err := entity.ErrInvalidSnapshot("snapshot must not be empty")
+
+		assert.Contains(t, err.Error(), "rpc error: code = InvalidArgument")
+	})
+}
+
+func TestErrImportConflict(t *testing.T) {
+	t.Run("success get import conflict error", func(t *testing.T) {
+		err := entity.ErrImportConflict("toggle-1 differs from the snapshot")
+
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Equal(t, "toggle-1 differs from the snapshot", status.Convert(err).Message())
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_IMPORT_CONFLICT, entity.ToggleErrorCodeOf(err))
+	})
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// EvaluationReason defines why a toggle is evaluated to a certain value.
+type EvaluationReason string
+
+const (
+	// EvaluationReasonDisabled means the toggle is disabled, hence it is evaluated to false.
+	EvaluationReasonDisabled EvaluationReason = "DISABLED"
+	// EvaluationReasonRuleMatch means one of the toggle's rules matches the evaluation context.
+	EvaluationReasonRuleMatch EvaluationReason = "RULE_MATCH"
+	// EvaluationReasonFallthrough means none of the toggle's rules matches, hence the default value is served.
+	EvaluationReasonFallthrough EvaluationReason = "FALLTHROUGH"
+	// EvaluationReasonRollout means none of the toggle's rules matches and the value is decided by the percentage rollout.
+	EvaluationReasonRollout EvaluationReason = "ROLLOUT"
+	// EvaluationReasonPrerequisiteFailed means one of the toggle's prerequisites isn't satisfied, hence it is evaluated to false.
+	EvaluationReasonPrerequisiteFailed EvaluationReason = "PREREQUISITE_FAILED"
+)
+
+var (
+	protoEvaluationReasons = map[EvaluationReason]togglev1.EvaluationReason{
+		EvaluationReasonDisabled:           togglev1.EvaluationReason_EVALUATION_REASON_DISABLED,
+		EvaluationReasonRuleMatch:          togglev1.EvaluationReason_EVALUATION_REASON_RULE_MATCH,
+		EvaluationReasonFallthrough:        togglev1.EvaluationReason_EVALUATION_REASON_FALLTHROUGH,
+		EvaluationReasonRollout:            togglev1.EvaluationReason_EVALUATION_REASON_ROLLOUT,
+		EvaluationReasonPrerequisiteFailed: togglev1.EvaluationReason_EVALUATION_REASON_PREREQUISITE_FAILED,
+	}
+)
+
+// Evaluation defines the result of evaluating a toggle against an evaluation context.
+type Evaluation struct {
+	// Key defines the evaluated toggle's key.
+	Key string
+	// Value defines the resolved value of the toggle.
+	Value bool
+	// Reason defines why the value is resolved.
+	Reason EvaluationReason
+	// MatchedRule defines the rule that matches the evaluation context.
+	// It is nil if none of the rules matches.
+	MatchedRule *Rule
+	// Variant defines the resolved variant of a multivariate toggle.
+	// It is nil if the toggle doesn't have any variant.
+	Variant *Variant
+	// FailedPrerequisite defines the key of the first prerequisite that isn't satisfied.
+	// It is empty if all prerequisites are satisfied.
+	FailedPrerequisite string
+	// Version defines the version of the evaluated toggle.
+	Version int64
+}
+
+// EvaluationReasonToProto converts evaluation reason to proto evaluation reason.
+func EvaluationReasonToProto(reason EvaluationReason) togglev1.EvaluationReason {
+	return protoEvaluationReasons[reason]
+}
+
+// EvaluationReasonFromProto converts proto evaluation reason to evaluation reason.
+// Unknown reason is converted to empty reason.
+func EvaluationReasonFromProto(reason togglev1.EvaluationReason) EvaluationReason {
+	for key, val := range protoEvaluationReasons {
+		if val == reason {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,24 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestEvaluationReasonToProto(t *testing.T) {
+	t.Run("successfully convert evaluation reason to proto", func(t *testing.T) {
+		assert.Equal(t, togglev1.EvaluationReason_EVALUATION_REASON_RULE_MATCH, entity.EvaluationReasonToProto(entity.EvaluationReasonRuleMatch))
+		assert.Equal(t, togglev1.EvaluationReason_EVALUATION_REASON_UNSPECIFIED, entity.EvaluationReasonToProto(""))
+	})
+}
+
+func TestEvaluationReasonFromProto(t *testing.T) {
+	t.Run("successfully convert proto evaluation reason", func(t *testing.T) {
+		assert.Equal(t, entity.EvaluationReasonFallthrough, entity.EvaluationReasonFromProto(togglev1.EvaluationReason_EVALUATION_REASON_FALLTHROUGH))
+		assert.Empty(t, entity.EvaluationReasonFromProto(togglev1.EvaluationReason_EVALUATION_REASON_UNSPECIFIED))
+	})
+}
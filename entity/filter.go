@@ -0,0 +1,25 @@
+package entity
+
+// ToggleFilter defines the conditions the listed toggles must satisfy.
+type ToggleFilter struct {
+	// AnyTags defines the tags of which the toggle must have at least one.
+	// It is ignored if it is empty.
+	AnyTags []string
+	// AllTags defines the tags the toggle must have.
+	// It is ignored if it is empty.
+	AllTags []string
+	// KeyPrefix defines the prefix the toggle's key must start with.
+	// It is ignored if it is empty.
+	KeyPrefix string
+	// Search defines the words the toggle's description must contain.
+	// It is ignored if it is empty.
+	Search string
+	// IncludeDeleted defines whether the soft-deleted toggles are included.
+	IncludeDeleted bool
+}
+
+// IsEmpty checks whether the filter doesn't have any condition.
+// Nil filter is empty.
+func (f *ToggleFilter) IsEmpty() bool {
+	return f == nil || (len(f.AnyTags) == 0 && len(f.AllTags) == 0 && f.KeyPrefix == "" && f.Search == "" && !f.IncludeDeleted)
+}
@@ -0,0 +1,29 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+func TestToggleFilter_IsEmpty(t *testing.T) {
+	t.Run("nil filter is empty", func(t *testing.T) {
+		var filter *entity.ToggleFilter
+		assert.True(t, filter.IsEmpty())
+	})
+
+	t.Run("filter without tags is empty", func(t *testing.T) {
+		filter := &entity.ToggleFilter{AnyTags: []string{}}
+		assert.True(t, filter.IsEmpty())
+	})
+
+	t.Run("filter with any condition isn't empty", func(t *testing.T) {
+		assert.False(t, (&entity.ToggleFilter{AnyTags: []string{"team:payments"}}).IsEmpty())
+		assert.False(t, (&entity.ToggleFilter{AllTags: []string{"kind:kill-switch"}}).IsEmpty())
+		assert.False(t, (&entity.ToggleFilter{KeyPrefix: "checkout-"}).IsEmpty())
+		assert.False(t, (&entity.ToggleFilter{Search: "payment"}).IsEmpty())
+		assert.False(t, (&entity.ToggleFilter{IncludeDeleted: true}).IsEmpty())
+	})
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// OutboxEvent defines a toggle's event waiting in the outbox to be published.
+// The event is written in the same transaction as the toggle's change,
+// hence it is never lost even if the message queue is unavailable at that time.
+type OutboxEvent struct {
+	// ID defines the event's unique identifier.
+	// It increases along with the time the event is written, hence it orders the toggle's events.
+	ID int64
+	// Project defines the name of the project the toggle belongs to.
+	Project string
+	// Key defines the key of the toggle the event belongs to.
+	Key string
+	// Event defines the event to be published.
+	// It is nil if the event is malformed.
+	Event *togglev1.ToggleEvent
+	// Malformed defines why the event can't be read from the outbox, e.g. it was written by a newer server version.
+	// A malformed event can't be published.
+	Malformed string
+	// Attempts defines how many times the event has been claimed to be published, including the current claim.
+	Attempts int
+	// CreatedAt defines the time when the event was written.
+	CreatedAt time.Time
+}
+
+// NewOutboxEvent creates an outbox event of the toggle's event.
+// The toggle is identified by the event's project and toggle's key.
+func NewOutboxEvent(event *togglev1.ToggleEvent) *OutboxEvent {
+	return &OutboxEvent{
+		Project:   event.GetProject(),
+		Key:       event.GetToggle().GetKey(),
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+	}
+}
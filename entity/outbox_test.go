@@ -0,0 +1,24 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+func TestNewOutboxEvent(t *testing.T) {
+	t.Run("outbox event is identified by the event's toggle", func(t *testing.T) {
+		event := entity.EventToggleEnabled(&entity.Toggle{Key: "toggle-1", Project: "checkout", Environment: "production"})
+
+		res := entity.NewOutboxEvent(event)
+
+		assert.Zero(t, res.ID)
+		assert.Equal(t, "checkout", res.Project)
+		assert.Equal(t, "toggle-1", res.Key)
+		assert.Equal(t, event, res.Event)
+		assert.Zero(t, res.Attempts)
+		assert.False(t, res.CreatedAt.IsZero())
+	})
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"time"
+)
+
+// ToggleOrderField defines the field used to sort toggles.
+type ToggleOrderField string
+
+const (
+	// ToggleOrderByKey sorts toggles by their keys.
+	ToggleOrderByKey ToggleOrderField = "key"
+	// ToggleOrderByCreatedAt sorts toggles by the time they were created.
+	ToggleOrderByCreatedAt ToggleOrderField = "created_at"
+	// ToggleOrderByUpdatedAt sorts toggles by the time their states were last updated.
+	ToggleOrderByUpdatedAt ToggleOrderField = "updated_at"
+)
+
+// IsValidToggleOrderField checks whether the field can be used to sort toggles.
+func IsValidToggleOrderField(field ToggleOrderField) bool {
+	return field == ToggleOrderByKey || field == ToggleOrderByCreatedAt || field == ToggleOrderByUpdatedAt
+}
+
+// PageRequest defines the requested page of a listing.
+type PageRequest struct {
+	// Size defines the maximum number of items in the page.
+	// Zero means the default size.
+	Size uint32
+	// Token defines the opaque token returned by the previous page.
+	// It is empty for the first page.
+	Token string
+	// OrderBy defines the sort field, optionally followed by " desc".
+	// Empty means the default order.
+	OrderBy string
+}
+
+// ToggleCursor defines the position of the last toggle in the previous page.
+type ToggleCursor struct {
+	// Key defines the last toggle's key.
+	Key string
+	// Time defines the last toggle's created_at or updated_at, depending on the sort field.
+	// It is zero if the toggles are sorted by key.
+	Time time.Time
+}
+
+// TogglePagination defines the keyset pagination of toggles.
+// Toggles are sorted by OrderBy and then by key, hence the order is total and stable under concurrent inserts.
+type TogglePagination struct {
+	// OrderBy defines the sort field.
+	OrderBy ToggleOrderField
+	// Descending defines whether the toggles are sorted descending.
+	Descending bool
+	// After defines the position after which the page starts.
+	// It is nil for the first page.
+	After *ToggleCursor
+	// Limit defines the maximum number of toggles in the page.
+	Limit uint
+}
+
+// TogglePage defines a page of toggles.
+type TogglePage struct {
+	// Toggles defines the toggles in the page.
+	Toggles []*Toggle
+	// NextPageToken defines the token to retrieve the next page.
+	// It is empty if there isn't any next page.
+	NextPageToken string
+	// TotalSize defines the number of toggles which satisfy the filter across all pages.
+	TotalSize int
+}
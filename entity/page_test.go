@@ -0,0 +1,22 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+func TestIsValidToggleOrderField(t *testing.T) {
+	t.Run("known fields are valid", func(t *testing.T) {
+		assert.True(t, entity.IsValidToggleOrderField(entity.ToggleOrderByKey))
+		assert.True(t, entity.IsValidToggleOrderField(entity.ToggleOrderByCreatedAt))
+		assert.True(t, entity.IsValidToggleOrderField(entity.ToggleOrderByUpdatedAt))
+	})
+
+	t.Run("unknown fields are invalid", func(t *testing.T) {
+		assert.False(t, entity.IsValidToggleOrderField(""))
+		assert.False(t, entity.IsValidToggleOrderField("description"))
+	})
+}
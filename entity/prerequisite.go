@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"fmt"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// Prerequisite defines a toggle which must be evaluated to a required value
+// before the dependent toggle is evaluated.
+type Prerequisite struct {
+	// Key defines the key of the prerequisite toggle in the same project.
+	Key string `json:"key"`
+	// Value defines the value the prerequisite toggle must be evaluated to.
+	Value bool `json:"value"`
+}
+
+// PrerequisitesFromProto converts list of proto prerequisites to list of prerequisites.
+// Empty list is converted to nil.
+func PrerequisitesFromProto(prerequisites []*togglev1.Prerequisite) []*Prerequisite {
+	var res []*Prerequisite
+	for _, prerequisite := range prerequisites {
+		res = append(res, PrerequisiteFromProto(prerequisite))
+	}
+	return res
+}
+
+// PrerequisiteFromProto converts proto prerequisite to prerequisite.
+func PrerequisiteFromProto(prerequisite *togglev1.Prerequisite) *Prerequisite {
+	if prerequisite == nil {
+		return nil
+	}
+	return &Prerequisite{
+		Key:   prerequisite.GetKey(),
+		Value: prerequisite.GetValue(),
+	}
+}
+
+// PrerequisitesToProto converts list of prerequisites to list of proto prerequisites.
+func PrerequisitesToProto(prerequisites []*Prerequisite) []*togglev1.Prerequisite {
+	var res []*togglev1.Prerequisite
+	for _, prerequisite := range prerequisites {
+		res = append(res, PrerequisiteToProto(prerequisite))
+	}
+	return res
+}
+
+// PrerequisiteToProto converts prerequisite to proto prerequisite.
+func PrerequisiteToProto(prerequisite *Prerequisite) *togglev1.Prerequisite {
+	if prerequisite == nil {
+		return nil
+	}
+	return &togglev1.Prerequisite{
+		Key:   prerequisite.Key,
+		Value: prerequisite.Value,
+	}
+}
+
+// CheckPrerequisiteGraph ensures that the prerequisites refer to existing toggles
+// and that they don't create a dependency cycle once they replace the toggle's current prerequisites in the graph.
+// The graph is keyed by the toggle's key.
+func CheckPrerequisiteGraph(key string, prerequisites []*Prerequisite, graph map[string][]*Prerequisite) error {
+	for i, prerequisite := range prerequisites {
+		if _, ok := graph[prerequisite.Key]; !ok {
+			return ErrInvalidPrerequisite(fmt.Sprintf("prerequisite %d: toggle %q doesn't exist", i, prerequisite.Key))
+		}
+	}
+
+	next := make(map[string][]*Prerequisite, len(graph)+1)
+	for k, v := range graph {
+		next[k] = v
+	}
+	next[key] = prerequisites
+	if path := findCycle(key, next, map[string]bool{}, []string{key}); path != nil {
+		return ErrPrerequisiteCycle(path)
+	}
+	return nil
+}
+
+// findCycle walks the graph depth-first from the last key in the path and returns the path
+// once it goes back to the first key in the path.
+// It returns nil if there isn't any cycle through the first key.
+func findCycle(start string, graph map[string][]*Prerequisite, visited map[string]bool, path []string) []string {
+	current := path[len(path)-1]
+	for _, prerequisite := range graph[current] {
+		next := append(path[:len(path):len(path)], prerequisite.Key)
+		if prerequisite.Key == start {
+			return next
+		}
+		if visited[prerequisite.Key] {
+			continue
+		}
+		visited[prerequisite.Key] = true
+		if res := findCycle(start, graph, visited, next); res != nil {
+			return res
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestPrerequisitesFromProto(t *testing.T) {
+	t.Run("successfully convert proto prerequisites", func(t *testing.T) {
+		prerequisites := entity.PrerequisitesFromProto([]*togglev1.Prerequisite{
+			{Key: "new-checkout", Value: true},
+			nil,
+		})
+
+		assert.Equal(t, 2, len(prerequisites))
+		assert.Equal(t, &entity.Prerequisite{Key: "new-checkout", Value: true}, prerequisites[0])
+		assert.Nil(t, prerequisites[1])
+	})
+
+	t.Run("empty proto prerequisites is converted to nil", func(t *testing.T) {
+		assert.Nil(t, entity.PrerequisitesFromProto([]*togglev1.Prerequisite{}))
+	})
+}
+
+func TestPrerequisitesToProto(t *testing.T) {
+	t.Run("successfully convert prerequisites to proto", func(t *testing.T) {
+		prerequisites := entity.PrerequisitesToProto([]*entity.Prerequisite{
+			{Key: "new-checkout", Value: true},
+			nil,
+		})
+
+		assert.Equal(t, 2, len(prerequisites))
+		assert.Equal(t, "new-checkout", prerequisites[0].GetKey())
+		assert.True(t, prerequisites[0].GetValue())
+		assert.Nil(t, prerequisites[1])
+	})
+}
+
+func TestCheckPrerequisiteGraph(t *testing.T) {
+	graph := map[string][]*entity.Prerequisite{
+		"toggle-1": nil,
+		"toggle-2": {{Key: "toggle-1", Value: true}},
+	}
+
+	t.Run("prerequisite refers to unknown toggle", func(t *testing.T) {
+		err := entity.CheckPrerequisiteGraph("toggle-3", []*entity.Prerequisite{{Key: "toggle-4", Value: true}}, graph)
+
+		assert.Equal(t, entity.ErrInvalidPrerequisite(`prerequisite 0: toggle "toggle-4" doesn't exist`), err)
+	})
+
+	t.Run("prerequisites create a dependency cycle", func(t *testing.T) {
+		err := entity.CheckPrerequisiteGraph("toggle-1", []*entity.Prerequisite{{Key: "toggle-2", Value: true}}, graph)
+
+		assert.Equal(t, entity.ErrPrerequisiteCycle([]string{"toggle-1", "toggle-2", "toggle-1"}), err)
+	})
+
+	t.Run("prerequisites are valid", func(t *testing.T) {
+		err := entity.CheckPrerequisiteGraph("toggle-3", []*entity.Prerequisite{{Key: "toggle-2", Value: true}}, graph)
+
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"time"
+)
+
+// Project defines the logical data of a project.
+// A project owns toggles, usually all toggles of a team.
+type Project struct {
+	// Name defines the project's identifier.
+	// It must be unique from the rest.
+	Name string
+	// Description defines project's description.
+	Description string
+	// CreatedAt defines the time when the project was created.
+	CreatedAt time.Time
+	// UpdatedAt defines the time when the project was last updated.
+	UpdatedAt time.Time
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// Rollout defines a percentage rollout of a toggle.
+type Rollout struct {
+	// Percentage defines the percentage of subjects that get true, from 0 to 100.
+	Percentage uint32 `json:"percentage"`
+	// BucketBy defines the evaluation context's attribute used to bucket a subject.
+	BucketBy string `json:"bucket_by"`
+}
+
+// RolloutFromProto converts proto rollout to rollout.
+func RolloutFromProto(rollout *togglev1.Rollout) *Rollout {
+	if rollout == nil {
+		return nil
+	}
+	return &Rollout{
+		Percentage: rollout.GetPercentage(),
+		BucketBy:   rollout.GetBucketBy(),
+	}
+}
+
+// RolloutToProto converts rollout to proto rollout.
+func RolloutToProto(rollout *Rollout) *togglev1.Rollout {
+	if rollout == nil {
+		return nil
+	}
+	return &togglev1.Rollout{
+		Percentage: rollout.Percentage,
+		BucketBy:   rollout.BucketBy,
+	}
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestRolloutFromProto(t *testing.T) {
+	t.Run("nil proto rollout is converted to nil", func(t *testing.T) {
+		assert.Nil(t, entity.RolloutFromProto(nil))
+	})
+
+	t.Run("successfully convert proto rollout", func(t *testing.T) {
+		rollout := entity.RolloutFromProto(&togglev1.Rollout{Percentage: 10, BucketBy: "user_id"})
+
+		assert.Equal(t, &entity.Rollout{Percentage: 10, BucketBy: "user_id"}, rollout)
+	})
+}
+
+func TestRolloutToProto(t *testing.T) {
+	t.Run("nil rollout is converted to nil", func(t *testing.T) {
+		assert.Nil(t, entity.RolloutToProto(nil))
+	})
+
+	t.Run("successfully convert rollout to proto", func(t *testing.T) {
+		rollout := entity.RolloutToProto(&entity.Rollout{Percentage: 50, BucketBy: "session_id"})
+
+		assert.Equal(t, uint32(50), rollout.GetPercentage())
+		assert.Equal(t, "session_id", rollout.GetBucketBy())
+	})
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// RuleOperator defines how a rule compares the evaluation context's attribute with the rule's values.
+type RuleOperator string
+
+const (
+	// RuleOperatorEquals matches if the attribute equals the only value.
+	RuleOperatorEquals RuleOperator = "equals"
+	// RuleOperatorNotEquals matches if the attribute doesn't equal the only value.
+	RuleOperatorNotEquals RuleOperator = "not-equals"
+	// RuleOperatorIn matches if the attribute equals one of the values.
+	RuleOperatorIn RuleOperator = "in"
+	// RuleOperatorNotIn matches if the attribute doesn't equal any of the values.
+	RuleOperatorNotIn RuleOperator = "not-in"
+	// RuleOperatorRegex matches if the attribute matches the regular expression in the only value.
+	RuleOperatorRegex RuleOperator = "regex"
+	// RuleOperatorSemverGreaterThan matches if the attribute is a semantic version greater than the only value.
+	RuleOperatorSemverGreaterThan RuleOperator = "semver-gt"
+	// RuleOperatorSemverLessThan matches if the attribute is a semantic version less than the only value.
+	RuleOperatorSemverLessThan RuleOperator = "semver-lt"
+	// RuleOperatorInSegment matches if the attribute belongs to one of the segments whose keys are the values.
+	RuleOperatorInSegment RuleOperator = "in-segment"
+	// RuleOperatorNotInSegment matches if the attribute doesn't belong to any of the segments whose keys are the values.
+	RuleOperatorNotInSegment RuleOperator = "not-in-segment"
+)
+
+var (
+	protoRuleOperators = map[togglev1.RuleOperator]RuleOperator{
+		togglev1.RuleOperator_RULE_OPERATOR_EQUALS:         RuleOperatorEquals,
+		togglev1.RuleOperator_RULE_OPERATOR_NOT_EQUALS:     RuleOperatorNotEquals,
+		togglev1.RuleOperator_RULE_OPERATOR_IN:             RuleOperatorIn,
+		togglev1.RuleOperator_RULE_OPERATOR_NOT_IN:         RuleOperatorNotIn,
+		togglev1.RuleOperator_RULE_OPERATOR_REGEX:          RuleOperatorRegex,
+		togglev1.RuleOperator_RULE_OPERATOR_SEMVER_GT:      RuleOperatorSemverGreaterThan,
+		togglev1.RuleOperator_RULE_OPERATOR_SEMVER_LT:      RuleOperatorSemverLessThan,
+		togglev1.RuleOperator_RULE_OPERATOR_IN_SEGMENT:     RuleOperatorInSegment,
+		togglev1.RuleOperator_RULE_OPERATOR_NOT_IN_SEGMENT: RuleOperatorNotInSegment,
+	}
+)
+
+// Rule defines a targeting rule of a toggle.
+type Rule struct {
+	// Attribute defines the name of the evaluation context's attribute.
+	Attribute string `json:"attribute"`
+	// Operator defines how the attribute is compared with the values.
+	Operator RuleOperator `json:"operator"`
+	// Values defines the values compared with the attribute.
+	Values []string `json:"values"`
+	// Value defines the value served when the rule matches.
+	Value bool `json:"value"`
+	// Variant defines the name of the variant served when the rule matches and its value is true.
+	// If it is empty, the toggle's default variant is served.
+	Variant string `json:"variant,omitempty"`
+}
+
+// RulesFromProto converts list of proto rules to list of rules.
+// Unknown operator is converted to empty operator.
+// Empty list is converted to nil.
+func RulesFromProto(rules []*togglev1.Rule) []*Rule {
+	var res []*Rule
+	for _, rule := range rules {
+		res = append(res, RuleFromProto(rule))
+	}
+	return res
+}
+
+// RuleFromProto converts proto rule to rule.
+func RuleFromProto(rule *togglev1.Rule) *Rule {
+	if rule == nil {
+		return nil
+	}
+	return &Rule{
+		Attribute: rule.GetAttribute(),
+		Operator:  protoRuleOperators[rule.GetOperator()],
+		Values:    rule.GetValues(),
+		Value:     rule.GetValue(),
+		Variant:   rule.GetVariant(),
+	}
+}
+
+// RulesToProto converts list of rules to list of proto rules.
+func RulesToProto(rules []*Rule) []*togglev1.Rule {
+	var res []*togglev1.Rule
+	for _, rule := range rules {
+		res = append(res, RuleToProto(rule))
+	}
+	return res
+}
+
+// RuleToProto converts rule to proto rule.
+func RuleToProto(rule *Rule) *togglev1.Rule {
+	if rule == nil {
+		return nil
+	}
+	return &togglev1.Rule{
+		Attribute: rule.Attribute,
+		Operator:  ruleOperatorToProto(rule.Operator),
+		Values:    rule.Values,
+		Value:     rule.Value,
+		Variant:   rule.Variant,
+	}
+}
+
+func ruleOperatorToProto(operator RuleOperator) togglev1.RuleOperator {
+	for key, val := range protoRuleOperators {
+		if val == operator {
+			return key
+		}
+	}
+	return togglev1.RuleOperator_RULE_OPERATOR_UNSPECIFIED
+}
@@ -0,0 +1,49 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestRulesFromProto(t *testing.T) {
+	t.Run("successfully convert proto rules", func(t *testing.T) {
+		rules := entity.RulesFromProto([]*togglev1.Rule{
+			{Attribute: "country", Operator: togglev1.RuleOperator_RULE_OPERATOR_IN, Values: []string{"ID", "SG"}, Value: true},
+			{Attribute: "country", Operator: togglev1.RuleOperator_RULE_OPERATOR_UNSPECIFIED},
+		})
+
+		assert.Equal(t, 2, len(rules))
+		assert.Equal(t, entity.RuleOperatorIn, rules[0].Operator)
+		assert.Equal(t, []string{"ID", "SG"}, rules[0].Values)
+		assert.True(t, rules[0].Value)
+		assert.Empty(t, rules[1].Operator)
+	})
+
+	t.Run("successfully convert proto rule referencing segment", func(t *testing.T) {
+		rules := entity.RulesFromProto([]*togglev1.Rule{
+			{Attribute: "user_id", Operator: togglev1.RuleOperator_RULE_OPERATOR_IN_SEGMENT, Values: []string{"beta-testers"}, Value: true},
+			{Attribute: "user_id", Operator: togglev1.RuleOperator_RULE_OPERATOR_NOT_IN_SEGMENT, Values: []string{"employees"}},
+		})
+
+		assert.Equal(t, 2, len(rules))
+		assert.Equal(t, entity.RuleOperatorInSegment, rules[0].Operator)
+		assert.Equal(t, entity.RuleOperatorNotInSegment, rules[1].Operator)
+	})
+}
+
+func TestRulesToProto(t *testing.T) {
+	t.Run("successfully convert rules to proto", func(t *testing.T) {
+		rules := entity.RulesToProto([]*entity.Rule{
+			{Attribute: "version", Operator: entity.RuleOperatorSemverGreaterThan, Values: []string{"1.2.0"}, Value: true},
+			{Attribute: "version", Operator: entity.RuleOperator("unknown")},
+		})
+
+		assert.Equal(t, 2, len(rules))
+		assert.Equal(t, togglev1.RuleOperator_RULE_OPERATOR_SEMVER_GT, rules[0].GetOperator())
+		assert.Equal(t, togglev1.RuleOperator_RULE_OPERATOR_UNSPECIFIED, rules[1].GetOperator())
+	})
+}
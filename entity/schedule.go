@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"time"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// ScheduleAction defines what a schedule does to its toggle.
+type ScheduleAction string
+
+const (
+	// ScheduleActionEnable enables the toggle.
+	ScheduleActionEnable ScheduleAction = "ENABLE"
+	// ScheduleActionDisable disables the toggle.
+	ScheduleActionDisable ScheduleAction = "DISABLE"
+)
+
+var (
+	protoScheduleActions = map[ScheduleAction]togglev1.ScheduleAction{
+		ScheduleActionEnable:  togglev1.ScheduleAction_SCHEDULE_ACTION_ENABLE,
+		ScheduleActionDisable: togglev1.ScheduleAction_SCHEDULE_ACTION_DISABLE,
+	}
+)
+
+// Schedule defines the logical data of a toggle's schedule.
+// A schedule enables or disables a toggle in an environment at a future time.
+type Schedule struct {
+	// ID defines the schedule's unique identifier.
+	ID int64
+	// Key defines the scheduled toggle's key.
+	Key string
+	// Project defines the name of the project the toggle belongs to.
+	Project string
+	// Environment defines the name of the environment where the action is executed.
+	Environment string
+	// Action defines what the schedule does to the toggle.
+	Action ScheduleAction
+	// ExecuteAt defines the time when the action must be executed.
+	ExecuteAt time.Time
+	// CreatedAt defines the time when the schedule was created.
+	CreatedAt time.Time
+}
+
+// IsValidScheduleAction checks whether the action is known.
+func IsValidScheduleAction(action ScheduleAction) bool {
+	_, ok := protoScheduleActions[action]
+	return ok
+}
+
+// ScheduleActionToProto converts schedule action to proto schedule action.
+func ScheduleActionToProto(action ScheduleAction) togglev1.ScheduleAction {
+	return protoScheduleActions[action]
+}
+
+// ScheduleActionFromProto converts proto schedule action to schedule action.
+// Unknown action is converted to empty action.
+func ScheduleActionFromProto(action togglev1.ScheduleAction) ScheduleAction {
+	for key, val := range protoScheduleActions {
+		if val == action {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,36 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestIsValidScheduleAction(t *testing.T) {
+	t.Run("known action is valid", func(t *testing.T) {
+		assert.True(t, entity.IsValidScheduleAction(entity.ScheduleActionEnable))
+		assert.True(t, entity.IsValidScheduleAction(entity.ScheduleActionDisable))
+	})
+
+	t.Run("unknown action is invalid", func(t *testing.T) {
+		assert.False(t, entity.IsValidScheduleAction(""))
+		assert.False(t, entity.IsValidScheduleAction("DELETE"))
+	})
+}
+
+func TestScheduleActionToProto(t *testing.T) {
+	t.Run("successfully convert schedule action to proto", func(t *testing.T) {
+		assert.Equal(t, togglev1.ScheduleAction_SCHEDULE_ACTION_ENABLE, entity.ScheduleActionToProto(entity.ScheduleActionEnable))
+		assert.Equal(t, togglev1.ScheduleAction_SCHEDULE_ACTION_UNSPECIFIED, entity.ScheduleActionToProto(""))
+	})
+}
+
+func TestScheduleActionFromProto(t *testing.T) {
+	t.Run("successfully convert proto schedule action", func(t *testing.T) {
+		assert.Equal(t, entity.ScheduleActionDisable, entity.ScheduleActionFromProto(togglev1.ScheduleAction_SCHEDULE_ACTION_DISABLE))
+		assert.Empty(t, entity.ScheduleActionFromProto(togglev1.ScheduleAction_SCHEDULE_ACTION_UNSPECIFIED))
+	})
+}
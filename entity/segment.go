@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+)
+
+// Segment defines the logical data of a segment.
+// A segment is a reusable group of subjects which can be targeted by toggle's rules
+// using RuleOperatorInSegment and RuleOperatorNotInSegment.
+type Segment struct {
+	// Key defines the segment's identifier.
+	// It must be unique within its project.
+	Key string
+	// Project defines the name of the project which owns the segment.
+	Project string
+	// Description defines segment's description.
+	Description string
+	// Included defines the identifiers of subjects which always belong to the segment.
+	Included []string
+	// Excluded defines the identifiers of subjects which never belong to the segment.
+	// It takes precedence over Included and Rules.
+	Excluded []string
+	// Rules defines the rules of the segment.
+	// A subject belongs to the segment if it matches any of the rules.
+	// The rule's Value and Variant are ignored.
+	Rules []*Rule
+	// CreatedAt defines the time when the segment was created.
+	CreatedAt time.Time
+	// UpdatedAt defines the time when the segment was last updated.
+	UpdatedAt time.Time
+}
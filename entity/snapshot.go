@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/grpc/status"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// SnapshotFormatVersion is the version of the snapshot's format produced by export.
+// It must be increased every time the format changes in a way the older importers can't read.
+const SnapshotFormatVersion = 1
+
+// ImportConflictPolicy defines what import does to a toggle which exists with a different content than the snapshot.
+type ImportConflictPolicy string
+
+const (
+	// ImportConflictSkip keeps the existing toggle as it is.
+	ImportConflictSkip ImportConflictPolicy = "SKIP"
+	// ImportConflictOverwrite changes the existing toggle to match the snapshot.
+	ImportConflictOverwrite ImportConflictPolicy = "OVERWRITE"
+	// ImportConflictFail fails the whole import before anything is changed.
+	ImportConflictFail ImportConflictPolicy = "FAIL"
+)
+
+// ImportAction defines what import does to a toggle.
+type ImportAction string
+
+const (
+	// ImportActionCreate means the toggle doesn't exist and is created.
+	ImportActionCreate ImportAction = "CREATE"
+	// ImportActionUpdate means the toggle exists with a different content and is changed to match the snapshot.
+	ImportActionUpdate ImportAction = "UPDATE"
+	// ImportActionDelete means the toggle isn't in the snapshot and is deleted.
+	ImportActionDelete ImportAction = "DELETE"
+	// ImportActionUnchanged means the toggle already matches the snapshot.
+	ImportActionUnchanged ImportAction = "UNCHANGED"
+	// ImportActionSkip means the toggle exists with a different content and is kept as it is.
+	ImportActionSkip ImportAction = "SKIP"
+)
+
+var (
+	importConflictPolicies = map[togglev1.ImportConflictPolicy]ImportConflictPolicy{
+		togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED: ImportConflictFail,
+		togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP:        ImportConflictSkip,
+		togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE:   ImportConflictOverwrite,
+		togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL:        ImportConflictFail,
+	}
+	protoImportActions = map[ImportAction]togglev1.ToggleImportAction{
+		ImportActionCreate:    togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_CREATE,
+		ImportActionUpdate:    togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_UPDATE,
+		ImportActionDelete:    togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_DELETE,
+		ImportActionUnchanged: togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_UNCHANGED,
+		ImportActionSkip:      togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_SKIP,
+	}
+)
+
+// Snapshot defines the state of all toggles in an environment at a point in time.
+type Snapshot struct {
+	// FormatVersion defines the version of the snapshot's format.
+	// Zero means SnapshotFormatVersion.
+	FormatVersion int32
+	// Project defines the name of the project the snapshot was taken from.
+	Project string
+	// Environment defines the name of the environment the snapshot was taken from.
+	Environment string
+	// CreatedAt defines the time when the snapshot was taken.
+	CreatedAt time.Time
+	// Toggles defines the toggles in the snapshot, sorted by key.
+	// Their IsEnabled defines the toggles' state in the environment.
+	Toggles []*Toggle
+}
+
+// ImportOptions defines how a snapshot is imported.
+type ImportOptions struct {
+	// ConflictPolicy defines what to do with the toggles which exist with a different content.
+	// Empty means ImportConflictFail.
+	ConflictPolicy ImportConflictPolicy
+	// Prune defines whether the toggles which aren't in the snapshot are deleted.
+	Prune bool
+	// DryRun defines whether the changes are only computed without being made.
+	DryRun bool
+}
+
+// ImportChange defines the change import makes to a toggle.
+type ImportChange struct {
+	// Key defines the toggle's key.
+	Key string
+	// Action defines what import does to the toggle.
+	Action ImportAction
+	// Fields defines the toggle's fields which differ from the snapshot, named after the API's field names.
+	// It is only set for updated and skipped toggles.
+	Fields []string
+	// Err defines why the change fails. It is nil if the change succeeds.
+	Err error
+}
+
+// IsValidImportConflictPolicy checks whether the conflict policy is known.
+func IsValidImportConflictPolicy(policy ImportConflictPolicy) bool {
+	switch policy {
+	case ImportConflictSkip, ImportConflictOverwrite, ImportConflictFail:
+		return true
+	}
+	return false
+}
+
+// ImportConflictPolicyFromProto converts proto import conflict policy to import conflict policy.
+// Unspecified policy is converted to ImportConflictFail while unknown policy is converted to empty policy.
+func ImportConflictPolicyFromProto(policy togglev1.ImportConflictPolicy) ImportConflictPolicy {
+	return importConflictPolicies[policy]
+}
+
+// ImportChangesToProto converts import changes to proto toggle import changes.
+func ImportChangesToProto(changes []*ImportChange) []*togglev1.ToggleImportChange {
+	var res []*togglev1.ToggleImportChange
+	for _, change := range changes {
+		tmp := &togglev1.ToggleImportChange{
+			Key:    change.Key,
+			Action: protoImportActions[change.Action],
+			Fields: change.Fields,
+		}
+		if change.Err != nil {
+			tmp.ErrorCode = ToggleErrorCodeOf(change.Err)
+			tmp.ErrorMessage = status.Convert(change.Err).Message()
+		}
+		res = append(res, tmp)
+	}
+	return res
+}
@@ -0,0 +1,61 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestIsValidImportConflictPolicy(t *testing.T) {
+	t.Run("known conflict policies are valid", func(t *testing.T) {
+		assert.True(t, entity.IsValidImportConflictPolicy(entity.ImportConflictSkip))
+		assert.True(t, entity.IsValidImportConflictPolicy(entity.ImportConflictOverwrite))
+		assert.True(t, entity.IsValidImportConflictPolicy(entity.ImportConflictFail))
+	})
+
+	t.Run("unknown conflict policy is invalid", func(t *testing.T) {
+		assert.False(t, entity.IsValidImportConflictPolicy(""))
+		assert.False(t, entity.IsValidImportConflictPolicy("MERGE"))
+	})
+}
+
+func TestImportConflictPolicyFromProto(t *testing.T) {
+	t.Run("successfully convert proto conflict policies", func(t *testing.T) {
+		assert.Equal(t, entity.ImportConflictSkip, entity.ImportConflictPolicyFromProto(togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP))
+		assert.Equal(t, entity.ImportConflictOverwrite, entity.ImportConflictPolicyFromProto(togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE))
+		assert.Equal(t, entity.ImportConflictFail, entity.ImportConflictPolicyFromProto(togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL))
+	})
+
+	t.Run("unspecified conflict policy is converted to fail", func(t *testing.T) {
+		assert.Equal(t, entity.ImportConflictFail, entity.ImportConflictPolicyFromProto(togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED))
+	})
+
+	t.Run("unknown conflict policy is converted to empty policy", func(t *testing.T) {
+		assert.Equal(t, entity.ImportConflictPolicy(""), entity.ImportConflictPolicyFromProto(togglev1.ImportConflictPolicy(100)))
+	})
+}
+
+func TestImportChangesToProto(t *testing.T) {
+	t.Run("successfully convert import changes", func(t *testing.T) {
+		changes := []*entity.ImportChange{
+			{Key: "toggle-1", Action: entity.ImportActionCreate},
+			{Key: "toggle-2", Action: entity.ImportActionUpdate, Fields: []string{"description"}, Err: entity.ErrImportConflict("rules can't be changed")},
+		}
+
+		res := entity.ImportChangesToProto(changes)
+
+		assert.Equal(t, []*togglev1.ToggleImportChange{
+			{Key: "toggle-1", Action: togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_CREATE},
+			{
+				Key:          "toggle-2",
+				Action:       togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_UPDATE,
+				Fields:       []string{"description"},
+				ErrorCode:    togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_IMPORT_CONFLICT,
+				ErrorMessage: "rules can't be changed",
+			},
+		}, res)
+	})
+}
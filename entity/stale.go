@@ -0,0 +1,59 @@
+package entity
+
+import (
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// StaleReason defines why a toggle is stale.
+type StaleReason string
+
+const (
+	// StaleReasonExpired means the toggle's expiry time has passed.
+	StaleReasonExpired StaleReason = "EXPIRED"
+	// StaleReasonUnchanged means the toggle's state hasn't changed for a long time and it still serves different values.
+	StaleReasonUnchanged StaleReason = "UNCHANGED"
+	// StaleReasonFullyOn means the toggle's state hasn't changed for a long time and it serves true to everyone.
+	StaleReasonFullyOn StaleReason = "FULLY_ON"
+	// StaleReasonFullyOff means the toggle's state hasn't changed for a long time and it serves false to everyone.
+	StaleReasonFullyOff StaleReason = "FULLY_OFF"
+)
+
+var (
+	protoStaleReasons = map[StaleReason]togglev1.StaleReason{
+		StaleReasonExpired:   togglev1.StaleReason_STALE_REASON_EXPIRED,
+		StaleReasonUnchanged: togglev1.StaleReason_STALE_REASON_UNCHANGED,
+		StaleReasonFullyOn:   togglev1.StaleReason_STALE_REASON_FULLY_ON,
+		StaleReasonFullyOff:  togglev1.StaleReason_STALE_REASON_FULLY_OFF,
+	}
+)
+
+// StaleToggle defines a toggle which should be cleaned up.
+type StaleToggle struct {
+	// Toggle defines the stale toggle.
+	Toggle *Toggle
+	// Reasons defines why the toggle is stale.
+	Reasons []StaleReason
+}
+
+// StaleReasonsToProto converts stale reasons to proto stale reasons.
+func StaleReasonsToProto(reasons []StaleReason) []togglev1.StaleReason {
+	var res []togglev1.StaleReason
+	for _, reason := range reasons {
+		res = append(res, protoStaleReasons[reason])
+	}
+	return res
+}
+
+// StaleReasonsFromProto converts proto stale reasons to stale reasons.
+// Unknown reasons are omitted.
+func StaleReasonsFromProto(reasons []togglev1.StaleReason) []StaleReason {
+	var res []StaleReason
+	for _, reason := range reasons {
+		for key, val := range protoStaleReasons {
+			if val == reason {
+				res = append(res, key)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,28 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestStaleReasonsToProto(t *testing.T) {
+	t.Run("nil reasons is converted to nil", func(t *testing.T) {
+		assert.Nil(t, entity.StaleReasonsToProto(nil))
+	})
+
+	t.Run("successfully convert stale reasons to proto", func(t *testing.T) {
+		res := entity.StaleReasonsToProto([]entity.StaleReason{entity.StaleReasonExpired, entity.StaleReasonFullyOff})
+		assert.Equal(t, []togglev1.StaleReason{togglev1.StaleReason_STALE_REASON_EXPIRED, togglev1.StaleReason_STALE_REASON_FULLY_OFF}, res)
+	})
+}
+
+func TestStaleReasonsFromProto(t *testing.T) {
+	t.Run("successfully convert proto stale reasons and omit the unknown ones", func(t *testing.T) {
+		res := entity.StaleReasonsFromProto([]togglev1.StaleReason{togglev1.StaleReason_STALE_REASON_UNCHANGED, togglev1.StaleReason_STALE_REASON_UNSPECIFIED, togglev1.StaleReason_STALE_REASON_FULLY_ON})
+		assert.Equal(t, []entity.StaleReason{entity.StaleReasonUnchanged, entity.StaleReasonFullyOn}, res)
+	})
+}
@@ -3,16 +3,22 @@ package entity
 import (
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
-// Toggle defines the logical data of a toggle.
+// Toggle defines the logical data of a toggle in an environment.
 type Toggle struct {
 	// Key defines the toggle's identifier.
-	// It must be unique from the rest.
+	// It must be unique within the project.
 	Key string
+	// Project defines the name of the project the toggle belongs to.
+	Project string
+	// Environment defines the name of the environment the toggle's state belongs to.
+	// The toggle's state consists of IsEnabled, Rules, DefaultValue, and Rollout.
+	Environment string
 	// IsEnabled defines the toggle's value.
 	IsEnabled bool
 	// Description defines toggle's description.
@@ -21,48 +27,159 @@ type Toggle struct {
 	CreatedAt time.Time
 	// Updated defines the time when the toggle was last updated.
 	UpdatedAt time.Time
+	// Rules defines an ordered list of targeting rules.
+	// The first rule that matches the evaluation context wins.
+	Rules []*Rule
+	// DefaultValue defines the value served by an enabled toggle when none of the rules matches.
+	DefaultValue bool
+	// Rollout defines the percentage rollout applied by an enabled toggle when none of the rules matches.
+	// If it is set, it takes precedence over DefaultValue.
+	Rollout *Rollout
+	// Variants defines the list of named variants served by a multivariate toggle.
+	// A toggle without variants is an on/off toggle.
+	Variants []*Variant
+	// DefaultVariant defines the name of the variant served when the toggle resolves to true.
+	DefaultVariant string
+	// OffVariant defines the name of the variant served when the toggle resolves to false.
+	OffVariant string
+	// Prerequisites defines the toggles which must be evaluated to the required values
+	// before the toggle is evaluated.
+	// Unlike the toggle's state, the prerequisites are shared by all environments.
+	Prerequisites []*Prerequisite
+	// Owner defines the person or team responsible for the toggle.
+	Owner string
+	// ExpiresAt defines the time when the toggle is expected to be removed.
+	// It is nil if the toggle never expires.
+	// Like the prerequisites, it is shared by all environments.
+	ExpiresAt *time.Time
+	// Tags defines the labels attached to the toggle, such as team:payments or kind:kill-switch.
+	// Like the prerequisites, they are shared by all environments.
+	Tags []string
+	// Version defines the toggle's version which is increased every time the toggle changes.
+	// Like the prerequisites, it is shared by all environments.
+	Version int64
+	// DeletedAt defines the time when the toggle was soft-deleted.
+	// It is nil if the toggle isn't deleted.
+	// Like the prerequisites, it is shared by all environments.
+	DeletedAt *time.Time
+}
+
+// IsDeleted checks whether the toggle has been soft-deleted.
+func (t *Toggle) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// IsExpired checks whether the toggle's expiry time has passed at the given time.
+// Toggle without expiry time never expires.
+func (t *Toggle) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !t.ExpiresAt.After(now)
 }
 
 // EventToggleCreated creates an event for created toggle.
 func EventToggleCreated(toggle *Toggle) *togglev1.ToggleEvent {
 	return &togglev1.ToggleEvent{
-		Name:      togglev1.ToggleEventName_TOGGLE_EVENT_NAME_CREATED,
-		Toggle:    createAPIToggle(toggle),
-		CreatedAt: timestamppb.Now(),
+		Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_CREATED,
+		Toggle:      createAPIToggle(toggle),
+		CreatedAt:   timestamppb.Now(),
+		Environment: toggle.Environment,
+		Project:     toggle.Project,
 	}
 }
 
 // EventToggleEnabled creates an event for enabled toggle.
 func EventToggleEnabled(toggle *Toggle) *togglev1.ToggleEvent {
 	return &togglev1.ToggleEvent{
-		Name:      togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
-		Toggle:    createAPIToggle(toggle),
-		CreatedAt: timestamppb.Now(),
+		Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+		Toggle:      createAPIToggle(toggle),
+		CreatedAt:   timestamppb.Now(),
+		Environment: toggle.Environment,
+		Project:     toggle.Project,
 	}
 }
 
 // EventToggleDisabled creates an event for disabled toggle.
 func EventToggleDisabled(toggle *Toggle) *togglev1.ToggleEvent {
 	return &togglev1.ToggleEvent{
-		Name:      togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DISABLED,
-		Toggle:    createAPIToggle(toggle),
-		CreatedAt: timestamppb.Now(),
+		Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DISABLED,
+		Toggle:      createAPIToggle(toggle),
+		CreatedAt:   timestamppb.Now(),
+		Environment: toggle.Environment,
+		Project:     toggle.Project,
 	}
 }
 
 // EventToggleDeleted creates an event for deleted toggle.
 func EventToggleDeleted(toggle *Toggle) *togglev1.ToggleEvent {
 	return &togglev1.ToggleEvent{
-		Name:      togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DELETED,
+		Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DELETED,
+		Toggle:      createAPIToggle(toggle),
+		CreatedAt:   timestamppb.Now(),
+		Environment: toggle.Environment,
+		Project:     toggle.Project,
+	}
+}
+
+// EventToggleUpdated creates an event for updated toggle.
+// The toggle's updated fields are shared by all environments,
+// but the event is scoped to the environment the update was requested in.
+func EventToggleUpdated(toggle *Toggle) *togglev1.ToggleEvent {
+	return &togglev1.ToggleEvent{
+		Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_UPDATED,
+		Toggle:      createAPIToggle(toggle),
+		CreatedAt:   timestamppb.Now(),
+		Environment: toggle.Environment,
+		Project:     toggle.Project,
+	}
+}
+
+// EventToggleExpired creates an event for expired toggle.
+// The event isn't scoped to any environment since the expiry time is shared by all environments.
+func EventToggleExpired(toggle *Toggle) *togglev1.ToggleEvent {
+	return &togglev1.ToggleEvent{
+		Name:      togglev1.ToggleEventName_TOGGLE_EVENT_NAME_EXPIRED,
 		Toggle:    createAPIToggle(toggle),
 		CreatedAt: timestamppb.Now(),
+		Project:   toggle.Project,
 	}
 }
 
 func createAPIToggle(toggle *Toggle) *togglev1.Toggle {
 	return &togglev1.Toggle{
-		Key:         toggle.Key,
-		IsEnabled:   toggle.IsEnabled,
-		Description: toggle.Description,
+		Key:            toggle.Key,
+		IsEnabled:      toggle.IsEnabled,
+		Description:    toggle.Description,
+		Rules:          RulesToProto(toggle.Rules),
+		DefaultValue:   proto.Bool(toggle.DefaultValue),
+		Rollout:        RolloutToProto(toggle.Rollout),
+		Variants:       VariantsToProto(toggle.Variants),
+		DefaultVariant: toggle.DefaultVariant,
+		OffVariant:     toggle.OffVariant,
+		Environment:    toggle.Environment,
+		Project:        toggle.Project,
+		Prerequisites:  PrerequisitesToProto(toggle.Prerequisites),
+		Owner:          toggle.Owner,
+		ExpiresAt:      TimeToProto(toggle.ExpiresAt),
+		Tags:           toggle.Tags,
+		Version:        toggle.Version,
+		DeletedAt:      TimeToProto(toggle.DeletedAt),
+	}
+}
+
+// TimeToProto converts optional time to proto timestamp.
+// Nil time is converted to nil timestamp.
+func TimeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// TimeFromProto converts proto timestamp to optional time.
+// Nil timestamp is converted to nil time.
+func TimeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
 	}
+	res := t.AsTime()
+	return &res
 }
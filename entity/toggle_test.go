@@ -2,16 +2,22 @@ package entity_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/indrasaputra/toggle/entity"
 )
 
 func TestEventToggleCreated(t *testing.T) {
 	t.Run("successfully create event toggle created", func(t *testing.T) {
-		event := entity.EventToggleCreated(&entity.Toggle{})
+		event := entity.EventToggleCreated(&entity.Toggle{Environment: "staging", Project: "checkout"})
 		assert.NotNil(t, event)
+		assert.Equal(t, "staging", event.GetEnvironment())
+		assert.Equal(t, "staging", event.GetToggle().GetEnvironment())
+		assert.Equal(t, "checkout", event.GetProject())
+		assert.Equal(t, "checkout", event.GetToggle().GetProject())
 	})
 }
 
@@ -35,3 +41,74 @@ func TestEventToggleDeleted(t *testing.T) {
 		assert.NotNil(t, event)
 	})
 }
+
+func TestEventToggleUpdated(t *testing.T) {
+	t.Run("successfully create event toggle updated", func(t *testing.T) {
+		event := entity.EventToggleUpdated(&entity.Toggle{Environment: "staging", Project: "checkout", Description: "new description"})
+		assert.NotNil(t, event)
+		assert.Equal(t, "staging", event.GetEnvironment())
+		assert.Equal(t, "checkout", event.GetProject())
+		assert.Equal(t, "new description", event.GetToggle().GetDescription())
+	})
+}
+
+func TestEventToggleExpired(t *testing.T) {
+	t.Run("successfully create event toggle expired", func(t *testing.T) {
+		expiresAt := time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)
+		event := entity.EventToggleExpired(&entity.Toggle{Project: "checkout", Environment: "staging", Owner: "team-checkout", ExpiresAt: &expiresAt})
+		assert.NotNil(t, event)
+		assert.Empty(t, event.GetEnvironment())
+		assert.Equal(t, "checkout", event.GetProject())
+		assert.Equal(t, "team-checkout", event.GetToggle().GetOwner())
+		assert.Equal(t, expiresAt, event.GetToggle().GetExpiresAt().AsTime())
+	})
+}
+
+func TestToggle_IsExpired(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	t.Run("toggle without expiry time never expires", func(t *testing.T) {
+		assert.False(t, (&entity.Toggle{}).IsExpired(now))
+	})
+
+	t.Run("toggle expires once its expiry time has passed", func(t *testing.T) {
+		assert.True(t, (&entity.Toggle{ExpiresAt: &past}).IsExpired(now))
+		assert.True(t, (&entity.Toggle{ExpiresAt: &now}).IsExpired(now))
+		assert.False(t, (&entity.Toggle{ExpiresAt: &future}).IsExpired(now))
+	})
+}
+
+func TestToggle_IsDeleted(t *testing.T) {
+	t.Run("toggle without deletion time isn't deleted", func(t *testing.T) {
+		assert.False(t, (&entity.Toggle{}).IsDeleted())
+	})
+
+	t.Run("toggle with deletion time is deleted", func(t *testing.T) {
+		now := time.Now()
+		assert.True(t, (&entity.Toggle{DeletedAt: &now}).IsDeleted())
+	})
+}
+
+func TestTimeToProto(t *testing.T) {
+	t.Run("nil time is converted to nil timestamp", func(t *testing.T) {
+		assert.Nil(t, entity.TimeToProto(nil))
+	})
+
+	t.Run("successfully convert time to proto", func(t *testing.T) {
+		now := time.Now().UTC()
+		assert.Equal(t, now, entity.TimeToProto(&now).AsTime())
+	})
+}
+
+func TestTimeFromProto(t *testing.T) {
+	t.Run("nil timestamp is converted to nil time", func(t *testing.T) {
+		assert.Nil(t, entity.TimeFromProto(nil))
+	})
+
+	t.Run("successfully convert proto timestamp", func(t *testing.T) {
+		now := time.Now().UTC()
+		assert.Equal(t, now, *entity.TimeFromProto(timestamppb.New(now)))
+	})
+}
@@ -0,0 +1,122 @@
+package entity
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// EvaluationWindow defines the length of the time window the evaluation counts are aggregated in.
+	EvaluationWindow = time.Hour
+)
+
+// EvaluationCount defines how many times a toggle serves a variant within a time window.
+type EvaluationCount struct {
+	// Key defines the evaluated toggle's key.
+	Key string
+	// Variant defines the name of the served variant.
+	// It is the served value, true or false, if the toggle doesn't serve any variant.
+	Variant string
+	// WindowStart defines the start of the time window the evaluations are made in.
+	WindowStart time.Time
+	// Count defines how many times the toggle serves the variant within the time window.
+	Count int64
+	// LastEvaluatedAt defines the time of the last evaluation within the time window.
+	LastEvaluatedAt time.Time
+}
+
+// ToggleUsage defines how many times a toggle has been evaluated.
+type ToggleUsage struct {
+	// Key defines the toggle's key.
+	Key string
+	// Count defines how many times the toggle has been evaluated.
+	Count int64
+	// LastEvaluatedAt defines when the toggle was last evaluated.
+	// It is nil if the toggle has never been evaluated.
+	LastEvaluatedAt *time.Time
+	// Variants defines the usage per served variant.
+	Variants []*VariantUsage
+}
+
+// VariantUsage defines how many times a toggle has served a variant.
+type VariantUsage struct {
+	// Variant defines the name of the served variant.
+	Variant string
+	// Count defines how many times the toggle has served the variant.
+	Count int64
+	// LastEvaluatedAt defines when the toggle last served the variant.
+	LastEvaluatedAt time.Time
+}
+
+// EvaluationWindowStart returns the start of the time window the time belongs to.
+func EvaluationWindowStart(t time.Time) time.Time {
+	return t.UTC().Truncate(EvaluationWindow)
+}
+
+// EvaluationVariant returns the name of the variant served by the evaluation.
+// It is the served value, true or false, if the toggle doesn't serve any variant.
+func EvaluationVariant(eval *Evaluation) string {
+	if eval.Variant != nil {
+		return eval.Variant.Name
+	}
+	if eval.Value {
+		return "true"
+	}
+	return "false"
+}
+
+// EvaluationCountsToProto converts evaluation counts to proto evaluation counts.
+func EvaluationCountsToProto(counts []*EvaluationCount) []*togglev1.EvaluationCount {
+	var res []*togglev1.EvaluationCount
+	for _, count := range counts {
+		res = append(res, &togglev1.EvaluationCount{
+			Key:             count.Key,
+			Variant:         count.Variant,
+			WindowStart:     timestamppb.New(count.WindowStart),
+			Count:           count.Count,
+			LastEvaluatedAt: timestamppb.New(count.LastEvaluatedAt),
+		})
+	}
+	return res
+}
+
+// EvaluationCountsFromProto converts proto evaluation counts to evaluation counts.
+// Missing times are converted to zero time.
+func EvaluationCountsFromProto(counts []*togglev1.EvaluationCount) []*EvaluationCount {
+	var res []*EvaluationCount
+	for _, count := range counts {
+		tmp := &EvaluationCount{
+			Key:     count.GetKey(),
+			Variant: count.GetVariant(),
+			Count:   count.GetCount(),
+		}
+		if count.GetWindowStart() != nil {
+			tmp.WindowStart = count.GetWindowStart().AsTime()
+		}
+		if count.GetLastEvaluatedAt() != nil {
+			tmp.LastEvaluatedAt = count.GetLastEvaluatedAt().AsTime()
+		}
+		res = append(res, tmp)
+	}
+	return res
+}
+
+// ToggleUsageToProto converts toggle's usage to proto toggle's usage.
+func ToggleUsageToProto(usage *ToggleUsage) *togglev1.ToggleUsage {
+	res := &togglev1.ToggleUsage{
+		Key:             usage.Key,
+		EvaluationCount: usage.Count,
+		LastEvaluatedAt: TimeToProto(usage.LastEvaluatedAt),
+	}
+	for _, variant := range usage.Variants {
+		res.Variants = append(res.Variants, &togglev1.VariantUsage{
+			Variant:         variant.Variant,
+			EvaluationCount: variant.Count,
+			LastEvaluatedAt: timestamppb.New(variant.LastEvaluatedAt),
+		})
+	}
+	return res
+}
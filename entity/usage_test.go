@@ -0,0 +1,80 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestEvaluationWindowStart(t *testing.T) {
+	t.Run("time is rounded down to the hour in UTC", func(t *testing.T) {
+		now := time.Date(2022, time.February, 1, 14, 35, 10, 0, time.FixedZone("WIB", 7*60*60))
+		assert.Equal(t, time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC), entity.EvaluationWindowStart(now))
+	})
+}
+
+func TestEvaluationVariant(t *testing.T) {
+	t.Run("served variant's name is used", func(t *testing.T) {
+		eval := &entity.Evaluation{Value: true, Variant: &entity.Variant{Name: "blue"}}
+		assert.Equal(t, "blue", entity.EvaluationVariant(eval))
+	})
+
+	t.Run("served value is used if the toggle doesn't serve any variant", func(t *testing.T) {
+		assert.Equal(t, "true", entity.EvaluationVariant(&entity.Evaluation{Value: true}))
+		assert.Equal(t, "false", entity.EvaluationVariant(&entity.Evaluation{Value: false}))
+	})
+}
+
+func TestEvaluationCountsToProto(t *testing.T) {
+	t.Run("nil counts is converted to nil", func(t *testing.T) {
+		assert.Nil(t, entity.EvaluationCountsToProto(nil))
+	})
+
+	t.Run("successfully convert counts to proto and back", func(t *testing.T) {
+		window := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC)
+		counts := []*entity.EvaluationCount{{Key: "toggle-1", Variant: "true", WindowStart: window, Count: 3, LastEvaluatedAt: window.Add(time.Minute)}}
+
+		res := entity.EvaluationCountsFromProto(entity.EvaluationCountsToProto(counts))
+
+		assert.Equal(t, counts, res)
+	})
+}
+
+func TestEvaluationCountsFromProto(t *testing.T) {
+	t.Run("missing times are converted to zero time", func(t *testing.T) {
+		res := entity.EvaluationCountsFromProto([]*togglev1.EvaluationCount{{Key: "toggle-1", Variant: "false", Count: 1}})
+
+		assert.Equal(t, []*entity.EvaluationCount{{Key: "toggle-1", Variant: "false", Count: 1}}, res)
+	})
+}
+
+func TestToggleUsageToProto(t *testing.T) {
+	t.Run("toggle which has never been evaluated doesn't have last evaluated time", func(t *testing.T) {
+		res := entity.ToggleUsageToProto(&entity.ToggleUsage{Key: "toggle-1"})
+
+		assert.Equal(t, &togglev1.ToggleUsage{Key: "toggle-1"}, res)
+	})
+
+	t.Run("successfully convert toggle's usage to proto", func(t *testing.T) {
+		last := time.Date(2022, time.February, 1, 7, 30, 0, 0, time.UTC)
+		usage := &entity.ToggleUsage{
+			Key:             "toggle-1",
+			Count:           5,
+			LastEvaluatedAt: &last,
+			Variants:        []*entity.VariantUsage{{Variant: "true", Count: 5, LastEvaluatedAt: last}},
+		}
+
+		res := entity.ToggleUsageToProto(usage)
+
+		assert.Equal(t, "toggle-1", res.GetKey())
+		assert.Equal(t, int64(5), res.GetEvaluationCount())
+		assert.Equal(t, timestamppb.New(last).AsTime(), res.GetLastEvaluatedAt().AsTime())
+		assert.Equal(t, 1, len(res.GetVariants()))
+		assert.Equal(t, "true", res.GetVariants()[0].GetVariant())
+	})
+}
@@ -0,0 +1,89 @@
+package entity
+
+import (
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// VariantType defines the type of a variant's value.
+type VariantType string
+
+const (
+	// VariantTypeString means the variant's value is a plain string.
+	VariantTypeString VariantType = "string"
+	// VariantTypeNumber means the variant's value is a number written in decimal.
+	VariantTypeNumber VariantType = "number"
+	// VariantTypeJSON means the variant's value is a JSON document.
+	VariantTypeJSON VariantType = "json"
+)
+
+var (
+	protoVariantTypes = map[togglev1.VariantType]VariantType{
+		togglev1.VariantType_VARIANT_TYPE_STRING: VariantTypeString,
+		togglev1.VariantType_VARIANT_TYPE_NUMBER: VariantTypeNumber,
+		togglev1.VariantType_VARIANT_TYPE_JSON:   VariantTypeJSON,
+	}
+)
+
+// Variant defines a named value served by a multivariate toggle.
+type Variant struct {
+	// Name defines the variant's name.
+	// It must be unique in a toggle.
+	Name string `json:"name"`
+	// Type defines the type of the variant's value.
+	Type VariantType `json:"type"`
+	// Value defines the variant's value encoded as string.
+	Value string `json:"value"`
+}
+
+// VariantsFromProto converts list of proto variants to list of variants.
+// Unknown type is converted to empty type.
+// Empty list is converted to nil.
+func VariantsFromProto(variants []*togglev1.Variant) []*Variant {
+	var res []*Variant
+	for _, variant := range variants {
+		res = append(res, VariantFromProto(variant))
+	}
+	return res
+}
+
+// VariantFromProto converts proto variant to variant.
+func VariantFromProto(variant *togglev1.Variant) *Variant {
+	if variant == nil {
+		return nil
+	}
+	return &Variant{
+		Name:  variant.GetName(),
+		Type:  protoVariantTypes[variant.GetType()],
+		Value: variant.GetValue(),
+	}
+}
+
+// VariantsToProto converts list of variants to list of proto variants.
+func VariantsToProto(variants []*Variant) []*togglev1.Variant {
+	var res []*togglev1.Variant
+	for _, variant := range variants {
+		res = append(res, VariantToProto(variant))
+	}
+	return res
+}
+
+// VariantToProto converts variant to proto variant.
+func VariantToProto(variant *Variant) *togglev1.Variant {
+	if variant == nil {
+		return nil
+	}
+	return &togglev1.Variant{
+		Name:  variant.Name,
+		Type:  variantTypeToProto(variant.Type),
+		Value: variant.Value,
+	}
+}
+
+func variantTypeToProto(typ VariantType) togglev1.VariantType {
+	for key, val := range protoVariantTypes {
+		if val == typ {
+			return key
+		}
+	}
+	return togglev1.VariantType_VARIANT_TYPE_UNSPECIFIED
+}
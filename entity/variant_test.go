@@ -0,0 +1,45 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestVariantsFromProto(t *testing.T) {
+	t.Run("successfully convert proto variants", func(t *testing.T) {
+		variants := entity.VariantsFromProto([]*togglev1.Variant{
+			{Name: "limit", Type: togglev1.VariantType_VARIANT_TYPE_NUMBER, Value: "10"},
+			{Name: "unknown", Type: togglev1.VariantType_VARIANT_TYPE_UNSPECIFIED},
+			nil,
+		})
+
+		assert.Equal(t, 3, len(variants))
+		assert.Equal(t, &entity.Variant{Name: "limit", Type: entity.VariantTypeNumber, Value: "10"}, variants[0])
+		assert.Empty(t, variants[1].Type)
+		assert.Nil(t, variants[2])
+	})
+
+	t.Run("empty proto variants is converted to nil", func(t *testing.T) {
+		assert.Nil(t, entity.VariantsFromProto([]*togglev1.Variant{}))
+	})
+}
+
+func TestVariantsToProto(t *testing.T) {
+	t.Run("successfully convert variants to proto", func(t *testing.T) {
+		variants := entity.VariantsToProto([]*entity.Variant{
+			{Name: "config", Type: entity.VariantTypeJSON, Value: `{"color":"blue"}`},
+			{Name: "unknown", Type: entity.VariantType("unknown")},
+			nil,
+		})
+
+		assert.Equal(t, 3, len(variants))
+		assert.Equal(t, togglev1.VariantType_VARIANT_TYPE_JSON, variants[0].GetType())
+		assert.Equal(t, `{"color":"blue"}`, variants[0].GetValue())
+		assert.Equal(t, togglev1.VariantType_VARIANT_TYPE_UNSPECIFIED, variants[1].GetType())
+		assert.Nil(t, variants[2])
+	})
+}
@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"strings"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// WatchFilter defines the toggles a watcher is interested in.
+type WatchFilter struct {
+	// Keys defines the keys of the watched toggles.
+	Keys []string
+	// KeyPrefixes defines the prefixes of the watched toggles' keys.
+	KeyPrefixes []string
+}
+
+// Matches checks whether the toggle with the given key is watched.
+// A toggle is watched if its key matches any of the keys or key prefixes.
+// Nil or empty filter watches all toggles.
+func (f *WatchFilter) Matches(key string) bool {
+	if f == nil || (len(f.Keys) == 0 && len(f.KeyPrefixes) == 0) {
+		return true
+	}
+	for _, k := range f.Keys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range f.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// BroadcastEvent defines a toggle's event delivered to the in-process watchers.
+type BroadcastEvent struct {
+	// Event defines the toggle's event.
+	Event *togglev1.ToggleEvent
+	// Token defines the opaque token to resume watching right after the event.
+	Token string
+}
+
+// WatchUpdate defines a message sent to a watcher.
+// It holds either the snapshot of the watched toggles or an event of a watched toggle.
+type WatchUpdate struct {
+	// Snapshot defines the current state of all watched toggles, sorted by key.
+	// It is nil if the update is an event.
+	Snapshot []*Toggle
+	// Event defines the event of a watched toggle.
+	// It is nil if the update is a snapshot.
+	Event *togglev1.ToggleEvent
+	// Token defines the opaque token to resume watching right after the update.
+	Token string
+}
+
+// IsSnapshot checks whether the update is a snapshot.
+func (u *WatchUpdate) IsSnapshot() bool {
+	return u.Event == nil
+}
@@ -0,0 +1,34 @@
+package entity_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestWatchFilter_Matches(t *testing.T) {
+	t.Run("nil or empty filter matches all toggles", func(t *testing.T) {
+		var filter *entity.WatchFilter
+		assert.True(t, filter.Matches("checkout-v2"))
+		assert.True(t, (&entity.WatchFilter{}).Matches("checkout-v2"))
+	})
+
+	t.Run("toggle is matched by its key or key prefix", func(t *testing.T) {
+		filter := &entity.WatchFilter{Keys: []string{"search-v2"}, KeyPrefixes: []string{"checkout-"}}
+
+		assert.True(t, filter.Matches("search-v2"))
+		assert.True(t, filter.Matches("checkout-v2"))
+		assert.False(t, filter.Matches("search-v3"))
+		assert.False(t, filter.Matches("payment-checkout"))
+	})
+}
+
+func TestWatchUpdate_IsSnapshot(t *testing.T) {
+	t.Run("update without event is a snapshot", func(t *testing.T) {
+		assert.True(t, (&entity.WatchUpdate{}).IsSnapshot())
+		assert.False(t, (&entity.WatchUpdate{Event: &togglev1.ToggleEvent{}}).IsSnapshot())
+	})
+}
@@ -0,0 +1,101 @@
+Feature: Batch toggle
+
+    In order to manage many toggles at once
+    I need to create, enable, disable, and delete toggles in a single request
+
+    Scenario: Create many toggles at once
+        Given the toggle is empty
+        When I batch create toggles with body
+            """
+            {
+                "toggles": [
+                    {"key": "toggle-1", "description": "description 1"},
+                    {"key": "toggle-2", "prerequisites": [{"key": "toggle-1", "value": true}]}
+                ]
+            }
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "results": [
+                    {"key": "toggle-1", "version": "1"},
+                    {"key": "toggle-2", "version": "1"}
+                ]
+            }
+            """
+        When I get single toggle with key "toggle-2"
+        Then response status code must be 200
+
+    Scenario: Failed toggles are reported one by one
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1"} |
+        When I batch create toggles with body
+            """
+            {
+                "toggles": [
+                    {"key": "toggle-1"},
+                    {"key": "toggle-2"}
+                ]
+            }
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "results": [
+                    {"key": "toggle-1", "errorCode": "TOGGLE_ERROR_CODE_ALREADY_EXISTS"},
+                    {"key": "toggle-2", "version": "1"}
+                ]
+            }
+            """
+
+    Scenario: Nothing is created if a toggle fails in all-or-nothing batch
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1"} |
+        When I batch create toggles with body
+            """
+            {
+                "toggles": [
+                    {"key": "toggle-2"},
+                    {"key": "toggle-1"}
+                ],
+                "allOrNothing": true
+            }
+            """
+        Then response status code must be 409
+        When I get single toggle with key "toggle-2"
+        Then response status code must be 404
+
+    Scenario: Disable and delete toggles at once
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1"} |
+            | {"key": "toggle-2"} |
+        And I enable toggle with key "toggle-1"
+        When I batch update toggles with body
+            """
+            {
+                "operations": [
+                    {"key": "toggle-1", "type": "TOGGLE_OPERATION_TYPE_DISABLE"},
+                    {"key": "toggle-1", "type": "TOGGLE_OPERATION_TYPE_DELETE"},
+                    {"key": "toggle-2", "type": "TOGGLE_OPERATION_TYPE_ENABLE"}
+                ],
+                "allOrNothing": true
+            }
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "results": [
+                    {"key": "toggle-1", "version": "3"},
+                    {"key": "toggle-1", "version": "4"},
+                    {"key": "toggle-2", "version": "2"}
+                ]
+            }
+            """
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 404
@@ -28,5 +28,7 @@ Feature: Enable toggle
         Then response status code must be 200
         And response must match json
             """
-            {}
+            {
+                "version": "2"
+            }
             """
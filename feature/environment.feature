@@ -0,0 +1,164 @@
+Feature: Environment
+
+    In order to release toggle safely
+    I need to have separate toggle state per environment
+
+    Scenario: Default environments are available
+        When I get all environments
+        Then response status code must be 200
+        And response environments should contain "development, staging, production"
+
+    Scenario: Invalid environment name
+        When I create environment with body
+            """
+            {
+                "name": "qa env"
+            }
+            """
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "environment",
+                            "description": "empty or contain character outside of alphanumeric and dash"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_ENVIRONMENT"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle can't be created in unknown environment
+        Given the toggle is empty
+        When I create toggle in environment "unknown" with body
+            """
+            {
+                "key": "toggle-1"
+            }
+            """
+        Then response status code must be 404
+        And response must match json
+            """
+            {
+                "code": 5,
+                "message": "environment is not found",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_ENVIRONMENT_NOT_FOUND"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Enabling toggle in an environment doesn't change the other environments
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I enable toggle with key "toggle-1" in environment "staging"
+        And I get single toggle with key "toggle-1" in environment "staging"
+        Then response status code must be 200
+        And response single toggle should match
+            """
+            {
+                "toggle": {
+                    "key": "toggle-1",
+                    "is_enabled": true,
+                    "description": "description 1"
+                }
+            }
+            """
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 200
+        And response single toggle should match
+            """
+            {
+                "toggle": {
+                    "key": "toggle-1",
+                    "is_enabled": false,
+                    "description": "description 1"
+                }
+            }
+            """
+
+    Scenario: Toggle's rollout can be changed in an environment after the toggle is created
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I update rollout of toggle with key "toggle-1" in environment "staging" with body
+            """
+            {
+                "rollout": {"percentage": 30, "bucketBy": "user_id"},
+                "expectedVersion": 1
+            }
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "version": "2"
+            }
+            """
+        When I get the history of toggle with key "toggle-1"
+        Then response status code must be 200
+        And response history should be "AUDIT_ACTION_UPDATE::,AUDIT_ACTION_CREATE::"
+
+    Scenario: Invalid rollout can't be set in an environment
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        When I update rollout of toggle with key "toggle-1" in environment "staging" with body
+            """
+            {
+                "rollout": {"percentage": 101, "bucketBy": "user_id"}
+            }
+            """
+        Then response status code must be 400
+
+    Scenario: Toggle's rules can be changed in an environment after the toggle is created
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I update rules of toggle with key "toggle-1" in environment "staging" with body
+            """
+            {
+                "rules": [{"attribute": "country", "operator": "RULE_OPERATOR_IN", "values": ["ID", "SG"], "value": true}],
+                "defaultValue": false,
+                "expectedVersion": 1
+            }
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "version": "2"
+            }
+            """
+        When I get the history of toggle with key "toggle-1"
+        Then response status code must be 200
+        And response history should be "AUDIT_ACTION_UPDATE::,AUDIT_ACTION_CREATE::"
+
+    Scenario: Rule can't refer to a variant the toggle doesn't have
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        When I update rules of toggle with key "toggle-1" in environment "staging" with body
+            """
+            {
+                "rules": [{"attribute": "country", "operator": "RULE_OPERATOR_IN", "values": ["ID"], "value": true, "variant": "red"}]
+            }
+            """
+        Then response status code must be 400
+
+    Scenario: Toggle enabled in any environment can't be deleted
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        And I enable toggle with key "toggle-1" in environment "staging"
+        When I delete toggle with key "toggle-1"
+        Then response status code must be 400
@@ -0,0 +1,190 @@
+Feature: Evaluate toggle
+
+    In order to roll out a feature to a subset of users
+    I need to evaluate the toggle against their attributes
+
+    Scenario: Non-exists toggle can't be evaluated
+        Given the toggle is empty
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 404
+        And response must match json
+            """
+            {
+                "code": 5,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_NOT_FOUND"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Disabled toggle is evaluated to false
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": false,
+                "reason": "EVALUATION_REASON_DISABLED",
+                "failedPrerequisite": "",
+                "version": "1",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Enabled toggle serves the value of the matched rule
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "rules": [{"attribute": "country", "operator": "RULE_OPERATOR_IN", "values": ["ID", "SG"], "value": true}], "default_value": false} |
+        And I enable toggle with key "toggle-1"
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": true,
+                "reason": "EVALUATION_REASON_RULE_MATCH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": {
+                    "attribute": "country",
+                    "operator": "RULE_OPERATOR_IN",
+                    "values": ["ID", "SG"],
+                    "value": true,
+                    "variant": ""
+                },
+                "variant": null
+            }
+            """
+
+    Scenario: Enabled toggle serves the default value if none of the rules matches
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "rules": [{"attribute": "country", "operator": "RULE_OPERATOR_IN", "values": ["ID", "SG"], "value": true}], "default_value": false} |
+        And I enable toggle with key "toggle-1"
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"country": "MY"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": false,
+                "reason": "EVALUATION_REASON_FALLTHROUGH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Enabled toggle serves the rollout if none of the rules matches
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "default_value": false, "rollout": {"percentage": 100, "bucket_by": "user_id"}} |
+        And I enable toggle with key "toggle-1"
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"user_id": "user-1"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": true,
+                "reason": "EVALUATION_REASON_ROLLOUT",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Rollout excludes subject without bucketing attribute
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "default_value": true, "rollout": {"percentage": 100, "bucket_by": "user_id"}} |
+        And I enable toggle with key "toggle-1"
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": false,
+                "reason": "EVALUATION_REASON_ROLLOUT",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Multivariate toggle serves the matched rule's variant
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "rules": [{"attribute": "country", "operator": "RULE_OPERATOR_EQUALS", "values": ["ID"], "value": true, "variant": "red"}], "variants": [{"name": "control", "type": "VARIANT_TYPE_STRING", "value": "grey"}, {"name": "blue", "type": "VARIANT_TYPE_STRING", "value": "#0000ff"}, {"name": "red", "type": "VARIANT_TYPE_STRING", "value": "#ff0000"}], "default_variant": "blue", "off_variant": "control"} |
+        And I enable toggle with key "toggle-1"
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": true,
+                "reason": "EVALUATION_REASON_RULE_MATCH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": {
+                    "attribute": "country",
+                    "operator": "RULE_OPERATOR_EQUALS",
+                    "values": ["ID"],
+                    "value": true,
+                    "variant": "red"
+                },
+                "variant": {
+                    "name": "red",
+                    "type": "VARIANT_TYPE_STRING",
+                    "value": "#ff0000"
+                }
+            }
+            """
+
+    Scenario: Disabled multivariate toggle serves the off variant
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "variants": [{"name": "control", "type": "VARIANT_TYPE_NUMBER", "value": "10"}, {"name": "bigger", "type": "VARIANT_TYPE_NUMBER", "value": "20"}], "default_variant": "bigger", "off_variant": "control"} |
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": false,
+                "reason": "EVALUATION_REASON_DISABLED",
+                "failedPrerequisite": "",
+                "version": "1",
+                "matchedRule": null,
+                "variant": {
+                    "name": "control",
+                    "type": "VARIANT_TYPE_NUMBER",
+                    "value": "10"
+                }
+            }
+            """
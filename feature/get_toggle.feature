@@ -10,7 +10,9 @@ Feature: Get toggle
         And response must match json
             """
             {
-                "toggles": []
+                "toggles": [],
+                "nextPageToken": "",
+                "totalSize": 0
             }
             """
 
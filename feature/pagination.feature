@@ -0,0 +1,58 @@
+Feature: Pagination
+
+    In order to browse projects with many toggles
+    I need to list toggles page by page, sorted and searched
+
+    Scenario: Order by must be valid
+        When I get all toggles with query "orderBy=name"
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "order_by",
+                            "description": "must be one of key, created_at, and updated_at, optionally followed by desc"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_QUERY"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Page token must be valid
+        When I get all toggles with query "pageToken=invalid"
+        Then response status code must be 400
+
+    Scenario: Toggles are listed page by page
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+            | {"key": "toggle-2", "description": "description 2"} |
+            | {"key": "toggle-3", "description": "description 3"} |
+        When I get all toggles with query "pageSize=2&orderBy=key%20desc"
+        Then response status code must be 200
+        And response toggle keys should be "toggle-3,toggle-2" out of 3
+        When I get the next page of toggles with query "pageSize=2&orderBy=key%20desc"
+        Then response status code must be 200
+        And response toggle keys should be "toggle-1" out of 3
+
+    Scenario: Toggles are filtered by key prefix and description
+        Given there are toggles with
+            | {"key": "checkout-redesign", "description": "new checkout flow"}  |
+            | {"key": "checkout-coupon", "description": "coupon at checkout"}   |
+            | {"key": "search-ranking", "description": "new ranking algorithm"} |
+        When I get all toggles with query "keyPrefix=checkout"
+        Then response status code must be 200
+        And response toggle keys should be "checkout-coupon,checkout-redesign" out of 2
+        When I get all toggles with query "search=new"
+        Then response status code must be 200
+        And response toggle keys should be "checkout-redesign,search-ranking" out of 2
@@ -0,0 +1,157 @@
+Feature: Prerequisite
+
+    In order to only turn on a feature when the feature it depends on is on
+    I need to declare the toggles it depends on as its prerequisites
+
+    Scenario: Toggle can't be its own prerequisite
+        When I create toggle with body
+            | {"key": "toggle-1", "prerequisites": [{"key": "toggle-1", "value": true}]} |
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "prerequisites",
+                            "description": "prerequisite 0: toggle can't be its own prerequisite"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_PREREQUISITE"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Prerequisite must refer to an existing toggle
+        When I create toggle with body
+            | {"key": "toggle-1", "prerequisites": [{"key": "unknown", "value": true}]} |
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "prerequisites",
+                            "description": "prerequisite 0: toggle \"unknown\" doesn't exist"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_PREREQUISITE"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle is evaluated to false if its prerequisite isn't satisfied
+        Given there are toggles with
+            | {"key": "new-checkout"} |
+            | {"key": "new-checkout-upsell", "prerequisites": [{"key": "new-checkout", "value": true}]} |
+        And I enable toggle with key "new-checkout-upsell"
+        When I evaluate toggle with key "new-checkout-upsell" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": false,
+                "reason": "EVALUATION_REASON_PREREQUISITE_FAILED",
+                "failedPrerequisite": "new-checkout",
+                "version": "2",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Toggle is evaluated normally if all of its prerequisites are satisfied
+        Given there are toggles with
+            | {"key": "new-checkout"} |
+            | {"key": "new-checkout-upsell", "prerequisites": [{"key": "new-checkout", "value": true}]} |
+        And I enable toggle with key "new-checkout"
+        And I enable toggle with key "new-checkout-upsell"
+        When I evaluate toggle with key "new-checkout-upsell" and context
+            """
+            {"context": {"country": "ID"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": true,
+                "reason": "EVALUATION_REASON_FALLTHROUGH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Prerequisites can't create a dependency cycle
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+            | {"key": "toggle-2", "prerequisites": [{"key": "toggle-1", "value": true}]} |
+        When I update prerequisites of toggle with key "toggle-1" with body
+            """
+            {"prerequisites": [{"key": "toggle-2", "value": true}]}
+            """
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 9,
+                "message": "toggle's prerequisites create a dependency cycle: toggle-1 -> toggle-2 -> toggle-1",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_PREREQUISITE_CYCLE"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle which is a prerequisite of another toggle can't be deleted
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+            | {"key": "toggle-2", "prerequisites": [{"key": "toggle-1", "value": true}]} |
+        When I delete toggle with key "toggle-1"
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 9,
+                "message": "toggle is still a prerequisite of toggle-2 hence it can't be deleted",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_HAS_DEPENDENTS"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle can be deleted once no toggle depends on it
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+            | {"key": "toggle-2", "prerequisites": [{"key": "toggle-1", "value": true}]} |
+        And I update prerequisites of toggle with key "toggle-2" with body
+            """
+            {"prerequisites": []}
+            """
+        When I delete toggle with key "toggle-1"
+        Then response status code must be 200
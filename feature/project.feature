@@ -0,0 +1,142 @@
+Feature: Project
+
+    In order to let teams manage their own toggles
+    I need to have toggles isolated per project
+
+    Scenario: Default project is available
+        When I get all projects
+        Then response status code must be 200
+        And response projects should contain "default"
+
+    Scenario: Invalid project name
+        When I create project with body
+            """
+            {
+                "name": "payment team"
+            }
+            """
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "project",
+                            "description": "empty or contain character outside of alphanumeric and dash"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_PROJECT"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Unknown project is not found
+        When I get project with name "unknown"
+        Then response status code must be 404
+        And response must match json
+            """
+            {
+                "code": 5,
+                "message": "project is not found",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_PROJECT_NOT_FOUND"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle can't be created in unknown project
+        Given the toggle is empty
+        When I create toggle in project "unknown" with body
+            """
+            {
+                "key": "toggle-1"
+            }
+            """
+        Then response status code must be 404
+        And response must match json
+            """
+            {
+                "code": 5,
+                "message": "project is not found",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_PROJECT_NOT_FOUND"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Same toggle key can be used in different projects
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "default toggle"} |
+        And I create project with body
+            """
+            {
+                "name": "payment",
+                "description": "payment team"
+            }
+            """
+        When I create toggle in project "payment" with body
+            """
+            {
+                "key": "toggle-1",
+                "description": "payment toggle"
+            }
+            """
+        Then response status code must be 200
+        When I get all toggles in project "payment"
+        Then response status code must be 200
+        And response toggles should match
+            """
+            {
+                "toggles": [
+                    {
+                        "key": "toggle-1",
+                        "is_enabled": false,
+                        "description": "payment toggle"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Project that still owns toggles can't be deleted
+        Given I create project with body
+            """
+            {
+                "name": "payment"
+            }
+            """
+        And I create toggle in project "payment" with body
+            """
+            {
+                "key": "toggle-1"
+            }
+            """
+        When I delete project with name "payment"
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 9,
+                "message": "project still owns toggles hence it can't be deleted",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_PROJECT_NOT_EMPTY"
+                    }
+                ]
+            }
+            """
@@ -0,0 +1,58 @@
+Feature: Restore toggle
+
+    In order to undo an accidental deletion
+    I need to restore the deleted toggle before it is purged
+
+    Scenario: Deleted toggle can be restored
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        And I delete toggle with key "toggle-1"
+        When I restore toggle with key "toggle-1"
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "version": "3"
+            }
+            """
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 200
+
+    Scenario: Deleted toggle can't be retrieved
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        And I delete toggle with key "toggle-1"
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 404
+
+    Scenario: Deleted toggle's key stays reserved until it is purged
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        And I delete toggle with key "toggle-1"
+        When I create toggle with body
+            | {"key": "toggle-1"} |
+        Then response status code must be 409
+        And response must match json
+            """
+            {
+                "code": 6,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_ALREADY_EXISTS"
+                    }
+                ]
+            }
+            """
+        When I purge toggle with key "toggle-1"
+        Then response status code must be 200
+        When I create toggle with body
+            | {"key": "toggle-1"} |
+        Then response status code must be 200
+
+    Scenario: Toggle that isn't deleted can't be restored
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I restore toggle with key "toggle-1"
+        Then response status code must be 404
@@ -0,0 +1,102 @@
+Feature: Schedule
+
+    In order to launch a feature at a fixed time without anyone being awake
+    I need to schedule the toggle to be enabled or disabled in the future
+
+    Scenario: Schedule's execution time must be in the future
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        When I schedule toggle with key "toggle-1" with body
+            """
+            {"action": "SCHEDULE_ACTION_ENABLE", "executeAt": "2021-01-01T00:00:00Z"}
+            """
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "schedule",
+                            "description": "execution time must be in the future"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_SCHEDULE"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Schedule's action must be known
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        When I schedule toggle with key "toggle-1" with body
+            """
+            {"executeAt": "2099-01-01T00:00:00Z"}
+            """
+        Then response status code must be 400
+
+    Scenario: Toggle must exist to be scheduled
+        When I schedule toggle with key "toggle-1" with body
+            """
+            {"action": "SCHEDULE_ACTION_ENABLE", "executeAt": "2099-01-01T00:00:00Z"}
+            """
+        Then response status code must be 404
+
+    Scenario: Pending schedules are listed by their execution time
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        And I schedule toggle with key "toggle-1" with body
+            """
+            {"action": "SCHEDULE_ACTION_DISABLE", "executeAt": "2099-01-02T00:00:00Z"}
+            """
+        And I schedule toggle with key "toggle-1" with body
+            """
+            {"action": "SCHEDULE_ACTION_ENABLE", "executeAt": "2099-01-01T00:00:00Z"}
+            """
+        When I get schedules of toggle with key "toggle-1"
+        Then response status code must be 200
+        And response schedules should be "SCHEDULE_ACTION_ENABLE,SCHEDULE_ACTION_DISABLE"
+
+    Scenario: Pending schedule can be cancelled
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        And I schedule toggle with key "toggle-1" with body
+            """
+            {"action": "SCHEDULE_ACTION_ENABLE", "executeAt": "2099-01-01T00:00:00Z"}
+            """
+        When I cancel the created schedule of toggle with key "toggle-1"
+        Then response status code must be 200
+        And I get schedules of toggle with key "toggle-1"
+        And response schedules should be ""
+
+    Scenario: Cancelled schedule can't be cancelled again
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        And I schedule toggle with key "toggle-1" with body
+            """
+            {"action": "SCHEDULE_ACTION_ENABLE", "executeAt": "2099-01-01T00:00:00Z"}
+            """
+        And I cancel the created schedule of toggle with key "toggle-1"
+        When I cancel the created schedule of toggle with key "toggle-1"
+        Then response status code must be 404
+        And response must match json
+            """
+            {
+                "code": 5,
+                "message": "schedule is not found or it isn't pending anymore",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_SCHEDULE_NOT_FOUND"
+                    }
+                ]
+            }
+            """
@@ -0,0 +1,182 @@
+Feature: Segment
+
+    In order to stop repeating the same targeting across toggles
+    I need to target a reusable segment from toggle's rules
+
+    Scenario: Invalid segment key
+        When I create segment with body
+            """
+            {
+                "key": "beta testers"
+            }
+            """
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "segment",
+                            "description": "key is empty or contain character outside of alphanumeric and dash"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_SEGMENT"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Unknown segment is not found
+        When I get segment with key "unknown"
+        Then response status code must be 404
+        And response must match json
+            """
+            {
+                "code": 5,
+                "message": "segment is not found",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_SEGMENT_NOT_FOUND"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle targets subjects of a segment
+        Given I create segment with body
+            """
+            {
+                "key": "beta-testers",
+                "included": ["user-1"],
+                "excluded": ["user-2"],
+                "rules": [{"attribute": "country", "operator": "RULE_OPERATOR_IN", "values": ["ID", "SG"]}]
+            }
+            """
+        And there are toggles with
+            | {"key": "toggle-1", "rules": [{"attribute": "user_id", "operator": "RULE_OPERATOR_IN_SEGMENT", "values": ["beta-testers"], "value": true}], "default_value": false} |
+        And I enable toggle with key "toggle-1"
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"user_id": "user-3", "country": "SG"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": true,
+                "reason": "EVALUATION_REASON_RULE_MATCH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": {
+                    "attribute": "user_id",
+                    "operator": "RULE_OPERATOR_IN_SEGMENT",
+                    "values": ["beta-testers"],
+                    "value": true,
+                    "variant": ""
+                },
+                "variant": null
+            }
+            """
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"user_id": "user-2", "country": "SG"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": false,
+                "reason": "EVALUATION_REASON_FALLTHROUGH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": null,
+                "variant": null
+            }
+            """
+
+    Scenario: Updated segment is used by the next evaluation
+        Given I create segment with body
+            """
+            {
+                "key": "beta-testers"
+            }
+            """
+        And there are toggles with
+            | {"key": "toggle-1", "rules": [{"attribute": "user_id", "operator": "RULE_OPERATOR_IN_SEGMENT", "values": ["beta-testers"], "value": true}], "default_value": false} |
+        And I enable toggle with key "toggle-1"
+        When I update segment with key "beta-testers" with body
+            """
+            {
+                "included": ["user-1"]
+            }
+            """
+        Then response status code must be 200
+        When I evaluate toggle with key "toggle-1" and context
+            """
+            {"context": {"user_id": "user-1"}}
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "value": true,
+                "reason": "EVALUATION_REASON_RULE_MATCH",
+                "failedPrerequisite": "",
+                "version": "2",
+                "matchedRule": {
+                    "attribute": "user_id",
+                    "operator": "RULE_OPERATOR_IN_SEGMENT",
+                    "values": ["beta-testers"],
+                    "value": true,
+                    "variant": ""
+                },
+                "variant": null
+            }
+            """
+
+    Scenario: Segment that is still used by toggles can't be deleted
+        Given I create segment with body
+            """
+            {
+                "key": "beta-testers",
+                "included": ["user-1"]
+            }
+            """
+        And there are toggles with
+            | {"key": "toggle-1", "rules": [{"attribute": "user_id", "operator": "RULE_OPERATOR_NOT_IN_SEGMENT", "values": ["beta-testers"], "value": true}]} |
+        When I delete segment with key "beta-testers"
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 9,
+                "message": "segment is still used by toggles hence it can't be deleted",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_SEGMENT_IN_USE"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Unused segment can be deleted
+        Given I create segment with body
+            """
+            {
+                "key": "beta-testers"
+            }
+            """
+        When I delete segment with key "beta-testers"
+        Then response status code must be 200
+        When I get segment with key "beta-testers"
+        Then response status code must be 404
@@ -0,0 +1,120 @@
+Feature: Toggle snapshot
+
+    In order to move toggles between environments
+    I need to export toggles as a snapshot and import them back
+
+    Scenario: Dry run shows the changes without making them
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1", "description": "old"} |
+            | {"key": "toggle-2"}                       |
+        When I upload toggles with query "dry_run=true&prune=true" and body
+            """
+            toggles:
+              - key: toggle-1
+                description: new
+              - key: toggle-3
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "changes": [
+                    {
+                        "key": "toggle-1",
+                        "action": "TOGGLE_IMPORT_ACTION_UPDATE",
+                        "fields": ["description"],
+                        "errorCode": "TOGGLE_ERROR_CODE_IMPORT_CONFLICT",
+                        "errorMessage": "toggle differs from the snapshot"
+                    },
+                    {"key": "toggle-2", "action": "TOGGLE_IMPORT_ACTION_DELETE"},
+                    {"key": "toggle-3", "action": "TOGGLE_IMPORT_ACTION_CREATE"}
+                ]
+            }
+            """
+        When I get single toggle with key "toggle-3"
+        Then response status code must be 404
+
+    Scenario: Conflict fails the import
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1", "description": "old"} |
+        When I import toggles with body
+            """
+            {
+                "snapshot": {"toggles": [{"key": "toggle-1", "description": "new"}, {"key": "toggle-2"}]}
+            }
+            """
+        Then response status code must be 409
+        When I get single toggle with key "toggle-2"
+        Then response status code must be 404
+
+    Scenario: Existing toggles are overwritten
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1", "description": "old"} |
+        When I import toggles with body
+            """
+            {
+                "snapshot": {"toggles": [{"key": "toggle-1", "description": "new", "isEnabled": true}, {"key": "toggle-2"}]},
+                "conflictPolicy": "IMPORT_CONFLICT_POLICY_OVERWRITE"
+            }
+            """
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "changes": [
+                    {"key": "toggle-1", "action": "TOGGLE_IMPORT_ACTION_UPDATE", "fields": ["is_enabled", "description"]},
+                    {"key": "toggle-2", "action": "TOGGLE_IMPORT_ACTION_CREATE"}
+                ]
+            }
+            """
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 200
+        And response single toggle should match
+            """
+            {
+                "toggle": {
+                    "key": "toggle-1",
+                    "is_enabled": true,
+                    "description": "new"
+                }
+            }
+            """
+
+    Scenario: Overwritten toggles are recorded in the history
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1", "description": "old"} |
+        When I import toggles with body
+            """
+            {
+                "snapshot": {"toggles": [{"key": "toggle-1", "description": "old", "isEnabled": true, "rollout": {"percentage": 30, "bucketBy": "user_id"}}]},
+                "conflictPolicy": "IMPORT_CONFLICT_POLICY_OVERWRITE"
+            }
+            """
+        Then response status code must be 200
+        When I get the history of toggle with key "toggle-1"
+        Then response status code must be 200
+        And response history should be "AUDIT_ACTION_ENABLE::import,AUDIT_ACTION_UPDATE::import,AUDIT_ACTION_CREATE::"
+
+    Scenario: Downloaded toggles are unchanged when uploaded back
+        Given the toggle is empty
+        And there are toggles with
+            | {"key": "toggle-1", "tags": ["team-a"]} |
+            | {"key": "toggle-2"}                     |
+        And I enable toggle with key "toggle-1"
+        When I download toggles as "yaml"
+        Then response status code must be 200
+        When I upload the downloaded toggles with query "format=yaml"
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "changes": [
+                    {"key": "toggle-1", "action": "TOGGLE_IMPORT_ACTION_UNCHANGED"},
+                    {"key": "toggle-2", "action": "TOGGLE_IMPORT_ACTION_UNCHANGED"}
+                ]
+            }
+            """
@@ -0,0 +1,30 @@
+Feature: Stale toggle
+
+    In order to clean up toggles long after their launches finish
+    I need to find toggles which have expired or haven't changed for a long time
+
+    Scenario: Toggle whose expiry time has passed is stale
+        Given there are toggles with
+            | {"key": "toggle-1", "owner": "team-checkout", "expiresAt": "2021-01-01T00:00:00Z"} |
+            | {"key": "toggle-2", "owner": "team-checkout", "expiresAt": "2099-01-01T00:00:00Z"} |
+            | {"key": "toggle-3"}                                                                |
+        When I get stale toggles
+        Then response status code must be 200
+        And response stale toggles should be "toggle-1:STALE_REASON_EXPIRED"
+
+    Scenario: Recently changed toggle without expiry time isn't stale
+        Given there are toggles with
+            | {"key": "toggle-1"} |
+        When I get stale toggles
+        Then response status code must be 200
+        And response stale toggles should be ""
+
+    Scenario: Toggle keeps its owner
+        Given there are toggles with
+            | {"key": "toggle-1", "owner": " team-checkout "} |
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 200
+        And response single toggle should match
+            """
+            {"toggle": {"key": "toggle-1", "owner": "team-checkout"}}
+            """
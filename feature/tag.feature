@@ -0,0 +1,76 @@
+Feature: Tag
+
+    In order to organize toggles by team or purpose
+    I need to attach tags to toggles and filter toggles by their tags
+
+    Scenario: Tag must have valid format
+        When I create toggle with body
+            | {"key": "toggle-1", "tags": ["team:payments:core"]} |
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "tags",
+                            "description": "contain character outside of alphanumeric, dash, underscore, dot, and a single colon"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_TAG"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggles are filtered by any of the tags
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "tags": ["team:payments", "kind:kill-switch"]} |
+            | {"key": "toggle-2", "description": "description 2", "tags": ["team:checkout"]}                     |
+            | {"key": "toggle-3", "description": "description 3", "tags": ["team:search"]}                       |
+        When I get all toggles with query "anyTags=team:payments&anyTags=team:checkout"
+        Then response status code must be 200
+        And response toggles should match
+            """
+            {
+                "toggles": [
+                    {
+                        "key": "toggle-1",
+                        "is_enabled": false,
+                        "description": "description 1"
+                    },
+                    {
+                        "key": "toggle-2",
+                        "is_enabled": false,
+                        "description": "description 2"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggles are filtered by all of the tags
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "tags": ["team:payments", "kind:kill-switch"]} |
+            | {"key": "toggle-2", "description": "description 2", "tags": ["team:payments"]}                     |
+            | {"key": "toggle-3", "description": "description 3", "tags": ["kind:kill-switch"]}                  |
+        When I get all toggles with query "allTags=team:payments&allTags=kind:kill-switch"
+        Then response status code must be 200
+        And response toggles should match
+            """
+            {
+                "toggles": [
+                    {
+                        "key": "toggle-1",
+                        "is_enabled": false,
+                        "description": "description 1"
+                    }
+                ]
+            }
+            """
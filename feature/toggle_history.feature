@@ -0,0 +1,35 @@
+Feature: Toggle history
+
+    In order to know who changed a toggle and why
+    I need to list the toggle's history
+
+    Scenario: Toggle's changes are recorded along with their actors and reasons
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I enable toggle with key "toggle-1" as "jane" because "launch"
+        And I disable toggle with key "toggle-1" as "john" because "incident"
+        And I get the history of toggle with key "toggle-1"
+        Then response status code must be 200
+        And response history should be "AUDIT_ACTION_DISABLE:john:incident,AUDIT_ACTION_ENABLE:jane:launch,AUDIT_ACTION_CREATE::"
+
+    Scenario: Toggle's history can be filtered by actor
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I enable toggle with key "toggle-1" as "jane" because "launch"
+        And I disable toggle with key "toggle-1" as "john" because "incident"
+        And I get the history of toggle with key "toggle-1" changed by "jane"
+        Then response status code must be 200
+        And response history should be "AUDIT_ACTION_ENABLE:jane:launch"
+
+    Scenario: Toggle's history doesn't contain the changes of the other toggles
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+            | {"key": "toggle-2", "description": "description 2"} |
+        When I enable toggle with key "toggle-2" as "jane" because "launch"
+        And I get the history of toggle with key "toggle-1"
+        Then response status code must be 200
+        And response history should be "AUDIT_ACTION_CREATE::"
+
+    Scenario: Empty time range is invalid
+        When I get the history with query "startTime=2022-03-01T00:00:00Z&endTime=2022-02-01T00:00:00Z"
+        Then response status code must be 400
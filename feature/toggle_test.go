@@ -1,12 +1,14 @@
 package toggle_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"reflect"
 	"strings"
@@ -20,10 +22,16 @@ import (
 var (
 	ctx       = context.Background()
 	client    = http.DefaultClient
-	toggleURL = "http://localhost:8081/v1/toggles"
+	toggleURL = "http://localhost:8081/v1/projects/default/environments/production/toggles"
+
+	defaultProject     = "default"
+	defaultEnvironment = "production"
 
 	httpStatus int
 	httpBody   []byte
+
+	createdScheduleID string
+	scenarioStartedAt time.Time
 )
 
 type Toggle struct {
@@ -32,6 +40,7 @@ type Toggle struct {
 	Description string    `json:"description"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	Owner       string    `json:"owner"`
 }
 
 type GetSingleResponse struct {
@@ -39,7 +48,68 @@ type GetSingleResponse struct {
 }
 
 type GetAllResponse struct {
-	Toggles []*Toggle `json:"toggles"`
+	Toggles       []*Toggle `json:"toggles"`
+	NextPageToken string    `json:"nextPageToken"`
+	TotalSize     int       `json:"totalSize"`
+}
+
+type Environment struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type GetAllEnvironmentsResponse struct {
+	Environments []*Environment `json:"environments"`
+}
+
+type Project struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type GetAllProjectsResponse struct {
+	Projects []*Project `json:"projects"`
+}
+
+type Segment struct {
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+type GetAllSegmentsResponse struct {
+	Segments []*Segment `json:"segments"`
+}
+
+type Schedule struct {
+	ID     string `json:"id"`
+	Action string `json:"action"`
+}
+
+type CreateScheduleResponse struct {
+	Schedule *Schedule `json:"schedule"`
+}
+
+type GetAllSchedulesResponse struct {
+	Schedules []*Schedule `json:"schedules"`
+}
+
+type StaleToggle struct {
+	Toggle  *Toggle  `json:"toggle"`
+	Reasons []string `json:"reasons"`
+}
+
+type GetStaleTogglesResponse struct {
+	StaleToggles []*StaleToggle `json:"staleToggles"`
+}
+
+type ToggleAuditEntry struct {
+	Action string `json:"action"`
+	Actor  string `json:"actor"`
+	Reason string `json:"reason"`
+}
+
+type ListToggleHistoryResponse struct {
+	Entries []*ToggleAuditEntry `json:"entries"`
 }
 
 func TestMain(_ *testing.M) {
@@ -54,6 +124,7 @@ func TestMain(_ *testing.M) {
 func restoreDefaultState(ctx context.Context, sc *godog.Scenario) (context.Context, error) {
 	err := disableAndDeleteAll()
 	checkErr(err)
+	scenarioStartedAt = time.Now().UTC()
 	return ctx, nil
 }
 
@@ -79,12 +150,60 @@ func InitializeScenario(ctx *godog.ScenarioContext) {
 	ctx.Step(`^I enable toggle with key "([^"]*)"$`, iEnableToggleWithKey)
 	ctx.Step(`^I disable toggle with key "([^"]*)"$`, iDisableToggleWithKey)
 	ctx.Step(`^I delete toggle with key "([^"]*)"$`, iDeleteToggleWithKey)
+	ctx.Step(`^I update toggle with key "([^"]*)" with body$`, iUpdateToggleWithKeyWithBody)
+	ctx.Step(`^I enable toggle with key "([^"]*)" if its version is (\d+)$`, iEnableToggleWithKeyIfItsVersionIs)
+	ctx.Step(`^I update toggle with key "([^"]*)" if its version is (\d+) with body$`, iUpdateToggleWithKeyIfItsVersionIsWithBody)
+	ctx.Step(`^I delete toggle with key "([^"]*)" with expected version (\d+)$`, iDeleteToggleWithKeyWithExpectedVersion)
+	ctx.Step(`^I purge toggle with key "([^"]*)"$`, iPurgeToggleWithKey)
+	ctx.Step(`^I restore toggle with key "([^"]*)"$`, iRestoreToggleWithKey)
+	ctx.Step(`^I batch create toggles with body$`, iBatchCreateTogglesWithBody)
+	ctx.Step(`^I batch update toggles with body$`, iBatchUpdateTogglesWithBody)
+	ctx.Step(`^I import toggles with body$`, iImportTogglesWithBody)
+	ctx.Step(`^I download toggles as "([^"]*)"$`, iDownloadTogglesAs)
+	ctx.Step(`^I upload toggles with query "([^"]*)" and body$`, iUploadTogglesWithQueryAndBody)
+	ctx.Step(`^I upload the downloaded toggles with query "([^"]*)"$`, iUploadTheDownloadedTogglesWithQuery)
 	ctx.Step(`^I get all toggles$`, iGetAllToggles)
+	ctx.Step(`^I get all toggles with query "([^"]*)"$`, iGetAllTogglesWithQuery)
+	ctx.Step(`^I get the next page of toggles with query "([^"]*)"$`, iGetTheNextPageOfTogglesWithQuery)
 	ctx.Step(`^I get single toggle with key "([^"]*)"$`, iGetSingleToggleWithKey)
+	ctx.Step(`^I create toggle in environment "([^"]*)" with body$`, iCreateToggleInEnvironmentWithBody)
+	ctx.Step(`^I enable toggle with key "([^"]*)" in environment "([^"]*)"$`, iEnableToggleWithKeyInEnvironment)
+	ctx.Step(`^I get single toggle with key "([^"]*)" in environment "([^"]*)"$`, iGetSingleToggleWithKeyInEnvironment)
+	ctx.Step(`^I create environment with body$`, iCreateEnvironmentWithBody)
+	ctx.Step(`^I get all environments$`, iGetAllEnvironments)
+	ctx.Step(`^I create project with body$`, iCreateProjectWithBody)
+	ctx.Step(`^I get all projects$`, iGetAllProjects)
+	ctx.Step(`^I get project with name "([^"]*)"$`, iGetProjectWithName)
+	ctx.Step(`^I delete project with name "([^"]*)"$`, iDeleteProjectWithName)
+	ctx.Step(`^I create toggle in project "([^"]*)" with body$`, iCreateToggleInProjectWithBody)
+	ctx.Step(`^I get all toggles in project "([^"]*)"$`, iGetAllTogglesInProject)
+	ctx.Step(`^I create segment with body$`, iCreateSegmentWithBody)
+	ctx.Step(`^I update segment with key "([^"]*)" with body$`, iUpdateSegmentWithKeyWithBody)
+	ctx.Step(`^I get segment with key "([^"]*)"$`, iGetSegmentWithKey)
+	ctx.Step(`^I delete segment with key "([^"]*)"$`, iDeleteSegmentWithKey)
+	ctx.Step(`^I evaluate toggle with key "([^"]*)" and context$`, iEvaluateToggleWithKeyAndContext)
+	ctx.Step(`^I update prerequisites of toggle with key "([^"]*)" with body$`, iUpdatePrerequisitesOfToggleWithKeyWithBody)
+	ctx.Step(`^I update rollout of toggle with key "([^"]*)" in environment "([^"]*)" with body$`, iUpdateRolloutOfToggleWithKeyInEnvironmentWithBody)
+	ctx.Step(`^I update rules of toggle with key "([^"]*)" in environment "([^"]*)" with body$`, iUpdateRulesOfToggleWithKeyInEnvironmentWithBody)
+	ctx.Step(`^I schedule toggle with key "([^"]*)" with body$`, iScheduleToggleWithKeyWithBody)
+	ctx.Step(`^I get schedules of toggle with key "([^"]*)"$`, iGetSchedulesOfToggleWithKey)
+	ctx.Step(`^I cancel the created schedule of toggle with key "([^"]*)"$`, iCancelTheCreatedScheduleOfToggleWithKey)
+	ctx.Step(`^I get stale toggles$`, iGetStaleToggles)
+	ctx.Step(`^I enable toggle with key "([^"]*)" as "([^"]*)" because "([^"]*)"$`, iEnableToggleWithKeyAsBecause)
+	ctx.Step(`^I disable toggle with key "([^"]*)" as "([^"]*)" because "([^"]*)"$`, iDisableToggleWithKeyAsBecause)
+	ctx.Step(`^I get the history of toggle with key "([^"]*)"$`, iGetTheHistoryOfToggleWithKey)
+	ctx.Step(`^I get the history of toggle with key "([^"]*)" changed by "([^"]*)"$`, iGetTheHistoryOfToggleWithKeyChangedBy)
+	ctx.Step(`^I get the history with query "([^"]*)"$`, iGetTheHistoryWithQuery)
 	ctx.Step(`^response status code must be (\d+)$`, responseStatusCodeMustBe)
 	ctx.Step(`^response must match json$`, responseMustMatchJSON)
 	ctx.Step(`^response single toggle should match$`, responseSingleToggleShouldMatch)
 	ctx.Step(`^response toggles should match$`, responseTogglesShouldMatch)
+	ctx.Step(`^response toggle keys should be "([^"]*)" out of (\d+)$`, responseToggleKeysShouldBeOutOf)
+	ctx.Step(`^response environments should contain "([^"]*)"$`, responseEnvironmentsShouldContain)
+	ctx.Step(`^response projects should contain "([^"]*)"$`, responseProjectsShouldContain)
+	ctx.Step(`^response schedules should be "([^"]*)"$`, responseSchedulesShouldBe)
+	ctx.Step(`^response stale toggles should be "([^"]*)"$`, responseStaleTogglesShouldBe)
+	ctx.Step(`^response history should be "([^"]*)"$`, responseHistoryShouldBe)
 }
 
 func thereAreTogglesWith(requests *godog.Table) error {
@@ -118,14 +237,148 @@ func iDeleteToggleWithKey(key string) error {
 	return callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s", toggleURL, key), nil)
 }
 
+func iUpdateToggleWithKeyWithBody(key string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPatch, fmt.Sprintf("%s/%s", toggleURL, key), strings.NewReader(body.Content))
+}
+
+func iEnableToggleWithKeyIfItsVersionIs(key string, version int) error {
+	return callEndpointWithHeader(http.MethodPut, fmt.Sprintf("%s/%s/enable", toggleURL, key), nil, ifMatch(version))
+}
+
+func iUpdateToggleWithKeyIfItsVersionIsWithBody(key string, version int, body *godog.DocString) error {
+	return callEndpointWithHeader(http.MethodPatch, fmt.Sprintf("%s/%s", toggleURL, key), strings.NewReader(body.Content), ifMatch(version))
+}
+
+func iDeleteToggleWithKeyWithExpectedVersion(key string, version int) error {
+	return callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s?expectedVersion=%d", toggleURL, key, version), nil)
+}
+
+func ifMatch(version int) http.Header {
+	header := http.Header{}
+	header.Set("If-Match", fmt.Sprintf("%q", fmt.Sprint(version)))
+	return header
+}
+
+func iPurgeToggleWithKey(key string) error {
+	return callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s?purge=true", toggleURL, key), nil)
+}
+
+func iRestoreToggleWithKey(key string) error {
+	return callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/restore", toggleURL, key), nil)
+}
+
+func iBatchCreateTogglesWithBody(body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, toggleURL+":batchCreate", strings.NewReader(body.Content))
+}
+
+func iBatchUpdateTogglesWithBody(body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, toggleURL+":batchUpdate", strings.NewReader(body.Content))
+}
+
+func iImportTogglesWithBody(body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, toggleURL+":import", strings.NewReader(body.Content))
+}
+
+func iDownloadTogglesAs(format string) error {
+	return callEndpoint(http.MethodGet, toggleURL+":download?format="+format, nil)
+}
+
+func iUploadTogglesWithQueryAndBody(query string, body *godog.DocString) error {
+	header := http.Header{"Content-Type": []string{"application/yaml"}}
+	return callEndpointWithHeader(http.MethodPost, toggleURL+":upload?"+query, strings.NewReader(body.Content), header)
+}
+
+func iUploadTheDownloadedTogglesWithQuery(query string) error {
+	return callEndpoint(http.MethodPost, toggleURL+":upload?"+query, bytes.NewReader(httpBody))
+}
+
 func iGetAllToggles() error {
 	return callEndpoint(http.MethodGet, toggleURL, nil)
 }
 
+func iGetAllTogglesWithQuery(query string) error {
+	return callEndpoint(http.MethodGet, toggleURL+"?"+query, nil)
+}
+
+func iGetTheNextPageOfTogglesWithQuery(query string) error {
+	var resp GetAllResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+	if resp.NextPageToken == "" {
+		return fmt.Errorf("expected next page token but not found")
+	}
+	return callEndpoint(http.MethodGet, toggleURL+"?"+query+"&pageToken="+url.QueryEscape(resp.NextPageToken), nil)
+}
+
 func iGetSingleToggleWithKey(key string) error {
 	return callEndpoint(http.MethodGet, fmt.Sprintf("%s/%s", toggleURL, key), nil)
 }
 
+func iCreateToggleInEnvironmentWithBody(env string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, toggleURLInEnvironment(env), strings.NewReader(body.Content))
+}
+
+func iEnableToggleWithKeyInEnvironment(key, env string) error {
+	return callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/enable", toggleURLInEnvironment(env), key), nil)
+}
+
+func iGetSingleToggleWithKeyInEnvironment(key, env string) error {
+	return callEndpoint(http.MethodGet, fmt.Sprintf("%s/%s", toggleURLInEnvironment(env), key), nil)
+}
+
+func iCreateEnvironmentWithBody(body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, environmentURL(), strings.NewReader(body.Content))
+}
+
+func iGetAllEnvironments() error {
+	return callEndpoint(http.MethodGet, environmentURL(), nil)
+}
+
+func iCreateProjectWithBody(body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, projectURL(), strings.NewReader(body.Content))
+}
+
+func iGetAllProjects() error {
+	return callEndpoint(http.MethodGet, projectURL(), nil)
+}
+
+func iGetProjectWithName(name string) error {
+	return callEndpoint(http.MethodGet, fmt.Sprintf("%s/%s", projectURL(), name), nil)
+}
+
+func iDeleteProjectWithName(name string) error {
+	return callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s", projectURL(), name), nil)
+}
+
+func iCreateToggleInProjectWithBody(project string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, toggleURLInProject(project), strings.NewReader(body.Content))
+}
+
+func iGetAllTogglesInProject(project string) error {
+	return callEndpoint(http.MethodGet, toggleURLInProject(project), nil)
+}
+
+func iCreateSegmentWithBody(body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, segmentURL(defaultProject), strings.NewReader(body.Content))
+}
+
+func iUpdateSegmentWithKeyWithBody(key string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s", segmentURL(defaultProject), key), strings.NewReader(body.Content))
+}
+
+func iGetSegmentWithKey(key string) error {
+	return callEndpoint(http.MethodGet, fmt.Sprintf("%s/%s", segmentURL(defaultProject), key), nil)
+}
+
+func iDeleteSegmentWithKey(key string) error {
+	return callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s", segmentURL(defaultProject), key), nil)
+}
+
+func iEvaluateToggleWithKeyAndContext(key string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPost, fmt.Sprintf("%s/%s/evaluate", toggleURL, key), strings.NewReader(body.Content))
+}
+
 func responseStatusCodeMustBe(code int) error {
 	if httpStatus != code {
 		return fmt.Errorf("expected HTTP status code %d, but got %d", code, httpStatus)
@@ -145,8 +398,71 @@ func responseTogglesShouldMatch(want *godog.DocString) error {
 	return compareTogglesJSON([]byte(want.Content), httpBody)
 }
 
+func responseToggleKeysShouldBeOutOf(keys string, total int) error {
+	var resp GetAllResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	have := []string{}
+	for _, toggle := range resp.Toggles {
+		have = append(have, toggle.Key)
+	}
+	want := []string{}
+	if keys != "" {
+		want = strings.Split(keys, ",")
+	}
+	if !reflect.DeepEqual(want, have) {
+		return fmt.Errorf("expected toggle keys %v, but got %v", want, have)
+	}
+	if resp.TotalSize != total {
+		return fmt.Errorf("expected total size %d, but got %d", total, resp.TotalSize)
+	}
+	return nil
+}
+
+func responseEnvironmentsShouldContain(names string) error {
+	var resp GetAllEnvironmentsResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	flag := make(map[string]bool)
+	for _, env := range resp.Environments {
+		flag[env.Name] = true
+	}
+	for _, name := range strings.Split(names, ",") {
+		if !flag[strings.TrimSpace(name)] {
+			return fmt.Errorf("expected environment %s but not found", strings.TrimSpace(name))
+		}
+	}
+	return nil
+}
+
+func responseProjectsShouldContain(names string) error {
+	var resp GetAllProjectsResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	flag := make(map[string]bool)
+	for _, project := range resp.Projects {
+		flag[project.Name] = true
+	}
+	for _, name := range strings.Split(names, ",") {
+		if !flag[strings.TrimSpace(name)] {
+			return fmt.Errorf("expected project %s but not found", strings.TrimSpace(name))
+		}
+	}
+	return nil
+}
+
 func getAllToggles() ([]*Toggle, error) {
-	if err := callEndpoint(http.MethodGet, toggleURL, nil); err != nil {
+	return getAllTogglesInProject(defaultProject)
+}
+
+func getAllTogglesInProject(project string) ([]*Toggle, error) {
+	if err := callEndpoint(http.MethodGet, toggleURLInProject(project)+"?includeDeleted=true", nil); err != nil {
 		return nil, err
 	}
 
@@ -158,28 +474,292 @@ func getAllToggles() ([]*Toggle, error) {
 	return resp.Toggles, nil
 }
 
+func getAllEnvironments() ([]*Environment, error) {
+	if err := callEndpoint(http.MethodGet, environmentURL(), nil); err != nil {
+		return nil, err
+	}
+
+	var resp GetAllEnvironmentsResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Environments, nil
+}
+
+func getAllProjects() ([]*Project, error) {
+	if err := callEndpoint(http.MethodGet, projectURL(), nil); err != nil {
+		return nil, err
+	}
+
+	var resp GetAllProjectsResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Projects, nil
+}
+
+// deleteAllSegments deletes every segment in the project.
+// The project's toggles must be deleted first since segment that is still used by toggles can't be deleted.
+func deleteAllSegments(project string) error {
+	if err := callEndpoint(http.MethodGet, segmentURL(project), nil); err != nil {
+		return err
+	}
+
+	var resp GetAllSegmentsResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	for _, segment := range resp.Segments {
+		if err := callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s", segmentURL(project), segment.Key), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteAllProjects deletes every project other than the default project.
+// The project's toggles and segments are deleted first since project that still owns them can't be deleted.
+func deleteAllProjects() error {
+	projects, err := getAllProjects()
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		if project.Name == defaultProject {
+			continue
+		}
+		toggles, err := getAllTogglesInProject(project.Name)
+		if err != nil {
+			return err
+		}
+		for _, toggle := range toggles {
+			if err = callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s?purge=true", toggleURLInProject(project.Name), toggle.Key), nil); err != nil {
+				return err
+			}
+		}
+		if err = deleteAllSegments(project.Name); err != nil {
+			return err
+		}
+		if err = callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s", projectURL(), project.Name), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func iUpdatePrerequisitesOfToggleWithKeyWithBody(key string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/prerequisites", toggleURL, key), strings.NewReader(body.Content))
+}
+
+func iUpdateRolloutOfToggleWithKeyInEnvironmentWithBody(key, env string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/rollout", toggleURLInEnvironment(env), key), strings.NewReader(body.Content))
+}
+
+func iUpdateRulesOfToggleWithKeyInEnvironmentWithBody(key, env string, body *godog.DocString) error {
+	return callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/rules", toggleURLInEnvironment(env), key), strings.NewReader(body.Content))
+}
+
+// iScheduleToggleWithKeyWithBody creates a schedule and remembers its id so that it can be cancelled later.
+func iScheduleToggleWithKeyWithBody(key string, body *godog.DocString) error {
+	if err := callEndpoint(http.MethodPost, fmt.Sprintf("%s/%s/schedules", toggleURL, key), strings.NewReader(body.Content)); err != nil {
+		return err
+	}
+	var resp CreateScheduleResponse
+	if httpStatus == http.StatusOK && json.Unmarshal(httpBody, &resp) == nil && resp.Schedule != nil {
+		createdScheduleID = resp.Schedule.ID
+	}
+	return nil
+}
+
+func iGetSchedulesOfToggleWithKey(key string) error {
+	return callEndpoint(http.MethodGet, fmt.Sprintf("%s/%s/schedules", toggleURL, key), nil)
+}
+
+func iCancelTheCreatedScheduleOfToggleWithKey(key string) error {
+	return callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s/schedules/%s", toggleURL, key, createdScheduleID), nil)
+}
+
+// responseSchedulesShouldBe compares the actions of the schedules in the response, in order, with the comma-separated actions.
+// Empty actions means there isn't any schedule.
+func responseSchedulesShouldBe(actions string) error {
+	var resp GetAllSchedulesResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	have := []string{}
+	for _, schedule := range resp.Schedules {
+		have = append(have, schedule.Action)
+	}
+	want := []string{}
+	if actions != "" {
+		want = strings.Split(actions, ",")
+	}
+	if !reflect.DeepEqual(want, have) {
+		return fmt.Errorf("expected schedules %v, but got %v", want, have)
+	}
+	return nil
+}
+
+func iGetStaleToggles() error {
+	return callEndpoint(http.MethodGet, strings.TrimSuffix(toggleURL, "/toggles")+"/stale-toggles", nil)
+}
+
+// responseStaleTogglesShouldBe compares the stale toggles in the response with the comma-separated list of key:reason.
+// Empty list means there isn't any stale toggle.
+func responseStaleTogglesShouldBe(stales string) error {
+	var resp GetStaleTogglesResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	have := []string{}
+	for _, stale := range resp.StaleToggles {
+		for _, reason := range stale.Reasons {
+			have = append(have, stale.Toggle.Key+":"+reason)
+		}
+	}
+	want := []string{}
+	if stales != "" {
+		want = strings.Split(stales, ",")
+	}
+	if !reflect.DeepEqual(want, have) {
+		return fmt.Errorf("expected stale toggles %v, but got %v", want, have)
+	}
+	return nil
+}
+
+func iEnableToggleWithKeyAsBecause(key, actor, reason string) error {
+	return callEndpointWithHeader(http.MethodPut, fmt.Sprintf("%s/%s/enable", toggleURL, key), nil, auditHeader(actor, reason))
+}
+
+func iDisableToggleWithKeyAsBecause(key, actor, reason string) error {
+	return callEndpointWithHeader(http.MethodPut, fmt.Sprintf("%s/%s/disable", toggleURL, key), nil, auditHeader(actor, reason))
+}
+
+func auditHeader(actor, reason string) http.Header {
+	header := http.Header{}
+	header.Set("X-Actor", actor)
+	header.Set("X-Reason", reason)
+	return header
+}
+
+// iGetTheHistoryOfToggleWithKey only gets the history since the scenario started,
+// since the history of the toggles deleted by the previous scenarios is never removed.
+func iGetTheHistoryOfToggleWithKey(key string) error {
+	return iGetTheHistoryWithQuery("key=" + url.QueryEscape(key) + "&startTime=" + scenarioStartedAt.Format(time.RFC3339Nano))
+}
+
+func iGetTheHistoryOfToggleWithKeyChangedBy(key, actor string) error {
+	return iGetTheHistoryWithQuery("key=" + url.QueryEscape(key) + "&actor=" + url.QueryEscape(actor) + "&startTime=" + scenarioStartedAt.Format(time.RFC3339Nano))
+}
+
+func iGetTheHistoryWithQuery(query string) error {
+	return callEndpoint(http.MethodGet, strings.TrimSuffix(toggleURL, "/environments/production/toggles")+"/toggle-history?"+query, nil)
+}
+
+// responseHistoryShouldBe compares the history in the response with the comma-separated list of action:actor:reason.
+func responseHistoryShouldBe(entries string) error {
+	var resp ListToggleHistoryResponse
+	if err := json.Unmarshal(httpBody, &resp); err != nil {
+		return err
+	}
+
+	have := []string{}
+	for _, entry := range resp.Entries {
+		have = append(have, entry.Action+":"+entry.Actor+":"+entry.Reason)
+	}
+	want := []string{}
+	if entries != "" {
+		want = strings.Split(entries, ",")
+	}
+	if !reflect.DeepEqual(want, have) {
+		return fmt.Errorf("expected history %v, but got %v", want, have)
+	}
+	return nil
+}
+
+// disableAndDeleteAll disables toggles in all environments and drops their prerequisites before deleting them,
+// since toggle can't be deleted if it is enabled in any environment or if it is a prerequisite of another toggle.
+// Segments of the default project and projects other than the default project are deleted as well.
 func disableAndDeleteAll() error {
+	if err := deleteAllProjects(); err != nil {
+		return err
+	}
+
 	toggles, err := getAllToggles()
 	if err != nil {
 		return err
 	}
+	envs, err := getAllEnvironments()
+	if err != nil {
+		return err
+	}
 
 	for _, toggle := range toggles {
-		if err = callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/disable", toggleURL, toggle.Key), nil); err != nil {
+		if err = callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/prerequisites", toggleURL, toggle.Key), strings.NewReader(`{"prerequisites": []}`)); err != nil {
 			return err
 		}
-		if err = callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s", toggleURL, toggle.Key), nil); err != nil {
+	}
+	for _, toggle := range toggles {
+		for _, env := range envs {
+			if err = callEndpoint(http.MethodPut, fmt.Sprintf("%s/%s/disable", toggleURLInEnvironment(env.Name), toggle.Key), nil); err != nil {
+				return err
+			}
+		}
+		if err = callEndpoint(http.MethodDelete, fmt.Sprintf("%s/%s?purge=true", toggleURL, toggle.Key), nil); err != nil {
 			return err
 		}
 	}
-	return nil
+	return deleteAllSegments(defaultProject)
+}
+
+// toggleURLInEnvironment replaces the default environment in toggleURL with env.
+func toggleURLInEnvironment(env string) string {
+	return strings.Replace(toggleURL, "/"+defaultEnvironment+"/", "/"+env+"/", 1)
+}
+
+// toggleURLInProject replaces the default project in toggleURL with project.
+func toggleURLInProject(project string) string {
+	return strings.Replace(toggleURL, "/projects/"+defaultProject+"/", "/projects/"+project+"/", 1)
+}
+
+// baseURL derives the API's base URL, such as http://localhost:8081/v1, from toggleURL.
+func baseURL() string {
+	return toggleURL[:strings.Index(toggleURL, "/projects/")]
+}
+
+// environmentURL derives the environment's URL from toggleURL.
+func environmentURL() string {
+	return baseURL() + "/environments"
+}
+
+// projectURL derives the project's URL from toggleURL.
+func projectURL() string {
+	return baseURL() + "/projects"
+}
+
+// segmentURL derives the URL of the project's segments from toggleURL.
+func segmentURL(project string) string {
+	return projectURL() + "/" + project + "/segments"
 }
 
 func callEndpoint(method, url string, body io.Reader) error {
+	return callEndpointWithHeader(method, url, body, nil)
+}
+
+func callEndpointWithHeader(method, url string, body io.Reader, header http.Header) error {
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return err
 	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -226,10 +806,10 @@ func compareSingleToggleJSON(want, have []byte) error {
 		return err
 	}
 
-	expKey := fmt.Sprintf("%s!!%t!!%s", expected.Toggle.Key, expected.Toggle.IsEnabled, expected.Toggle.Description)
-	actKey := fmt.Sprintf("%s!!%t!!%s", actual.Toggle.Key, actual.Toggle.IsEnabled, actual.Toggle.Description)
+	expKey := fmt.Sprintf("%s!!%t!!%s!!%s", expected.Toggle.Key, expected.Toggle.IsEnabled, expected.Toggle.Description, expected.Toggle.Owner)
+	actKey := fmt.Sprintf("%s!!%t!!%s!!%s", actual.Toggle.Key, actual.Toggle.IsEnabled, actual.Toggle.Description, actual.Toggle.Owner)
 	if expKey != actKey {
-		return fmt.Errorf("expected key: %s, is_enabled: %t, description: %s but not found", expected.Toggle.Key, expected.Toggle.IsEnabled, expected.Toggle.Description)
+		return fmt.Errorf("expected key: %s, is_enabled: %t, description: %s, owner: %s but not found", expected.Toggle.Key, expected.Toggle.IsEnabled, expected.Toggle.Description, expected.Toggle.Owner)
 	}
 	return nil
 }
@@ -0,0 +1,82 @@
+Feature: Update toggle
+
+    In order to keep toggle's information up to date
+    I need to update the toggle without recreating it
+
+    Scenario: Update non-existing toggle
+        Given the toggle is empty
+        When I update toggle with key "toggle-1" with body
+            """
+            {
+                "description": "new description"
+            }
+            """
+        Then response status code must be 404
+
+    Scenario: Key can't be updated
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I update toggle with key "toggle-1" with body
+            """
+            {
+                "key": "toggle-2"
+            }
+            """
+        Then response status code must be 400
+        And response must match json
+            """
+            {
+                "code": 3,
+                "message": "",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/google.rpc.BadRequest",
+                        "fieldViolations": [
+                            {
+                            "field": "update_mask",
+                            "description": "key is unknown or immutable, only description, owner, expires_at, and tags can be updated"
+                            }
+                        ]
+                    },
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_INVALID_UPDATE_MASK"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Only the given fields are updated
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1", "owner": "team-checkout"} |
+        When I update toggle with key "toggle-1" with body
+            """
+            {
+                "description": "new description"
+            }
+            """
+        Then response status code must be 200
+        And response single toggle should match
+            """
+            {
+                "toggle": {
+                    "key": "toggle-1",
+                    "is_enabled": false,
+                    "description": "new description",
+                    "owner": "team-checkout"
+                }
+            }
+            """
+        When I get single toggle with key "toggle-1"
+        Then response status code must be 200
+        And response single toggle should match
+            """
+            {
+                "toggle": {
+                    "key": "toggle-1",
+                    "is_enabled": false,
+                    "description": "new description",
+                    "owner": "team-checkout"
+                }
+            }
+            """
@@ -0,0 +1,57 @@
+Feature: Toggle version
+
+    In order to not overwrite someone else's change
+    I need to change the toggle only if it hasn't been changed since I read it
+
+    Scenario: Toggle can be enabled if its version matches
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        When I enable toggle with key "toggle-1" if its version is 1
+        Then response status code must be 200
+        And response must match json
+            """
+            {
+                "version": "2"
+            }
+            """
+
+    Scenario: Toggle changed by someone else can't be enabled
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        And I disable toggle with key "toggle-1"
+        When I enable toggle with key "toggle-1" if its version is 1
+        Then response status code must be 409
+        And response must match json
+            """
+            {
+                "code": 10,
+                "message": "toggle has been changed, reload it and try again",
+                "details": [
+                    {
+                        "@type": "type.googleapis.com/proto.indrasaputra.toggle.v1.ToggleError",
+                        "errorCode": "TOGGLE_ERROR_CODE_VERSION_CONFLICT"
+                    }
+                ]
+            }
+            """
+
+    Scenario: Toggle changed by someone else can't be updated
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        And I disable toggle with key "toggle-1"
+        When I update toggle with key "toggle-1" if its version is 1 with body
+            """
+            {
+                "description": "new description"
+            }
+            """
+        Then response status code must be 409
+
+    Scenario: Toggle changed by someone else can't be deleted
+        Given there are toggles with
+            | {"key": "toggle-1", "description": "description 1"} |
+        And I disable toggle with key "toggle-1"
+        When I delete toggle with key "toggle-1" with expected version 1
+        Then response status code must be 409
+        When I delete toggle with key "toggle-1" with expected version 2
+        Then response status code must be 200
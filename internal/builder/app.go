@@ -17,6 +17,7 @@ import (
 	"github.com/indrasaputra/toggle/internal/repository"
 	"github.com/indrasaputra/toggle/internal/repository/postgres"
 	"github.com/indrasaputra/toggle/internal/repository/redis"
+	"github.com/indrasaputra/toggle/internal/scheduler"
 	"github.com/indrasaputra/toggle/service"
 )
 
@@ -28,8 +29,9 @@ var (
 // Dependency holds any dependency to build full use cases.
 type Dependency struct {
 	PgxPool     *pgxpool.Pool
-	RedisClient goredis.Cmdable
+	RedisClient goredis.UniversalClient
 	KafkaWriter *kafka.Writer
+	Broadcaster *messaging.Broadcaster
 	Config      *config.Config
 }
 
@@ -37,21 +39,47 @@ type Dependency struct {
 func BuildToggleCommandHandler(dep *Dependency) *handler.ToggleCommand {
 	psql := postgres.NewToggle(dep.PgxPool)
 	rds := redis.NewToggle(dep.RedisClient, time.Duration(dep.Config.Redis.TTL)*time.Minute)
-	publisher := messaging.NewRedisPublisher(&dep.Config.Redis)
+
+	evaluationPsql := postgres.NewEvaluation(dep.PgxPool)
 
 	inserterRepo := repository.NewToggleInserter(psql, rds)
 	updaterRepo := repository.NewToggleUpdater(psql, rds)
 	deleterRepo := repository.NewToggleDeleter(psql, rds)
+	prerequisiteUpdaterRepo := repository.NewTogglePrerequisiteUpdater(psql, rds)
+	batcherRepo := repository.NewToggleBatcher(psql, rds)
 
-	creator := service.NewToggleCreator(inserterRepo, publisher)
-	enabler := service.NewToggleEnabler(updaterRepo, publisher)
-	disabler := service.NewToggleDisabler(updaterRepo, publisher)
-	deleter := service.NewToggleDeleter(deleterRepo, publisher)
+	creator := service.NewToggleCreator(inserterRepo, psql)
+	enabler := service.NewToggleEnabler(updaterRepo)
+	disabler := service.NewToggleDisabler(updaterRepo)
+	deleter := service.NewToggleDeleter(deleterRepo, psql)
+	prerequisiteUpdater := service.NewTogglePrerequisiteUpdater(prerequisiteUpdaterRepo, psql)
+	updater := service.NewToggleUpdater(updaterRepo)
+	rolloutUpdater := service.NewToggleRolloutUpdater(updaterRepo)
+	rulesUpdater := service.NewToggleRulesUpdater(updaterRepo)
+	restorer := service.NewToggleRestorer(psql, psql)
+	reporter := service.NewEvaluationReporter(evaluationPsql)
+	batchCreator := service.NewToggleBatchCreator(batcherRepo, psql)
+	batchUpdater := service.NewToggleBatchUpdater(batcherRepo, psql)
+	importer := service.NewToggleImporter(psql, creator, updater, enabler, disabler, prerequisiteUpdater, rulesUpdater, rolloutUpdater, deleter)
 
-	decor := decorservice.NewTracing(creator, nil, enabler, disabler, deleter)
+	decor := decorservice.NewTracing(decorservice.ToggleServices{
+		Creator:             creator,
+		Enabler:             enabler,
+		Disabler:            disabler,
+		Deleter:             deleter,
+		PrerequisiteUpdater: prerequisiteUpdater,
+		Updater:             updater,
+		Restorer:            restorer,
+		Reporter:            reporter,
+		BatchCreator:        batchCreator,
+		BatchUpdater:        batchUpdater,
+		Importer:            importer,
+		RolloutUpdater:      rolloutUpdater,
+		RulesUpdater:        rulesUpdater,
+	})
 
 	// this one is not a good example, but I let it be for now since I compose all services in one decorator.
-	return handler.NewToggleCommand(decor, decor, decor, decor)
+	return handler.NewToggleCommand(decor, decor, decor, decor, decor, decor, decor, decor, decor, decor, decor, decor, decor)
 }
 
 // BuildToggleQueryHandler builds toggle query handler including all of its dependencies.
@@ -59,14 +87,178 @@ func BuildToggleQueryHandler(dep *Dependency) *handler.ToggleQuery {
 	psql := postgres.NewToggle(dep.PgxPool)
 	rds := redis.NewToggle(dep.RedisClient, time.Duration(dep.Config.Redis.TTL)*time.Minute)
 
+	segmentPsql := postgres.NewSegment(dep.PgxPool)
+	auditPsql := postgres.NewAudit(dep.PgxPool)
+	evaluationPsql := postgres.NewEvaluation(dep.PgxPool)
+
 	getterRepo := repository.NewToggleGetter(psql, rds)
 
 	getter := service.NewToggleGetter(getterRepo)
+	evaluator := service.NewToggleEvaluator(getterRepo, segmentPsql)
+	finder := service.NewStaleToggleFinder(getterRepo)
+	historyGetter := service.NewToggleHistoryGetter(auditPsql)
+	watcher := service.NewToggleWatcher(getterRepo, dep.Broadcaster)
+	usageGetter := service.NewToggleUsageGetter(getterRepo, evaluationPsql)
+	exporter := service.NewToggleExporter(getterRepo)
 
-	decor := decorservice.NewTracing(nil, getter, nil, nil, nil)
+	decor := decorservice.NewTracing(decorservice.ToggleServices{
+		Getter:        getter,
+		Evaluator:     evaluator,
+		Finder:        finder,
+		HistoryGetter: historyGetter,
+		Watcher:       watcher,
+		UsageGetter:   usageGetter,
+		Exporter:      exporter,
+	})
 
 	// this one is not a good example, but I let it be for now since I compose all services in one decorator.
-	return handler.NewToggleQuery(decor)
+	return handler.NewToggleQuery(decor, decor, decor, decor, decor, decor, decor)
+}
+
+// BuildEnvironmentCommandHandler builds environment command handler including all of its dependencies.
+func BuildEnvironmentCommandHandler(dep *Dependency) *handler.EnvironmentCommand {
+	psql := postgres.NewEnvironment(dep.PgxPool)
+
+	creator := service.NewEnvironmentCreator(psql)
+
+	decor := decorservice.NewEnvironmentTracing(creator, nil)
+	return handler.NewEnvironmentCommand(decor)
+}
+
+// BuildEnvironmentQueryHandler builds environment query handler including all of its dependencies.
+func BuildEnvironmentQueryHandler(dep *Dependency) *handler.EnvironmentQuery {
+	psql := postgres.NewEnvironment(dep.PgxPool)
+
+	getter := service.NewEnvironmentGetter(psql)
+
+	decor := decorservice.NewEnvironmentTracing(nil, getter)
+	return handler.NewEnvironmentQuery(decor)
+}
+
+// BuildProjectCommandHandler builds project command handler including all of its dependencies.
+func BuildProjectCommandHandler(dep *Dependency) *handler.ProjectCommand {
+	psql := postgres.NewProject(dep.PgxPool)
+
+	creator := service.NewProjectCreator(psql)
+	updater := service.NewProjectUpdater(psql)
+	deleter := service.NewProjectDeleter(psql)
+
+	decor := decorservice.NewProjectTracing(creator, nil, updater, deleter)
+	return handler.NewProjectCommand(decor, decor, decor)
+}
+
+// BuildProjectQueryHandler builds project query handler including all of its dependencies.
+func BuildProjectQueryHandler(dep *Dependency) *handler.ProjectQuery {
+	psql := postgres.NewProject(dep.PgxPool)
+
+	getter := service.NewProjectGetter(psql)
+
+	decor := decorservice.NewProjectTracing(nil, getter, nil, nil)
+	return handler.NewProjectQuery(decor)
+}
+
+// BuildSegmentCommandHandler builds segment command handler including all of its dependencies.
+func BuildSegmentCommandHandler(dep *Dependency) *handler.SegmentCommand {
+	psql := postgres.NewSegment(dep.PgxPool)
+
+	creator := service.NewSegmentCreator(psql)
+	updater := service.NewSegmentUpdater(psql)
+	deleter := service.NewSegmentDeleter(psql)
+
+	decor := decorservice.NewSegmentTracing(creator, nil, updater, deleter)
+	return handler.NewSegmentCommand(decor, decor, decor)
+}
+
+// BuildSegmentQueryHandler builds segment query handler including all of its dependencies.
+func BuildSegmentQueryHandler(dep *Dependency) *handler.SegmentQuery {
+	psql := postgres.NewSegment(dep.PgxPool)
+
+	getter := service.NewSegmentGetter(psql)
+
+	decor := decorservice.NewSegmentTracing(nil, getter, nil, nil)
+	return handler.NewSegmentQuery(decor)
+}
+
+// BuildScheduleCommandHandler builds toggle's schedule command handler including all of its dependencies.
+func BuildScheduleCommandHandler(dep *Dependency) *handler.ScheduleCommand {
+	psql := postgres.NewSchedule(dep.PgxPool)
+
+	creator := service.NewScheduleCreator(psql)
+	canceller := service.NewScheduleCanceller(psql)
+
+	decor := decorservice.NewScheduleTracing(creator, nil, canceller, nil)
+	return handler.NewScheduleCommand(decor, decor)
+}
+
+// BuildScheduleQueryHandler builds toggle's schedule query handler including all of its dependencies.
+func BuildScheduleQueryHandler(dep *Dependency) *handler.ScheduleQuery {
+	psql := postgres.NewSchedule(dep.PgxPool)
+
+	getter := service.NewScheduleGetter(psql)
+
+	decor := decorservice.NewScheduleTracing(nil, getter, nil, nil)
+	return handler.NewScheduleQuery(decor)
+}
+
+// BuildScheduler builds scheduler which executes due toggle's schedules, notifies expired toggles,
+// and purges deleted toggles including all of its dependencies.
+// The schedules are executed using the same enabler and disabler as the toggle command handler.
+func BuildScheduler(dep *Dependency) *scheduler.Scheduler {
+	psql := postgres.NewToggle(dep.PgxPool)
+	rds := redis.NewToggle(dep.RedisClient, time.Duration(dep.Config.Redis.TTL)*time.Minute)
+	schedulePsql := postgres.NewSchedule(dep.PgxPool)
+
+	updaterRepo := repository.NewToggleUpdater(psql, rds)
+
+	enabler := service.NewToggleEnabler(updaterRepo)
+	disabler := service.NewToggleDisabler(updaterRepo)
+	executor := service.NewScheduleExecutor(schedulePsql, enabler, disabler, dep.Config.Scheduler.BatchSize)
+	notifier := service.NewToggleExpiryNotifier(psql, dep.Config.Scheduler.BatchSize)
+	purger := service.NewTogglePurger(psql, time.Duration(dep.Config.Scheduler.DeletedToggleRetention)*time.Hour, dep.Config.Scheduler.BatchSize)
+
+	scheduleDecor := decorservice.NewScheduleTracing(nil, nil, nil, executor)
+	toggleDecor := decorservice.NewTracing(decorservice.ToggleServices{Notifier: notifier, Purger: purger})
+	return scheduler.NewScheduler(
+		"toggle scheduler",
+		time.Duration(dep.Config.Scheduler.Interval)*time.Second,
+		scheduler.Job{Name: "execute due schedules", Run: scheduleDecor.ExecuteDue},
+		scheduler.Job{Name: "notify expired toggles", Run: toggleDecor.NotifyExpired},
+		scheduler.Job{Name: "purge deleted toggles", Run: toggleDecor.PurgeDeleted},
+	)
+}
+
+// BuildWatchFanout builds the fanout which feeds the events relayed by any server replica to the broadcaster.
+func BuildWatchFanout(dep *Dependency) *messaging.RedisFanout {
+	return messaging.NewRedisFanout(dep.RedisClient, dep.Broadcaster)
+}
+
+// BuildOutboxRelay builds the relay which publishes the outbox's events to the configured message queue
+// including all of its dependencies.
+// Kafka publisher uses the dependency's Kafka writer.
+// Once an event is published to the message queue, it is also fanned out to every server replica,
+// whose watch fanout broadcasts it to the toggle's watchers.
+// The fanout is best-effort: its failure is only logged and counted, so that the event isn't published to the message queue again.
+func BuildOutboxRelay(dep *Dependency) *scheduler.Scheduler {
+	psql := postgres.NewOutbox(dep.PgxPool)
+
+	var publisher service.TogglePublisher = messaging.NewRedisPublisher(&dep.Config.Redis)
+	if dep.Config.Outbox.Publisher == config.PublisherKafka {
+		publisher = messaging.NewKafkaPublisher(dep.KafkaWriter)
+	}
+
+	relay := service.NewOutboxRelay(
+		messaging.NewMetricsOutbox(psql),
+		messaging.NewChainPublisher(messaging.NewMetricsPublisher(publisher), messaging.NewBestEffortPublisher(BuildWatchFanout(dep))),
+		dep.Config.Outbox.BatchSize,
+		dep.Config.Outbox.MaxAttempts,
+		time.Duration(dep.Config.Outbox.Lease)*time.Second,
+		time.Duration(dep.Config.Outbox.MaxBackoff)*time.Second,
+	)
+	return scheduler.NewScheduler(
+		"outbox relay",
+		time.Duration(dep.Config.Outbox.RelayInterval)*time.Millisecond,
+		scheduler.Job{Name: "relay outbox events", Run: relay.Relay},
+	)
 }
 
 // BuildPostgrePgxPool builds a pool of pgx client.
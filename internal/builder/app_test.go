@@ -10,6 +10,7 @@ import (
 
 	"github.com/indrasaputra/toggle/internal/builder"
 	"github.com/indrasaputra/toggle/internal/config"
+	"github.com/indrasaputra/toggle/internal/messaging"
 )
 
 func TestBuildToggleCommandHandler(t *testing.T) {
@@ -44,6 +45,166 @@ func TestBuildToggleHandler(t *testing.T) {
 	})
 }
 
+func TestBuildEnvironmentCommandHandler(t *testing.T) {
+	t.Run("success create environment command handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildEnvironmentCommandHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildEnvironmentQueryHandler(t *testing.T) {
+	t.Run("success create environment query handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildEnvironmentQueryHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildProjectCommandHandler(t *testing.T) {
+	t.Run("success create project command handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildProjectCommandHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildProjectQueryHandler(t *testing.T) {
+	t.Run("success create project query handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildProjectQueryHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildSegmentCommandHandler(t *testing.T) {
+	t.Run("success create segment command handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildSegmentCommandHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildSegmentQueryHandler(t *testing.T) {
+	t.Run("success create segment query handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildSegmentQueryHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildScheduleCommandHandler(t *testing.T) {
+	t.Run("success create schedule command handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildScheduleCommandHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildScheduleQueryHandler(t *testing.T) {
+	t.Run("success create schedule query handler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool: &pgxpool.Pool{},
+		}
+
+		handler := builder.BuildScheduleQueryHandler(dep)
+
+		assert.NotNil(t, handler)
+	})
+}
+
+func TestBuildScheduler(t *testing.T) {
+	t.Run("success create scheduler", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool:     &pgxpool.Pool{},
+			RedisClient: &goredis.Client{},
+			Config: &config.Config{
+				Redis:     config.Redis{},
+				Scheduler: config.Scheduler{Interval: 10, BatchSize: 100},
+			},
+		}
+
+		scheduler := builder.BuildScheduler(dep)
+
+		assert.NotNil(t, scheduler)
+	})
+}
+
+func TestBuildWatchFanout(t *testing.T) {
+	t.Run("success create watch fanout", func(t *testing.T) {
+		dep := &builder.Dependency{
+			RedisClient: &goredis.Client{},
+			Broadcaster: messaging.NewBroadcaster(10, 10),
+		}
+
+		fanout := builder.BuildWatchFanout(dep)
+
+		assert.NotNil(t, fanout)
+		assert.Equal(t, "watch fanout", fanout.Name())
+	})
+}
+
+func TestBuildOutboxRelay(t *testing.T) {
+	t.Run("success create outbox relay publishing to redis", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool:     &pgxpool.Pool{},
+			Broadcaster: messaging.NewBroadcaster(10, 10),
+			Config: &config.Config{
+				Redis:  config.Redis{},
+				Outbox: config.Outbox{Publisher: config.PublisherRedis, RelayInterval: 500, BatchSize: 100},
+			},
+		}
+
+		relay := builder.BuildOutboxRelay(dep)
+
+		assert.NotNil(t, relay)
+		assert.Equal(t, "outbox relay", relay.Name())
+	})
+
+	t.Run("success create outbox relay publishing to kafka", func(t *testing.T) {
+		dep := &builder.Dependency{
+			PgxPool:     &pgxpool.Pool{},
+			KafkaWriter: builder.BuildKafkaWriter(&config.Kafka{}),
+			Broadcaster: messaging.NewBroadcaster(10, 10),
+			Config: &config.Config{
+				Outbox: config.Outbox{Publisher: config.PublisherKafka, RelayInterval: 500, BatchSize: 100},
+			},
+		}
+
+		relay := builder.BuildOutboxRelay(dep)
+
+		assert.NotNil(t, relay)
+	})
+}
+
 func TestBuildPostgrePgxPool(t *testing.T) {
 	cfg := &config.Postgres{
 		Host:            "localhost",
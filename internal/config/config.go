@@ -6,6 +6,13 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// PublisherRedis means the toggle's events are published to Redis using asynq.
+	PublisherRedis = "redis"
+	// PublisherKafka means the toggle's events are published to Kafka.
+	PublisherKafka = "kafka"
+)
+
 // Config holds configuration for the project.
 type Config struct {
 	ServiceName string `env:"SERVICE_NAME,default=toggle-api"`
@@ -16,6 +23,9 @@ type Config struct {
 	Redis       Redis
 	Kafka       Kafka
 	Jaeger      Jaeger
+	Scheduler   Scheduler
+	Outbox      Outbox
+	Watch       Watch
 }
 
 // Port holds configuration for project's port.
@@ -83,6 +93,40 @@ type Jaeger struct {
 	FlushInterval uint    `env:"JAEGER_FLUSH_INTERVAL,default=1"`
 }
 
+// Scheduler holds configuration for toggle's schedule executor, expiry notifier, and deleted toggle's purger.
+type Scheduler struct {
+	// Interval in second.
+	Interval  uint `env:"SCHEDULER_INTERVAL,default=10"`
+	BatchSize int  `env:"SCHEDULER_BATCH_SIZE,default=100"`
+	// DeletedToggleRetention in hour.
+	DeletedToggleRetention uint `env:"SCHEDULER_DELETED_TOGGLE_RETENTION,default=720"`
+}
+
+// Outbox holds configuration for the relay of the outbox's events.
+type Outbox struct {
+	// Publisher is the message queue the events are published to, either PublisherRedis or PublisherKafka.
+	Publisher string `env:"OUTBOX_PUBLISHER,default=redis"`
+	// RelayInterval in millisecond.
+	RelayInterval uint `env:"OUTBOX_RELAY_INTERVAL,default=500"`
+	BatchSize     int  `env:"OUTBOX_BATCH_SIZE,default=100"`
+	// MaxAttempts is the number of times a malformed event is claimed before it is dead-lettered.
+	MaxAttempts int `env:"OUTBOX_MAX_ATTEMPTS,default=10"`
+	// Lease in second.
+	Lease uint `env:"OUTBOX_LEASE,default=30"`
+	// MaxBackoff in second.
+	MaxBackoff uint `env:"OUTBOX_MAX_BACKOFF,default=60"`
+}
+
+// Watch holds configuration for the in-process broadcaster of the watched toggle's events.
+type Watch struct {
+	// HistorySize is the number of the latest events remembered to resume the watchers.
+	HistorySize int `env:"WATCH_HISTORY_SIZE,default=1000"`
+	// BufferSize is the number of events a watcher can fall behind before it is disconnected.
+	BufferSize int `env:"WATCH_BUFFER_SIZE,default=100"`
+	// KeepAliveInterval in second.
+	KeepAliveInterval uint `env:"WATCH_KEEPALIVE_INTERVAL,default=15"`
+}
+
 // NewConfig creates an instance of Config.
 // It needs the path of the env file to be used.
 func NewConfig(env string) (*Config, error) {
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// EnvironmentTracing decorates environment service and imbues it with tracing.
+type EnvironmentTracing struct {
+	creator service.CreateEnvironment
+	getter  service.GetEnvironment
+}
+
+// NewEnvironmentTracing creates an instance of EnvironmentTracing.
+func NewEnvironmentTracing(creator service.CreateEnvironment, getter service.GetEnvironment) *EnvironmentTracing {
+	return &EnvironmentTracing{
+		creator: creator,
+		getter:  getter,
+	}
+}
+
+// Create decorates Create method.
+func (t *EnvironmentTracing) Create(ctx context.Context, env *entity.Environment) error {
+	ctx, span := app.GetTracer().Start(ctx, "CreateEnvironment")
+	defer span.End()
+
+	return t.creator.Create(ctx, env)
+}
+
+// GetAll decorates GetAll method.
+func (t *EnvironmentTracing) GetAll(ctx context.Context) ([]*entity.Environment, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetAllEnvironments")
+	defer span.End()
+
+	resp, err := t.getter.GetAll(ctx)
+
+	return resp, err
+}
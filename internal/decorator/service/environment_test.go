@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/internal/decorator/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testEnvironment = &entity.Environment{Name: "sandbox", Description: "sandbox environment"}
+)
+
+type EnvironmentTracingExecutor struct {
+	tracing *service.EnvironmentTracing
+
+	creator *mock_service.MockCreateEnvironment
+	getter  *mock_service.MockGetEnvironment
+}
+
+func TestEnvironmentTracing_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Create method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "CreateEnvironment")
+		defer span.End()
+
+		exec := createEnvironmentTracingExecutor(ctrl)
+		exec.creator.EXPECT().Create(ctx, testEnvironment).Return(nil)
+
+		err := exec.tracing.Create(testCtx, testEnvironment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestEnvironmentTracing_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetAll method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetAllEnvironments")
+		defer span.End()
+
+		exec := createEnvironmentTracingExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(ctx).Return(nil, nil)
+
+		resp, err := exec.tracing.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func createEnvironmentTracingExecutor(ctrl *gomock.Controller) *EnvironmentTracingExecutor {
+	c := mock_service.NewMockCreateEnvironment(ctrl)
+	g := mock_service.NewMockGetEnvironment(ctrl)
+
+	t := service.NewEnvironmentTracing(c, g)
+	return &EnvironmentTracingExecutor{
+		tracing: t,
+		creator: c,
+		getter:  g,
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ProjectTracing decorates project service and imbues it with tracing.
+type ProjectTracing struct {
+	creator service.CreateProject
+	getter  service.GetProject
+	updater service.UpdateProject
+	deleter service.DeleteProject
+}
+
+// NewProjectTracing creates an instance of ProjectTracing.
+func NewProjectTracing(creator service.CreateProject, getter service.GetProject, updater service.UpdateProject, deleter service.DeleteProject) *ProjectTracing {
+	return &ProjectTracing{
+		creator: creator,
+		getter:  getter,
+		updater: updater,
+		deleter: deleter,
+	}
+}
+
+// Create decorates Create method.
+func (t *ProjectTracing) Create(ctx context.Context, project *entity.Project) error {
+	ctx, span := app.GetTracer().Start(ctx, "CreateProject")
+	defer span.End()
+
+	return t.creator.Create(ctx, project)
+}
+
+// GetByName decorates GetByName method.
+func (t *ProjectTracing) GetByName(ctx context.Context, name string) (*entity.Project, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetProjectByName")
+	defer span.End()
+
+	resp, err := t.getter.GetByName(ctx, name)
+
+	return resp, err
+}
+
+// GetAll decorates GetAll method.
+func (t *ProjectTracing) GetAll(ctx context.Context) ([]*entity.Project, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetAllProjects")
+	defer span.End()
+
+	resp, err := t.getter.GetAll(ctx)
+
+	return resp, err
+}
+
+// Update decorates Update method.
+func (t *ProjectTracing) Update(ctx context.Context, project *entity.Project) error {
+	ctx, span := app.GetTracer().Start(ctx, "UpdateProject")
+	defer span.End()
+
+	return t.updater.Update(ctx, project)
+}
+
+// DeleteByName decorates DeleteByName method.
+func (t *ProjectTracing) DeleteByName(ctx context.Context, name string) error {
+	ctx, span := app.GetTracer().Start(ctx, "DeleteProject")
+	defer span.End()
+
+	return t.deleter.DeleteByName(ctx, name)
+}
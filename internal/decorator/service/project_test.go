@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/internal/decorator/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testProject = &entity.Project{Name: "payment", Description: "payment team"}
+)
+
+type ProjectTracingExecutor struct {
+	tracing *service.ProjectTracing
+
+	creator *mock_service.MockCreateProject
+	getter  *mock_service.MockGetProject
+	updater *mock_service.MockUpdateProject
+	deleter *mock_service.MockDeleteProject
+}
+
+func TestProjectTracing_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Create method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "CreateProject")
+		defer span.End()
+
+		exec := createProjectTracingExecutor(ctrl)
+		exec.creator.EXPECT().Create(ctx, testProject).Return(nil)
+
+		err := exec.tracing.Create(testCtx, testProject)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestProjectTracing_GetByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetByName method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetProjectByName")
+		defer span.End()
+
+		exec := createProjectTracingExecutor(ctrl)
+		exec.getter.EXPECT().GetByName(ctx, testProject.Name).Return(testProject, nil)
+
+		resp, err := exec.tracing.GetByName(testCtx, testProject.Name)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testProject, resp)
+	})
+}
+
+func TestProjectTracing_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetAll method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetAllProjects")
+		defer span.End()
+
+		exec := createProjectTracingExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(ctx).Return(nil, nil)
+
+		resp, err := exec.tracing.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestProjectTracing_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Update method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "UpdateProject")
+		defer span.End()
+
+		exec := createProjectTracingExecutor(ctrl)
+		exec.updater.EXPECT().Update(ctx, testProject).Return(nil)
+
+		err := exec.tracing.Update(testCtx, testProject)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestProjectTracing_DeleteByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate DeleteByName method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "DeleteProject")
+		defer span.End()
+
+		exec := createProjectTracingExecutor(ctrl)
+		exec.deleter.EXPECT().DeleteByName(ctx, testProject.Name).Return(nil)
+
+		err := exec.tracing.DeleteByName(testCtx, testProject.Name)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createProjectTracingExecutor(ctrl *gomock.Controller) *ProjectTracingExecutor {
+	c := mock_service.NewMockCreateProject(ctrl)
+	g := mock_service.NewMockGetProject(ctrl)
+	u := mock_service.NewMockUpdateProject(ctrl)
+	d := mock_service.NewMockDeleteProject(ctrl)
+
+	t := service.NewProjectTracing(c, g, u, d)
+	return &ProjectTracingExecutor{
+		tracing: t,
+		creator: c,
+		getter:  g,
+		updater: u,
+		deleter: d,
+	}
+}
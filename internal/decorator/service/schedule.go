@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ScheduleTracing decorates toggle's schedule service and imbues it with tracing.
+type ScheduleTracing struct {
+	creator   service.CreateSchedule
+	getter    service.GetSchedule
+	canceller service.CancelSchedule
+	executor  service.ExecuteSchedule
+}
+
+// NewScheduleTracing creates an instance of ScheduleTracing.
+func NewScheduleTracing(creator service.CreateSchedule, getter service.GetSchedule, canceller service.CancelSchedule, executor service.ExecuteSchedule) *ScheduleTracing {
+	return &ScheduleTracing{
+		creator:   creator,
+		getter:    getter,
+		canceller: canceller,
+		executor:  executor,
+	}
+}
+
+// Create decorates Create method.
+func (t *ScheduleTracing) Create(ctx context.Context, schedule *entity.Schedule) error {
+	ctx, span := app.GetTracer().Start(ctx, "CreateToggleSchedule")
+	defer span.End()
+
+	return t.creator.Create(ctx, schedule)
+}
+
+// GetAllPending decorates GetAllPending method.
+func (t *ScheduleTracing) GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetAllToggleSchedules")
+	defer span.End()
+
+	resp, err := t.getter.GetAllPending(ctx, project, env, key)
+
+	return resp, err
+}
+
+// Cancel decorates Cancel method.
+func (t *ScheduleTracing) Cancel(ctx context.Context, project, env, key string, id int64) error {
+	ctx, span := app.GetTracer().Start(ctx, "CancelToggleSchedule")
+	defer span.End()
+
+	return t.canceller.Cancel(ctx, project, env, key, id)
+}
+
+// ExecuteDue decorates ExecuteDue method.
+func (t *ScheduleTracing) ExecuteDue(ctx context.Context) (int, error) {
+	ctx, span := app.GetTracer().Start(ctx, "ExecuteDueToggleSchedules")
+	defer span.End()
+
+	resp, err := t.executor.ExecuteDue(ctx)
+
+	return resp, err
+}
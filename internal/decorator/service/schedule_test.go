@@ -0,0 +1,113 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/internal/decorator/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSchedule = &entity.Schedule{Key: "toggle-1", Project: "payment", Environment: "production", Action: entity.ScheduleActionEnable, ExecuteAt: time.Now().Add(time.Hour)}
+)
+
+type ScheduleTracingExecutor struct {
+	tracing *service.ScheduleTracing
+
+	creator   *mock_service.MockCreateSchedule
+	getter    *mock_service.MockGetSchedule
+	canceller *mock_service.MockCancelSchedule
+	executor  *mock_service.MockExecuteSchedule
+}
+
+func TestScheduleTracing_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Create method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "CreateToggleSchedule")
+		defer span.End()
+
+		exec := createScheduleTracingExecutor(ctrl)
+		exec.creator.EXPECT().Create(ctx, testSchedule).Return(nil)
+
+		err := exec.tracing.Create(testCtx, testSchedule)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestScheduleTracing_GetAllPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetAllPending method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetAllToggleSchedules")
+		defer span.End()
+
+		exec := createScheduleTracingExecutor(ctrl)
+		exec.getter.EXPECT().GetAllPending(ctx, testSchedule.Project, testSchedule.Environment, testSchedule.Key).Return(nil, nil)
+
+		resp, err := exec.tracing.GetAllPending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key)
+
+		assert.Nil(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestScheduleTracing_Cancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Cancel method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "CancelToggleSchedule")
+		defer span.End()
+
+		exec := createScheduleTracingExecutor(ctrl)
+		exec.canceller.EXPECT().Cancel(ctx, testSchedule.Project, testSchedule.Environment, testSchedule.Key, int64(1)).Return(nil)
+
+		err := exec.tracing.Cancel(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key, 1)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestScheduleTracing_ExecuteDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate ExecuteDue method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "ExecuteDueToggleSchedules")
+		defer span.End()
+
+		exec := createScheduleTracingExecutor(ctrl)
+		exec.executor.EXPECT().ExecuteDue(ctx).Return(2, nil)
+
+		resp, err := exec.tracing.ExecuteDue(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, resp)
+	})
+}
+
+func createScheduleTracingExecutor(ctrl *gomock.Controller) *ScheduleTracingExecutor {
+	c := mock_service.NewMockCreateSchedule(ctrl)
+	g := mock_service.NewMockGetSchedule(ctrl)
+	cc := mock_service.NewMockCancelSchedule(ctrl)
+	e := mock_service.NewMockExecuteSchedule(ctrl)
+
+	t := service.NewScheduleTracing(c, g, cc, e)
+	return &ScheduleTracingExecutor{
+		tracing:   t,
+		creator:   c,
+		getter:    g,
+		canceller: cc,
+		executor:  e,
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// SegmentTracing decorates segment service and imbues it with tracing.
+type SegmentTracing struct {
+	creator service.CreateSegment
+	getter  service.GetSegment
+	updater service.UpdateSegment
+	deleter service.DeleteSegment
+}
+
+// NewSegmentTracing creates an instance of SegmentTracing.
+func NewSegmentTracing(creator service.CreateSegment, getter service.GetSegment, updater service.UpdateSegment, deleter service.DeleteSegment) *SegmentTracing {
+	return &SegmentTracing{
+		creator: creator,
+		getter:  getter,
+		updater: updater,
+		deleter: deleter,
+	}
+}
+
+// Create decorates Create method.
+func (t *SegmentTracing) Create(ctx context.Context, segment *entity.Segment) error {
+	ctx, span := app.GetTracer().Start(ctx, "CreateSegment")
+	defer span.End()
+
+	return t.creator.Create(ctx, segment)
+}
+
+// GetByKey decorates GetByKey method.
+func (t *SegmentTracing) GetByKey(ctx context.Context, project, key string) (*entity.Segment, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetSegmentByKey")
+	defer span.End()
+
+	resp, err := t.getter.GetByKey(ctx, project, key)
+
+	return resp, err
+}
+
+// GetAll decorates GetAll method.
+func (t *SegmentTracing) GetAll(ctx context.Context, project string) ([]*entity.Segment, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetAllSegments")
+	defer span.End()
+
+	resp, err := t.getter.GetAll(ctx, project)
+
+	return resp, err
+}
+
+// Update decorates Update method.
+func (t *SegmentTracing) Update(ctx context.Context, segment *entity.Segment) error {
+	ctx, span := app.GetTracer().Start(ctx, "UpdateSegment")
+	defer span.End()
+
+	return t.updater.Update(ctx, segment)
+}
+
+// DeleteByKey decorates DeleteByKey method.
+func (t *SegmentTracing) DeleteByKey(ctx context.Context, project, key string) error {
+	ctx, span := app.GetTracer().Start(ctx, "DeleteSegment")
+	defer span.End()
+
+	return t.deleter.DeleteByKey(ctx, project, key)
+}
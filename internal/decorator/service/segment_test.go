@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/app"
+	"github.com/indrasaputra/toggle/internal/decorator/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSegment = &entity.Segment{Key: "beta-testers", Project: "payment", Included: []string{"user-1"}}
+)
+
+type SegmentTracingExecutor struct {
+	tracing *service.SegmentTracing
+
+	creator *mock_service.MockCreateSegment
+	getter  *mock_service.MockGetSegment
+	updater *mock_service.MockUpdateSegment
+	deleter *mock_service.MockDeleteSegment
+}
+
+func TestSegmentTracing_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Create method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "CreateSegment")
+		defer span.End()
+
+		exec := createSegmentTracingExecutor(ctrl)
+		exec.creator.EXPECT().Create(ctx, testSegment).Return(nil)
+
+		err := exec.tracing.Create(testCtx, testSegment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestSegmentTracing_GetByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetByKey method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetSegmentByKey")
+		defer span.End()
+
+		exec := createSegmentTracingExecutor(ctrl)
+		exec.getter.EXPECT().GetByKey(ctx, testSegment.Project, testSegment.Key).Return(testSegment, nil)
+
+		resp, err := exec.tracing.GetByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testSegment, resp)
+	})
+}
+
+func TestSegmentTracing_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetAll method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetAllSegments")
+		defer span.End()
+
+		exec := createSegmentTracingExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(ctx, testSegment.Project).Return(nil, nil)
+
+		resp, err := exec.tracing.GetAll(testCtx, testSegment.Project)
+
+		assert.Nil(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestSegmentTracing_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Update method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "UpdateSegment")
+		defer span.End()
+
+		exec := createSegmentTracingExecutor(ctrl)
+		exec.updater.EXPECT().Update(ctx, testSegment).Return(nil)
+
+		err := exec.tracing.Update(testCtx, testSegment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestSegmentTracing_DeleteByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate DeleteByKey method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "DeleteSegment")
+		defer span.End()
+
+		exec := createSegmentTracingExecutor(ctrl)
+		exec.deleter.EXPECT().DeleteByKey(ctx, testSegment.Project, testSegment.Key).Return(nil)
+
+		err := exec.tracing.DeleteByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createSegmentTracingExecutor(ctrl *gomock.Controller) *SegmentTracingExecutor {
+	c := mock_service.NewMockCreateSegment(ctrl)
+	g := mock_service.NewMockGetSegment(ctrl)
+	u := mock_service.NewMockUpdateSegment(ctrl)
+	d := mock_service.NewMockDeleteSegment(ctrl)
+
+	t := service.NewSegmentTracing(c, g, u, d)
+	return &SegmentTracingExecutor{
+		tracing: t,
+		creator: c,
+		getter:  g,
+		updater: u,
+		deleter: d,
+	}
+}
@@ -2,30 +2,48 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/indrasaputra/toggle/entity"
 	"github.com/indrasaputra/toggle/internal/app"
 	"github.com/indrasaputra/toggle/service"
 )
 
+// ToggleServices groups the toggle services which are decorated by Tracing.
+// A service can be left nil if its methods are never called.
+type ToggleServices struct {
+	Creator             service.CreateToggle
+	Getter              service.GetToggle
+	Enabler             service.EnableToggle
+	Disabler            service.DisableToggle
+	Deleter             service.DeleteToggle
+	Evaluator           service.EvaluateToggle
+	PrerequisiteUpdater service.UpdateTogglePrerequisites
+	Finder              service.FindStaleToggle
+	Notifier            service.NotifyExpiredToggle
+	Updater             service.UpdateToggle
+	HistoryGetter       service.GetToggleHistory
+	Restorer            service.RestoreToggle
+	Purger              service.PurgeDeletedToggle
+	Watcher             service.WatchToggle
+	Reporter            service.ReportEvaluation
+	UsageGetter         service.GetToggleUsage
+	BatchCreator        service.BatchCreateToggle
+	BatchUpdater        service.BatchUpdateToggle
+	Exporter            service.ExportToggle
+	Importer            service.ImportToggle
+	RolloutUpdater      service.UpdateToggleRollout
+	RulesUpdater        service.UpdateToggleRules
+}
+
 // Tracing decorates toggle service and imbues it with tracing.
 type Tracing struct {
-	creator  service.CreateToggle
-	getter   service.GetToggle
-	enabler  service.EnableToggle
-	disabler service.DisableToggle
-	deleter  service.DeleteToggle
+	services ToggleServices
 }
 
 // NewTracing creates an instance of Tracing.
-func NewTracing(creator service.CreateToggle, getter service.GetToggle, enabler service.EnableToggle, disabler service.DisableToggle, deleter service.DeleteToggle) *Tracing {
-	return &Tracing{
-		creator:  creator,
-		getter:   getter,
-		enabler:  enabler,
-		disabler: disabler,
-		deleter:  deleter,
-	}
+func NewTracing(services ToggleServices) *Tracing {
+	return &Tracing{services: services}
 }
 
 // Create decorates Create method.
@@ -33,49 +51,194 @@ func (t *Tracing) Create(ctx context.Context, toggle *entity.Toggle) error {
 	ctx, span := app.GetTracer().Start(ctx, "Create")
 	defer span.End()
 
-	return t.creator.Create(ctx, toggle)
+	return t.services.Creator.Create(ctx, toggle)
+}
+
+// CreateAll decorates CreateAll method.
+func (t *Tracing) CreateAll(ctx context.Context, project, env string, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	ctx, span := app.GetTracer().Start(ctx, "CreateAll")
+	defer span.End()
+
+	return t.services.BatchCreator.CreateAll(ctx, project, env, toggles, atomic)
+}
+
+// UpdateAll decorates UpdateAll method.
+func (t *Tracing) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	ctx, span := app.GetTracer().Start(ctx, "UpdateAll")
+	defer span.End()
+
+	return t.services.BatchUpdater.UpdateAll(ctx, project, env, operations, atomic)
+}
+
+// Export decorates Export method.
+func (t *Tracing) Export(ctx context.Context, project, env string) (*entity.Snapshot, error) {
+	ctx, span := app.GetTracer().Start(ctx, "Export")
+	defer span.End()
+
+	return t.services.Exporter.Export(ctx, project, env)
+}
+
+// Import decorates Import method.
+func (t *Tracing) Import(ctx context.Context, project, env string, snapshot *entity.Snapshot, options *entity.ImportOptions) ([]*entity.ImportChange, error) {
+	ctx, span := app.GetTracer().Start(ctx, "Import")
+	defer span.End()
+
+	return t.services.Importer.Import(ctx, project, env, snapshot, options)
+}
+
+// UpdateRollout decorates UpdateRollout method.
+func (t *Tracing) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	ctx, span := app.GetTracer().Start(ctx, "UpdateRollout")
+	defer span.End()
+
+	return t.services.RolloutUpdater.UpdateRollout(ctx, project, env, key, rollout, version)
+}
+
+// UpdateRules decorates UpdateRules method.
+func (t *Tracing) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	ctx, span := app.GetTracer().Start(ctx, "UpdateRules")
+	defer span.End()
+
+	return t.services.RulesUpdater.UpdateRules(ctx, project, env, key, rules, defaultValue, version)
 }
 
 // DeleteByKey decorates DeleteByKey method.
-func (t *Tracing) DeleteByKey(ctx context.Context, key string) error {
+func (t *Tracing) DeleteByKey(ctx context.Context, project, env, key string, version int64) error {
 	ctx, span := app.GetTracer().Start(ctx, "DeleteByKey")
 	defer span.End()
 
-	return t.deleter.DeleteByKey(ctx, key)
+	return t.services.Deleter.DeleteByKey(ctx, project, env, key, version)
+}
+
+// PurgeByKey decorates PurgeByKey method.
+func (t *Tracing) PurgeByKey(ctx context.Context, project, env, key string, version int64) error {
+	ctx, span := app.GetTracer().Start(ctx, "PurgeByKey")
+	defer span.End()
+
+	return t.services.Deleter.PurgeByKey(ctx, project, env, key, version)
+}
+
+// Restore decorates Restore method.
+func (t *Tracing) Restore(ctx context.Context, project, env, key string, version int64) (int64, error) {
+	ctx, span := app.GetTracer().Start(ctx, "Restore")
+	defer span.End()
+
+	return t.services.Restorer.Restore(ctx, project, env, key, version)
 }
 
 // GetByKey decorates GetByKey method.
-func (t *Tracing) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
+func (t *Tracing) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
 	ctx, span := app.GetTracer().Start(ctx, "GetByKey")
 	defer span.End()
 
-	resp, err := t.getter.GetByKey(ctx, key)
+	resp, err := t.services.Getter.GetByKey(ctx, project, env, key)
 
 	return resp, err
 }
 
 // GetAll decorates GetAll method.
-func (t *Tracing) GetAll(ctx context.Context) ([]*entity.Toggle, error) {
+func (t *Tracing) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.PageRequest) (*entity.TogglePage, error) {
 	ctx, span := app.GetTracer().Start(ctx, "GetAll")
 	defer span.End()
 
-	resp, err := t.getter.GetAll(ctx)
+	resp, err := t.services.Getter.GetAll(ctx, project, env, filter, page)
 
 	return resp, err
 }
 
 // Enable decorates Enable method.
-func (t *Tracing) Enable(ctx context.Context, key string) error {
+func (t *Tracing) Enable(ctx context.Context, project, env, key string, version int64) (int64, error) {
 	ctx, span := app.GetTracer().Start(ctx, "Enable")
 	defer span.End()
 
-	return t.enabler.Enable(ctx, key)
+	return t.services.Enabler.Enable(ctx, project, env, key, version)
 }
 
 // Disable decorates Disable method.
-func (t *Tracing) Disable(ctx context.Context, key string) error {
+func (t *Tracing) Disable(ctx context.Context, project, env, key string, version int64) (int64, error) {
 	ctx, span := app.GetTracer().Start(ctx, "Disable")
 	defer span.End()
 
-	return t.disabler.Disable(ctx, key)
+	return t.services.Disabler.Disable(ctx, project, env, key, version)
+}
+
+// Evaluate decorates Evaluate method.
+func (t *Tracing) Evaluate(ctx context.Context, project, env, key string, evalCtx map[string]string) (*entity.Evaluation, error) {
+	ctx, span := app.GetTracer().Start(ctx, "Evaluate")
+	defer span.End()
+
+	return t.services.Evaluator.Evaluate(ctx, project, env, key, evalCtx)
+}
+
+// UpdatePrerequisites decorates UpdatePrerequisites method.
+func (t *Tracing) UpdatePrerequisites(ctx context.Context, project, env, key string, prerequisites []*entity.Prerequisite) error {
+	ctx, span := app.GetTracer().Start(ctx, "UpdatePrerequisites")
+	defer span.End()
+
+	return t.services.PrerequisiteUpdater.UpdatePrerequisites(ctx, project, env, key, prerequisites)
+}
+
+// Update decorates Update method.
+func (t *Tracing) Update(ctx context.Context, project, env, key string, toggle *entity.Toggle, mask []string, version int64) (*entity.Toggle, error) {
+	ctx, span := app.GetTracer().Start(ctx, "Update")
+	defer span.End()
+
+	return t.services.Updater.Update(ctx, project, env, key, toggle, mask, version)
+}
+
+// GetStale decorates GetStale method.
+func (t *Tracing) GetStale(ctx context.Context, project, env string, staleAfter time.Duration) ([]*entity.StaleToggle, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetStale")
+	defer span.End()
+
+	return t.services.Finder.GetStale(ctx, project, env, staleAfter)
+}
+
+// NotifyExpired decorates NotifyExpired method.
+func (t *Tracing) NotifyExpired(ctx context.Context) (int, error) {
+	ctx, span := app.GetTracer().Start(ctx, "NotifyExpired")
+	defer span.End()
+
+	return t.services.Notifier.NotifyExpired(ctx)
+}
+
+// PurgeDeleted decorates PurgeDeleted method.
+func (t *Tracing) PurgeDeleted(ctx context.Context) (int, error) {
+	ctx, span := app.GetTracer().Start(ctx, "PurgeDeleted")
+	defer span.End()
+
+	return t.services.Purger.PurgeDeleted(ctx)
+}
+
+// GetHistory decorates GetHistory method.
+func (t *Tracing) GetHistory(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.PageRequest) (*entity.AuditPage, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetHistory")
+	defer span.End()
+
+	return t.services.HistoryGetter.GetHistory(ctx, project, filter, page)
+}
+
+// Watch decorates Watch method.
+// The span covers the whole watch, from the snapshot until the watcher is gone.
+func (t *Tracing) Watch(ctx context.Context, project, env string, filter *entity.WatchFilter, token string, send func(*entity.WatchUpdate) error) error {
+	ctx, span := app.GetTracer().Start(ctx, "Watch")
+	defer span.End()
+
+	return t.services.Watcher.Watch(ctx, project, env, filter, token, send)
+}
+
+// Report decorates Report method.
+func (t *Tracing) Report(ctx context.Context, project, env string, counts []*entity.EvaluationCount) (int, error) {
+	ctx, span := app.GetTracer().Start(ctx, "Report")
+	defer span.End()
+
+	return t.services.Reporter.Report(ctx, project, env, counts)
+}
+
+// GetUsage decorates GetUsage method.
+func (t *Tracing) GetUsage(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.ToggleUsage, error) {
+	ctx, span := app.GetTracer().Start(ctx, "GetUsage")
+	defer span.End()
+
+	return t.services.UsageGetter.GetUsage(ctx, project, env, keys, since)
 }
@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,8 @@ import (
 var (
 	testCtx               = context.Background()
 	testToggleKey         = "test_key"
+	testToggleProject     = "default"
+	testToggleEnv         = "production"
 	testToggleIsEnabled   = false
 	testToggleDescription = "description"
 	testToggle            = &entity.Toggle{
@@ -28,11 +31,28 @@ var (
 type TracingExecutor struct {
 	tracing *service.Tracing
 
-	creator  *mock_service.MockCreateToggle
-	getter   *mock_service.MockGetToggle
-	enabler  *mock_service.MockEnableToggle
-	disabler *mock_service.MockDisableToggle
-	deleter  *mock_service.MockDeleteToggle
+	creator             *mock_service.MockCreateToggle
+	getter              *mock_service.MockGetToggle
+	enabler             *mock_service.MockEnableToggle
+	disabler            *mock_service.MockDisableToggle
+	deleter             *mock_service.MockDeleteToggle
+	evaluator           *mock_service.MockEvaluateToggle
+	prerequisiteUpdater *mock_service.MockUpdateTogglePrerequisites
+	finder              *mock_service.MockFindStaleToggle
+	notifier            *mock_service.MockNotifyExpiredToggle
+	updater             *mock_service.MockUpdateToggle
+	historyGetter       *mock_service.MockGetToggleHistory
+	restorer            *mock_service.MockRestoreToggle
+	purger              *mock_service.MockPurgeDeletedToggle
+	watcher             *mock_service.MockWatchToggle
+	reporter            *mock_service.MockReportEvaluation
+	usageGetter         *mock_service.MockGetToggleUsage
+	batchCreator        *mock_service.MockBatchCreateToggle
+	batchUpdater        *mock_service.MockBatchUpdateToggle
+	exporter            *mock_service.MockExportToggle
+	importer            *mock_service.MockImportToggle
+	rolloutUpdater      *mock_service.MockUpdateToggleRollout
+	rulesUpdater        *mock_service.MockUpdateToggleRules
 }
 
 func TestTracing_Create(t *testing.T) {
@@ -52,6 +72,124 @@ func TestTracing_Create(t *testing.T) {
 	})
 }
 
+func TestTracing_CreateAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate CreateAll method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "CreateAll")
+		defer span.End()
+
+		toggles := []*entity.Toggle{testToggle}
+		results := []*entity.BatchResult{{Key: testToggleKey, Version: 1}}
+		exec := createTracingExecutor(ctrl)
+		exec.batchCreator.EXPECT().CreateAll(ctx, testToggleProject, testToggleEnv, toggles, true).Return(results, nil)
+
+		res, err := exec.tracing.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+	})
+}
+
+func TestTracing_UpdateAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate UpdateAll method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "UpdateAll")
+		defer span.End()
+
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationEnable}}
+		results := []*entity.BatchResult{{Key: testToggleKey, Version: 2}}
+		exec := createTracingExecutor(ctrl)
+		exec.batchUpdater.EXPECT().UpdateAll(ctx, testToggleProject, testToggleEnv, ops, false).Return(results, nil)
+
+		res, err := exec.tracing.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+	})
+}
+
+func TestTracing_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Export method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Export")
+		defer span.End()
+
+		snapshot := &entity.Snapshot{FormatVersion: entity.SnapshotFormatVersion, Toggles: []*entity.Toggle{testToggle}}
+		exec := createTracingExecutor(ctrl)
+		exec.exporter.EXPECT().Export(ctx, testToggleProject, testToggleEnv).Return(snapshot, nil)
+
+		res, err := exec.tracing.Export(testCtx, testToggleProject, testToggleEnv)
+
+		assert.Nil(t, err)
+		assert.Equal(t, snapshot, res)
+	})
+}
+
+func TestTracing_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Import method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Import")
+		defer span.End()
+
+		snapshot := &entity.Snapshot{Toggles: []*entity.Toggle{testToggle}}
+		options := &entity.ImportOptions{DryRun: true}
+		changes := []*entity.ImportChange{{Key: testToggleKey, Action: entity.ImportActionCreate}}
+		exec := createTracingExecutor(ctrl)
+		exec.importer.EXPECT().Import(ctx, testToggleProject, testToggleEnv, snapshot, options).Return(changes, nil)
+
+		res, err := exec.tracing.Import(testCtx, testToggleProject, testToggleEnv, snapshot, options)
+
+		assert.Nil(t, err)
+		assert.Equal(t, changes, res)
+	})
+}
+
+func TestTracing_UpdateRollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate UpdateRollout method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "UpdateRollout")
+		defer span.End()
+
+		rollout := &entity.Rollout{Percentage: 30, BucketBy: "user_id"}
+		exec := createTracingExecutor(ctrl)
+		exec.rolloutUpdater.EXPECT().UpdateRollout(ctx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(1)).Return(int64(2), nil)
+
+		version, err := exec.tracing.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), version)
+	})
+}
+
+func TestTracing_UpdateRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate UpdateRules method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "UpdateRules")
+		defer span.End()
+
+		rules := []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}}
+		exec := createTracingExecutor(ctrl)
+		exec.rulesUpdater.EXPECT().UpdateRules(ctx, testToggleProject, testToggleEnv, testToggleKey, rules, false, int64(1)).Return(int64(2), nil)
+
+		version, err := exec.tracing.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), version)
+	})
+}
+
 func TestTracing_DeleteByKey(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -61,14 +199,49 @@ func TestTracing_DeleteByKey(t *testing.T) {
 		defer span.End()
 
 		exec := createTracingExecutor(ctrl)
-		exec.deleter.EXPECT().DeleteByKey(ctx, testToggleKey).Return(nil)
+		exec.deleter.EXPECT().DeleteByKey(ctx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(nil)
 
-		err := exec.tracing.DeleteByKey(testCtx, testToggleKey)
+		err := exec.tracing.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
 		assert.Nil(t, err)
 	})
 }
 
+func TestTracing_PurgeByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate PurgeByKey method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "PurgeByKey")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.deleter.EXPECT().PurgeByKey(ctx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(nil)
+
+		err := exec.tracing.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestTracing_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Restore method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Restore")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.restorer.EXPECT().Restore(ctx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(int64(2), nil)
+
+		version, err := exec.tracing.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), version)
+	})
+}
+
 func TestTracing_Enable(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -78,11 +251,12 @@ func TestTracing_Enable(t *testing.T) {
 		defer span.End()
 
 		exec := createTracingExecutor(ctrl)
-		exec.enabler.EXPECT().Enable(ctx, testToggleKey).Return(nil)
+		exec.enabler.EXPECT().Enable(ctx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(int64(2), nil)
 
-		err := exec.tracing.Enable(testCtx, testToggleKey)
+		res, err := exec.tracing.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 }
 
@@ -95,11 +269,12 @@ func TestTracing_Disable(t *testing.T) {
 		defer span.End()
 
 		exec := createTracingExecutor(ctrl)
-		exec.disabler.EXPECT().Disable(ctx, testToggleKey).Return(nil)
+		exec.disabler.EXPECT().Disable(ctx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(int64(2), nil)
 
-		err := exec.tracing.Disable(testCtx, testToggleKey)
+		res, err := exec.tracing.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 }
 
@@ -112,9 +287,9 @@ func TestTracing_GetByKey(t *testing.T) {
 		defer span.End()
 
 		exec := createTracingExecutor(ctrl)
-		exec.getter.EXPECT().GetByKey(ctx, testToggleKey).Return(nil, nil)
+		exec.getter.EXPECT().GetByKey(ctx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, nil)
 
-		resp, err := exec.tracing.GetByKey(testCtx, testToggleKey)
+		resp, err := exec.tracing.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.Nil(t, err)
 		assert.Nil(t, resp)
@@ -130,29 +305,267 @@ func TestTracing_GetAll(t *testing.T) {
 		defer span.End()
 
 		exec := createTracingExecutor(ctrl)
-		exec.getter.EXPECT().GetAll(ctx).Return(nil, nil)
+		exec.getter.EXPECT().GetAll(ctx, testToggleProject, testToggleEnv, nil, nil).Return(nil, nil)
 
-		resp, err := exec.tracing.GetAll(testCtx)
+		resp, err := exec.tracing.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
 
 		assert.Nil(t, err)
 		assert.Nil(t, resp)
 	})
 }
 
+func TestTracing_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Evaluate method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Evaluate")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.evaluator.EXPECT().Evaluate(ctx, testToggleProject, testToggleEnv, testToggleKey, nil).Return(nil, nil)
+
+		resp, err := exec.tracing.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil)
+
+		assert.Nil(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestTracing_UpdatePrerequisites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate UpdatePrerequisites method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "UpdatePrerequisites")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.prerequisiteUpdater.EXPECT().UpdatePrerequisites(ctx, testToggleProject, testToggleEnv, testToggleKey, nil).Return(nil)
+
+		err := exec.tracing.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestTracing_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Update method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Update")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.updater.EXPECT().Update(ctx, testToggleProject, testToggleEnv, testToggleKey, testToggle, []string{"description"}, int64(1)).Return(testToggle, nil)
+
+		res, err := exec.tracing.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggle, []string{"description"}, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testToggle, res)
+	})
+}
+
+func TestTracing_GetStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetStale method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetStale")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.finder.EXPECT().GetStale(ctx, testToggleProject, testToggleEnv, time.Hour).Return([]*entity.StaleToggle{}, nil)
+
+		resp, err := exec.tracing.GetStale(testCtx, testToggleProject, testToggleEnv, time.Hour)
+
+		assert.Nil(t, err)
+		assert.Empty(t, resp)
+	})
+}
+
+func TestTracing_NotifyExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate NotifyExpired method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "NotifyExpired")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.notifier.EXPECT().NotifyExpired(ctx).Return(2, nil)
+
+		n, err := exec.tracing.NotifyExpired(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, n)
+	})
+}
+
+func TestTracing_PurgeDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate PurgeDeleted method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "PurgeDeleted")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.purger.EXPECT().PurgeDeleted(ctx).Return(3, nil)
+
+		n, err := exec.tracing.PurgeDeleted(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+	})
+}
+
+func TestTracing_GetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetHistory method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetHistory")
+		defer span.End()
+
+		page := &entity.AuditPage{Audits: []*entity.Audit{{ID: 1, Key: testToggleKey}}}
+		exec := createTracingExecutor(ctrl)
+		exec.historyGetter.EXPECT().GetHistory(ctx, testToggleProject, nil, nil).Return(page, nil)
+
+		res, err := exec.tracing.GetHistory(testCtx, testToggleProject, nil, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, page, res)
+	})
+}
+
+func TestTracing_Watch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Watch method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Watch")
+		defer span.End()
+
+		exec := createTracingExecutor(ctrl)
+		exec.watcher.EXPECT().Watch(ctx, testToggleProject, testToggleEnv, nil, "", gomock.Any()).Return(nil)
+
+		err := exec.tracing.Watch(testCtx, testToggleProject, testToggleEnv, nil, "", func(*entity.WatchUpdate) error { return nil })
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestTracing_Report(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate Report method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "Report")
+		defer span.End()
+
+		counts := []*entity.EvaluationCount{{Key: testToggleKey, Variant: "true", Count: 1}}
+		exec := createTracingExecutor(ctrl)
+		exec.reporter.EXPECT().Report(ctx, testToggleProject, testToggleEnv, counts).Return(1, nil)
+
+		rejected, err := exec.tracing.Report(testCtx, testToggleProject, testToggleEnv, counts)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, rejected)
+	})
+}
+
+func TestTracing_GetUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success decorate GetUsage method", func(t *testing.T) {
+		ctx, span := app.GetTracer().Start(testCtx, "GetUsage")
+		defer span.End()
+
+		usages := []*entity.ToggleUsage{{Key: testToggleKey}}
+		exec := createTracingExecutor(ctrl)
+		exec.usageGetter.EXPECT().GetUsage(ctx, testToggleProject, testToggleEnv, nil, time.Time{}).Return(usages, nil)
+
+		res, err := exec.tracing.GetUsage(testCtx, testToggleProject, testToggleEnv, nil, time.Time{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, usages, res)
+	})
+}
+
 func createTracingExecutor(ctrl *gomock.Controller) *TracingExecutor {
 	c := mock_service.NewMockCreateToggle(ctrl)
 	g := mock_service.NewMockGetToggle(ctrl)
 	e := mock_service.NewMockEnableToggle(ctrl)
 	s := mock_service.NewMockDisableToggle(ctrl)
 	d := mock_service.NewMockDeleteToggle(ctrl)
-
-	t := service.NewTracing(c, g, e, s, d)
+	v := mock_service.NewMockEvaluateToggle(ctrl)
+	u := mock_service.NewMockUpdateTogglePrerequisites(ctrl)
+	f := mock_service.NewMockFindStaleToggle(ctrl)
+	n := mock_service.NewMockNotifyExpiredToggle(ctrl)
+	m := mock_service.NewMockUpdateToggle(ctrl)
+	h := mock_service.NewMockGetToggleHistory(ctrl)
+	r := mock_service.NewMockRestoreToggle(ctrl)
+	p := mock_service.NewMockPurgeDeletedToggle(ctrl)
+	w := mock_service.NewMockWatchToggle(ctrl)
+	o := mock_service.NewMockReportEvaluation(ctrl)
+	a := mock_service.NewMockGetToggleUsage(ctrl)
+	bc := mock_service.NewMockBatchCreateToggle(ctrl)
+	bu := mock_service.NewMockBatchUpdateToggle(ctrl)
+	ex := mock_service.NewMockExportToggle(ctrl)
+	im := mock_service.NewMockImportToggle(ctrl)
+	ro := mock_service.NewMockUpdateToggleRollout(ctrl)
+	ru := mock_service.NewMockUpdateToggleRules(ctrl)
+
+	t := service.NewTracing(service.ToggleServices{
+		Creator:             c,
+		Getter:              g,
+		Enabler:             e,
+		Disabler:            s,
+		Deleter:             d,
+		Evaluator:           v,
+		PrerequisiteUpdater: u,
+		Finder:              f,
+		Notifier:            n,
+		Updater:             m,
+		HistoryGetter:       h,
+		Restorer:            r,
+		Purger:              p,
+		Watcher:             w,
+		Reporter:            o,
+		UsageGetter:         a,
+		BatchCreator:        bc,
+		BatchUpdater:        bu,
+		Exporter:            ex,
+		Importer:            im,
+		RolloutUpdater:      ro,
+		RulesUpdater:        ru,
+	})
 	return &TracingExecutor{
-		tracing:  t,
-		creator:  c,
-		getter:   g,
-		enabler:  e,
-		disabler: s,
-		deleter:  d,
+		tracing:             t,
+		creator:             c,
+		getter:              g,
+		enabler:             e,
+		disabler:            s,
+		deleter:             d,
+		evaluator:           v,
+		prerequisiteUpdater: u,
+		finder:              f,
+		notifier:            n,
+		updater:             m,
+		historyGetter:       h,
+		restorer:            r,
+		purger:              p,
+		watcher:             w,
+		reporter:            o,
+		usageGetter:         a,
+		batchCreator:        bc,
+		batchUpdater:        bu,
+		exporter:            ex,
+		importer:            im,
+		rolloutUpdater:      ro,
+		rulesUpdater:        ru,
 	}
 }
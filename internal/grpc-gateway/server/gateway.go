@@ -1,18 +1,37 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/interceptor"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
 const (
 	grpcGatewayServerName = "grpc-gateway server"
+	// expectedVersionParam is the query parameter which carries the expected version taken from If-Match header.
+	expectedVersionParam = "expected_version"
 )
 
+// versionResponse is implemented by the responses which carry the toggle's version.
+type versionResponse interface {
+	GetVersion() int64
+}
+
+// toggleResponse is implemented by the responses which carry a single toggle.
+type toggleResponse interface {
+	GetToggle() *togglev1.Toggle
+}
+
 // GrpcGateway is responsible to act as HTTP/1.1 server.
 // It composes grpc-gateway runtime.ServeMux.
 type GrpcGateway struct {
@@ -25,7 +44,7 @@ type GrpcGateway struct {
 // It enables Prometheus metrics by default.
 func NewGrpcGateway(port string) *GrpcGateway {
 	srv := &GrpcGateway{
-		mux:  runtime.NewServeMux(),
+		mux:  runtime.NewServeMux(runtime.WithForwardResponseOption(setETag), runtime.WithIncomingHeaderMatcher(matchIncomingHeader)),
 		port: port,
 	}
 	_ = srv.EnablePrometheus() // error is impossible, hence ignored.
@@ -63,7 +82,12 @@ func (gg *GrpcGateway) Serve() error {
 			return err
 		}
 	}
-	return http.ListenAndServe(fmt.Sprintf(":%s", gg.port), allowCORS(gg.mux))
+	return http.ListenAndServe(fmt.Sprintf(":%s", gg.port), gg.Handler())
+}
+
+// Handler returns the HTTP handler which serves the attached services along with CORS and If-Match handling.
+func (gg *GrpcGateway) Handler() http.Handler {
+	return allowCORS(gg.handleIfMatch(gg.mux))
 }
 
 // AttachService attaches service to gRPC Gateway server.
@@ -90,14 +114,27 @@ func healthHandler() runtime.HandlerFunc {
 	}
 }
 
+// matchIncomingHeader forwards the audit headers, such as X-Actor, to gRPC metadata as they are,
+// so that the changes made through HTTP are recorded along with their actor, reason, and request ID.
+// The rest of the headers are forwarded using the default rule.
+func matchIncomingHeader(key string) (string, bool) {
+	for _, md := range interceptor.AuditMetadataKeys {
+		if strings.EqualFold(key, md) {
+			return md, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func allowCORS(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if origin := r.Header.Get("Origin"); origin != "" {
 			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Expose-Headers", "ETag")
 			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
-				headers := []string{"Content-Type", "Accept"}
+				headers := []string{"Content-Type", "Accept", "If-Match", "Last-Event-ID", "X-Actor", "X-Reason", "X-Request-Id"}
 				w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
-				methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
+				methods := []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"}
 				w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
 				return
 			}
@@ -105,3 +142,65 @@ func allowCORS(h http.Handler) http.Handler {
 		h.ServeHTTP(w, r)
 	})
 }
+
+// handleIfMatch translates If-Match header of state-changing requests into the expected version,
+// so that the request is aborted if the toggle has been changed since its ETag was read.
+// The expected version set explicitly in the query takes precedence over If-Match header.
+// If-Match "*" matches any version. An ETag which isn't a toggle's version can never match.
+func (gg *GrpcGateway) handleIfMatch(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := strings.TrimSpace(r.Header.Get("If-Match"))
+		if etag == "" || etag == "*" || r.Method == http.MethodGet || r.Method == http.MethodHead {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		query := r.URL.Query()
+		if query.Get(expectedVersionParam) != "" || query.Get("expectedVersion") != "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+		version, err := parseETag(etag)
+		if err != nil {
+			_, outbound := runtime.MarshalerForRequest(gg.mux, r)
+			runtime.HTTPError(r.Context(), gg.mux, outbound, w, r, entity.ErrVersionConflict())
+			return
+		}
+		query.Set(expectedVersionParam, strconv.FormatInt(version, 10))
+		r.URL.RawQuery = query.Encode()
+		h.ServeHTTP(w, r)
+	})
+}
+
+// setETag sets ETag header to the toggle's version if the response carries it.
+func setETag(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	var version int64
+	switch resp := msg.(type) {
+	case versionResponse:
+		version = resp.GetVersion()
+	case toggleResponse:
+		version = resp.GetToggle().GetVersion()
+	}
+	if version > 0 {
+		w.Header().Set("ETag", createETag(version))
+	}
+	return nil
+}
+
+// createETag creates strong ETag from the toggle's version.
+func createETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// parseETag parses strong ETag created by createETag back to the toggle's version.
+func parseETag(etag string) (int64, error) {
+	value, err := strconv.Unquote(etag)
+	if err != nil {
+		return 0, err
+	}
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, fmt.Errorf("etag %s isn't a toggle's version", etag)
+	}
+	return version, nil
+}
@@ -0,0 +1,257 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// toggleDownloadPath is the path of the endpoint which downloads the snapshot of the environment's toggles as a file.
+	toggleDownloadPath = "/v1/projects/{project}/environments/{environment}/toggles:download"
+	// toggleUploadPath is the path of the endpoint which imports the snapshot uploaded as a file.
+	toggleUploadPath = "/v1/projects/{project}/environments/{environment}/toggles:upload"
+
+	snapshotFormatJSON = "json"
+	snapshotFormatYAML = "yaml"
+)
+
+var (
+	snapshotContentTypes = map[string]string{
+		snapshotFormatJSON: "application/json",
+		snapshotFormatYAML: "application/yaml",
+	}
+)
+
+// EnableToggleSnapshot enables the endpoints which download and upload the snapshot of the environment's toggles
+// as a JSON or YAML file using ExportToggles and ImportToggles.
+//
+// The snapshot can be downloaded via GET /v1/projects/{project}/environments/{environment}/toggles:download?format=<json|yaml>.
+// It is the same file the SDK's file data source reads.
+//
+// The snapshot can be uploaded as the body of POST /v1/projects/{project}/environments/{environment}/toggles:upload.
+// The body is read as YAML if the format query parameter is yaml or the Content-Type header is YAML, otherwise it is read as JSON.
+// The import is configured by the dry_run, prune, and conflict_policy (skip, overwrite, or fail) query parameters.
+func (gg *GrpcGateway) EnableToggleSnapshot(query togglev1.ToggleQueryServiceClient, command togglev1.ToggleCommandServiceClient) error {
+	if err := gg.mux.HandlePath(http.MethodGet, toggleDownloadPath, toggleDownloadHandler(gg.mux, query)); err != nil {
+		return err
+	}
+	return gg.mux.HandlePath(http.MethodPost, toggleUploadPath, toggleUploadHandler(gg.mux, command))
+}
+
+func toggleDownloadHandler(mux *runtime.ServeMux, client togglev1.ToggleQueryServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/proto.indrasaputra.toggle.v1.ToggleQueryService/ExportToggles", runtime.WithHTTPPathPattern(toggleDownloadPath))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		format, err := snapshotFormat(r.URL.Query().Get("format"), "")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		resp, err := client.ExportToggles(ctx, &togglev1.ExportTogglesRequest{Project: params["project"], Environment: params["environment"]})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		data, err := marshalSnapshot(resp.GetSnapshot(), format)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, entity.ErrInternal(err.Error()))
+			return
+		}
+
+		filename := fmt.Sprintf("toggles-%s-%s.%s", params["project"], params["environment"], format)
+		w.Header().Set("Content-Type", snapshotContentTypes[format])
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+	}
+}
+
+func toggleUploadHandler(mux *runtime.ServeMux, client togglev1.ToggleCommandServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r, "/proto.indrasaputra.toggle.v1.ToggleCommandService/ImportToggles", runtime.WithHTTPPathPattern(toggleUploadPath))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+		request, err := createImportTogglesRequest(r, params)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		var md runtime.ServerMetadata
+		resp, err := client.ImportToggles(ctx, request, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp)
+	}
+}
+
+func createImportTogglesRequest(r *http.Request, params map[string]string) (*togglev1.ImportTogglesRequest, error) {
+	query := r.URL.Query()
+	format, err := snapshotFormat(query.Get("format"), r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	policy, err := parseImportConflictPolicy(query.Get("conflict_policy"))
+	if err != nil {
+		return nil, err
+	}
+	prune, err := parseBoolParam(query, "prune")
+	if err != nil {
+		return nil, err
+	}
+	dryRun, err := parseBoolParam(query, "dry_run")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, entity.ErrInvalidSnapshot(err.Error())
+	}
+	snapshot, err := unmarshalSnapshot(data, format)
+	if err != nil {
+		return nil, entity.ErrInvalidSnapshot(err.Error())
+	}
+	return &togglev1.ImportTogglesRequest{
+		Project:        params["project"],
+		Environment:    params["environment"],
+		Snapshot:       snapshot,
+		ConflictPolicy: policy,
+		Prune:          prune,
+		DryRun:         dryRun,
+	}, nil
+}
+
+// snapshotFormat returns the snapshot's format given explicitly, or taken from the content type, and defaults to JSON.
+func snapshotFormat(format, contentType string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = snapshotFormatJSON
+		if strings.Contains(strings.ToLower(contentType), snapshotFormatYAML) {
+			format = snapshotFormatYAML
+		}
+	}
+	if format == "yml" {
+		format = snapshotFormatYAML
+	}
+	if _, ok := snapshotContentTypes[format]; !ok {
+		return "", entity.ErrInvalidSnapshot(fmt.Sprintf("format %s isn't supported", format))
+	}
+	return format, nil
+}
+
+// parseImportConflictPolicy parses the conflict policy given either by its short name, such as skip, or by its enum's name.
+// Empty policy is unspecified policy.
+func parseImportConflictPolicy(policy string) (togglev1.ImportConflictPolicy, error) {
+	policy = strings.ToUpper(strings.TrimSpace(policy))
+	if policy == "" {
+		return togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED, nil
+	}
+	if !strings.HasPrefix(policy, "IMPORT_CONFLICT_POLICY_") {
+		policy = "IMPORT_CONFLICT_POLICY_" + policy
+	}
+	value, ok := togglev1.ImportConflictPolicy_value[policy]
+	if !ok {
+		return 0, entity.ErrInvalidSnapshot(fmt.Sprintf("conflict policy %s is unknown", strings.TrimPrefix(policy, "IMPORT_CONFLICT_POLICY_")))
+	}
+	return togglev1.ImportConflictPolicy(value), nil
+}
+
+// parseBoolParam parses the boolean query parameter. Missing parameter is false while a parameter without value, such as ?dry_run, is true.
+func parseBoolParam(query map[string][]string, name string) (bool, error) {
+	values, ok := query[name]
+	if !ok {
+		return false, nil
+	}
+	if len(values) == 0 || values[0] == "" {
+		return true, nil
+	}
+	value, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, entity.ErrInvalidSnapshot(fmt.Sprintf("%s must be a boolean", name))
+	}
+	return value, nil
+}
+
+// marshalSnapshot marshals the snapshot as indented JSON, or as YAML which keeps the JSON's field order.
+func marshalSnapshot(snapshot *togglev1.ToggleSnapshot, format string) ([]byte, error) {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(snapshot)
+	if err != nil || format == snapshotFormatJSON {
+		return data, err
+	}
+
+	// JSON is valid YAML, hence the node keeps the fields' order. Its flow style is removed to produce the usual block style.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// unmarshalSnapshot unmarshals the snapshot from JSON or YAML. Empty data is nil snapshot.
+func unmarshalSnapshot(data []byte, format string) (*togglev1.ToggleSnapshot, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+	if format == snapshotFormatYAML {
+		var tmp interface{}
+		if err := yaml.Unmarshal(data, &tmp); err != nil {
+			return nil, err
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		var err error
+		if data, err = json.Marshal(tmp); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &togglev1.ToggleSnapshot{}
+	if err := protojson.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
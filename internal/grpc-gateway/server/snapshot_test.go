@@ -0,0 +1,208 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc-gateway/server"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// exportTogglesClient answers ExportToggles with the given snapshot or error.
+type exportTogglesClient struct {
+	togglev1.ToggleQueryServiceClient
+	request  *togglev1.ExportTogglesRequest
+	snapshot *togglev1.ToggleSnapshot
+	err      error
+}
+
+func (c *exportTogglesClient) ExportToggles(_ context.Context, in *togglev1.ExportTogglesRequest, _ ...grpc.CallOption) (*togglev1.ExportTogglesResponse, error) {
+	c.request = in
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &togglev1.ExportTogglesResponse{Snapshot: c.snapshot}, nil
+}
+
+// importTogglesClient answers ImportToggles with the given changes or error.
+type importTogglesClient struct {
+	togglev1.ToggleCommandServiceClient
+	request  *togglev1.ImportTogglesRequest
+	metadata metadata.MD
+	changes  []*togglev1.ToggleImportChange
+	err      error
+}
+
+func (c *importTogglesClient) ImportToggles(ctx context.Context, in *togglev1.ImportTogglesRequest, _ ...grpc.CallOption) (*togglev1.ImportTogglesResponse, error) {
+	c.request = in
+	c.metadata, _ = metadata.FromOutgoingContext(ctx)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &togglev1.ImportTogglesResponse{Changes: c.changes}, nil
+}
+
+func TestGrpcGateway_EnableToggleSnapshot(t *testing.T) {
+	snapshot := &togglev1.ToggleSnapshot{
+		FormatVersion: 1,
+		Project:       "default",
+		Environment:   "production",
+		Toggles: []*togglev1.Toggle{
+			{Key: "toggle-1", IsEnabled: true, DefaultValue: proto.Bool(true), Tags: []string{"team-a"}},
+			{Key: "toggle-2", Description: "123", DefaultValue: proto.Bool(false)},
+		},
+	}
+
+	t.Run("success enable toggle snapshot", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		err := srv.EnableToggleSnapshot(&exportTogglesClient{}, &importTogglesClient{})
+		assert.Nil(t, err)
+	})
+
+	t.Run("export error is returned as HTTP error", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleSnapshot(&exportTogglesClient{err: entity.ErrInvalidProject()}, &importTogglesClient{})
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/projects/default/environments/production/toggles:download", nil))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("unsupported format is prohibited", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleSnapshot(&exportTogglesClient{snapshot: snapshot}, &importTogglesClient{})
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/projects/default/environments/production/toggles:download?format=xml", nil))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("snapshot is downloaded as JSON file", func(t *testing.T) {
+		client := &exportTogglesClient{snapshot: snapshot}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleSnapshot(client, &importTogglesClient{})
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/projects/default/environments/production/toggles:download", nil))
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, &togglev1.ExportTogglesRequest{Project: "default", Environment: "production"}, client.request)
+		assert.Equal(t, "application/json", resp.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="toggles-default-production.json"`, resp.Header().Get("Content-Disposition"))
+		res := &togglev1.ToggleSnapshot{}
+		assert.Nil(t, protojson.Unmarshal(resp.Body.Bytes(), res))
+		assert.True(t, proto.Equal(snapshot, res))
+	})
+
+	t.Run("snapshot is downloaded as YAML file in the JSON's field order", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleSnapshot(&exportTogglesClient{snapshot: snapshot}, &importTogglesClient{})
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/projects/default/environments/production/toggles:download?format=yaml", nil))
+
+		body := resp.Body.String()
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/yaml", resp.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="toggles-default-production.yaml"`, resp.Header().Get("Content-Disposition"))
+		assert.True(t, strings.HasPrefix(body, "toggles:\n  - key: toggle-1\n    isEnabled: true\n"))
+		assert.Contains(t, body, "    tags:\n      - team-a\n")
+		assert.Contains(t, body, `description: "123"`)
+		assert.Contains(t, body, "formatVersion: 1\n")
+	})
+
+	t.Run("downloaded snapshot can be uploaded", func(t *testing.T) {
+		for _, format := range []string{"json", "yaml"} {
+			srv := server.NewGrpcGateway(testGrpcGatewayPort)
+			client := &importTogglesClient{}
+			_ = srv.EnableToggleSnapshot(&exportTogglesClient{snapshot: snapshot}, client)
+
+			download := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(download, httptest.NewRequest(http.MethodGet, "/v1/projects/default/environments/production/toggles:download?format="+format, nil))
+			req := httptest.NewRequest(http.MethodPost, "/v1/projects/default/environments/staging/toggles:upload", download.Body)
+			req.Header.Set("Content-Type", download.Header().Get("Content-Type"))
+			resp := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(resp, req)
+
+			assert.Equal(t, http.StatusOK, resp.Code)
+			assert.Equal(t, "staging", client.request.GetEnvironment())
+			assert.True(t, proto.Equal(snapshot, client.request.GetSnapshot()), format)
+		}
+	})
+
+	t.Run("upload is configured by the query parameters", func(t *testing.T) {
+		client := &importTogglesClient{changes: []*togglev1.ToggleImportChange{{Key: "toggle-1", Action: togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_CREATE}}}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleSnapshot(&exportTogglesClient{}, client)
+
+		body := "toggles:\n  - key: toggle-1\n    isEnabled: true\n"
+		req := httptest.NewRequest(http.MethodPost, "/v1/projects/default/environments/production/toggles:upload?format=yml&conflict_policy=overwrite&prune=true&dry_run", strings.NewReader(body))
+		req.Header.Set("X-Actor", "alice")
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE, client.request.GetConflictPolicy())
+		assert.True(t, client.request.GetPrune())
+		assert.True(t, client.request.GetDryRun())
+		assert.Equal(t, "toggle-1", client.request.GetSnapshot().GetToggles()[0].GetKey())
+		assert.True(t, client.request.GetSnapshot().GetToggles()[0].GetIsEnabled())
+		assert.Equal(t, []string{"alice"}, client.metadata.Get("x-actor"))
+		assert.Contains(t, resp.Body.String(), "TOGGLE_IMPORT_ACTION_CREATE")
+	})
+
+	t.Run("empty body is uploaded as nil snapshot", func(t *testing.T) {
+		client := &importTogglesClient{err: entity.ErrInvalidSnapshot("snapshot must not be empty")}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleSnapshot(&exportTogglesClient{}, client)
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/v1/projects/default/environments/production/toggles:upload", nil))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Nil(t, client.request.GetSnapshot())
+	})
+
+	t.Run("invalid upload is prohibited before importing", func(t *testing.T) {
+		urls := []string{
+			"/v1/projects/default/environments/production/toggles:upload?conflict_policy=merge",
+			"/v1/projects/default/environments/production/toggles:upload?dry_run=maybe",
+			"/v1/projects/default/environments/production/toggles:upload?format=xml",
+		}
+		for _, url := range urls {
+			client := &importTogglesClient{}
+			srv := server.NewGrpcGateway(testGrpcGatewayPort)
+			_ = srv.EnableToggleSnapshot(&exportTogglesClient{}, client)
+
+			resp := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodPost, url, strings.NewReader(`{"toggles":[]}`)))
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+			assert.Nil(t, client.request, url)
+		}
+
+		for _, body := range []string{`{"toggles": [`, `{"unknown": true}`} {
+			client := &importTogglesClient{}
+			srv := server.NewGrpcGateway(testGrpcGatewayPort)
+			_ = srv.EnableToggleSnapshot(&exportTogglesClient{}, client)
+
+			resp := httptest.NewRecorder()
+			srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/v1/projects/default/environments/production/toggles:upload", strings.NewReader(body)))
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code, body)
+			assert.Nil(t, client.request, body)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/proto"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// toggleStreamPath is the path of the Server-Sent Events endpoint which streams the toggle's changes.
+	toggleStreamPath = "/v1/toggles/stream"
+	// defaultStreamKeepAlive is the keep-alive interval used when the given interval isn't positive.
+	defaultStreamKeepAlive = 15 * time.Second
+	// lastEventIDParam is the query parameter which can be used in place of Last-Event-ID header on the first connection.
+	lastEventIDParam = "last_event_id"
+
+	sseEventSnapshot = "snapshot"
+	sseEventChange   = "change"
+)
+
+// EnableToggleStream enables Server-Sent Events endpoint which streams the toggle's changes using WatchToggles.
+// It can be accessed via GET /v1/toggles/stream?project=<project>&environment=<environment>.
+// The toggles can be filtered by the repeated keys and key_prefixes query parameters.
+//
+// The stream starts with a snapshot event and is followed by a change event for every toggle's event.
+// Each event's ID is its resume token, hence a browser which reconnects resumes from the last received event
+// using Last-Event-ID header. A comment is sent every keepAlive to keep the idle connection open.
+// If keepAlive isn't positive, the comment is sent every 15 seconds.
+func (gg *GrpcGateway) EnableToggleStream(client togglev1.ToggleQueryServiceClient, keepAlive time.Duration) error {
+	if keepAlive <= 0 {
+		keepAlive = defaultStreamKeepAlive
+	}
+	return gg.mux.HandlePath(http.MethodGet, toggleStreamPath, toggleStreamHandler(gg.mux, client, keepAlive))
+}
+
+func toggleStreamHandler(mux *runtime.ServeMux, client togglev1.ToggleQueryServiceClient, keepAlive time.Duration) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		stream, err := client.WatchToggles(ctx, createWatchTogglesRequest(r))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		// the first message is received before the response is started,
+		// so that an invalid request is still answered with a proper HTTP error.
+		first, err := stream.Recv()
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+
+		if err := writeWatchTogglesResponse(w, outbound, first); err != nil {
+			return
+		}
+		flusher.Flush()
+
+		messages := receiveWatchToggles(ctx, stream)
+		ticker := time.NewTicker(keepAlive)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+					return
+				}
+			case resp, ok := <-messages:
+				if !ok {
+					// the stream is over, the browser reconnects with its last event's ID.
+					return
+				}
+				if err := writeWatchTogglesResponse(w, outbound, resp); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// receiveWatchToggles receives the stream's messages in the background until the stream ends or the context is done.
+func receiveWatchToggles(ctx context.Context, stream togglev1.ToggleQueryService_WatchTogglesClient) <-chan *togglev1.WatchTogglesResponse {
+	res := make(chan *togglev1.WatchTogglesResponse)
+	go func() {
+		defer close(res)
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case res <- resp:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return res
+}
+
+func createWatchTogglesRequest(r *http.Request) *togglev1.WatchTogglesRequest {
+	query := r.URL.Query()
+	token := r.Header.Get("Last-Event-ID")
+	if token == "" {
+		token = query.Get(lastEventIDParam)
+	}
+	return &togglev1.WatchTogglesRequest{
+		Project:     query.Get("project"),
+		Environment: query.Get("environment"),
+		Keys:        query["keys"],
+		KeyPrefixes: query["key_prefixes"],
+		ResumeToken: token,
+	}
+}
+
+// writeWatchTogglesResponse writes the message as a Server-Sent Event.
+// The snapshot is sent as a snapshot event while the toggle's event is sent as a change event.
+func writeWatchTogglesResponse(w io.Writer, marshaler runtime.Marshaler, resp *togglev1.WatchTogglesResponse) error {
+	name := sseEventChange
+	var msg proto.Message = resp.GetEvent()
+	if resp.GetSnapshot() != nil {
+		name = sseEventSnapshot
+		msg = resp.GetSnapshot()
+	}
+
+	data, err := marshaler.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "id: %s\nevent: %s\n", resp.GetResumeToken(), name)
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+
+	_, err = w.Write(buf.Bytes())
+	return err
+}
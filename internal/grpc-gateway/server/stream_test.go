@@ -0,0 +1,174 @@
+package server_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc-gateway/server"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// watchTogglesClient answers WatchToggles with the given responses followed by the given error.
+type watchTogglesClient struct {
+	togglev1.ToggleQueryServiceClient
+	request   *togglev1.WatchTogglesRequest
+	responses []*togglev1.WatchTogglesResponse
+	err       error
+	block     bool
+}
+
+func (c *watchTogglesClient) WatchToggles(ctx context.Context, in *togglev1.WatchTogglesRequest, _ ...grpc.CallOption) (togglev1.ToggleQueryService_WatchTogglesClient, error) {
+	c.request = in
+	return &watchTogglesStreamClient{ctx: ctx, client: c}, nil
+}
+
+type watchTogglesStreamClient struct {
+	grpc.ClientStream
+	ctx    context.Context
+	client *watchTogglesClient
+}
+
+func (s *watchTogglesStreamClient) Recv() (*togglev1.WatchTogglesResponse, error) {
+	if len(s.client.responses) > 0 {
+		resp := s.client.responses[0]
+		s.client.responses = s.client.responses[1:]
+		return resp, nil
+	}
+	if s.client.block {
+		<-s.ctx.Done()
+		return nil, s.ctx.Err()
+	}
+	return nil, s.client.err
+}
+
+func TestGrpcGateway_EnableToggleStream(t *testing.T) {
+	t.Run("success enable toggle stream", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		err := srv.EnableToggleStream(&watchTogglesClient{}, time.Second)
+		assert.Nil(t, err)
+	})
+
+	t.Run("error before the first message is returned as HTTP error", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleStream(&watchTogglesClient{err: entity.ErrInvalidProject()}, time.Second)
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/toggles/stream", nil))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("snapshot and changes are streamed as server-sent events", func(t *testing.T) {
+		client := &watchTogglesClient{
+			responses: []*togglev1.WatchTogglesResponse{
+				{
+					Change:      &togglev1.WatchTogglesResponse_Snapshot{Snapshot: &togglev1.ToggleSnapshot{Toggles: []*togglev1.Toggle{{Key: "toggle-1"}}}},
+					ResumeToken: "token-1",
+				},
+				{
+					Change:      &togglev1.WatchTogglesResponse_Event{Event: &togglev1.ToggleEvent{Name: togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED, Toggle: &togglev1.Toggle{Key: "toggle-1"}}},
+					ResumeToken: "token-2",
+				},
+			},
+			err: io.EOF,
+		}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleStream(client, time.Minute)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/toggles/stream?project=default&environment=production&keys=toggle-1&key_prefixes=checkout-&key_prefixes=search-", nil)
+		req.Header.Set("Origin", "http://localhost")
+		req.Header.Set("Last-Event-ID", "token-0")
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+		assert.Equal(t, "http://localhost", resp.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, &togglev1.WatchTogglesRequest{
+			Project:     "default",
+			Environment: "production",
+			Keys:        []string{"toggle-1"},
+			KeyPrefixes: []string{"checkout-", "search-"},
+			ResumeToken: "token-0",
+		}, client.request)
+
+		body := resp.Body.String()
+		assert.Contains(t, body, "id: token-1\nevent: snapshot\ndata: {")
+		assert.Contains(t, body, "id: token-2\nevent: change\ndata: {")
+		assert.Less(t, strings.Index(body, "token-1"), strings.Index(body, "token-2"))
+	})
+
+	t.Run("resume token can be given in the query", func(t *testing.T) {
+		client := &watchTogglesClient{err: io.EOF}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleStream(client, time.Minute)
+
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/toggles/stream?last_event_id=token-0", nil))
+
+		assert.Equal(t, "token-0", client.request.GetResumeToken())
+	})
+
+	t.Run("idle stream is kept alive until the client goes away", func(t *testing.T) {
+		client := &watchTogglesClient{
+			responses: []*togglev1.WatchTogglesResponse{
+				{Change: &togglev1.WatchTogglesResponse_Snapshot{Snapshot: &togglev1.ToggleSnapshot{}}, ResumeToken: "token-1"},
+			},
+			block: true,
+		}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		_ = srv.EnableToggleStream(client, 10*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/toggles/stream", nil).WithContext(ctx))
+
+		assert.Contains(t, resp.Body.String(), ": keepalive\n\n")
+	})
+
+	t.Run("non-positive keep-alive falls back to the default", func(t *testing.T) {
+		client := &watchTogglesClient{
+			responses: []*togglev1.WatchTogglesResponse{
+				{Change: &togglev1.WatchTogglesResponse_Snapshot{Snapshot: &togglev1.ToggleSnapshot{}}, ResumeToken: "token-1"},
+			},
+			block: true,
+		}
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+		err := srv.EnableToggleStream(client, 0)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		resp := httptest.NewRecorder()
+		assert.NotPanics(t, func() {
+			srv.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/v1/toggles/stream", nil).WithContext(ctx))
+		})
+
+		assert.Nil(t, err)
+		assert.Contains(t, resp.Body.String(), "id: token-1\nevent: snapshot\n")
+		assert.NotContains(t, resp.Body.String(), ": keepalive\n\n")
+	})
+}
+
+func TestGrpcGateway_Handler(t *testing.T) {
+	t.Run("preflight request allows Last-Event-ID header", func(t *testing.T) {
+		srv := server.NewGrpcGateway(testGrpcGatewayPort)
+
+		req := httptest.NewRequest(http.MethodOptions, "/v1/toggles/stream", nil)
+		req.Header.Set("Origin", "http://localhost")
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		resp := httptest.NewRecorder()
+		srv.Handler().ServeHTTP(resp, req)
+
+		assert.Contains(t, resp.Header().Get("Access-Control-Allow-Headers"), "Last-Event-ID")
+	})
+}
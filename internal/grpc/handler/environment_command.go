@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// EnvironmentCommand handles HTTP/2 gRPC request for state-changing environment.
+type EnvironmentCommand struct {
+	togglev1.UnimplementedEnvironmentCommandServiceServer
+
+	creator service.CreateEnvironment
+}
+
+// NewEnvironmentCommand creates an instance of EnvironmentCommand.
+func NewEnvironmentCommand(creator service.CreateEnvironment) *EnvironmentCommand {
+	return &EnvironmentCommand{creator: creator}
+}
+
+// CreateEnvironment handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (ec *EnvironmentCommand) CreateEnvironment(ctx context.Context, request *togglev1.CreateEnvironmentRequest) (*togglev1.CreateEnvironmentResponse, error) {
+	if request == nil || request.GetEnvironment() == nil {
+		return nil, entity.ErrInvalidEnvironment()
+	}
+
+	err := ec.creator.Create(ctx, createEnvironmentFromCreateEnvironmentRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.CreateEnvironmentResponse{}, nil
+}
+
+func createEnvironmentFromCreateEnvironmentRequest(request *togglev1.CreateEnvironmentRequest) *entity.Environment {
+	return &entity.Environment{
+		Name:        request.GetEnvironment().GetName(),
+		Description: request.GetEnvironment().GetDescription(),
+	}
+}
@@ -0,0 +1,96 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testEnvironmentName        = "sandbox"
+	testEnvironmentDescription = "sandbox environment"
+	testEnvironment            = &entity.Environment{Name: testEnvironmentName, Description: testEnvironmentDescription}
+	testCreateEnvironmentReq   = &togglev1.CreateEnvironmentRequest{
+		Environment: &togglev1.Environment{Name: testEnvironmentName, Description: testEnvironmentDescription},
+	}
+)
+
+type EnvironmentCommandExecutor struct {
+	handler *handler.EnvironmentCommand
+	creator *mock_service.MockCreateEnvironment
+}
+
+func TestNewEnvironmentCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of EnvironmentCommand", func(t *testing.T) {
+		exec := createEnvironmentCommandExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestEnvironmentCommand_CreateEnvironment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createEnvironmentCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateEnvironment(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("empty environment is prohibited", func(t *testing.T) {
+		exec := createEnvironmentCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateEnvironment(testCtx, &togglev1.CreateEnvironmentRequest{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("creator service returns error", func(t *testing.T) {
+		exec := createEnvironmentCommandExecutor(ctrl)
+		errTables := []error{entity.ErrInvalidEnvironment(), entity.ErrAlreadyExists(), entity.ErrInternal("")}
+
+		for _, errTab := range errTables {
+			exec.creator.EXPECT().Create(testCtx, testEnvironment).Return(errTab)
+
+			res, err := exec.handler.CreateEnvironment(testCtx, testCreateEnvironmentReq)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, errTab, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success create an environment", func(t *testing.T) {
+		exec := createEnvironmentCommandExecutor(ctrl)
+		exec.creator.EXPECT().Create(testCtx, testEnvironment).Return(nil)
+
+		res, err := exec.handler.CreateEnvironment(testCtx, testCreateEnvironmentReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func createEnvironmentCommandExecutor(ctrl *gomock.Controller) *EnvironmentCommandExecutor {
+	c := mock_service.NewMockCreateEnvironment(ctrl)
+	h := handler.NewEnvironmentCommand(c)
+	return &EnvironmentCommandExecutor{
+		handler: h,
+		creator: c,
+	}
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// EnvironmentQuery handles HTTP/2 gRPC request for retrieve environment.
+type EnvironmentQuery struct {
+	togglev1.UnimplementedEnvironmentQueryServiceServer
+
+	getter service.GetEnvironment
+}
+
+// NewEnvironmentQuery creates an instance of EnvironmentQuery.
+func NewEnvironmentQuery(getter service.GetEnvironment) *EnvironmentQuery {
+	return &EnvironmentQuery{getter: getter}
+}
+
+// GetAllEnvironments handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets all available environments in system.
+func (eq *EnvironmentQuery) GetAllEnvironments(ctx context.Context, request *togglev1.GetAllEnvironmentsRequest) (*togglev1.GetAllEnvironmentsResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidEnvironment()
+	}
+
+	envs, err := eq.getter.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return createGetAllEnvironmentsResponse(envs), nil
+}
+
+func createGetAllEnvironmentsResponse(envs []*entity.Environment) *togglev1.GetAllEnvironmentsResponse {
+	resp := &togglev1.GetAllEnvironmentsResponse{}
+	for _, env := range envs {
+		resp.Environments = append(resp.Environments, createProtoEnvironment(env))
+	}
+	return resp
+}
+
+func createProtoEnvironment(env *entity.Environment) *togglev1.Environment {
+	return &togglev1.Environment{
+		Name:        env.Name,
+		Description: env.Description,
+		CreatedAt:   timestamppb.New(env.CreatedAt),
+	}
+}
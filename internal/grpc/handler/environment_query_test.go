@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testEnvironmentCreatedAt = time.Now()
+	testEnvironmentResult    = &entity.Environment{
+		Name:        testEnvironmentName,
+		Description: testEnvironmentDescription,
+		CreatedAt:   testEnvironmentCreatedAt,
+	}
+	testGetAllEnvironmentsResponse = &togglev1.GetAllEnvironmentsResponse{
+		Environments: []*togglev1.Environment{
+			{Name: testEnvironmentName, Description: testEnvironmentDescription, CreatedAt: timestamppb.New(testEnvironmentCreatedAt)},
+		},
+	}
+)
+
+type EnvironmentQueryExecutor struct {
+	handler *handler.EnvironmentQuery
+	getter  *mock_service.MockGetEnvironment
+}
+
+func TestNewEnvironmentQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of EnvironmentQuery", func(t *testing.T) {
+		exec := createEnvironmentQueryExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestEnvironmentQuery_GetAllEnvironments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createEnvironmentQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetAllEnvironments(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		exec := createEnvironmentQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(testCtx).Return([]*entity.Environment{}, entity.ErrInternal(""))
+
+		res, err := exec.handler.GetAllEnvironments(testCtx, &togglev1.GetAllEnvironmentsRequest{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get all environments", func(t *testing.T) {
+		exec := createEnvironmentQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(testCtx).Return([]*entity.Environment{testEnvironmentResult}, nil)
+
+		res, err := exec.handler.GetAllEnvironments(testCtx, &togglev1.GetAllEnvironmentsRequest{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, testGetAllEnvironmentsResponse, res)
+	})
+}
+
+func createEnvironmentQueryExecutor(ctrl *gomock.Controller) *EnvironmentQueryExecutor {
+	g := mock_service.NewMockGetEnvironment(ctrl)
+	h := handler.NewEnvironmentQuery(g)
+	return &EnvironmentQueryExecutor{
+		handler: h,
+		getter:  g,
+	}
+}
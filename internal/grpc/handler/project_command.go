@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ProjectCommand handles HTTP/2 gRPC request for state-changing project.
+type ProjectCommand struct {
+	togglev1.UnimplementedProjectCommandServiceServer
+
+	creator service.CreateProject
+	updater service.UpdateProject
+	deleter service.DeleteProject
+}
+
+// NewProjectCommand creates an instance of ProjectCommand.
+func NewProjectCommand(creator service.CreateProject, updater service.UpdateProject, deleter service.DeleteProject) *ProjectCommand {
+	return &ProjectCommand{
+		creator: creator,
+		updater: updater,
+		deleter: deleter,
+	}
+}
+
+// CreateProject handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (pc *ProjectCommand) CreateProject(ctx context.Context, request *togglev1.CreateProjectRequest) (*togglev1.CreateProjectResponse, error) {
+	if request == nil || request.GetProject() == nil {
+		return nil, entity.ErrInvalidProject()
+	}
+
+	err := pc.creator.Create(ctx, createProjectFromProto(request.GetProject().GetName(), request.GetProject()))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.CreateProjectResponse{}, nil
+}
+
+// UpdateProject handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
+// It only updates the project's description.
+func (pc *ProjectCommand) UpdateProject(ctx context.Context, request *togglev1.UpdateProjectRequest) (*togglev1.UpdateProjectResponse, error) {
+	if request == nil || request.GetProject() == nil {
+		return nil, entity.ErrInvalidProject()
+	}
+
+	err := pc.updater.Update(ctx, createProjectFromProto(request.GetName(), request.GetProject()))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.UpdateProjectResponse{}, nil
+}
+
+// DeleteProject handles HTTP/2 gRPC request similar to DELETE in HTTP/1.1.
+// It only deletes project that doesn't own any toggle.
+func (pc *ProjectCommand) DeleteProject(ctx context.Context, request *togglev1.DeleteProjectRequest) (*togglev1.DeleteProjectResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidProject()
+	}
+
+	err := pc.deleter.DeleteByName(ctx, request.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.DeleteProjectResponse{}, nil
+}
+
+func createProjectFromProto(name string, project *togglev1.Project) *entity.Project {
+	return &entity.Project{
+		Name:        name,
+		Description: project.GetDescription(),
+	}
+}
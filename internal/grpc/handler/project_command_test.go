@@ -0,0 +1,179 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testProjectName        = "payment"
+	testProjectDescription = "payment team"
+	testProject            = &entity.Project{Name: testProjectName, Description: testProjectDescription}
+	testCreateProjectReq   = &togglev1.CreateProjectRequest{
+		Project: &togglev1.Project{Name: testProjectName, Description: testProjectDescription},
+	}
+	testUpdateProjectReq = &togglev1.UpdateProjectRequest{
+		Name:    testProjectName,
+		Project: &togglev1.Project{Description: testProjectDescription},
+	}
+	testDeleteProjectReq = &togglev1.DeleteProjectRequest{Name: testProjectName}
+)
+
+type ProjectCommandExecutor struct {
+	handler *handler.ProjectCommand
+	creator *mock_service.MockCreateProject
+	updater *mock_service.MockUpdateProject
+	deleter *mock_service.MockDeleteProject
+}
+
+func TestNewProjectCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of ProjectCommand", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestProjectCommand_CreateProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateProject(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("empty project is prohibited", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateProject(testCtx, &togglev1.CreateProjectRequest{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("creator service returns error", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		errTables := []error{entity.ErrInvalidProject(), entity.ErrAlreadyExists(), entity.ErrInternal("")}
+
+		for _, errTab := range errTables {
+			exec.creator.EXPECT().Create(testCtx, testProject).Return(errTab)
+
+			res, err := exec.handler.CreateProject(testCtx, testCreateProjectReq)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, errTab, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success create a project", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		exec.creator.EXPECT().Create(testCtx, testProject).Return(nil)
+
+		res, err := exec.handler.CreateProject(testCtx, testCreateProjectReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestProjectCommand_UpdateProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty project is prohibited", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateProject(testCtx, &togglev1.UpdateProjectRequest{Name: testProjectName})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		exec.updater.EXPECT().Update(testCtx, testProject).Return(entity.ErrProjectNotFound())
+
+		res, err := exec.handler.UpdateProject(testCtx, testUpdateProjectReq)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update a project", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		exec.updater.EXPECT().Update(testCtx, testProject).Return(nil)
+
+		res, err := exec.handler.UpdateProject(testCtx, testUpdateProjectReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestProjectCommand_DeleteProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+
+		res, err := exec.handler.DeleteProject(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("deleter service returns error", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		exec.deleter.EXPECT().DeleteByName(testCtx, testProjectName).Return(entity.ErrProjectNotEmpty())
+
+		res, err := exec.handler.DeleteProject(testCtx, testDeleteProjectReq)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotEmpty(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success delete a project", func(t *testing.T) {
+		exec := createProjectCommandExecutor(ctrl)
+		exec.deleter.EXPECT().DeleteByName(testCtx, testProjectName).Return(nil)
+
+		res, err := exec.handler.DeleteProject(testCtx, testDeleteProjectReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func createProjectCommandExecutor(ctrl *gomock.Controller) *ProjectCommandExecutor {
+	c := mock_service.NewMockCreateProject(ctrl)
+	u := mock_service.NewMockUpdateProject(ctrl)
+	d := mock_service.NewMockDeleteProject(ctrl)
+	h := handler.NewProjectCommand(c, u, d)
+	return &ProjectCommandExecutor{
+		handler: h,
+		creator: c,
+		updater: u,
+		deleter: d,
+	}
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ProjectQuery handles HTTP/2 gRPC request for retrieve project.
+type ProjectQuery struct {
+	togglev1.UnimplementedProjectQueryServiceServer
+
+	getter service.GetProject
+}
+
+// NewProjectQuery creates an instance of ProjectQuery.
+func NewProjectQuery(getter service.GetProject) *ProjectQuery {
+	return &ProjectQuery{getter: getter}
+}
+
+// GetProjectByName handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets a single project by its name.
+func (pq *ProjectQuery) GetProjectByName(ctx context.Context, request *togglev1.GetProjectByNameRequest) (*togglev1.GetProjectByNameResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidProject()
+	}
+
+	project, err := pq.getter.GetByName(ctx, request.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.GetProjectByNameResponse{Project: createProtoProject(project)}, nil
+}
+
+// GetAllProjects handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets all available projects in system.
+func (pq *ProjectQuery) GetAllProjects(ctx context.Context, request *togglev1.GetAllProjectsRequest) (*togglev1.GetAllProjectsResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidProject()
+	}
+
+	projects, err := pq.getter.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return createGetAllProjectsResponse(projects), nil
+}
+
+func createGetAllProjectsResponse(projects []*entity.Project) *togglev1.GetAllProjectsResponse {
+	resp := &togglev1.GetAllProjectsResponse{}
+	for _, project := range projects {
+		resp.Projects = append(resp.Projects, createProtoProject(project))
+	}
+	return resp
+}
+
+func createProtoProject(project *entity.Project) *togglev1.Project {
+	return &togglev1.Project{
+		Name:        project.Name,
+		Description: project.Description,
+		CreatedAt:   timestamppb.New(project.CreatedAt),
+		UpdatedAt:   timestamppb.New(project.UpdatedAt),
+	}
+}
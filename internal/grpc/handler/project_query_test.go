@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testProjectTime   = time.Now()
+	testProjectResult = &entity.Project{
+		Name:        testProjectName,
+		Description: testProjectDescription,
+		CreatedAt:   testProjectTime,
+		UpdatedAt:   testProjectTime,
+	}
+	testProjectProto = &togglev1.Project{
+		Name:        testProjectName,
+		Description: testProjectDescription,
+		CreatedAt:   timestamppb.New(testProjectTime),
+		UpdatedAt:   timestamppb.New(testProjectTime),
+	}
+)
+
+type ProjectQueryExecutor struct {
+	handler *handler.ProjectQuery
+	getter  *mock_service.MockGetProject
+}
+
+func TestNewProjectQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of ProjectQuery", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestProjectQuery_GetProjectByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetProjectByName(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetByName(testCtx, testProjectName).Return(nil, entity.ErrProjectNotFound())
+
+		res, err := exec.handler.GetProjectByName(testCtx, &togglev1.GetProjectByNameRequest{Name: testProjectName})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get a project", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetByName(testCtx, testProjectName).Return(testProjectResult, nil)
+
+		res, err := exec.handler.GetProjectByName(testCtx, &togglev1.GetProjectByNameRequest{Name: testProjectName})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &togglev1.GetProjectByNameResponse{Project: testProjectProto}, res)
+	})
+}
+
+func TestProjectQuery_GetAllProjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetAllProjects(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(testCtx).Return([]*entity.Project{}, entity.ErrInternal(""))
+
+		res, err := exec.handler.GetAllProjects(testCtx, &togglev1.GetAllProjectsRequest{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get all projects", func(t *testing.T) {
+		exec := createProjectQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(testCtx).Return([]*entity.Project{testProjectResult}, nil)
+
+		res, err := exec.handler.GetAllProjects(testCtx, &togglev1.GetAllProjectsRequest{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &togglev1.GetAllProjectsResponse{Projects: []*togglev1.Project{testProjectProto}}, res)
+	})
+}
+
+func createProjectQueryExecutor(ctrl *gomock.Controller) *ProjectQueryExecutor {
+	g := mock_service.NewMockGetProject(ctrl)
+	h := handler.NewProjectQuery(g)
+	return &ProjectQueryExecutor{
+		handler: h,
+		getter:  g,
+	}
+}
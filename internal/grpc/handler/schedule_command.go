@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ScheduleCommand handles HTTP/2 gRPC request for state-changing toggle's schedule.
+type ScheduleCommand struct {
+	togglev1.UnimplementedScheduleCommandServiceServer
+
+	creator   service.CreateSchedule
+	canceller service.CancelSchedule
+}
+
+// NewScheduleCommand creates an instance of ScheduleCommand.
+func NewScheduleCommand(creator service.CreateSchedule, canceller service.CancelSchedule) *ScheduleCommand {
+	return &ScheduleCommand{
+		creator:   creator,
+		canceller: canceller,
+	}
+}
+
+// CreateToggleSchedule handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+// It returns the created schedule so that its id can be used to cancel it.
+func (sc *ScheduleCommand) CreateToggleSchedule(ctx context.Context, request *togglev1.CreateToggleScheduleRequest) (*togglev1.CreateToggleScheduleResponse, error) {
+	if request == nil || request.GetSchedule() == nil {
+		return nil, entity.ErrInvalidSchedule("empty or nil")
+	}
+
+	schedule := createScheduleFromProto(request)
+	if err := sc.creator.Create(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return &togglev1.CreateToggleScheduleResponse{Schedule: createProtoSchedule(schedule)}, nil
+}
+
+// CancelToggleSchedule handles HTTP/2 gRPC request similar to DELETE in HTTP/1.1.
+// It only cancels pending schedule.
+func (sc *ScheduleCommand) CancelToggleSchedule(ctx context.Context, request *togglev1.CancelToggleScheduleRequest) (*togglev1.CancelToggleScheduleResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidSchedule("empty or nil")
+	}
+
+	err := sc.canceller.Cancel(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.CancelToggleScheduleResponse{}, nil
+}
+
+func createScheduleFromProto(request *togglev1.CreateToggleScheduleRequest) *entity.Schedule {
+	return &entity.Schedule{
+		Key:         request.GetKey(),
+		Project:     request.GetProject(),
+		Environment: request.GetEnvironment(),
+		Action:      entity.ScheduleActionFromProto(request.GetSchedule().GetAction()),
+		ExecuteAt:   request.GetSchedule().GetExecuteAt().AsTime(),
+	}
+}
@@ -0,0 +1,153 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testScheduleExecuteAt = time.Date(2030, time.February, 1, 0, 0, 0, 0, time.UTC)
+	testSchedule          = &entity.Schedule{
+		Key:         testToggleKey,
+		Project:     testToggleProject,
+		Environment: testToggleEnv,
+		Action:      entity.ScheduleActionEnable,
+		ExecuteAt:   testScheduleExecuteAt,
+	}
+	testCreateToggleScheduleReq = &togglev1.CreateToggleScheduleRequest{
+		Key:         testToggleKey,
+		Project:     testToggleProject,
+		Environment: testToggleEnv,
+		Schedule: &togglev1.Schedule{
+			Action:    togglev1.ScheduleAction_SCHEDULE_ACTION_ENABLE,
+			ExecuteAt: timestamppb.New(testScheduleExecuteAt),
+		},
+	}
+	testCancelToggleScheduleReq = &togglev1.CancelToggleScheduleRequest{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Id: 1}
+)
+
+type ScheduleCommandExecutor struct {
+	handler   *handler.ScheduleCommand
+	creator   *mock_service.MockCreateSchedule
+	canceller *mock_service.MockCancelSchedule
+}
+
+func TestNewScheduleCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of ScheduleCommand", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestScheduleCommand_CreateToggleSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateToggleSchedule(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("empty schedule is prohibited", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateToggleSchedule(testCtx, &togglev1.CreateToggleScheduleRequest{Key: testToggleKey})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("creator service returns error", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+		errTables := []error{entity.ErrInvalidSchedule(""), entity.ErrNotFound(), entity.ErrInternal("")}
+
+		for _, errTab := range errTables {
+			exec.creator.EXPECT().Create(testCtx, testSchedule).Return(errTab)
+
+			res, err := exec.handler.CreateToggleSchedule(testCtx, testCreateToggleScheduleReq)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, errTab, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success create a schedule", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+		exec.creator.EXPECT().Create(testCtx, testSchedule).DoAndReturn(func(_ interface{}, schedule *entity.Schedule) error {
+			schedule.ID = 1
+			return nil
+		})
+
+		res, err := exec.handler.CreateToggleSchedule(testCtx, testCreateToggleScheduleReq)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.GetSchedule().GetId())
+		assert.Equal(t, togglev1.ScheduleAction_SCHEDULE_ACTION_ENABLE, res.GetSchedule().GetAction())
+	})
+}
+
+func TestScheduleCommand_CancelToggleSchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+
+		res, err := exec.handler.CancelToggleSchedule(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("canceller service returns error", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+		exec.canceller.EXPECT().Cancel(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(entity.ErrScheduleNotFound())
+
+		res, err := exec.handler.CancelToggleSchedule(testCtx, testCancelToggleScheduleReq)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrScheduleNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success cancel a schedule", func(t *testing.T) {
+		exec := createScheduleCommandExecutor(ctrl)
+		exec.canceller.EXPECT().Cancel(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(nil)
+
+		res, err := exec.handler.CancelToggleSchedule(testCtx, testCancelToggleScheduleReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func createScheduleCommandExecutor(ctrl *gomock.Controller) *ScheduleCommandExecutor {
+	c := mock_service.NewMockCreateSchedule(ctrl)
+	cc := mock_service.NewMockCancelSchedule(ctrl)
+	h := handler.NewScheduleCommand(c, cc)
+	return &ScheduleCommandExecutor{
+		handler:   h,
+		creator:   c,
+		canceller: cc,
+	}
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ScheduleQuery handles HTTP/2 gRPC request for retrieve toggle's schedule.
+type ScheduleQuery struct {
+	togglev1.UnimplementedScheduleQueryServiceServer
+
+	getter service.GetSchedule
+}
+
+// NewScheduleQuery creates an instance of ScheduleQuery.
+func NewScheduleQuery(getter service.GetSchedule) *ScheduleQuery {
+	return &ScheduleQuery{getter: getter}
+}
+
+// GetAllToggleSchedules handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets all pending schedules of the toggle in the project's environment.
+func (sq *ScheduleQuery) GetAllToggleSchedules(ctx context.Context, request *togglev1.GetAllToggleSchedulesRequest) (*togglev1.GetAllToggleSchedulesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidSchedule("empty or nil")
+	}
+
+	schedules, err := sq.getter.GetAllPending(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return createGetAllToggleSchedulesResponse(schedules), nil
+}
+
+func createGetAllToggleSchedulesResponse(schedules []*entity.Schedule) *togglev1.GetAllToggleSchedulesResponse {
+	resp := &togglev1.GetAllToggleSchedulesResponse{}
+	for _, schedule := range schedules {
+		resp.Schedules = append(resp.Schedules, createProtoSchedule(schedule))
+	}
+	return resp
+}
+
+func createProtoSchedule(schedule *entity.Schedule) *togglev1.Schedule {
+	return &togglev1.Schedule{
+		Id:          schedule.ID,
+		Action:      entity.ScheduleActionToProto(schedule.Action),
+		ExecuteAt:   timestamppb.New(schedule.ExecuteAt),
+		Key:         schedule.Key,
+		Environment: schedule.Environment,
+		Project:     schedule.Project,
+		CreatedAt:   timestamppb.New(schedule.CreatedAt),
+	}
+}
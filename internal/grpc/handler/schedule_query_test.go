@@ -0,0 +1,82 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testGetAllToggleSchedulesReq = &togglev1.GetAllToggleSchedulesRequest{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv}
+)
+
+type ScheduleQueryExecutor struct {
+	handler *handler.ScheduleQuery
+	getter  *mock_service.MockGetSchedule
+}
+
+func TestNewScheduleQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of ScheduleQuery", func(t *testing.T) {
+		exec := createScheduleQueryExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestScheduleQuery_GetAllToggleSchedules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createScheduleQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetAllToggleSchedules(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		exec := createScheduleQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAllPending(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.handler.GetAllToggleSchedules(testCtx, testGetAllToggleSchedulesReq)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get all pending schedules", func(t *testing.T) {
+		exec := createScheduleQueryExecutor(ctrl)
+		schedules := []*entity.Schedule{
+			{ID: 1, Key: testToggleKey, Action: entity.ScheduleActionEnable},
+			{ID: 2, Key: testToggleKey, Action: entity.ScheduleActionDisable},
+		}
+		exec.getter.EXPECT().GetAllPending(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(schedules, nil)
+
+		res, err := exec.handler.GetAllToggleSchedules(testCtx, testGetAllToggleSchedulesReq)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetSchedules()))
+		assert.Equal(t, togglev1.ScheduleAction_SCHEDULE_ACTION_DISABLE, res.GetSchedules()[1].GetAction())
+	})
+}
+
+func createScheduleQueryExecutor(ctrl *gomock.Controller) *ScheduleQueryExecutor {
+	g := mock_service.NewMockGetSchedule(ctrl)
+	h := handler.NewScheduleQuery(g)
+	return &ScheduleQueryExecutor{
+		handler: h,
+		getter:  g,
+	}
+}
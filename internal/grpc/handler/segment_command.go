@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// SegmentCommand handles HTTP/2 gRPC request for state-changing segment.
+type SegmentCommand struct {
+	togglev1.UnimplementedSegmentCommandServiceServer
+
+	creator service.CreateSegment
+	updater service.UpdateSegment
+	deleter service.DeleteSegment
+}
+
+// NewSegmentCommand creates an instance of SegmentCommand.
+func NewSegmentCommand(creator service.CreateSegment, updater service.UpdateSegment, deleter service.DeleteSegment) *SegmentCommand {
+	return &SegmentCommand{
+		creator: creator,
+		updater: updater,
+		deleter: deleter,
+	}
+}
+
+// CreateSegment handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+func (sc *SegmentCommand) CreateSegment(ctx context.Context, request *togglev1.CreateSegmentRequest) (*togglev1.CreateSegmentResponse, error) {
+	if request == nil || request.GetSegment() == nil {
+		return nil, entity.ErrInvalidSegment("empty or nil")
+	}
+
+	err := sc.creator.Create(ctx, createSegmentFromProto(request.GetProject(), request.GetSegment().GetKey(), request.GetSegment()))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.CreateSegmentResponse{}, nil
+}
+
+// UpdateSegment handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
+// It replaces the segment's description, included list, excluded list, and rules.
+func (sc *SegmentCommand) UpdateSegment(ctx context.Context, request *togglev1.UpdateSegmentRequest) (*togglev1.UpdateSegmentResponse, error) {
+	if request == nil || request.GetSegment() == nil {
+		return nil, entity.ErrInvalidSegment("empty or nil")
+	}
+
+	err := sc.updater.Update(ctx, createSegmentFromProto(request.GetProject(), request.GetKey(), request.GetSegment()))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.UpdateSegmentResponse{}, nil
+}
+
+// DeleteSegment handles HTTP/2 gRPC request similar to DELETE in HTTP/1.1.
+// It only deletes segment that isn't referenced by any toggle's rule.
+func (sc *SegmentCommand) DeleteSegment(ctx context.Context, request *togglev1.DeleteSegmentRequest) (*togglev1.DeleteSegmentResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidSegment("empty or nil")
+	}
+
+	err := sc.deleter.DeleteByKey(ctx, request.GetProject(), request.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.DeleteSegmentResponse{}, nil
+}
+
+func createSegmentFromProto(project, key string, segment *togglev1.Segment) *entity.Segment {
+	return &entity.Segment{
+		Key:         key,
+		Project:     project,
+		Description: segment.GetDescription(),
+		Included:    segment.GetIncluded(),
+		Excluded:    segment.GetExcluded(),
+		Rules:       entity.RulesFromProto(segment.GetRules()),
+	}
+}
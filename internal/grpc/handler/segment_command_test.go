@@ -0,0 +1,184 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSegmentKey     = "beta-testers"
+	testSegmentProject = "payment"
+	testSegment        = &entity.Segment{
+		Key:      testSegmentKey,
+		Project:  testSegmentProject,
+		Included: []string{"user-1"},
+		Rules:    []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}}},
+	}
+	testSegmentProtoInput = &togglev1.Segment{
+		Key:      testSegmentKey,
+		Included: []string{"user-1"},
+		Rules:    []*togglev1.Rule{{Attribute: "country", Operator: togglev1.RuleOperator_RULE_OPERATOR_IN, Values: []string{"ID", "SG"}}},
+	}
+	testCreateSegmentReq = &togglev1.CreateSegmentRequest{Project: testSegmentProject, Segment: testSegmentProtoInput}
+	testUpdateSegmentReq = &togglev1.UpdateSegmentRequest{Project: testSegmentProject, Key: testSegmentKey, Segment: testSegmentProtoInput}
+	testDeleteSegmentReq = &togglev1.DeleteSegmentRequest{Project: testSegmentProject, Key: testSegmentKey}
+)
+
+type SegmentCommandExecutor struct {
+	handler *handler.SegmentCommand
+	creator *mock_service.MockCreateSegment
+	updater *mock_service.MockUpdateSegment
+	deleter *mock_service.MockDeleteSegment
+}
+
+func TestNewSegmentCommand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of SegmentCommand", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestSegmentCommand_CreateSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateSegment(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("empty segment is prohibited", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+
+		res, err := exec.handler.CreateSegment(testCtx, &togglev1.CreateSegmentRequest{Project: testSegmentProject})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("creator service returns error", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		errTables := []error{entity.ErrInvalidSegment(""), entity.ErrAlreadyExists(), entity.ErrProjectNotFound(), entity.ErrInternal("")}
+
+		for _, errTab := range errTables {
+			exec.creator.EXPECT().Create(testCtx, testSegment).Return(errTab)
+
+			res, err := exec.handler.CreateSegment(testCtx, testCreateSegmentReq)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, errTab, err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("success create a segment", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		exec.creator.EXPECT().Create(testCtx, testSegment).Return(nil)
+
+		res, err := exec.handler.CreateSegment(testCtx, testCreateSegmentReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestSegmentCommand_UpdateSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty segment is prohibited", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateSegment(testCtx, &togglev1.UpdateSegmentRequest{Project: testSegmentProject, Key: testSegmentKey})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		exec.updater.EXPECT().Update(testCtx, testSegment).Return(entity.ErrSegmentNotFound())
+
+		res, err := exec.handler.UpdateSegment(testCtx, testUpdateSegmentReq)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update a segment", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		exec.updater.EXPECT().Update(testCtx, testSegment).Return(nil)
+
+		res, err := exec.handler.UpdateSegment(testCtx, testUpdateSegmentReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestSegmentCommand_DeleteSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+
+		res, err := exec.handler.DeleteSegment(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("deleter service returns error", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		exec.deleter.EXPECT().DeleteByKey(testCtx, testSegmentProject, testSegmentKey).Return(entity.ErrSegmentInUse())
+
+		res, err := exec.handler.DeleteSegment(testCtx, testDeleteSegmentReq)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentInUse(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success delete a segment", func(t *testing.T) {
+		exec := createSegmentCommandExecutor(ctrl)
+		exec.deleter.EXPECT().DeleteByKey(testCtx, testSegmentProject, testSegmentKey).Return(nil)
+
+		res, err := exec.handler.DeleteSegment(testCtx, testDeleteSegmentReq)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func createSegmentCommandExecutor(ctrl *gomock.Controller) *SegmentCommandExecutor {
+	c := mock_service.NewMockCreateSegment(ctrl)
+	u := mock_service.NewMockUpdateSegment(ctrl)
+	d := mock_service.NewMockDeleteSegment(ctrl)
+	h := handler.NewSegmentCommand(c, u, d)
+	return &SegmentCommandExecutor{
+		handler: h,
+		creator: c,
+		updater: u,
+		deleter: d,
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// SegmentQuery handles HTTP/2 gRPC request for retrieve segment.
+type SegmentQuery struct {
+	togglev1.UnimplementedSegmentQueryServiceServer
+
+	getter service.GetSegment
+}
+
+// NewSegmentQuery creates an instance of SegmentQuery.
+func NewSegmentQuery(getter service.GetSegment) *SegmentQuery {
+	return &SegmentQuery{getter: getter}
+}
+
+// GetSegmentByKey handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets a single segment in the project by its key.
+func (sq *SegmentQuery) GetSegmentByKey(ctx context.Context, request *togglev1.GetSegmentByKeyRequest) (*togglev1.GetSegmentByKeyResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidSegment("empty or nil")
+	}
+
+	segment, err := sq.getter.GetByKey(ctx, request.GetProject(), request.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.GetSegmentByKeyResponse{Segment: createProtoSegment(segment)}, nil
+}
+
+// GetAllSegments handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets all available segments in the project.
+func (sq *SegmentQuery) GetAllSegments(ctx context.Context, request *togglev1.GetAllSegmentsRequest) (*togglev1.GetAllSegmentsResponse, error) {
+	if request == nil {
+		return nil, entity.ErrInvalidSegment("empty or nil")
+	}
+
+	segments, err := sq.getter.GetAll(ctx, request.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	return createGetAllSegmentsResponse(segments), nil
+}
+
+func createGetAllSegmentsResponse(segments []*entity.Segment) *togglev1.GetAllSegmentsResponse {
+	resp := &togglev1.GetAllSegmentsResponse{}
+	for _, segment := range segments {
+		resp.Segments = append(resp.Segments, createProtoSegment(segment))
+	}
+	return resp
+}
+
+func createProtoSegment(segment *entity.Segment) *togglev1.Segment {
+	return &togglev1.Segment{
+		Key:         segment.Key,
+		Project:     segment.Project,
+		Description: segment.Description,
+		Included:    segment.Included,
+		Excluded:    segment.Excluded,
+		Rules:       entity.RulesToProto(segment.Rules),
+		CreatedAt:   timestamppb.New(segment.CreatedAt),
+		UpdatedAt:   timestamppb.New(segment.UpdatedAt),
+	}
+}
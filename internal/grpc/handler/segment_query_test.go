@@ -0,0 +1,133 @@
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/handler"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSegmentTime   = time.Now()
+	testSegmentResult = &entity.Segment{
+		Key:       testSegmentKey,
+		Project:   testSegmentProject,
+		Included:  []string{"user-1"},
+		Excluded:  []string{"user-2"},
+		Rules:     []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}}},
+		CreatedAt: testSegmentTime,
+		UpdatedAt: testSegmentTime,
+	}
+	testSegmentProto = &togglev1.Segment{
+		Key:       testSegmentKey,
+		Project:   testSegmentProject,
+		Included:  []string{"user-1"},
+		Excluded:  []string{"user-2"},
+		Rules:     []*togglev1.Rule{{Attribute: "country", Operator: togglev1.RuleOperator_RULE_OPERATOR_IN, Values: []string{"ID", "SG"}}},
+		CreatedAt: timestamppb.New(testSegmentTime),
+		UpdatedAt: timestamppb.New(testSegmentTime),
+	}
+)
+
+type SegmentQueryExecutor struct {
+	handler *handler.SegmentQuery
+	getter  *mock_service.MockGetSegment
+}
+
+func TestNewSegmentQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successful create an instance of SegmentQuery", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+		assert.NotNil(t, exec.handler)
+	})
+}
+
+func TestSegmentQuery_GetSegmentByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetSegmentByKey(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("segment is not found", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetByKey(testCtx, testSegmentProject, testSegmentKey).Return(nil, entity.ErrSegmentNotFound())
+
+		res, err := exec.handler.GetSegmentByKey(testCtx, &togglev1.GetSegmentByKeyRequest{Project: testSegmentProject, Key: testSegmentKey})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get a segment", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetByKey(testCtx, testSegmentProject, testSegmentKey).Return(testSegmentResult, nil)
+
+		res, err := exec.handler.GetSegmentByKey(testCtx, &togglev1.GetSegmentByKeyRequest{Project: testSegmentProject, Key: testSegmentKey})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &togglev1.GetSegmentByKeyResponse{Segment: testSegmentProto}, res)
+	})
+}
+
+func TestSegmentQuery_GetAllSegments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetAllSegments(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("getter service returns error", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(testCtx, testSegmentProject).Return([]*entity.Segment{}, entity.ErrInternal(""))
+
+		res, err := exec.handler.GetAllSegments(testCtx, &togglev1.GetAllSegmentsRequest{Project: testSegmentProject})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get all segments", func(t *testing.T) {
+		exec := createSegmentQueryExecutor(ctrl)
+		exec.getter.EXPECT().GetAll(testCtx, testSegmentProject).Return([]*entity.Segment{testSegmentResult}, nil)
+
+		res, err := exec.handler.GetAllSegments(testCtx, &togglev1.GetAllSegmentsRequest{Project: testSegmentProject})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &togglev1.GetAllSegmentsResponse{Segments: []*togglev1.Segment{testSegmentProto}}, res)
+	})
+}
+
+func createSegmentQueryExecutor(ctrl *gomock.Controller) *SegmentQueryExecutor {
+	g := mock_service.NewMockGetSegment(ctrl)
+	h := handler.NewSegmentQuery(g)
+	return &SegmentQueryExecutor{
+		handler: h,
+		getter:  g,
+	}
+}
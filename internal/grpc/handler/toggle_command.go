@@ -12,19 +12,37 @@ import (
 type ToggleCommand struct {
 	togglev1.UnimplementedToggleCommandServiceServer
 
-	creator  service.CreateToggle
-	enabler  service.EnableToggle
-	disabler service.DisableToggle
-	deleter  service.DeleteToggle
+	creator             service.CreateToggle
+	enabler             service.EnableToggle
+	disabler            service.DisableToggle
+	deleter             service.DeleteToggle
+	prerequisiteUpdater service.UpdateTogglePrerequisites
+	updater             service.UpdateToggle
+	restorer            service.RestoreToggle
+	reporter            service.ReportEvaluation
+	batchCreator        service.BatchCreateToggle
+	batchUpdater        service.BatchUpdateToggle
+	importer            service.ImportToggle
+	rolloutUpdater      service.UpdateToggleRollout
+	rulesUpdater        service.UpdateToggleRules
 }
 
 // NewToggleCommand creates an instance of ToggleCommand.
-func NewToggleCommand(creator service.CreateToggle, enabler service.EnableToggle, disabler service.DisableToggle, deleter service.DeleteToggle) *ToggleCommand {
+func NewToggleCommand(creator service.CreateToggle, enabler service.EnableToggle, disabler service.DisableToggle, deleter service.DeleteToggle, prerequisiteUpdater service.UpdateTogglePrerequisites, updater service.UpdateToggle, restorer service.RestoreToggle, reporter service.ReportEvaluation, batchCreator service.BatchCreateToggle, batchUpdater service.BatchUpdateToggle, importer service.ImportToggle, rolloutUpdater service.UpdateToggleRollout, rulesUpdater service.UpdateToggleRules) *ToggleCommand {
 	return &ToggleCommand{
-		creator:  creator,
-		enabler:  enabler,
-		disabler: disabler,
-		deleter:  deleter,
+		creator:             creator,
+		enabler:             enabler,
+		disabler:            disabler,
+		deleter:             deleter,
+		prerequisiteUpdater: prerequisiteUpdater,
+		updater:             updater,
+		restorer:            restorer,
+		reporter:            reporter,
+		batchCreator:        batchCreator,
+		batchUpdater:        batchUpdater,
+		importer:            importer,
+		rolloutUpdater:      rolloutUpdater,
+		rulesUpdater:        rulesUpdater,
 	}
 }
 
@@ -48,11 +66,11 @@ func (tc *ToggleCommand) EnableToggle(ctx context.Context, request *togglev1.Ena
 		return nil, entity.ErrEmptyToggle()
 	}
 
-	err := tc.enabler.Enable(ctx, request.GetKey())
+	version, err := tc.enabler.Enable(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetExpectedVersion())
 	if err != nil {
 		return nil, err
 	}
-	return &togglev1.EnableToggleResponse{}, nil
+	return &togglev1.EnableToggleResponse{Version: version}, nil
 }
 
 // DisableToggle handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
@@ -62,30 +80,218 @@ func (tc *ToggleCommand) DisableToggle(ctx context.Context, request *togglev1.Di
 		return nil, entity.ErrEmptyToggle()
 	}
 
-	err := tc.disabler.Disable(ctx, request.GetKey())
+	version, err := tc.disabler.Disable(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetExpectedVersion())
 	if err != nil {
 		return nil, err
 	}
-	return &togglev1.DisableToggleResponse{}, nil
+	return &togglev1.DisableToggleResponse{Version: version}, nil
+}
+
+// UpdateToggle handles HTTP/2 gRPC request similar to PATCH in HTTP/1.1.
+// It updates the toggle's fields listed in the update mask.
+func (tc *ToggleCommand) UpdateToggle(ctx context.Context, request *togglev1.UpdateToggleRequest) (*togglev1.UpdateToggleResponse, error) {
+	if request == nil || request.GetToggle() == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	toggle, err := tc.updater.Update(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), createToggleFromUpdateToggleRequest(request), request.GetUpdateMask().GetPaths(), request.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.UpdateToggleResponse{Toggle: createProtoToggle(toggle)}, nil
+}
+
+// UpdateTogglePrerequisites handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
+// It replaces the toggle's prerequisites.
+func (tc *ToggleCommand) UpdateTogglePrerequisites(ctx context.Context, request *togglev1.UpdateTogglePrerequisitesRequest) (*togglev1.UpdateTogglePrerequisitesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	err := tc.prerequisiteUpdater.UpdatePrerequisites(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), entity.PrerequisitesFromProto(request.GetPrerequisites()))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.UpdateTogglePrerequisitesResponse{}, nil
+}
+
+// UpdateToggleRollout handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
+// It replaces the toggle's rollout in the environment.
+func (tc *ToggleCommand) UpdateToggleRollout(ctx context.Context, request *togglev1.UpdateToggleRolloutRequest) (*togglev1.UpdateToggleRolloutResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	version, err := tc.rolloutUpdater.UpdateRollout(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), entity.RolloutFromProto(request.GetRollout()), request.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.UpdateToggleRolloutResponse{Version: version}, nil
+}
+
+// UpdateToggleRules handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
+// It replaces the toggle's rules and default value in the environment.
+func (tc *ToggleCommand) UpdateToggleRules(ctx context.Context, request *togglev1.UpdateToggleRulesRequest) (*togglev1.UpdateToggleRulesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	defaultValue := request.DefaultValue == nil || request.GetDefaultValue()
+	version, err := tc.rulesUpdater.UpdateRules(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), entity.RulesFromProto(request.GetRules()), defaultValue, request.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.UpdateToggleRulesResponse{Version: version}, nil
 }
 
 // DeleteToggle handles HTTP/2 gRPC request similar to DELETE in HTTP/1.1.
-// It delete the toggle.
+// It soft-deletes the toggle, or purges it if the request asks so.
 func (tc *ToggleCommand) DeleteToggle(ctx context.Context, request *togglev1.DeleteToggleRequest) (*togglev1.DeleteToggleResponse, error) {
 	if request == nil {
 		return nil, entity.ErrEmptyToggle()
 	}
 
-	err := tc.deleter.DeleteByKey(ctx, request.GetKey())
+	var err error
+	if request.GetPurge() {
+		err = tc.deleter.PurgeByKey(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetExpectedVersion())
+	} else {
+		err = tc.deleter.DeleteByKey(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetExpectedVersion())
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &togglev1.DeleteToggleResponse{}, nil
 }
 
+// RestoreToggle handles HTTP/2 gRPC request similar to PUT in HTTP/1.1.
+// It brings back a soft-deleted toggle.
+func (tc *ToggleCommand) RestoreToggle(ctx context.Context, request *togglev1.RestoreToggleRequest) (*togglev1.RestoreToggleResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	version, err := tc.restorer.Restore(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetExpectedVersion())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.RestoreToggleResponse{Version: version}, nil
+}
+
+// BatchCreateToggles handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+// It creates many toggles in the project's environment at once.
+func (tc *ToggleCommand) BatchCreateToggles(ctx context.Context, request *togglev1.BatchCreateTogglesRequest) (*togglev1.BatchCreateTogglesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	toggles := make([]*entity.Toggle, 0, len(request.GetToggles()))
+	for _, toggle := range request.GetToggles() {
+		toggles = append(toggles, createToggleFromProto(request.GetProject(), request.GetEnvironment(), toggle))
+	}
+	res, err := tc.batchCreator.CreateAll(ctx, request.GetProject(), request.GetEnvironment(), toggles, request.GetAllOrNothing())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.BatchCreateTogglesResponse{Results: entity.BatchResultsToProto(res)}, nil
+}
+
+// BatchUpdateToggles handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+// It enables, disables, or deletes many toggles in the project's environment at once.
+func (tc *ToggleCommand) BatchUpdateToggles(ctx context.Context, request *togglev1.BatchUpdateTogglesRequest) (*togglev1.BatchUpdateTogglesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	res, err := tc.batchUpdater.UpdateAll(ctx, request.GetProject(), request.GetEnvironment(), entity.ToggleOperationsFromProto(request.GetOperations()), request.GetAllOrNothing())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.BatchUpdateTogglesResponse{Results: entity.BatchResultsToProto(res)}, nil
+}
+
+// ImportToggles handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+// It makes the toggles in the project's environment match the snapshot, or only shows the changes if it is a dry run.
+func (tc *ToggleCommand) ImportToggles(ctx context.Context, request *togglev1.ImportTogglesRequest) (*togglev1.ImportTogglesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	options := &entity.ImportOptions{
+		ConflictPolicy: entity.ImportConflictPolicyFromProto(request.GetConflictPolicy()),
+		Prune:          request.GetPrune(),
+		DryRun:         request.GetDryRun(),
+	}
+	changes, err := tc.importer.Import(ctx, request.GetProject(), request.GetEnvironment(), createSnapshotFromProto(request.GetProject(), request.GetEnvironment(), request.GetSnapshot()), options)
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.ImportTogglesResponse{Changes: entity.ImportChangesToProto(changes)}, nil
+}
+
+// ReportEvaluations handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+// It records how many times the toggles in the project's environment have been evaluated.
+func (tc *ToggleCommand) ReportEvaluations(ctx context.Context, request *togglev1.ReportEvaluationsRequest) (*togglev1.ReportEvaluationsResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	rejected, err := tc.reporter.Report(ctx, request.GetProject(), request.GetEnvironment(), entity.EvaluationCountsFromProto(request.GetCounts()))
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.ReportEvaluationsResponse{RejectedCount: int64(rejected)}, nil
+}
+
 func createToggleFromCreateToggleRequest(request *togglev1.CreateToggleRequest) *entity.Toggle {
+	return createToggleFromProto(request.GetProject(), request.GetEnvironment(), request.GetToggle())
+}
+
+func createToggleFromProto(project, env string, toggle *togglev1.Toggle) *entity.Toggle {
+	if toggle == nil {
+		return nil
+	}
+	return &entity.Toggle{
+		Key:            toggle.GetKey(),
+		Description:    toggle.GetDescription(),
+		Rules:          entity.RulesFromProto(toggle.GetRules()),
+		DefaultValue:   toggle.DefaultValue == nil || toggle.GetDefaultValue(),
+		Rollout:        entity.RolloutFromProto(toggle.GetRollout()),
+		Variants:       entity.VariantsFromProto(toggle.GetVariants()),
+		DefaultVariant: toggle.GetDefaultVariant(),
+		OffVariant:     toggle.GetOffVariant(),
+		Prerequisites:  entity.PrerequisitesFromProto(toggle.GetPrerequisites()),
+		Owner:          toggle.GetOwner(),
+		ExpiresAt:      entity.TimeFromProto(toggle.GetExpiresAt()),
+		Tags:           toggle.GetTags(),
+		Project:        project,
+		Environment:    env,
+	}
+}
+
+func createSnapshotFromProto(project, env string, snapshot *togglev1.ToggleSnapshot) *entity.Snapshot {
+	if snapshot == nil {
+		return nil
+	}
+	res := &entity.Snapshot{
+		FormatVersion: snapshot.GetFormatVersion(),
+		Project:       snapshot.GetProject(),
+		Environment:   snapshot.GetEnvironment(),
+	}
+	for _, toggle := range snapshot.GetToggles() {
+		tmp := createToggleFromProto(project, env, toggle)
+		if tmp != nil {
+			tmp.IsEnabled = toggle.GetIsEnabled()
+		}
+		res.Toggles = append(res.Toggles, tmp)
+	}
+	return res
+}
+
+func createToggleFromUpdateToggleRequest(request *togglev1.UpdateToggleRequest) *entity.Toggle {
 	return &entity.Toggle{
-		Key:         request.GetToggle().GetKey(),
 		Description: request.GetToggle().GetDescription(),
+		Owner:       request.GetToggle().GetOwner(),
+		ExpiresAt:   entity.TimeFromProto(request.GetToggle().GetExpiresAt()),
+		Tags:        request.GetToggle().GetTags(),
 	}
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/indrasaputra/toggle/entity"
@@ -20,42 +22,102 @@ import (
 var (
 	testCtx               = context.Background()
 	testToggleKey         = "test_key"
+	testToggleProject     = "default"
+	testToggleEnv         = "production"
 	testToggleIsEnabled   = false
 	testToggleDescription = "description"
 	testToggleCreatedAt   = time.Now()
 	testToggleUpdatedAt   = time.Now()
-	testToggle            = &entity.Toggle{
-		Key:         testToggleKey,
-		IsEnabled:   testToggleIsEnabled,
-		Description: testToggleDescription,
+	testToggleRule        = &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}, Value: true}
+	testToggleRollout     = &entity.Rollout{Percentage: 10, BucketBy: "user_id"}
+	testToggleVariants    = []*entity.Variant{
+		{Name: "on", Type: entity.VariantTypeString, Value: "on"},
+		{Name: "off", Type: entity.VariantTypeString, Value: "off"},
+	}
+	testTogglePrerequisites = []*entity.Prerequisite{{Key: "parent", Value: true}}
+	testToggleOwner         = "team-checkout"
+	testToggleExpiresAt     = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	testToggleTags          = []string{"team:payments", "kind:kill-switch"}
+	testToggle              = &entity.Toggle{
+		Key:            testToggleKey,
+		IsEnabled:      testToggleIsEnabled,
+		Description:    testToggleDescription,
+		Rules:          []*entity.Rule{testToggleRule},
+		DefaultValue:   true,
+		Rollout:        testToggleRollout,
+		Variants:       testToggleVariants,
+		DefaultVariant: "on",
+		OffVariant:     "off",
+		Environment:    testToggleEnv,
+		Project:        testToggleProject,
+		Prerequisites:  testTogglePrerequisites,
+		Owner:          testToggleOwner,
+		ExpiresAt:      &testToggleExpiresAt,
+		Tags:           testToggleTags,
 	}
 	testToggleResult = &entity.Toggle{
-		Key:         testToggleKey,
-		IsEnabled:   testToggleIsEnabled,
-		Description: testToggleDescription,
-		CreatedAt:   testToggleCreatedAt,
-		UpdatedAt:   testToggleUpdatedAt,
+		Key:            testToggleKey,
+		IsEnabled:      testToggleIsEnabled,
+		Description:    testToggleDescription,
+		CreatedAt:      testToggleCreatedAt,
+		UpdatedAt:      testToggleUpdatedAt,
+		Rules:          []*entity.Rule{testToggleRule},
+		DefaultValue:   true,
+		Rollout:        testToggleRollout,
+		Variants:       testToggleVariants,
+		DefaultVariant: "on",
+		OffVariant:     "off",
+		Environment:    testToggleEnv,
+		Project:        testToggleProject,
+		Prerequisites:  testTogglePrerequisites,
+		Owner:          testToggleOwner,
+		ExpiresAt:      &testToggleExpiresAt,
+		Tags:           testToggleTags,
 	}
 	testToggleProto = &togglev1.Toggle{
-		Key:         testToggleKey,
-		IsEnabled:   testToggleIsEnabled,
-		Description: testToggleDescription,
-		CreatedAt:   timestamppb.New(testToggleCreatedAt),
-		UpdatedAt:   timestamppb.New(testToggleUpdatedAt),
+		Key:            testToggleKey,
+		IsEnabled:      testToggleIsEnabled,
+		Description:    testToggleDescription,
+		CreatedAt:      timestamppb.New(testToggleCreatedAt),
+		UpdatedAt:      timestamppb.New(testToggleUpdatedAt),
+		Rules:          []*togglev1.Rule{entity.RuleToProto(testToggleRule)},
+		DefaultValue:   proto.Bool(true),
+		Rollout:        entity.RolloutToProto(testToggleRollout),
+		Variants:       entity.VariantsToProto(testToggleVariants),
+		DefaultVariant: "on",
+		OffVariant:     "off",
+		Environment:    testToggleEnv,
+		Project:        testToggleProject,
+		Prerequisites:  entity.PrerequisitesToProto(testTogglePrerequisites),
+		Owner:          testToggleOwner,
+		ExpiresAt:      timestamppb.New(testToggleExpiresAt),
+		Tags:           testToggleTags,
 	}
-	testCreateToggleRequest  = &togglev1.CreateToggleRequest{Toggle: testToggleProto}
-	testEnableToggleRequest  = &togglev1.EnableToggleRequest{Key: testToggleKey}
-	testDisableToggleRequest = &togglev1.DisableToggleRequest{Key: testToggleKey}
-	testDeleteToggleRequest  = &togglev1.DeleteToggleRequest{Key: testToggleKey}
+	testCreateToggleRequest  = &togglev1.CreateToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Toggle: testToggleProto}
+	testEnableToggleRequest  = &togglev1.EnableToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey}
+	testDisableToggleRequest = &togglev1.DisableToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey}
+	testDeleteToggleRequest  = &togglev1.DeleteToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey}
+	testPurgeToggleRequest   = &togglev1.DeleteToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey, Purge: true}
+	testRestoreToggleRequest = &togglev1.RestoreToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey}
+	testUpdatePrerequisites  = &togglev1.UpdateTogglePrerequisitesRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey, Prerequisites: entity.PrerequisitesToProto(testTogglePrerequisites)}
 )
 
 type ToggleCommandExecutor struct {
 	handler *handler.ToggleCommand
 
-	creator  *mock_service.MockCreateToggle
-	enabler  *mock_service.MockEnableToggle
-	disabler *mock_service.MockDisableToggle
-	deleter  *mock_service.MockDeleteToggle
+	creator             *mock_service.MockCreateToggle
+	enabler             *mock_service.MockEnableToggle
+	disabler            *mock_service.MockDisableToggle
+	deleter             *mock_service.MockDeleteToggle
+	prerequisiteUpdater *mock_service.MockUpdateTogglePrerequisites
+	updater             *mock_service.MockUpdateToggle
+	restorer            *mock_service.MockRestoreToggle
+	reporter            *mock_service.MockReportEvaluation
+	batchCreator        *mock_service.MockBatchCreateToggle
+	batchUpdater        *mock_service.MockBatchUpdateToggle
+	importer            *mock_service.MockImportToggle
+	rolloutUpdater      *mock_service.MockUpdateToggleRollout
+	rulesUpdater        *mock_service.MockUpdateToggleRules
 }
 
 func TestNewToggleCommand(t *testing.T) {
@@ -118,6 +180,16 @@ func TestToggleCommand_CreateToggle(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("default value is true if it is not set", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.creator.EXPECT().Create(testCtx, &entity.Toggle{Key: testToggleKey, DefaultValue: true, Project: testToggleProject, Environment: testToggleEnv}).Return(nil)
+
+		res, err := exec.handler.CreateToggle(testCtx, &togglev1.CreateToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Toggle: &togglev1.Toggle{Key: testToggleKey}})
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+
 	t.Run("success create a toggle", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
 		exec.creator.EXPECT().Create(testCtx, testToggle).Return(nil)
@@ -145,7 +217,7 @@ func TestToggleCommand_EnableToggle(t *testing.T) {
 
 	t.Run("toggle not found", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.enabler.EXPECT().Enable(testCtx, testToggleKey).Return(entity.ErrNotFound())
+		exec.enabler.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(0), entity.ErrNotFound())
 
 		res, err := exec.handler.EnableToggle(testCtx, testEnableToggleRequest)
 
@@ -156,7 +228,7 @@ func TestToggleCommand_EnableToggle(t *testing.T) {
 
 	t.Run("updater service returns internal error", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.enabler.EXPECT().Enable(testCtx, testToggleKey).Return(entity.ErrInternal(""))
+		exec.enabler.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(0), entity.ErrInternal(""))
 
 		res, err := exec.handler.EnableToggle(testCtx, testEnableToggleRequest)
 
@@ -167,12 +239,12 @@ func TestToggleCommand_EnableToggle(t *testing.T) {
 
 	t.Run("success enable toggle", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.enabler.EXPECT().Enable(testCtx, testToggleKey).Return(nil)
+		exec.enabler.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(2), nil)
 
 		res, err := exec.handler.EnableToggle(testCtx, testEnableToggleRequest)
 
 		assert.Nil(t, err)
-		assert.NotNil(t, res)
+		assert.Equal(t, int64(2), res.GetVersion())
 	})
 }
 
@@ -192,7 +264,7 @@ func TestToggleCommand_DisableToggle(t *testing.T) {
 
 	t.Run("toggle not found", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.disabler.EXPECT().Disable(testCtx, testToggleKey).Return(entity.ErrNotFound())
+		exec.disabler.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(0), entity.ErrNotFound())
 
 		res, err := exec.handler.DisableToggle(testCtx, testDisableToggleRequest)
 
@@ -203,7 +275,7 @@ func TestToggleCommand_DisableToggle(t *testing.T) {
 
 	t.Run("updater service returns internal error", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.disabler.EXPECT().Disable(testCtx, testToggleKey).Return(entity.ErrInternal(""))
+		exec.disabler.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(0), entity.ErrInternal(""))
 
 		res, err := exec.handler.DisableToggle(testCtx, testDisableToggleRequest)
 
@@ -214,12 +286,12 @@ func TestToggleCommand_DisableToggle(t *testing.T) {
 
 	t.Run("success disable toggle", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.disabler.EXPECT().Disable(testCtx, testToggleKey).Return(nil)
+		exec.disabler.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(2), nil)
 
 		res, err := exec.handler.DisableToggle(testCtx, testDisableToggleRequest)
 
 		assert.Nil(t, err)
-		assert.NotNil(t, res)
+		assert.Equal(t, int64(2), res.GetVersion())
 	})
 }
 
@@ -239,7 +311,7 @@ func TestToggleCommand_DeleteToggle(t *testing.T) {
 
 	t.Run("deleter service returns internal error", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.deleter.EXPECT().DeleteByKey(testCtx, testToggleKey).Return(entity.ErrInternal(""))
+		exec.deleter.EXPECT().DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(entity.ErrInternal(""))
 
 		res, err := exec.handler.DeleteToggle(testCtx, testDeleteToggleRequest)
 
@@ -250,13 +322,469 @@ func TestToggleCommand_DeleteToggle(t *testing.T) {
 
 	t.Run("success delete toggle", func(t *testing.T) {
 		exec := createToggleCommandExecutor(ctrl)
-		exec.deleter.EXPECT().DeleteByKey(testCtx, testToggleKey).Return(nil)
+		exec.deleter.EXPECT().DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(nil)
 
 		res, err := exec.handler.DeleteToggle(testCtx, testDeleteToggleRequest)
 
 		assert.Nil(t, err)
 		assert.NotNil(t, res)
 	})
+
+	t.Run("deleter service returns error for purge", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.deleter.EXPECT().PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(entity.ErrNotFound())
+
+		res, err := exec.handler.DeleteToggle(testCtx, testPurgeToggleRequest)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success purge toggle", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.deleter.EXPECT().PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(nil)
+
+		res, err := exec.handler.DeleteToggle(testCtx, testPurgeToggleRequest)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestToggleCommand_RestoreToggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.RestoreToggle(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("restorer service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.restorer.EXPECT().Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(0), entity.ErrNotFound())
+
+		res, err := exec.handler.RestoreToggle(testCtx, testRestoreToggleRequest)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success restore toggle", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.restorer.EXPECT().Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(5), nil)
+
+		res, err := exec.handler.RestoreToggle(testCtx, testRestoreToggleRequest)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), res.GetVersion())
+	})
+}
+
+func TestToggleCommand_ReportEvaluations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	window := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC)
+	counts := []*entity.EvaluationCount{{Key: testToggleKey, Variant: "true", WindowStart: window, Count: 3, LastEvaluatedAt: window}}
+	request := &togglev1.ReportEvaluationsRequest{Project: testToggleProject, Environment: testToggleEnv, Counts: entity.EvaluationCountsToProto(counts)}
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.ReportEvaluations(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("reporter service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.reporter.EXPECT().Report(testCtx, testToggleProject, testToggleEnv, counts).Return(0, entity.ErrInvalidEvaluationReport(""))
+
+		res, err := exec.handler.ReportEvaluations(testCtx, request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidEvaluationReport(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success report evaluations", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.reporter.EXPECT().Report(testCtx, testToggleProject, testToggleEnv, counts).Return(1, nil)
+
+		res, err := exec.handler.ReportEvaluations(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), res.GetRejectedCount())
+	})
+}
+
+func TestToggleCommand_UpdateToggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &togglev1.UpdateToggleRequest{
+		Project:     testToggleProject,
+		Environment: testToggleEnv,
+		Key:         testToggleKey,
+		Toggle: &togglev1.Toggle{
+			Description: testToggleDescription,
+			Owner:       testToggleOwner,
+			ExpiresAt:   timestamppb.New(testToggleExpiresAt),
+			Tags:        testToggleTags,
+		},
+		UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"description", "tags"}},
+		ExpectedVersion: 3,
+	}
+	update := &entity.Toggle{Description: testToggleDescription, Owner: testToggleOwner, ExpiresAt: &testToggleExpiresAt, Tags: testToggleTags}
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateToggle(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("nil toggle is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateToggle(testCtx, &togglev1.UpdateToggleRequest{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.updater.EXPECT().Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, update, []string{"description", "tags"}, int64(3)).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.handler.UpdateToggle(testCtx, request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update toggle", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.updater.EXPECT().Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, update, []string{"description", "tags"}, int64(3)).Return(testToggle, nil)
+
+		res, err := exec.handler.UpdateToggle(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testToggleKey, res.GetToggle().GetKey())
+		assert.Equal(t, testToggleDescription, res.GetToggle().GetDescription())
+	})
+}
+
+func TestToggleCommand_UpdateTogglePrerequisites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateTogglePrerequisites(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.prerequisiteUpdater.EXPECT().UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, testTogglePrerequisites).Return(entity.ErrPrerequisiteCycle([]string{testToggleKey, "parent", testToggleKey}))
+
+		res, err := exec.handler.UpdateTogglePrerequisites(testCtx, testUpdatePrerequisites)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update toggle's prerequisites", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.prerequisiteUpdater.EXPECT().UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, testTogglePrerequisites).Return(nil)
+
+		res, err := exec.handler.UpdateTogglePrerequisites(testCtx, testUpdatePrerequisites)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+	})
+}
+
+func TestToggleCommand_UpdateToggleRollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &togglev1.UpdateToggleRolloutRequest{
+		Key:             testToggleKey,
+		Environment:     testToggleEnv,
+		Project:         testToggleProject,
+		Rollout:         &togglev1.Rollout{Percentage: 30, BucketBy: "user_id"},
+		ExpectedVersion: 2,
+	}
+	rollout := &entity.Rollout{Percentage: 30, BucketBy: "user_id"}
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateToggleRollout(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.rolloutUpdater.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(2)).Return(int64(0), entity.ErrVersionConflict())
+
+		res, err := exec.handler.UpdateToggleRollout(testCtx, request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update toggle's rollout", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.rolloutUpdater.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(2)).Return(int64(3), nil)
+
+		res, err := exec.handler.UpdateToggleRollout(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), res.GetVersion())
+	})
+}
+
+func TestToggleCommand_UpdateToggleRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &togglev1.UpdateToggleRulesRequest{
+		Key:             testToggleKey,
+		Environment:     testToggleEnv,
+		Project:         testToggleProject,
+		Rules:           []*togglev1.Rule{{Attribute: "country", Operator: togglev1.RuleOperator_RULE_OPERATOR_IN, Values: []string{"ID", "SG"}, Value: true}},
+		DefaultValue:    proto.Bool(false),
+		ExpectedVersion: 2,
+	}
+	rules := entity.RulesFromProto(request.GetRules())
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.UpdateToggleRules(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("updater service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.rulesUpdater.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, int64(2)).Return(int64(0), entity.ErrVersionConflict())
+
+		res, err := exec.handler.UpdateToggleRules(testCtx, request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("unset default value defaults to true", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.rulesUpdater.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, true, int64(0)).Return(int64(3), nil)
+
+		res, err := exec.handler.UpdateToggleRules(testCtx, &togglev1.UpdateToggleRulesRequest{Key: testToggleKey, Environment: testToggleEnv, Project: testToggleProject})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), res.GetVersion())
+	})
+
+	t.Run("success update toggle's rules", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.rulesUpdater.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, int64(2)).Return(int64(3), nil)
+
+		res, err := exec.handler.UpdateToggleRules(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), res.GetVersion())
+	})
+}
+
+func TestToggleCommand_BatchCreateToggles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &togglev1.BatchCreateTogglesRequest{
+		Project:      testToggleProject,
+		Environment:  testToggleEnv,
+		Toggles:      []*togglev1.Toggle{testToggleProto, nil},
+		AllOrNothing: true,
+	}
+	toggles := []*entity.Toggle{testToggle, nil}
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.BatchCreateToggles(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("batch creator service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.batchCreator.EXPECT().CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true).Return(nil, entity.ErrBatchItemFailed(1, entity.ErrEmptyToggle()))
+
+		res, err := exec.handler.BatchCreateToggles(testCtx, request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success create toggles", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		results := []*entity.BatchResult{{Key: testToggleKey, Version: 1}, {Err: entity.ErrEmptyToggle()}}
+		exec.batchCreator.EXPECT().CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true).Return(results, nil)
+
+		res, err := exec.handler.BatchCreateToggles(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetResults()))
+		assert.Equal(t, int64(1), res.GetResults()[0].GetVersion())
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED, res.GetResults()[0].GetErrorCode())
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_EMPTY_TOGGLE, res.GetResults()[1].GetErrorCode())
+	})
+}
+
+func TestToggleCommand_BatchUpdateToggles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &togglev1.BatchUpdateTogglesRequest{
+		Project:     testToggleProject,
+		Environment: testToggleEnv,
+		Operations: []*togglev1.ToggleOperation{
+			{Key: testToggleKey, Type: togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_DISABLE, ExpectedVersion: 2},
+			{Key: testToggleKey, Type: togglev1.ToggleOperationType_TOGGLE_OPERATION_TYPE_DELETE, ExpectedVersion: 3},
+		},
+	}
+	ops := []*entity.ToggleOperation{
+		{Key: testToggleKey, Type: entity.ToggleOperationDisable, Version: 2},
+		{Key: testToggleKey, Type: entity.ToggleOperationDelete, Version: 3},
+	}
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.BatchUpdateToggles(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("batch updater service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.batchUpdater.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.handler.BatchUpdateToggles(testCtx, request)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success update toggles", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		results := []*entity.BatchResult{{Key: testToggleKey, Version: 3}, {Key: testToggleKey, Err: entity.ErrProhibitedToDelete()}}
+		exec.batchUpdater.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false).Return(results, nil)
+
+		res, err := exec.handler.BatchUpdateToggles(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetResults()))
+		assert.Equal(t, int64(3), res.GetResults()[0].GetVersion())
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_PROHIBITED_TO_DELETE, res.GetResults()[1].GetErrorCode())
+		assert.NotEmpty(t, res.GetResults()[1].GetErrorMessage())
+	})
+}
+
+func TestToggleCommand_ImportToggles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	request := &togglev1.ImportTogglesRequest{
+		Project:        testToggleProject,
+		Environment:    testToggleEnv,
+		Snapshot:       &togglev1.ToggleSnapshot{FormatVersion: 1, Project: "other", Toggles: []*togglev1.Toggle{{Key: testToggleKey, IsEnabled: true}, nil}},
+		ConflictPolicy: togglev1.ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE,
+		Prune:          true,
+		DryRun:         true,
+	}
+	snapshot := &entity.Snapshot{
+		FormatVersion: 1,
+		Project:       "other",
+		Toggles:       []*entity.Toggle{{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Project: testToggleProject, Environment: testToggleEnv}, nil},
+	}
+	options := &entity.ImportOptions{ConflictPolicy: entity.ImportConflictOverwrite, Prune: true, DryRun: true}
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+
+		res, err := exec.handler.ImportToggles(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("importer service returns error", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		exec.importer.EXPECT().Import(testCtx, testToggleProject, testToggleEnv, nil, &entity.ImportOptions{ConflictPolicy: entity.ImportConflictFail}).
+			Return(nil, entity.ErrInvalidSnapshot("snapshot must not be empty"))
+
+		res, err := exec.handler.ImportToggles(testCtx, &togglev1.ImportTogglesRequest{Project: testToggleProject, Environment: testToggleEnv})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success import toggles", func(t *testing.T) {
+		exec := createToggleCommandExecutor(ctrl)
+		changes := []*entity.ImportChange{
+			{Key: "toggle-0", Action: entity.ImportActionDelete},
+			{Key: testToggleKey, Action: entity.ImportActionUpdate, Fields: []string{"is_enabled"}},
+		}
+		exec.importer.EXPECT().Import(testCtx, testToggleProject, testToggleEnv, snapshot, options).Return(changes, nil)
+
+		res, err := exec.handler.ImportToggles(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetChanges()))
+		assert.Equal(t, togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_DELETE, res.GetChanges()[0].GetAction())
+		assert.Equal(t, togglev1.ToggleImportAction_TOGGLE_IMPORT_ACTION_UPDATE, res.GetChanges()[1].GetAction())
+		assert.Equal(t, []string{"is_enabled"}, res.GetChanges()[1].GetFields())
+	})
 }
 
 func createToggleCommandExecutor(ctrl *gomock.Controller) *ToggleCommandExecutor {
@@ -264,13 +792,31 @@ func createToggleCommandExecutor(ctrl *gomock.Controller) *ToggleCommandExecutor
 	e := mock_service.NewMockEnableToggle(ctrl)
 	s := mock_service.NewMockDisableToggle(ctrl)
 	d := mock_service.NewMockDeleteToggle(ctrl)
-
-	h := handler.NewToggleCommand(c, e, s, d)
+	p := mock_service.NewMockUpdateTogglePrerequisites(ctrl)
+	u := mock_service.NewMockUpdateToggle(ctrl)
+	r := mock_service.NewMockRestoreToggle(ctrl)
+	o := mock_service.NewMockReportEvaluation(ctrl)
+	bc := mock_service.NewMockBatchCreateToggle(ctrl)
+	bu := mock_service.NewMockBatchUpdateToggle(ctrl)
+	im := mock_service.NewMockImportToggle(ctrl)
+	ro := mock_service.NewMockUpdateToggleRollout(ctrl)
+	ru := mock_service.NewMockUpdateToggleRules(ctrl)
+
+	h := handler.NewToggleCommand(c, e, s, d, p, u, r, o, bc, bu, im, ro, ru)
 	return &ToggleCommandExecutor{
-		handler:  h,
-		creator:  c,
-		enabler:  e,
-		disabler: s,
-		deleter:  d,
+		handler:             h,
+		creator:             c,
+		enabler:             e,
+		disabler:            s,
+		deleter:             d,
+		prerequisiteUpdater: p,
+		updater:             u,
+		restorer:            r,
+		reporter:            o,
+		batchCreator:        bc,
+		batchUpdater:        bu,
+		importer:            im,
+		rolloutUpdater:      ro,
+		rulesUpdater:        ru,
 	}
 }
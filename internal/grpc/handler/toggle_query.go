@@ -2,7 +2,9 @@ package handler
 
 import (
 	"context"
+	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/indrasaputra/toggle/entity"
@@ -10,16 +12,34 @@ import (
 	"github.com/indrasaputra/toggle/service"
 )
 
+const (
+	defaultStaleAfterDays = 30
+)
+
 // ToggleQuery handles HTTP/2 gRPC request for retrieve toggle .
 type ToggleQuery struct {
 	togglev1.UnimplementedToggleQueryServiceServer
 
-	getter service.GetToggle
+	getter    service.GetToggle
+	evaluator service.EvaluateToggle
+	finder    service.FindStaleToggle
+	history   service.GetToggleHistory
+	watcher   service.WatchToggle
+	usage     service.GetToggleUsage
+	exporter  service.ExportToggle
 }
 
 // NewToggleQuery creates an instance of ToggleQuery.
-func NewToggleQuery(getter service.GetToggle) *ToggleQuery {
-	return &ToggleQuery{getter: getter}
+func NewToggleQuery(getter service.GetToggle, evaluator service.EvaluateToggle, finder service.FindStaleToggle, history service.GetToggleHistory, watcher service.WatchToggle, usage service.GetToggleUsage, exporter service.ExportToggle) *ToggleQuery {
+	return &ToggleQuery{
+		getter:    getter,
+		evaluator: evaluator,
+		finder:    finder,
+		history:   history,
+		watcher:   watcher,
+		usage:     usage,
+		exporter:  exporter,
+	}
 }
 
 // GetToggleByKey handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
@@ -29,7 +49,7 @@ func (tq *ToggleQuery) GetToggleByKey(ctx context.Context, request *togglev1.Get
 		return nil, entity.ErrEmptyToggle()
 	}
 
-	toggle, err := tq.getter.GetByKey(ctx, request.GetKey())
+	toggle, err := tq.getter.GetByKey(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey())
 	if err != nil {
 		return nil, err
 	}
@@ -37,17 +57,154 @@ func (tq *ToggleQuery) GetToggleByKey(ctx context.Context, request *togglev1.Get
 }
 
 // GetAllToggles handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
-// It gets all available toggles in system.
+// It gets a page of toggles in the project's environment which satisfy the filter.
 func (tq *ToggleQuery) GetAllToggles(ctx context.Context, request *togglev1.GetAllTogglesRequest) (*togglev1.GetAllTogglesResponse, error) {
 	if request == nil {
 		return nil, entity.ErrEmptyToggle()
 	}
 
-	toggles, err := tq.getter.GetAll(ctx)
+	page, err := tq.getter.GetAll(ctx, request.GetProject(), request.GetEnvironment(), createToggleFilter(request), createPageRequest(request))
+	if err != nil {
+		return nil, err
+	}
+	return createGetAllTogglesResponse(page), nil
+}
+
+// EvaluateToggle handles HTTP/2 gRPC request similar to POST in HTTP/1.1.
+// It evaluates a single toggle against the evaluation context.
+func (tq *ToggleQuery) EvaluateToggle(ctx context.Context, request *togglev1.EvaluateToggleRequest) (*togglev1.EvaluateToggleResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	eval, err := tq.evaluator.Evaluate(ctx, request.GetProject(), request.GetEnvironment(), request.GetKey(), request.GetContext())
+	if err != nil {
+		return nil, err
+	}
+	return createEvaluateToggleResponse(eval), nil
+}
+
+// GetStaleToggles handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets all toggles in the project's environment which should be cleaned up.
+func (tq *ToggleQuery) GetStaleToggles(ctx context.Context, request *togglev1.GetStaleTogglesRequest) (*togglev1.GetStaleTogglesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	days := request.GetStaleAfterDays()
+	if days == 0 {
+		days = defaultStaleAfterDays
+	}
+	stales, err := tq.finder.GetStale(ctx, request.GetProject(), request.GetEnvironment(), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	return createGetStaleTogglesResponse(stales), nil
+}
+
+// ListToggleHistory handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It lists a page of the project's audit log which satisfies the filter, newest first.
+func (tq *ToggleQuery) ListToggleHistory(ctx context.Context, request *togglev1.ListToggleHistoryRequest) (*togglev1.ListToggleHistoryResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	page := &entity.PageRequest{
+		Size:  request.GetPageSize(),
+		Token: request.GetPageToken(),
+	}
+	history, err := tq.history.GetHistory(ctx, request.GetProject(), createAuditFilter(request), page)
 	if err != nil {
 		return nil, err
 	}
-	return createGetAllTogglesResponse(toggles), nil
+	return createListToggleHistoryResponse(history), nil
+}
+
+// GetToggleUsage handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It gets how many times the toggles in the project's environment have been evaluated.
+func (tq *ToggleQuery) GetToggleUsage(ctx context.Context, request *togglev1.GetToggleUsageRequest) (*togglev1.GetToggleUsageResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	var since time.Time
+	if request.GetStartTime() != nil {
+		since = request.GetStartTime().AsTime()
+	}
+	usages, err := tq.usage.GetUsage(ctx, request.GetProject(), request.GetEnvironment(), request.GetKeys(), since)
+	if err != nil {
+		return nil, err
+	}
+	return createGetToggleUsageResponse(usages), nil
+}
+
+// ExportToggles handles HTTP/2 gRPC request similar to GET in HTTP/1.1.
+// It takes a snapshot of all toggles in the project's environment.
+func (tq *ToggleQuery) ExportToggles(ctx context.Context, request *togglev1.ExportTogglesRequest) (*togglev1.ExportTogglesResponse, error) {
+	if request == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+
+	snapshot, err := tq.exporter.Export(ctx, request.GetProject(), request.GetEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	return &togglev1.ExportTogglesResponse{Snapshot: createProtoSnapshot(snapshot)}, nil
+}
+
+// WatchToggles handles HTTP/2 gRPC server-streaming request.
+// It streams the snapshot of the watched toggles in the project's environment and then every event of the watched toggles.
+func (tq *ToggleQuery) WatchToggles(request *togglev1.WatchTogglesRequest, stream togglev1.ToggleQueryService_WatchTogglesServer) error {
+	if request == nil {
+		return entity.ErrEmptyToggle()
+	}
+
+	filter := &entity.WatchFilter{
+		Keys:        request.GetKeys(),
+		KeyPrefixes: request.GetKeyPrefixes(),
+	}
+	return tq.watcher.Watch(stream.Context(), request.GetProject(), request.GetEnvironment(), filter, request.GetResumeToken(), func(update *entity.WatchUpdate) error {
+		return stream.Send(createWatchTogglesResponse(update))
+	})
+}
+
+func createAuditFilter(request *togglev1.ListToggleHistoryRequest) *entity.AuditFilter {
+	filter := &entity.AuditFilter{
+		Key:   request.GetKey(),
+		Actor: request.GetActor(),
+	}
+	if request.GetStartTime() != nil {
+		filter.From = request.GetStartTime().AsTime()
+	}
+	if request.GetEndTime() != nil {
+		filter.To = request.GetEndTime().AsTime()
+	}
+	if *filter == (entity.AuditFilter{}) {
+		return nil
+	}
+	return filter
+}
+
+func createToggleFilter(request *togglev1.GetAllTogglesRequest) *entity.ToggleFilter {
+	filter := &entity.ToggleFilter{
+		AnyTags:        request.GetAnyTags(),
+		AllTags:        request.GetAllTags(),
+		KeyPrefix:      request.GetKeyPrefix(),
+		Search:         request.GetSearch(),
+		IncludeDeleted: request.GetIncludeDeleted(),
+	}
+	if filter.IsEmpty() {
+		return nil
+	}
+	return filter
+}
+
+func createPageRequest(request *togglev1.GetAllTogglesRequest) *entity.PageRequest {
+	return &entity.PageRequest{
+		Size:    request.GetPageSize(),
+		Token:   request.GetPageToken(),
+		OrderBy: request.GetOrderBy(),
+	}
 }
 
 func createGetToggleByKeyResponse(toggle *entity.Toggle) *togglev1.GetToggleByKeyResponse {
@@ -56,20 +213,122 @@ func createGetToggleByKeyResponse(toggle *entity.Toggle) *togglev1.GetToggleByKe
 	}
 }
 
-func createGetAllTogglesResponse(toggles []*entity.Toggle) *togglev1.GetAllTogglesResponse {
-	resp := &togglev1.GetAllTogglesResponse{}
-	for _, toggle := range toggles {
+func createGetAllTogglesResponse(page *entity.TogglePage) *togglev1.GetAllTogglesResponse {
+	resp := &togglev1.GetAllTogglesResponse{
+		NextPageToken: page.NextPageToken,
+		TotalSize:     int32(page.TotalSize),
+	}
+	for _, toggle := range page.Toggles {
 		resp.Toggles = append(resp.Toggles, createProtoToggle(toggle))
 	}
 	return resp
 }
 
+func createGetStaleTogglesResponse(stales []*entity.StaleToggle) *togglev1.GetStaleTogglesResponse {
+	resp := &togglev1.GetStaleTogglesResponse{}
+	for _, stale := range stales {
+		resp.StaleToggles = append(resp.StaleToggles, &togglev1.StaleToggle{
+			Toggle:  createProtoToggle(stale.Toggle),
+			Reasons: entity.StaleReasonsToProto(stale.Reasons),
+		})
+	}
+	return resp
+}
+
+func createGetToggleUsageResponse(usages []*entity.ToggleUsage) *togglev1.GetToggleUsageResponse {
+	resp := &togglev1.GetToggleUsageResponse{}
+	for _, usage := range usages {
+		resp.Usages = append(resp.Usages, entity.ToggleUsageToProto(usage))
+	}
+	return resp
+}
+
+func createListToggleHistoryResponse(page *entity.AuditPage) *togglev1.ListToggleHistoryResponse {
+	resp := &togglev1.ListToggleHistoryResponse{
+		NextPageToken: page.NextPageToken,
+	}
+	for _, audit := range page.Audits {
+		entry := &togglev1.ToggleAuditEntry{
+			Id:          audit.ID,
+			Project:     audit.Project,
+			Key:         audit.Key,
+			Environment: audit.Environment,
+			Action:      entity.AuditActionToProto(audit.Action),
+			Actor:       audit.Actor,
+			Reason:      audit.Reason,
+			RequestId:   audit.RequestID,
+			CreatedAt:   timestamppb.New(audit.CreatedAt),
+		}
+		if audit.Before != nil {
+			entry.Before = createProtoToggle(audit.Before)
+		}
+		if audit.After != nil {
+			entry.After = createProtoToggle(audit.After)
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+	return resp
+}
+
+func createWatchTogglesResponse(update *entity.WatchUpdate) *togglev1.WatchTogglesResponse {
+	resp := &togglev1.WatchTogglesResponse{ResumeToken: update.Token}
+	if !update.IsSnapshot() {
+		resp.Change = &togglev1.WatchTogglesResponse_Event{Event: update.Event}
+		return resp
+	}
+
+	snapshot := &togglev1.ToggleSnapshot{}
+	for _, toggle := range update.Snapshot {
+		snapshot.Toggles = append(snapshot.Toggles, createProtoToggle(toggle))
+	}
+	resp.Change = &togglev1.WatchTogglesResponse_Snapshot{Snapshot: snapshot}
+	return resp
+}
+
+func createProtoSnapshot(snapshot *entity.Snapshot) *togglev1.ToggleSnapshot {
+	resp := &togglev1.ToggleSnapshot{
+		FormatVersion: snapshot.FormatVersion,
+		Project:       snapshot.Project,
+		Environment:   snapshot.Environment,
+		CreatedAt:     timestamppb.New(snapshot.CreatedAt),
+	}
+	for _, toggle := range snapshot.Toggles {
+		resp.Toggles = append(resp.Toggles, createProtoToggle(toggle))
+	}
+	return resp
+}
+
+func createEvaluateToggleResponse(eval *entity.Evaluation) *togglev1.EvaluateToggleResponse {
+	return &togglev1.EvaluateToggleResponse{
+		Value:              eval.Value,
+		Reason:             entity.EvaluationReasonToProto(eval.Reason),
+		MatchedRule:        entity.RuleToProto(eval.MatchedRule),
+		Variant:            entity.VariantToProto(eval.Variant),
+		FailedPrerequisite: eval.FailedPrerequisite,
+		Version:            eval.Version,
+	}
+}
+
 func createProtoToggle(toggle *entity.Toggle) *togglev1.Toggle {
 	return &togglev1.Toggle{
-		Key:         toggle.Key,
-		IsEnabled:   toggle.IsEnabled,
-		Description: toggle.Description,
-		CreatedAt:   timestamppb.New(toggle.CreatedAt),
-		UpdatedAt:   timestamppb.New(toggle.UpdatedAt),
+		Key:            toggle.Key,
+		IsEnabled:      toggle.IsEnabled,
+		Description:    toggle.Description,
+		CreatedAt:      timestamppb.New(toggle.CreatedAt),
+		UpdatedAt:      timestamppb.New(toggle.UpdatedAt),
+		Rules:          entity.RulesToProto(toggle.Rules),
+		DefaultValue:   proto.Bool(toggle.DefaultValue),
+		Rollout:        entity.RolloutToProto(toggle.Rollout),
+		Variants:       entity.VariantsToProto(toggle.Variants),
+		DefaultVariant: toggle.DefaultVariant,
+		OffVariant:     toggle.OffVariant,
+		Environment:    toggle.Environment,
+		Project:        toggle.Project,
+		Prerequisites:  entity.PrerequisitesToProto(toggle.Prerequisites),
+		Owner:          toggle.Owner,
+		ExpiresAt:      entity.TimeToProto(toggle.ExpiresAt),
+		Tags:           toggle.Tags,
+		Version:        toggle.Version,
+		DeletedAt:      entity.TimeToProto(toggle.DeletedAt),
 	}
 }
@@ -1,10 +1,14 @@
 package handler_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/indrasaputra/toggle/entity"
 	"github.com/indrasaputra/toggle/internal/grpc/handler"
@@ -13,16 +17,32 @@ import (
 )
 
 var (
-	testGetToggleByKeyRequest  = &togglev1.GetToggleByKeyRequest{Key: testToggleKey}
+	testGetToggleByKeyRequest  = &togglev1.GetToggleByKeyRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey}
 	testGetToggleByKeyResponse = &togglev1.GetToggleByKeyResponse{Toggle: testToggleProto}
-	testGetAllTogglesRequest   = &togglev1.GetAllTogglesRequest{}
+	testGetAllTogglesRequest   = &togglev1.GetAllTogglesRequest{Project: testToggleProject, Environment: testToggleEnv}
 	testGetAllTogglesResponse  = &togglev1.GetAllTogglesResponse{Toggles: []*togglev1.Toggle{testToggleProto}}
+	testEvaluationContext      = map[string]string{"country": "ID"}
+	testEvaluateToggleRequest  = &togglev1.EvaluateToggleRequest{Project: testToggleProject, Environment: testToggleEnv, Key: testToggleKey, Context: testEvaluationContext}
+	testEvaluation             = &entity.Evaluation{Key: testToggleKey, Value: true, Reason: entity.EvaluationReasonRuleMatch, MatchedRule: testToggleRule, Variant: testToggleVariants[0], Version: 2}
+	testEvaluateToggleResponse = &togglev1.EvaluateToggleResponse{
+		Value:       true,
+		Reason:      togglev1.EvaluationReason_EVALUATION_REASON_RULE_MATCH,
+		MatchedRule: entity.RuleToProto(testToggleRule),
+		Variant:     entity.VariantToProto(testToggleVariants[0]),
+		Version:     2,
+	}
 )
 
 type ToggleQueryExecutor struct {
 	handler *handler.ToggleQuery
 
-	getter *mock_service.MockGetToggle
+	getter    *mock_service.MockGetToggle
+	evaluator *mock_service.MockEvaluateToggle
+	finder    *mock_service.MockFindStaleToggle
+	history   *mock_service.MockGetToggleHistory
+	watcher   *mock_service.MockWatchToggle
+	usage     *mock_service.MockGetToggleUsage
+	exporter  *mock_service.MockExportToggle
 }
 
 func TestNewToggleQuery(t *testing.T) {
@@ -51,7 +71,7 @@ func TestToggleQuery_GetToggleByKey(t *testing.T) {
 
 	t.Run("toggle not found", func(t *testing.T) {
 		exec := createToggleQueryExecutor(ctrl)
-		exec.getter.EXPECT().GetByKey(testCtx, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.getter.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrNotFound())
 
 		res, err := exec.handler.GetToggleByKey(testCtx, testGetToggleByKeyRequest)
 
@@ -62,7 +82,7 @@ func TestToggleQuery_GetToggleByKey(t *testing.T) {
 
 	t.Run("getter service returns error", func(t *testing.T) {
 		exec := createToggleQueryExecutor(ctrl)
-		exec.getter.EXPECT().GetByKey(testCtx, testToggleKey).Return(nil, entity.ErrInternal(""))
+		exec.getter.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrInternal(""))
 
 		res, err := exec.handler.GetToggleByKey(testCtx, testGetToggleByKeyRequest)
 
@@ -73,7 +93,7 @@ func TestToggleQuery_GetToggleByKey(t *testing.T) {
 
 	t.Run("success get a single toggle", func(t *testing.T) {
 		exec := createToggleQueryExecutor(ctrl)
-		exec.getter.EXPECT().GetByKey(testCtx, testToggleKey).Return(testToggleResult, nil)
+		exec.getter.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(testToggleResult, nil)
 
 		res, err := exec.handler.GetToggleByKey(testCtx, testGetToggleByKeyRequest)
 
@@ -98,7 +118,7 @@ func TestToggleQuery_GetAllToggles(t *testing.T) {
 
 	t.Run("getter service returns error", func(t *testing.T) {
 		exec := createToggleQueryExecutor(ctrl)
-		exec.getter.EXPECT().GetAll(testCtx).Return([]*entity.Toggle{}, entity.ErrInternal(""))
+		exec.getter.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{}).Return(nil, entity.ErrInternal(""))
 
 		res, err := exec.handler.GetAllToggles(testCtx, testGetAllTogglesRequest)
 
@@ -109,21 +129,412 @@ func TestToggleQuery_GetAllToggles(t *testing.T) {
 
 	t.Run("success get all toggles", func(t *testing.T) {
 		exec := createToggleQueryExecutor(ctrl)
-		exec.getter.EXPECT().GetAll(testCtx).Return([]*entity.Toggle{testToggleResult}, nil)
+		exec.getter.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{}).Return(&entity.TogglePage{Toggles: []*entity.Toggle{testToggleResult}}, nil)
 
 		res, err := exec.handler.GetAllToggles(testCtx, testGetAllTogglesRequest)
 
 		assert.Nil(t, err)
 		assert.Equal(t, testGetAllTogglesResponse, res)
 	})
+
+	t.Run("success get all toggles filtered by tags", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		filter := &entity.ToggleFilter{AnyTags: []string{"team:payments", "team:checkout"}, AllTags: []string{"kind:kill-switch"}}
+		exec.getter.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, filter, &entity.PageRequest{}).Return(&entity.TogglePage{Toggles: []*entity.Toggle{testToggleResult}}, nil)
+
+		req := &togglev1.GetAllTogglesRequest{
+			Project:     testToggleProject,
+			Environment: testToggleEnv,
+			AnyTags:     []string{"team:payments", "team:checkout"},
+			AllTags:     []string{"kind:kill-switch"},
+		}
+		res, err := exec.handler.GetAllToggles(testCtx, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testGetAllTogglesResponse, res)
+	})
+
+	t.Run("success get all toggles including the deleted ones", func(t *testing.T) {
+		now := time.Now().UTC()
+		deleted := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, DeletedAt: &now}
+		exec := createToggleQueryExecutor(ctrl)
+		filter := &entity.ToggleFilter{IncludeDeleted: true}
+		exec.getter.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, filter, &entity.PageRequest{}).Return(&entity.TogglePage{Toggles: []*entity.Toggle{deleted}}, nil)
+
+		req := &togglev1.GetAllTogglesRequest{
+			Project:        testToggleProject,
+			Environment:    testToggleEnv,
+			IncludeDeleted: true,
+		}
+		res, err := exec.handler.GetAllToggles(testCtx, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, now, res.GetToggles()[0].GetDeletedAt().AsTime())
+	})
+
+	t.Run("success get a page of toggles", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		filter := &entity.ToggleFilter{KeyPrefix: "checkout", Search: "new flow"}
+		page := &entity.PageRequest{Size: 1, Token: "token", OrderBy: "updated_at desc"}
+		exec.getter.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, filter, page).Return(&entity.TogglePage{Toggles: []*entity.Toggle{testToggleResult}, NextPageToken: "next", TotalSize: 2}, nil)
+
+		req := &togglev1.GetAllTogglesRequest{
+			Project:     testToggleProject,
+			Environment: testToggleEnv,
+			PageSize:    1,
+			PageToken:   "token",
+			OrderBy:     "updated_at desc",
+			KeyPrefix:   "checkout",
+			Search:      "new flow",
+		}
+		res, err := exec.handler.GetAllToggles(testCtx, req)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testGetAllTogglesResponse.Toggles, res.Toggles)
+		assert.Equal(t, "next", res.NextPageToken)
+		assert.Equal(t, int32(2), res.TotalSize)
+	})
+}
+
+func TestToggleQuery_EvaluateToggle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+
+		res, err := exec.handler.EvaluateToggle(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("evaluator service returns error", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.evaluator.EXPECT().Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.handler.EvaluateToggle(testCtx, testEvaluateToggleRequest)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success evaluate a toggle", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.evaluator.EXPECT().Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext).Return(testEvaluation, nil)
+
+		res, err := exec.handler.EvaluateToggle(testCtx, testEvaluateToggleRequest)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testEvaluateToggleResponse, res)
+	})
+
+	t.Run("success evaluate a toggle whose prerequisite fails", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		eval := &entity.Evaluation{Key: testToggleKey, Value: false, Reason: entity.EvaluationReasonPrerequisiteFailed, FailedPrerequisite: "parent"}
+		exec.evaluator.EXPECT().Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext).Return(eval, nil)
+
+		res, err := exec.handler.EvaluateToggle(testCtx, testEvaluateToggleRequest)
+
+		assert.Nil(t, err)
+		assert.Equal(t, togglev1.EvaluationReason_EVALUATION_REASON_PREREQUISITE_FAILED, res.GetReason())
+		assert.Equal(t, "parent", res.GetFailedPrerequisite())
+	})
+}
+
+func TestToggleQuery_GetStaleToggles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetStaleToggles(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("finder service returns error", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.finder.EXPECT().GetStale(testCtx, testToggleProject, testToggleEnv, 30*24*time.Hour).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.handler.GetStaleToggles(testCtx, &togglev1.GetStaleTogglesRequest{Project: testToggleProject, Environment: testToggleEnv})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get stale toggles", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		stales := []*entity.StaleToggle{{Toggle: testToggleResult, Reasons: []entity.StaleReason{entity.StaleReasonExpired, entity.StaleReasonFullyOff}}}
+		exec.finder.EXPECT().GetStale(testCtx, testToggleProject, testToggleEnv, 7*24*time.Hour).Return(stales, nil)
+
+		res, err := exec.handler.GetStaleToggles(testCtx, &togglev1.GetStaleTogglesRequest{Project: testToggleProject, Environment: testToggleEnv, StaleAfterDays: 7})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res.GetStaleToggles()))
+		assert.Equal(t, testToggleProto, res.GetStaleToggles()[0].GetToggle())
+		assert.Equal(t, []togglev1.StaleReason{togglev1.StaleReason_STALE_REASON_EXPIRED, togglev1.StaleReason_STALE_REASON_FULLY_OFF}, res.GetStaleToggles()[0].GetReasons())
+	})
+}
+
+func TestToggleQuery_GetToggleUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	since := time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+
+		res, err := exec.handler.GetToggleUsage(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("usage getter returns error", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.usage.EXPECT().GetUsage(testCtx, testToggleProject, testToggleEnv, nil, time.Time{}).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.handler.GetToggleUsage(testCtx, &togglev1.GetToggleUsageRequest{Project: testToggleProject, Environment: testToggleEnv})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully get toggles' usage", func(t *testing.T) {
+		usages := []*entity.ToggleUsage{
+			{Key: testToggleKey, Count: 3, LastEvaluatedAt: &since, Variants: []*entity.VariantUsage{{Variant: "true", Count: 3, LastEvaluatedAt: since}}},
+			{Key: "toggle-2"},
+		}
+		exec := createToggleQueryExecutor(ctrl)
+		exec.usage.EXPECT().GetUsage(testCtx, testToggleProject, testToggleEnv, []string{testToggleKey, "toggle-2"}, since).Return(usages, nil)
+
+		request := &togglev1.GetToggleUsageRequest{Project: testToggleProject, Environment: testToggleEnv, Keys: []string{testToggleKey, "toggle-2"}, StartTime: timestamppb.New(since)}
+		res, err := exec.handler.GetToggleUsage(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res.GetUsages()))
+		assert.Equal(t, int64(3), res.GetUsages()[0].GetEvaluationCount())
+		assert.Equal(t, "true", res.GetUsages()[0].GetVariants()[0].GetVariant())
+		assert.Nil(t, res.GetUsages()[1].GetLastEvaluatedAt())
+	})
+}
+
+func TestToggleQuery_ExportToggles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+
+		res, err := exec.handler.ExportToggles(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("exporter returns error", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.exporter.EXPECT().Export(testCtx, testToggleProject, testToggleEnv).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.handler.ExportToggles(testCtx, &togglev1.ExportTogglesRequest{Project: testToggleProject, Environment: testToggleEnv})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully export toggles", func(t *testing.T) {
+		createdAt := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+		snapshot := &entity.Snapshot{
+			FormatVersion: entity.SnapshotFormatVersion,
+			Project:       testToggleProject,
+			Environment:   testToggleEnv,
+			CreatedAt:     createdAt,
+			Toggles:       []*entity.Toggle{{Key: testToggleKey, IsEnabled: true, Tags: []string{"team-a"}}, {Key: "toggle-2"}},
+		}
+		exec := createToggleQueryExecutor(ctrl)
+		exec.exporter.EXPECT().Export(testCtx, testToggleProject, testToggleEnv).Return(snapshot, nil)
+
+		res, err := exec.handler.ExportToggles(testCtx, &togglev1.ExportTogglesRequest{Project: testToggleProject, Environment: testToggleEnv})
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(entity.SnapshotFormatVersion), res.GetSnapshot().GetFormatVersion())
+		assert.Equal(t, testToggleProject, res.GetSnapshot().GetProject())
+		assert.Equal(t, testToggleEnv, res.GetSnapshot().GetEnvironment())
+		assert.Equal(t, createdAt, res.GetSnapshot().GetCreatedAt().AsTime())
+		assert.Equal(t, 2, len(res.GetSnapshot().GetToggles()))
+		assert.True(t, res.GetSnapshot().GetToggles()[0].GetIsEnabled())
+		assert.Equal(t, []string{"team-a"}, res.GetSnapshot().GetToggles()[0].GetTags())
+		assert.Equal(t, "toggle-2", res.GetSnapshot().GetToggles()[1].GetKey())
+	})
+}
+
+func TestToggleQuery_ListToggleHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+
+		res, err := exec.handler.ListToggleHistory(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("history getter returns error", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.history.EXPECT().GetHistory(testCtx, testToggleProject, nil, &entity.PageRequest{}).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.handler.ListToggleHistory(testCtx, &togglev1.ListToggleHistoryRequest{Project: testToggleProject})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully list toggle history", func(t *testing.T) {
+		filter := &entity.AuditFilter{Key: testToggleKey, Actor: "jane", From: from, To: to}
+		page := &entity.AuditPage{
+			Audits: []*entity.Audit{
+				{ID: 2, Project: testToggleProject, Key: testToggleKey, Environment: testToggleEnv, Action: entity.AuditActionDelete, Actor: "jane", Reason: "cleanup", RequestID: "req-2", Before: testToggle, CreatedAt: to},
+				{ID: 1, Project: testToggleProject, Key: testToggleKey, Environment: testToggleEnv, Action: entity.AuditActionCreate, Actor: "jane", After: testToggle, CreatedAt: from},
+			},
+			NextPageToken: "next",
+		}
+		exec := createToggleQueryExecutor(ctrl)
+		exec.history.EXPECT().GetHistory(testCtx, testToggleProject, filter, &entity.PageRequest{Size: 2, Token: "token"}).Return(page, nil)
+
+		request := &togglev1.ListToggleHistoryRequest{
+			Project:   testToggleProject,
+			Key:       testToggleKey,
+			Actor:     "jane",
+			StartTime: timestamppb.New(from),
+			EndTime:   timestamppb.New(to),
+			PageSize:  2,
+			PageToken: "token",
+		}
+		res, err := exec.handler.ListToggleHistory(testCtx, request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "next", res.GetNextPageToken())
+		assert.Equal(t, 2, len(res.GetEntries()))
+		assert.Equal(t, int64(2), res.GetEntries()[0].GetId())
+		assert.Equal(t, togglev1.AuditAction_AUDIT_ACTION_DELETE, res.GetEntries()[0].GetAction())
+		assert.Equal(t, "cleanup", res.GetEntries()[0].GetReason())
+		assert.Equal(t, "req-2", res.GetEntries()[0].GetRequestId())
+		assert.Equal(t, testToggleKey, res.GetEntries()[0].GetBefore().GetKey())
+		assert.Nil(t, res.GetEntries()[0].GetAfter())
+		assert.Equal(t, togglev1.AuditAction_AUDIT_ACTION_CREATE, res.GetEntries()[1].GetAction())
+		assert.Nil(t, res.GetEntries()[1].GetBefore())
+		assert.Equal(t, testToggleKey, res.GetEntries()[1].GetAfter().GetKey())
+		assert.Equal(t, from, res.GetEntries()[1].GetCreatedAt().AsTime())
+	})
+}
+
+func TestToggleQuery_WatchToggles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("nil request is prohibited", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+
+		err := exec.handler.WatchToggles(nil, &watchTogglesStream{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+	})
+
+	t.Run("watcher returns error", func(t *testing.T) {
+		exec := createToggleQueryExecutor(ctrl)
+		exec.watcher.EXPECT().Watch(testCtx, testToggleProject, testToggleEnv, &entity.WatchFilter{}, "", gomock.Any()).Return(entity.ErrWatchLagging())
+
+		err := exec.handler.WatchToggles(&togglev1.WatchTogglesRequest{Project: testToggleProject, Environment: testToggleEnv}, &watchTogglesStream{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrWatchLagging(), err)
+	})
+
+	t.Run("successfully stream snapshot and events", func(t *testing.T) {
+		event := entity.EventToggleEnabled(testToggle)
+		filter := &entity.WatchFilter{Keys: []string{testToggleKey}, KeyPrefixes: []string{"checkout-"}}
+		exec := createToggleQueryExecutor(ctrl)
+		exec.watcher.EXPECT().Watch(testCtx, testToggleProject, testToggleEnv, filter, "token-0", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, _ *entity.WatchFilter, _ string, send func(*entity.WatchUpdate) error) error {
+				_ = send(&entity.WatchUpdate{Snapshot: []*entity.Toggle{testToggle}, Token: "token-1"})
+				_ = send(&entity.WatchUpdate{Snapshot: []*entity.Toggle{}, Token: "token-2"})
+				return send(&entity.WatchUpdate{Event: event, Token: "token-3"})
+			})
+		stream := &watchTogglesStream{}
+
+		request := &togglev1.WatchTogglesRequest{
+			Project:     testToggleProject,
+			Environment: testToggleEnv,
+			Keys:        []string{testToggleKey},
+			KeyPrefixes: []string{"checkout-"},
+			ResumeToken: "token-0",
+		}
+		err := exec.handler.WatchToggles(request, stream)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(stream.responses))
+		assert.Equal(t, "token-1", stream.responses[0].GetResumeToken())
+		assert.Equal(t, testToggleKey, stream.responses[0].GetSnapshot().GetToggles()[0].GetKey())
+		assert.NotNil(t, stream.responses[1].GetSnapshot())
+		assert.Empty(t, stream.responses[1].GetSnapshot().GetToggles())
+		assert.Equal(t, event, stream.responses[2].GetEvent())
+		assert.Nil(t, stream.responses[2].GetSnapshot())
+	})
+}
+
+// watchTogglesStream collects the responses sent to the watch toggles' stream.
+type watchTogglesStream struct {
+	grpc.ServerStream
+	responses []*togglev1.WatchTogglesResponse
+}
+
+func (s *watchTogglesStream) Context() context.Context {
+	return testCtx
+}
+
+func (s *watchTogglesStream) Send(resp *togglev1.WatchTogglesResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
 }
 
 func createToggleQueryExecutor(ctrl *gomock.Controller) *ToggleQueryExecutor {
 	g := mock_service.NewMockGetToggle(ctrl)
-
-	h := handler.NewToggleQuery(g)
+	e := mock_service.NewMockEvaluateToggle(ctrl)
+	f := mock_service.NewMockFindStaleToggle(ctrl)
+	a := mock_service.NewMockGetToggleHistory(ctrl)
+	w := mock_service.NewMockWatchToggle(ctrl)
+	u := mock_service.NewMockGetToggleUsage(ctrl)
+	x := mock_service.NewMockExportToggle(ctrl)
+
+	h := handler.NewToggleQuery(g, e, f, a, w, u, x)
 	return &ToggleQueryExecutor{
-		handler: h,
-		getter:  g,
+		handler:   h,
+		getter:    g,
+		evaluator: e,
+		finder:    f,
+		history:   a,
+		watcher:   w,
+		usage:     u,
+		exporter:  x,
 	}
 }
@@ -0,0 +1,47 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	// ActorMetadataKey is the request's metadata which tells who makes the request.
+	ActorMetadataKey = "x-actor"
+	// ReasonMetadataKey is the request's metadata which tells why the request is made.
+	ReasonMetadataKey = "x-reason"
+	// RequestIDMetadataKey is the request's metadata which identifies the request.
+	RequestIDMetadataKey = "x-request-id"
+)
+
+// AuditMetadataKeys lists the request's metadata used by AuditMetadata.
+var AuditMetadataKeys = []string{ActorMetadataKey, ReasonMetadataKey, RequestIDMetadataKey}
+
+// AuditMetadata creates an unary server interceptor which puts the actor, the reason, and the request ID
+// taken from the request's metadata into the request's context.
+// Hence, the toggle's changes made by the request are recorded along with them in the audit log.
+// Missing metadata is recorded as empty.
+func AuditMetadata() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		audit := &entity.AuditMetadata{
+			Actor:     getMetadataValue(md, ActorMetadataKey),
+			Reason:    getMetadataValue(md, ReasonMetadataKey),
+			RequestID: getMetadataValue(md, RequestIDMetadataKey),
+		}
+		return handler(entity.ContextWithAuditMetadata(ctx, audit), req)
+	}
+}
+
+func getMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
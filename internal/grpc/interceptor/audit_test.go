@@ -0,0 +1,44 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/grpc/interceptor"
+)
+
+func TestAuditMetadata(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/EnableToggle"}
+
+	t.Run("request without metadata gets empty audit metadata", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			assert.Equal(t, &entity.AuditMetadata{}, entity.AuditMetadataFromContext(ctx))
+			return "response", nil
+		}
+
+		res, err := interceptor.AuditMetadata()(context.Background(), "request", info, handler)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "response", res)
+	})
+
+	t.Run("successfully put audit metadata into context", func(t *testing.T) {
+		md := metadata.Pairs("x-actor", " jane@example.com ", "x-reason", "incident #42", "x-request-id", "req-1", "x-actor", "john")
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			expected := &entity.AuditMetadata{Actor: "jane@example.com", Reason: "incident #42", RequestID: "req-1"}
+			assert.Equal(t, expected, entity.AuditMetadataFromContext(ctx))
+			return "response", nil
+		}
+
+		res, err := interceptor.AuditMetadata()(ctx, "request", info, handler)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "response", res)
+	})
+}
@@ -15,6 +15,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/internal/grpc/interceptor"
 )
 
 const (
@@ -45,9 +47,12 @@ func newGrpcServer(port string, options ...grpc.ServerOption) *GrpcServer {
 // 	- Metrics, using Prometheus.
 // 	- Logging, using zap logger.
 // 	- Recoverer, using grpcrecovery.
+//
+// The streaming handlers are imbued with the same metrics, logging, recoverer, and tracing.
 func NewGrpcServer(port string) *GrpcServer {
-	options := grpcmiddleware.WithUnaryServerChain(defaultUnaryServerInterceptors()...)
-	srv := newGrpcServer(port, options)
+	unary := grpcmiddleware.WithUnaryServerChain(defaultUnaryServerInterceptors()...)
+	stream := grpcmiddleware.WithStreamServerChain(defaultStreamServerInterceptors()...)
+	srv := newGrpcServer(port, unary, stream)
 	grpc_prometheus.Register(srv.server)
 	return srv
 }
@@ -102,6 +107,18 @@ func (gs *GrpcServer) Stop() {
 	gs.server.Stop()
 }
 
+func defaultStreamServerInterceptors() []grpc.StreamServerInterceptor {
+	logger, _ := zap.NewProduction() // error is impossible, hence ignored.
+
+	options := []grpc.StreamServerInterceptor{
+		grpcrecovery.StreamServerInterceptor(grpcrecovery.WithRecoveryHandler(recoveryHandler)),
+		grpczap.StreamServerInterceptor(logger),
+		grpc_prometheus.StreamServerInterceptor,
+		otelgrpc.StreamServerInterceptor(otelgrpc.WithTracerProvider(otel.GetTracerProvider())),
+	}
+	return options
+}
+
 func defaultUnaryServerInterceptors() []grpc.UnaryServerInterceptor {
 	logger, _ := zap.NewProduction() // error is impossible, hence ignored.
 	grpczap.SetGrpcLoggerV2(grpclogsettable.ReplaceGrpcLoggerV2(), logger)
@@ -112,6 +129,7 @@ func defaultUnaryServerInterceptors() []grpc.UnaryServerInterceptor {
 		grpczap.UnaryServerInterceptor(logger),
 		grpc_prometheus.UnaryServerInterceptor,
 		otelgrpc.UnaryServerInterceptor(otelgrpc.WithTracerProvider(otel.GetTracerProvider())),
+		interceptor.AuditMetadata(),
 	}
 	return options
 }
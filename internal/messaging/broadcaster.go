@@ -0,0 +1,157 @@
+package messaging
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+const (
+	// defaultBroadcastHistorySize is the number of remembered events used when the given size isn't positive.
+	defaultBroadcastHistorySize = 1000
+	// defaultBroadcastBufferSize is the subscriber's buffer size used when the given size isn't positive.
+	defaultBroadcastBufferSize = 100
+)
+
+// Broadcaster is responsible to fan out toggle's events to the in-process subscribers.
+// It keeps the latest events in memory, so that a subscriber can resume from the token of the last event it received.
+// The tokens are only valid within the process which issued them.
+// It receives the events from RedisFanout rather than from the outbox relay,
+// so that every replica broadcasts the events relayed by any replica.
+type Broadcaster struct {
+	mutex       sync.Mutex
+	epoch       string
+	sequence    uint64
+	history     []*entity.BroadcastEvent
+	historySize int
+	bufferSize  int
+	subscribers map[*subscription]struct{}
+}
+
+// NewBroadcaster creates an instance of Broadcaster.
+// It remembers the latest historySize events and each subscriber can fall behind by at most bufferSize events
+// before it is dropped.
+// Sizes which aren't positive are replaced by the defaults.
+func NewBroadcaster(historySize, bufferSize int) *Broadcaster {
+	if historySize <= 0 {
+		historySize = defaultBroadcastHistorySize
+	}
+	if bufferSize <= 0 {
+		bufferSize = defaultBroadcastBufferSize
+	}
+	return &Broadcaster{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*subscription]struct{}),
+	}
+}
+
+// Publish broadcasts toggle event to all subscribers.
+// A subscriber whose buffer is full is dropped instead of blocking the others.
+// It never returns error.
+func (b *Broadcaster) Publish(_ context.Context, event *togglev1.ToggleEvent) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.sequence++
+	tmp := &entity.BroadcastEvent{Event: event, Token: b.token(b.sequence)}
+	b.history = append(b.history, tmp)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- tmp:
+		default:
+			b.remove(sub)
+		}
+	}
+	return nil
+}
+
+// Subscribe subscribes to the events published after the token.
+// If the token was issued by this broadcaster and the events after it are still remembered,
+// the missed events are delivered first and it returns true.
+// Otherwise, the subscription starts from the latest event and it returns false.
+func (b *Broadcaster) Subscribe(token string) (service.ToggleSubscription, bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	missed, resumed := b.since(token)
+	sub := &subscription{
+		broadcaster: b,
+		events:      make(chan *entity.BroadcastEvent, b.bufferSize+len(missed)),
+		token:       b.token(b.sequence),
+	}
+	for _, event := range missed {
+		sub.events <- event
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub, resumed
+}
+
+// since returns the remembered events published after the token.
+func (b *Broadcaster) since(token string) ([]*entity.BroadcastEvent, bool) {
+	epoch, value, ok := strings.Cut(token, ".")
+	if !ok || epoch != b.epoch {
+		return nil, false
+	}
+	sequence, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || sequence > b.sequence {
+		return nil, false
+	}
+	first := b.sequence - uint64(len(b.history)) + 1
+	if sequence+1 < first {
+		return nil, false
+	}
+	return append([]*entity.BroadcastEvent{}, b.history[sequence+1-first:]...), true
+}
+
+func (b *Broadcaster) token(sequence uint64) string {
+	return fmt.Sprintf("%s.%d", b.epoch, sequence)
+}
+
+// remove removes the subscriber and closes its channel.
+// It must be called while holding the mutex.
+func (b *Broadcaster) remove(sub *subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+// subscription is a subscription to the broadcaster's events.
+type subscription struct {
+	broadcaster *Broadcaster
+	events      chan *entity.BroadcastEvent
+	token       string
+}
+
+// Events returns the events published after the subscription's token.
+// The channel is closed if the subscriber falls too far behind.
+func (s *subscription) Events() <-chan *entity.BroadcastEvent {
+	return s.events
+}
+
+// Token returns the token of the latest event at the time of subscription.
+func (s *subscription) Token() string {
+	return s.token
+}
+
+// Close ends the subscription.
+// It is safe to be called more than once.
+func (s *subscription) Close() {
+	s.broadcaster.mutex.Lock()
+	defer s.broadcaster.mutex.Unlock()
+	s.broadcaster.remove(s)
+}
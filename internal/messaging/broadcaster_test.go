@@ -0,0 +1,133 @@
+package messaging_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/internal/messaging"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestNewBroadcaster(t *testing.T) {
+	t.Run("successfully create an instance of Broadcaster", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 10)
+		assert.NotNil(t, broadcaster)
+	})
+
+	t.Run("sizes which aren't positive fall back to the defaults", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(-1, 0)
+		sub, _ := broadcaster.Subscribe("")
+		defer sub.Close()
+
+		assert.NotPanics(t, func() {
+			for i := 0; i < 3; i++ {
+				_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+			}
+		})
+		for i := 0; i < 3; i++ {
+			event := <-sub.Events()
+			assert.Equal(t, "toggle-1", event.Event.GetToggle().GetKey())
+		}
+	})
+}
+
+func TestBroadcaster_Publish(t *testing.T) {
+	t.Run("event is delivered to all subscribers", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 10)
+		first, _ := broadcaster.Subscribe("")
+		second, _ := broadcaster.Subscribe("")
+		event := createBroadcastTestEvent("toggle-1")
+
+		err := broadcaster.Publish(testCtx, event)
+
+		assert.Nil(t, err)
+		assert.Equal(t, event, (<-first.Events()).Event)
+		assert.Equal(t, event, (<-second.Events()).Event)
+	})
+
+	t.Run("subscriber which falls behind is dropped", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 1)
+		slow, _ := broadcaster.Subscribe("")
+
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-2"))
+
+		_, ok := <-slow.Events()
+		assert.True(t, ok)
+		_, ok = <-slow.Events()
+		assert.False(t, ok)
+		slow.Close()
+	})
+
+	t.Run("closed subscription doesn't receive any event", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 10)
+		sub, _ := broadcaster.Subscribe("")
+		sub.Close()
+
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+
+		_, ok := <-sub.Events()
+		assert.False(t, ok)
+	})
+}
+
+func TestBroadcaster_Subscribe(t *testing.T) {
+	t.Run("empty or unknown token can't be resumed", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 10)
+		other := messaging.NewBroadcaster(10, 10)
+		otherSub, _ := other.Subscribe("")
+
+		for _, token := range []string{"", "malformed", "x.1", otherSub.Token() + "0"} {
+			sub, resumed := broadcaster.Subscribe(token)
+			assert.False(t, resumed)
+			assert.NotEmpty(t, sub.Token())
+		}
+	})
+
+	t.Run("missed events are delivered on resume", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 10)
+		sub, _ := broadcaster.Subscribe("")
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+		last := <-sub.Events()
+		sub.Close()
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-2"))
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-3"))
+
+		resumed, ok := broadcaster.Subscribe(last.Token)
+
+		assert.True(t, ok)
+		assert.Equal(t, "toggle-2", (<-resumed.Events()).Event.GetToggle().GetKey())
+		assert.Equal(t, "toggle-3", (<-resumed.Events()).Event.GetToggle().GetKey())
+	})
+
+	t.Run("subscription's token resumes without any missed event", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(10, 10)
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+		sub, _ := broadcaster.Subscribe("")
+
+		resumed, ok := broadcaster.Subscribe(sub.Token())
+
+		assert.True(t, ok)
+		assert.Empty(t, resumed.Events())
+	})
+
+	t.Run("forgotten events can't be resumed", func(t *testing.T) {
+		broadcaster := messaging.NewBroadcaster(1, 10)
+		sub, _ := broadcaster.Subscribe("")
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+		_ = broadcaster.Publish(testCtx, createBroadcastTestEvent("toggle-2"))
+
+		_, ok := broadcaster.Subscribe(sub.Token())
+
+		assert.False(t, ok)
+	})
+}
+
+func createBroadcastTestEvent(key string) *togglev1.ToggleEvent {
+	return &togglev1.ToggleEvent{
+		Name:    togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+		Toggle:  &togglev1.Toggle{Key: key},
+		Project: "default",
+	}
+}
@@ -0,0 +1,30 @@
+package messaging
+
+import (
+	"context"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+// ChainPublisher publishes toggle's events to many publishers in order.
+type ChainPublisher struct {
+	publishers []service.TogglePublisher
+}
+
+// NewChainPublisher creates an instance of ChainPublisher.
+func NewChainPublisher(publishers ...service.TogglePublisher) *ChainPublisher {
+	return &ChainPublisher{publishers: publishers}
+}
+
+// Publish publishes toggle event to each publisher in order.
+// It stops at the first publisher which fails, so that the next publishers only receive the event
+// once every previous publisher has received it.
+func (cp *ChainPublisher) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
+	for _, publisher := range cp.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
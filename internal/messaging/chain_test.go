@@ -0,0 +1,49 @@
+package messaging_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/internal/messaging"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+func TestNewChainPublisher(t *testing.T) {
+	t.Run("successfully create an instance of ChainPublisher", func(t *testing.T) {
+		publisher := messaging.NewChainPublisher()
+		assert.NotNil(t, publisher)
+	})
+}
+
+func TestChainPublisher_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	event := &togglev1.ToggleEvent{Name: togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED, Toggle: &togglev1.Toggle{}}
+
+	t.Run("next publishers are skipped once a publisher fails", func(t *testing.T) {
+		first := mock_service.NewMockTogglePublisher(ctrl)
+		second := mock_service.NewMockTogglePublisher(ctrl)
+		first.EXPECT().Publish(testCtx, event).Return(errReturn)
+
+		err := messaging.NewChainPublisher(first, second).Publish(testCtx, event)
+
+		assert.Equal(t, errReturn, err)
+	})
+
+	t.Run("event is published to all publishers in order", func(t *testing.T) {
+		first := mock_service.NewMockTogglePublisher(ctrl)
+		second := mock_service.NewMockTogglePublisher(ctrl)
+		gomock.InOrder(
+			first.EXPECT().Publish(testCtx, event).Return(nil),
+			second.EXPECT().Publish(testCtx, event).Return(nil),
+		)
+
+		err := messaging.NewChainPublisher(first, second).Publish(testCtx, event)
+
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,105 @@
+package messaging
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	goredis "github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+const (
+	// RedisFanoutChannel is the Redis Pub/Sub channel used to fan out toggle's events to every server replica.
+	RedisFanoutChannel = "toggle:events"
+
+	fanoutPort = "-"
+)
+
+// RedisFanout is responsible to fan out toggle's events to every server replica using Redis Pub/Sub.
+// Unlike RedisPublisher whose queue delivers each event to only one subscriber,
+// every replica subscribed to the channel receives every event.
+// Redis Pub/Sub doesn't keep the events, hence the events published while a replica isn't subscribed are missed by it.
+// It acts as a server so that it can be managed along with the other servers.
+type RedisFanout struct {
+	client goredis.UniversalClient
+	sink   service.TogglePublisher
+	quit   chan struct{}
+	once   sync.Once
+	wg     sync.WaitGroup
+}
+
+// NewRedisFanout creates an instance of RedisFanout.
+// The events received from the channel are published to the sink, usually the process' Broadcaster.
+func NewRedisFanout(client goredis.UniversalClient, sink service.TogglePublisher) *RedisFanout {
+	return &RedisFanout{
+		client: client,
+		sink:   sink,
+		quit:   make(chan struct{}),
+	}
+}
+
+// Publish publishes toggle event to every subscribed replica.
+// The event will be converted to JSON.
+func (rf *RedisFanout) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return rf.client.Publish(ctx, RedisFanoutChannel, data).Err()
+}
+
+// Name returns fanout's name.
+func (rf *RedisFanout) Name() string {
+	return "watch fanout"
+}
+
+// Port returns fanout's port.
+// RedisFanout doesn't listen to any port.
+func (rf *RedisFanout) Port() string {
+	return fanoutPort
+}
+
+// Serve subscribes to the channel and publishes the received events to the sink.
+// The subscription is reestablished if the connection is lost.
+// It blocks until GracefulStop is called.
+func (rf *RedisFanout) Serve() error {
+	rf.wg.Add(1)
+	defer rf.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pubsub := rf.client.Subscribe(ctx, RedisFanoutChannel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-rf.quit:
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			event := &togglev1.ToggleEvent{}
+			if err := protojson.Unmarshal([]byte(msg.Payload), event); err != nil {
+				log.Printf("[RedisFanout-Serve] unmarshal event error: %s", err.Error())
+				continue
+			}
+			if err := rf.sink.Publish(ctx, event); err != nil {
+				log.Printf("[RedisFanout-Serve] publish event error: %s", err.Error())
+			}
+		}
+	}
+}
+
+// GracefulStop stops the fanout.
+// It waits for the received event to be published to the sink.
+func (rf *RedisFanout) GracefulStop() {
+	rf.once.Do(func() { close(rf.quit) })
+	rf.wg.Wait()
+}
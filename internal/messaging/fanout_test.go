@@ -0,0 +1,67 @@
+package messaging_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/indrasaputra/toggle/internal/messaging"
+)
+
+func TestNewRedisFanout(t *testing.T) {
+	t.Run("successfully create an instance of RedisFanout", func(t *testing.T) {
+		fanout := messaging.NewRedisFanout(&goredis.Client{}, messaging.NewBroadcaster(10, 10))
+		assert.NotNil(t, fanout)
+		assert.Equal(t, "watch fanout", fanout.Name())
+		assert.Equal(t, "-", fanout.Port())
+	})
+}
+
+func TestRedisFanout_Publish(t *testing.T) {
+	t.Run("redis is unavailable", func(t *testing.T) {
+		server, _ := miniredis.Run()
+		client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+		defer client.Close()
+		server.Close()
+		fanout := messaging.NewRedisFanout(client, messaging.NewBroadcaster(10, 10))
+
+		err := fanout.Publish(testCtx, createBroadcastTestEvent("toggle-1"))
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("event is delivered to the broadcaster of every replica", func(t *testing.T) {
+		server, _ := miniredis.Run()
+		defer server.Close()
+		client := goredis.NewClient(&goredis.Options{Addr: server.Addr()})
+		defer client.Close()
+
+		broadcasters := []*messaging.Broadcaster{messaging.NewBroadcaster(10, 10), messaging.NewBroadcaster(10, 10)}
+		var fanouts []*messaging.RedisFanout
+		for _, broadcaster := range broadcasters {
+			fanout := messaging.NewRedisFanout(client, broadcaster)
+			go func() { _ = fanout.Serve() }()
+			fanouts = append(fanouts, fanout)
+		}
+		assert.Eventually(t, func() bool {
+			return server.PubSubNumSub(messaging.RedisFanoutChannel)[messaging.RedisFanoutChannel] == len(fanouts)
+		}, time.Second, 10*time.Millisecond)
+
+		event := createBroadcastTestEvent("toggle-1")
+		first, _ := broadcasters[0].Subscribe("")
+		second, _ := broadcasters[1].Subscribe("")
+
+		err := fanouts[0].Publish(testCtx, event)
+
+		assert.Nil(t, err)
+		assert.True(t, proto.Equal(event, (<-first.Events()).Event))
+		assert.True(t, proto.Equal(event, (<-second.Events()).Event))
+		for _, fanout := range fanouts {
+			fanout.GracefulStop()
+		}
+	})
+}
@@ -11,6 +11,10 @@ import (
 	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
+const (
+	kafkaHeaderProject = "project"
+)
+
 // Writer defines a little interface for Kafka writer/publisher functionality.
 // Since in the real implementation we can use kafka.Writer,
 // this interface exists mostly for testing purpose.
@@ -39,6 +43,8 @@ func NewKafkaPublisher(writer Writer) *KafkaPublisher {
 
 // Publish publishes toggle event to Kafka.
 // The event will be converted to JSON.
+// The message's key is prefixed with the event's project
+// and the project is also put in the message's header.
 func (kp *KafkaPublisher) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
 	data, err := json.Marshal(event)
 	if err != nil {
@@ -49,8 +55,9 @@ func (kp *KafkaPublisher) Publish(ctx context.Context, event *togglev1.ToggleEve
 	}
 
 	msg := kafka.Message{
-		Key:   []byte(event.GetToggle().Key),
-		Value: data,
+		Key:     []byte(event.GetProject() + ":" + event.GetToggle().Key),
+		Value:   data,
+		Headers: []kafka.Header{{Key: kafkaHeaderProject, Value: []byte(event.GetProject())}},
 	}
 	if err := kp.writer.WriteMessages(ctx, msg); err != nil {
 		return entity.ErrInternal(err.Error())
@@ -59,13 +66,15 @@ func (kp *KafkaPublisher) Publish(ctx context.Context, event *togglev1.ToggleEve
 }
 
 // KafkaSubscriber is responsible to subscribe message from Kafka.
+// It only processes events of its project.
 type KafkaSubscriber struct {
-	reader Reader
+	reader  Reader
+	project string
 }
 
 // NewKafkaSubscriber creates an instance of KafkaSubscriber.
-func NewKafkaSubscriber(reader Reader) *KafkaSubscriber {
-	return &KafkaSubscriber{reader: reader}
+func NewKafkaSubscriber(reader Reader, project string) *KafkaSubscriber {
+	return &KafkaSubscriber{reader: reader, project: project}
 }
 
 // Subscribe subscribes to a certain topic and process the incoming message using the fn parameter.
@@ -81,6 +90,9 @@ func (ks *KafkaSubscriber) Subscribe(ctx context.Context, fn func(*togglev1.Togg
 			log.Printf("error unmarshal message: %v\n", err)
 			continue
 		}
+		if event.GetProject() != ks.project {
+			continue
+		}
 		if err := fn(event); err != nil {
 			log.Printf("error process event in fn: %v\n", err)
 		}
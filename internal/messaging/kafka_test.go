@@ -16,8 +16,9 @@ import (
 )
 
 var (
-	testCtx   = context.Background()
-	errReturn = errors.New("error")
+	testCtx     = context.Background()
+	testProject = "default"
+	errReturn   = errors.New("error")
 )
 
 type KafkaPublisherExecutor struct {
@@ -62,9 +63,17 @@ func TestKafkaPublisher_Publish(t *testing.T) {
 
 	t.Run("success write message", func(t *testing.T) {
 		exec := createKafkaPublisherExecutor(ctrl)
-		exec.writer.EXPECT().WriteMessages(testCtx, gomock.Any()).Return(nil)
-
-		err := exec.publisher.Publish(testCtx, &togglev1.ToggleEvent{Toggle: &togglev1.Toggle{}})
+		msg := kafka.Message{
+			Key:     []byte(testProject + ":toggle-1"),
+			Headers: []kafka.Header{{Key: "project", Value: []byte(testProject)}},
+		}
+		exec.writer.EXPECT().WriteMessages(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, msgs ...kafka.Message) error {
+			assert.Equal(t, msg.Key, msgs[0].Key)
+			assert.Equal(t, msg.Headers, msgs[0].Headers)
+			return nil
+		})
+
+		err := exec.publisher.Publish(testCtx, &togglev1.ToggleEvent{Project: testProject, Toggle: &togglev1.Toggle{Key: "toggle-1"}})
 
 		assert.Nil(t, err)
 	})
@@ -119,9 +128,26 @@ func TestKafkaSubscriber_Subscribe(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 
+	t.Run("skip message of other project", func(t *testing.T) {
+		exec := createKafkaSubscriberExecutor(ctrl)
+		b, _ := json.Marshal(&togglev1.ToggleEvent{Project: "payment"})
+		msg := kafka.Message{Value: b}
+		exec.reader.EXPECT().ReadMessage(testCtx).Return(msg, nil)
+		exec.reader.EXPECT().ReadMessage(testCtx).Return(kafka.Message{}, errReturn)
+
+		called := false
+		err := exec.subscriber.Subscribe(testCtx, func(*togglev1.ToggleEvent) error {
+			called = true
+			return nil
+		})
+
+		assert.NotNil(t, err)
+		assert.False(t, called)
+	})
+
 	t.Run("error processing message", func(t *testing.T) {
 		exec := createKafkaSubscriberExecutor(ctrl)
-		b, _ := json.Marshal(&togglev1.ToggleEvent{})
+		b, _ := json.Marshal(&togglev1.ToggleEvent{Project: testProject})
 		msg := kafka.Message{Value: b}
 		exec.reader.EXPECT().ReadMessage(testCtx).Return(msg, nil)
 		exec.reader.EXPECT().ReadMessage(testCtx).Return(kafka.Message{}, errReturn)
@@ -133,7 +159,7 @@ func TestKafkaSubscriber_Subscribe(t *testing.T) {
 
 	t.Run("success processing message", func(t *testing.T) {
 		exec := createKafkaSubscriberExecutor(ctrl)
-		b, _ := json.Marshal(&togglev1.ToggleEvent{})
+		b, _ := json.Marshal(&togglev1.ToggleEvent{Project: testProject})
 		msg := kafka.Message{Value: b}
 		exec.reader.EXPECT().ReadMessage(testCtx).Return(msg, nil)
 		exec.reader.EXPECT().ReadMessage(testCtx).Return(kafka.Message{}, errReturn)
@@ -153,7 +179,7 @@ func processor(err error) func(*togglev1.ToggleEvent) error {
 
 func createKafkaSubscriberExecutor(ctrl *gomock.Controller) *KafkaSubscriberExecutor {
 	r := mock_messaging.NewMockReader(ctrl)
-	s := messaging.NewKafkaSubscriber(r)
+	s := messaging.NewKafkaSubscriber(r, testProject)
 	return &KafkaSubscriberExecutor{
 		subscriber: s,
 		reader:     r,
@@ -0,0 +1,122 @@
+package messaging
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+var (
+	publishedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "toggle_events_published_total",
+		Help: "Total number of toggle's events published to the message queue.",
+	}, []string{"name"})
+	failedEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "toggle_events_publish_failures_total",
+		Help: "Total number of failed attempts to publish toggle's events to the message queue.",
+	}, []string{"name"})
+	bestEffortFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "toggle_events_best_effort_failures_total",
+		Help: "Total number of toggle's events which failed to be published by a best-effort publisher, such as the watch fanout.",
+	}, []string{"name"})
+	deadLetteredEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "toggle_events_dead_lettered_total",
+		Help: "Total number of toggle's events moved out of the outbox since they can't be published.",
+	})
+	publishDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "toggle_events_publish_delay_seconds",
+		Help:    "Time between the toggle's change and the publication of its event.",
+		Buckets: []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 300, 900},
+	})
+)
+
+// MetricsPublisher decorates toggle's publisher and records its publications in Prometheus.
+type MetricsPublisher struct {
+	publisher service.TogglePublisher
+}
+
+// NewMetricsPublisher creates an instance of MetricsPublisher.
+func NewMetricsPublisher(publisher service.TogglePublisher) *MetricsPublisher {
+	return &MetricsPublisher{publisher: publisher}
+}
+
+// Publish publishes toggle event using the decorated publisher.
+// The published and failed events are counted by the event's name.
+// The delay is measured from the event's creation time, hence it includes the time the event waits in the outbox.
+func (mp *MetricsPublisher) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
+	name := event.GetName().String()
+	if err := mp.publisher.Publish(ctx, event); err != nil {
+		failedEvents.WithLabelValues(name).Inc()
+		return err
+	}
+
+	publishedEvents.WithLabelValues(name).Inc()
+	if event.GetCreatedAt() != nil {
+		publishDelay.Observe(time.Since(event.GetCreatedAt().AsTime()).Seconds())
+	}
+	return nil
+}
+
+// BestEffortPublisher decorates toggle's publisher whose failure mustn't fail the publication,
+// such as the watch fanout which is chained after the message queue's publisher,
+// so that its failure doesn't cause the event to be published to the message queue again.
+type BestEffortPublisher struct {
+	publisher service.TogglePublisher
+}
+
+// NewBestEffortPublisher creates an instance of BestEffortPublisher.
+func NewBestEffortPublisher(publisher service.TogglePublisher) *BestEffortPublisher {
+	return &BestEffortPublisher{publisher: publisher}
+}
+
+// Publish publishes toggle event using the decorated publisher.
+// The failure is logged and counted by the event's name instead of being returned, hence it never returns error.
+func (bp *BestEffortPublisher) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
+	if err := bp.publisher.Publish(ctx, event); err != nil {
+		log.Printf("best-effort publish event %s of toggle %s error: %v", event.GetName(), event.GetToggle().GetKey(), err)
+		bestEffortFailures.WithLabelValues(event.GetName().String()).Inc()
+	}
+	return nil
+}
+
+// MetricsOutbox decorates the relay's outbox repository and records its dead-lettered events in Prometheus.
+type MetricsOutbox struct {
+	repo service.RelayOutboxRepository
+}
+
+// NewMetricsOutbox creates an instance of MetricsOutbox.
+func NewMetricsOutbox(repo service.RelayOutboxRepository) *MetricsOutbox {
+	return &MetricsOutbox{repo: repo}
+}
+
+// ClaimPending claims the pending events using the decorated repository.
+func (mo *MetricsOutbox) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	return mo.repo.ClaimPending(ctx, now, lease, limit)
+}
+
+// Delete removes the published event using the decorated repository.
+func (mo *MetricsOutbox) Delete(ctx context.Context, id int64) error {
+	return mo.repo.Delete(ctx, id)
+}
+
+// Release makes the failed event available again using the decorated repository.
+func (mo *MetricsOutbox) Release(ctx context.Context, id int64, availableAt time.Time, reason string) error {
+	return mo.repo.Release(ctx, id, availableAt, reason)
+}
+
+// DeadLetter moves the event out of the outbox using the decorated repository.
+// The event is only counted once it has been moved.
+func (mo *MetricsOutbox) DeadLetter(ctx context.Context, id int64, reason string) error {
+	if err := mo.repo.DeadLetter(ctx, id, reason); err != nil {
+		return err
+	}
+	deadLetteredEvents.Inc()
+	return nil
+}
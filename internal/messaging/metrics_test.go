@@ -0,0 +1,169 @@
+package messaging_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/messaging"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+func TestNewMetricsPublisher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of MetricsPublisher", func(t *testing.T) {
+		publisher := messaging.NewMetricsPublisher(mock_service.NewMockTogglePublisher(ctrl))
+		assert.NotNil(t, publisher)
+	})
+}
+
+func TestMetricsPublisher_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("failed publication is counted and its error is returned", func(t *testing.T) {
+		event := &togglev1.ToggleEvent{Name: togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DISABLED, Toggle: &togglev1.Toggle{}}
+		publisher := mock_service.NewMockTogglePublisher(ctrl)
+		publisher.EXPECT().Publish(testCtx, event).Return(errReturn)
+		before := gatherCounter("toggle_events_publish_failures_total", event.Name.String())
+
+		err := messaging.NewMetricsPublisher(publisher).Publish(testCtx, event)
+
+		assert.Equal(t, errReturn, err)
+		assert.Equal(t, before+1, gatherCounter("toggle_events_publish_failures_total", event.Name.String()))
+	})
+
+	t.Run("successful publication is counted", func(t *testing.T) {
+		event := &togglev1.ToggleEvent{Name: togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED, Toggle: &togglev1.Toggle{}, CreatedAt: timestamppb.Now()}
+		publisher := mock_service.NewMockTogglePublisher(ctrl)
+		publisher.EXPECT().Publish(testCtx, event).Return(nil)
+		before := gatherCounter("toggle_events_published_total", event.Name.String())
+
+		err := messaging.NewMetricsPublisher(publisher).Publish(testCtx, event)
+
+		assert.Nil(t, err)
+		assert.Equal(t, before+1, gatherCounter("toggle_events_published_total", event.Name.String()))
+	})
+}
+
+func TestNewBestEffortPublisher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of BestEffortPublisher", func(t *testing.T) {
+		publisher := messaging.NewBestEffortPublisher(mock_service.NewMockTogglePublisher(ctrl))
+		assert.NotNil(t, publisher)
+	})
+}
+
+func TestBestEffortPublisher_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("failed publication is counted without returning its error", func(t *testing.T) {
+		event := &togglev1.ToggleEvent{Name: togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DELETED, Toggle: &togglev1.Toggle{}}
+		publisher := mock_service.NewMockTogglePublisher(ctrl)
+		publisher.EXPECT().Publish(testCtx, event).Return(errReturn)
+		before := gatherCounter("toggle_events_best_effort_failures_total", event.Name.String())
+
+		err := messaging.NewBestEffortPublisher(publisher).Publish(testCtx, event)
+
+		assert.Nil(t, err)
+		assert.Equal(t, before+1, gatherCounter("toggle_events_best_effort_failures_total", event.Name.String()))
+	})
+
+	t.Run("successful publication isn't counted", func(t *testing.T) {
+		event := &togglev1.ToggleEvent{Name: togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DELETED, Toggle: &togglev1.Toggle{}}
+		publisher := mock_service.NewMockTogglePublisher(ctrl)
+		publisher.EXPECT().Publish(testCtx, event).Return(nil)
+		before := gatherCounter("toggle_events_best_effort_failures_total", event.Name.String())
+
+		err := messaging.NewBestEffortPublisher(publisher).Publish(testCtx, event)
+
+		assert.Nil(t, err)
+		assert.Equal(t, before, gatherCounter("toggle_events_best_effort_failures_total", event.Name.String()))
+	})
+}
+
+func TestNewMetricsOutbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of MetricsOutbox", func(t *testing.T) {
+		outbox := messaging.NewMetricsOutbox(mock_service.NewMockRelayOutboxRepository(ctrl))
+		assert.NotNil(t, outbox)
+	})
+}
+
+func TestMetricsOutbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("claim, delete, and release are passed to the decorated repository", func(t *testing.T) {
+		now := time.Now().UTC()
+		events := []*entity.OutboxEvent{{ID: 1}}
+		repo := mock_service.NewMockRelayOutboxRepository(ctrl)
+		repo.EXPECT().ClaimPending(testCtx, now, time.Second, 10).Return(events, nil)
+		repo.EXPECT().Delete(testCtx, int64(1)).Return(errReturn)
+		repo.EXPECT().Release(testCtx, int64(1), now, "error").Return(errReturn)
+		outbox := messaging.NewMetricsOutbox(repo)
+
+		res, err := outbox.ClaimPending(testCtx, now, time.Second, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, events, res)
+		assert.Equal(t, errReturn, outbox.Delete(testCtx, 1))
+		assert.Equal(t, errReturn, outbox.Release(testCtx, 1, now, "error"))
+	})
+
+	t.Run("failed dead letter isn't counted", func(t *testing.T) {
+		repo := mock_service.NewMockRelayOutboxRepository(ctrl)
+		repo.EXPECT().DeadLetter(testCtx, int64(1), "malformed").Return(errReturn)
+		before := gatherCounter("toggle_events_dead_lettered_total", "")
+
+		err := messaging.NewMetricsOutbox(repo).DeadLetter(testCtx, 1, "malformed")
+
+		assert.Equal(t, errReturn, err)
+		assert.Equal(t, before, gatherCounter("toggle_events_dead_lettered_total", ""))
+	})
+
+	t.Run("dead-lettered event is counted", func(t *testing.T) {
+		repo := mock_service.NewMockRelayOutboxRepository(ctrl)
+		repo.EXPECT().DeadLetter(testCtx, int64(1), "malformed").Return(nil)
+		before := gatherCounter("toggle_events_dead_lettered_total", "")
+
+		err := messaging.NewMetricsOutbox(repo).DeadLetter(testCtx, 1, "malformed")
+
+		assert.Nil(t, err)
+		assert.Equal(t, before+1, gatherCounter("toggle_events_dead_lettered_total", ""))
+	})
+}
+
+// gatherCounter gets the value of the counter with the given name and event's name from the default registry.
+// The counter without any label is matched by empty event's name.
+func gatherCounter(name, eventName string) float64 {
+	families, _ := prometheus.DefaultGatherer.Gather()
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if eventName == "" && len(metric.GetLabel()) == 0 {
+				return metric.GetCounter().GetValue()
+			}
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" && label.GetValue() == eventName {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
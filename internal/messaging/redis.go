@@ -11,6 +11,12 @@ import (
 	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
+// RedisQueue returns the name of the queue used for the project's toggle events.
+// Each project has its own queue, so subscribers only receive their project's events.
+func RedisQueue(project string) string {
+	return "toggle:" + project
+}
+
 // RedisPublisher is responsible to publish message to Redis.
 // It uses asynq client.
 type RedisPublisher struct {
@@ -29,7 +35,7 @@ func NewRedisPublisher(cfg *config.Redis) *RedisPublisher {
 }
 
 // Publish publishes toggle event to Redis.
-// The event will be converted to JSON.
+// The event will be converted to JSON and enqueued to the event's project queue.
 func (rp *RedisPublisher) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
@@ -37,7 +43,7 @@ func (rp *RedisPublisher) Publish(ctx context.Context, event *togglev1.ToggleEve
 	}
 
 	task := asynq.NewTask(event.GetName().String(), payload)
-	_, err = rp.client.Enqueue(task)
+	_, err = rp.client.Enqueue(task, asynq.Queue(RedisQueue(event.GetProject())))
 	return err
 }
 
@@ -47,10 +53,14 @@ type RedisSubscriber struct {
 }
 
 // NewRedisSubscriber creates an instance of RedisSubscriber.
-func NewRedisSubscriber(cfg *config.Redis) *RedisSubscriber {
+// It only processes events of the given project.
+func NewRedisSubscriber(cfg *config.Redis, project string) *RedisSubscriber {
 	server := asynq.NewServer(
 		asynq.RedisClientOpt{Addr: cfg.Address},
-		asynq.Config{Concurrency: cfg.Concurrency},
+		asynq.Config{
+			Concurrency: cfg.Concurrency,
+			Queues:      map[string]int{RedisQueue(project): 1},
+		},
 	)
 	return &RedisSubscriber{server: server}
 }
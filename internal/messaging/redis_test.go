@@ -34,12 +34,19 @@ func TestRedisPublisher_Publish(t *testing.T) {
 		exec := createRedisPublisherExecutor()
 		defer exec.server.Close()
 
-		err := exec.publisher.Publish(ctx, &togglev1.ToggleEvent{})
+		err := exec.publisher.Publish(ctx, &togglev1.ToggleEvent{Project: "default"})
 
 		assert.Nil(t, err)
 	})
 }
 
+func TestRedisQueue(t *testing.T) {
+	t.Run("each project has its own queue", func(t *testing.T) {
+		assert.Equal(t, "toggle:default", RedisQueue("default"))
+		assert.NotEqual(t, RedisQueue("default"), RedisQueue("payment"))
+	})
+}
+
 type RedisSubscriberExecutor struct {
 	subscriber *RedisSubscriber
 	server     *miniredis.Miniredis
@@ -120,7 +127,7 @@ func createRedisSubscriberExecutor() *RedisSubscriberExecutor {
 	}
 
 	return &RedisSubscriberExecutor{
-		subscriber: NewRedisSubscriber(cfg),
+		subscriber: NewRedisSubscriber(cfg, "default"),
 		server:     mr,
 	}
 }
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	auditColumns = "id, project, toggle_key, environment, action, actor, reason, request_id, before, after, created_at"
+)
+
+// Audit is responsible to connect audit entity with toggle_audits table in PostgreSQL.
+// The table is append-only. Its entries are inserted by Toggle in the same transaction as the toggle's change.
+type Audit struct {
+	pool PgxPoolIface
+}
+
+// NewAudit creates an instance of Audit.
+func NewAudit(pool PgxPoolIface) *Audit {
+	return &Audit{pool: pool}
+}
+
+// GetAll gets the entries of the project's toggles which satisfy the filter, newest first.
+// Nil filter means all entries. The entries start right before the pagination's BeforeID.
+// If there isn't any entry, it returns empty list of audit and nil error.
+func (a *Audit) GetAll(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.AuditPagination) ([]*entity.Audit, error) {
+	conds, args := createAuditConditions(project, filter)
+	if page != nil && page.BeforeID > 0 {
+		args = append(args, page.BeforeID)
+		conds += fmt.Sprintf(" AND id < $%d", len(args))
+	}
+
+	query := "SELECT " + auditColumns + " FROM toggle_audits" + conds + " ORDER BY id DESC"
+	if page != nil && page.Limit > 0 {
+		args = append(args, page.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := a.pool.Query(ctx, query, args...)
+	if err != nil {
+		return []*entity.Audit{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.Audit{}
+	for rows.Next() {
+		var tmp entity.Audit
+		var action string
+		var before, after []byte
+		if err := rows.Scan(&tmp.ID, &tmp.Project, &tmp.Key, &tmp.Environment, &action, &tmp.Actor, &tmp.Reason, &tmp.RequestID, &before, &after, &tmp.CreatedAt); err != nil {
+			log.Printf("[Audit-GetAll] scan rows error: %s", err.Error())
+			continue
+		}
+		tmp.Action = entity.AuditAction(action)
+		if tmp.Before, err = unmarshalAuditToggle(before); err != nil {
+			log.Printf("[Audit-GetAll] unmarshal before error: %s", err.Error())
+			continue
+		}
+		if tmp.After, err = unmarshalAuditToggle(after); err != nil {
+			log.Printf("[Audit-GetAll] unmarshal after error: %s", err.Error())
+			continue
+		}
+		res = append(res, &tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.Audit{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
+
+// insertAudit inserts the entries into the toggle_audits table.
+// It is meant to be called within the transaction which changes the toggle.
+func insertAudit(ctx context.Context, db execer, audits ...*entity.Audit) error {
+	query := "INSERT INTO " +
+		"toggle_audits (project, toggle_key, environment, action, actor, reason, request_id, before, after, created_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)"
+	for _, audit := range audits {
+		before, err := marshalAuditToggle(audit.Before)
+		if err != nil {
+			return err
+		}
+		after, err := marshalAuditToggle(audit.After)
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(ctx, query, audit.Project, audit.Key, audit.Environment, string(audit.Action), audit.Actor, audit.Reason, audit.RequestID, before, after, audit.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createAuditConditions creates the WHERE clause which selects the project's entries
+// that satisfy the filter, along with its arguments.
+func createAuditConditions(project string, filter *entity.AuditFilter) (string, []interface{}) {
+	conds := " WHERE project = $1"
+	args := []interface{}{project}
+	if filter == nil {
+		return conds, args
+	}
+
+	if filter.Key != "" {
+		args = append(args, filter.Key)
+		conds += fmt.Sprintf(" AND toggle_key = $%d", len(args))
+	}
+	if filter.Actor != "" {
+		args = append(args, filter.Actor)
+		conds += fmt.Sprintf(" AND actor = $%d", len(args))
+	}
+	if !filter.From.IsZero() {
+		args = append(args, filter.From.UTC())
+		conds += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if !filter.To.IsZero() {
+		args = append(args, filter.To.UTC())
+		conds += fmt.Sprintf(" AND created_at < $%d", len(args))
+	}
+	return conds, args
+}
+
+// marshalAuditToggle marshals the toggle's snapshot to JSON object.
+// Nil toggle is marshaled to nil, hence it is stored as NULL.
+func marshalAuditToggle(toggle *entity.Toggle) ([]byte, error) {
+	if toggle == nil {
+		return nil, nil
+	}
+	return json.Marshal(toggle)
+}
+
+func unmarshalAuditToggle(data []byte) (*entity.Toggle, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var res entity.Toggle
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,106 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+)
+
+var (
+	testAuditColumns     = []string{"id", "project", "toggle_key", "environment", "action", "actor", "reason", "request_id", "before", "after", "created_at"}
+	testSelectAuditQuery = `SELECT id, project, toggle_key, environment, action, actor, reason, request_id, before, after, created_at FROM toggle_audits WHERE project = \$1`
+)
+
+type AuditExecutor struct {
+	audit *postgres.Audit
+	pgx   pgxmock.PgxPoolIface
+}
+
+func TestNewAudit(t *testing.T) {
+	t.Run("successfully create an instance of Audit", func(t *testing.T) {
+		exec := createAuditExecutor()
+		assert.NotNil(t, exec.audit)
+	})
+}
+
+func TestAudit_GetAll(t *testing.T) {
+	from := time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("select all query returns error", func(t *testing.T) {
+		exec := createAuditExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAuditQuery + ` ORDER BY id DESC`).
+			WillReturnError(errPostgresInternal)
+
+		res, err := exec.audit.GetAll(testCtx, testToggleProject, nil, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("malformed entries are skipped", func(t *testing.T) {
+		exec := createAuditExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAuditQuery + ` ORDER BY id DESC`).
+			WillReturnRows(pgxmock.
+				NewRows(testAuditColumns).
+				AddRow(int64(2), testToggleProject, testToggleKey, testToggleEnv, "ENABLE", "jane", "", "", []byte(`{`), []byte(`{}`), time.Now()).
+				AddRow(int64(1), testToggleProject, testToggleKey, testToggleEnv, "CREATE", "jane", "", "", nil, []byte(`{`), time.Now()),
+			)
+
+		res, err := exec.audit.GetAll(testCtx, testToggleProject, nil, nil)
+
+		assert.Nil(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("filter and pagination are applied in the query", func(t *testing.T) {
+		filter := &entity.AuditFilter{Key: testToggleKey, Actor: "jane", From: from, To: to}
+		page := &entity.AuditPagination{BeforeID: 10, Limit: 3}
+		exec := createAuditExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAuditQuery+` AND toggle_key = \$2 AND actor = \$3 AND created_at >= \$4 AND created_at < \$5 AND id < \$6 ORDER BY id DESC LIMIT \$7`).
+			WithArgs(testToggleProject, testToggleKey, "jane", from, to, int64(10), uint(3)).
+			WillReturnRows(pgxmock.
+				NewRows(testAuditColumns).
+				AddRow(int64(9), testToggleProject, testToggleKey, testToggleEnv, "ENABLE", "jane", "incident", "req-1", []byte(`{"Key":"toggle-1","IsEnabled":false}`), []byte(`{"Key":"toggle-1","IsEnabled":true}`), from).
+				AddRow(int64(8), testToggleProject, testToggleKey, testToggleEnv, "CREATE", "jane", "", "", nil, []byte(`{"Key":"toggle-1"}`), from),
+			)
+
+		res, err := exec.audit.GetAll(testCtx, testToggleProject, filter, page)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, int64(9), res[0].ID)
+		assert.Equal(t, entity.AuditActionEnable, res[0].Action)
+		assert.Equal(t, "incident", res[0].Reason)
+		assert.Equal(t, "req-1", res[0].RequestID)
+		assert.False(t, res[0].Before.IsEnabled)
+		assert.True(t, res[0].After.IsEnabled)
+		assert.Equal(t, entity.AuditActionCreate, res[1].Action)
+		assert.Nil(t, res[1].Before)
+		assert.Equal(t, testToggleKey, res[1].After.Key)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func createAuditExecutor() *AuditExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	audit := postgres.NewAudit(mock)
+	return &AuditExecutor{
+		audit: audit,
+		pgx:   mock,
+	}
+}
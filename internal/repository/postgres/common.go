@@ -14,6 +14,48 @@ type PgxPoolIface interface {
 	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
 	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
 	Ping(ctx context.Context) error
 	Close()
 }
+
+// execer defines the exec functionality shared by pgxpool.Pool and pgx.Tx.
+type execer interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// querier defines the query functionality shared by pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// beginner defines the begin functionality shared by pgxpool.Pool and pgx.Tx.
+// Beginning inside a transaction creates a savepoint.
+type beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+// withTransaction runs fn inside a transaction.
+// The transaction is committed if fn returns nil, otherwise it is rolled back.
+// If db is a transaction, fn runs inside a savepoint which is released or rolled back on its own.
+func withTransaction(ctx context.Context, db beginner, fn func(tx pgx.Tx) error) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback(ctx)
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// withBatchItem runs fn for an item of a batch inside the batch's transaction.
+// If atomic is true, fn runs directly in the transaction since any failure rolls back the whole batch.
+// Otherwise, fn runs inside its own savepoint, thus its failure only rolls back the item.
+func withBatchItem(ctx context.Context, tx pgx.Tx, atomic bool, fn func(tx pgx.Tx) error) error {
+	if atomic {
+		return fn(tx)
+	}
+	return withTransaction(ctx, tx, fn)
+}
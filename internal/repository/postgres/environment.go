@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// Environment is responsible to connect environment entity with environments table in PostgreSQL.
+type Environment struct {
+	pool PgxPoolIface
+}
+
+// NewEnvironment creates an instance of Environment.
+func NewEnvironment(pool PgxPoolIface) *Environment {
+	return &Environment{pool: pool}
+}
+
+// Insert inserts the environment into the environments table.
+// All existing toggles get the default state in the new environment: disabled, without rules, and without rollout.
+func (e *Environment) Insert(ctx context.Context, env *entity.Environment) error {
+	if env == nil {
+		return entity.ErrInvalidEnvironment()
+	}
+	env.CreatedAt = time.Now().UTC()
+
+	err := withTransaction(ctx, e.pool, func(tx pgx.Tx) error {
+		query := "INSERT INTO environments (name, description, created_at) VALUES ($1, $2, $3)"
+		if _, err := tx.Exec(ctx, query, env.Name, env.Description, env.CreatedAt); err != nil {
+			return err
+		}
+
+		query = "INSERT INTO " +
+			"toggle_states (project, toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at) " +
+			"SELECT project, key, $1, FALSE, '[]', TRUE, NULL, $2 FROM toggles"
+		_, err := tx.Exec(ctx, query, env.Name, env.CreatedAt)
+		return err
+	})
+
+	if err != nil && isUniqueViolationErr(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// GetAll gets all available environments from storage.
+// If there isn't any environment in repository, it returns empty list of environment and nil error.
+func (e *Environment) GetAll(ctx context.Context) ([]*entity.Environment, error) {
+	query := "SELECT name, description, created_at FROM environments ORDER BY id"
+	rows, err := e.pool.Query(ctx, query)
+	if err != nil {
+		return []*entity.Environment{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.Environment{}
+	for rows.Next() {
+		var tmp entity.Environment
+		if err := rows.Scan(&tmp.Name, &tmp.Description, &tmp.CreatedAt); err != nil {
+			log.Printf("[Environment-GetAll] scan rows error: %s", err.Error())
+			continue
+		}
+		res = append(res, &tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.Environment{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,182 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+)
+
+var (
+	testEnvironment                = &entity.Environment{Name: "sandbox", Description: "sandbox environment"}
+	testInsertEnvironmentQuery     = `INSERT INTO environments \(name, description, created_at\) VALUES \(\$1, \$2, \$3\)`
+	testInsertEnvironmentStates    = `INSERT INTO toggle_states \(project, toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at\) SELECT project, key, \$1, FALSE, '\[\]', TRUE, NULL, \$2 FROM toggles`
+	testSelectAllEnvironmentsQuery = `SELECT name, description, created_at FROM environments ORDER BY id`
+)
+
+type EnvironmentExecutor struct {
+	environment *postgres.Environment
+	pgx         pgxmock.PgxPoolIface
+}
+
+func TestNewEnvironment(t *testing.T) {
+	t.Run("successfully create an instance of Environment", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		assert.NotNil(t, exec.environment)
+	})
+}
+
+func TestEnvironment_Insert(t *testing.T) {
+	t.Run("nil environment is prohibited", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+
+		err := exec.environment.Insert(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+	})
+
+	t.Run("begin transaction returns error", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.ExpectBegin().WillReturnError(errPostgresInternal)
+
+		err := exec.environment.Insert(testCtx, testEnvironment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("insert duplicate environment", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertEnvironmentQuery).WillReturnError(&pgconn.PgError{Code: "23505"})
+		exec.pgx.ExpectRollback()
+
+		err := exec.environment.Insert(testCtx, testEnvironment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("insert toggle states returns internal error", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertEnvironmentQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertEnvironmentStates).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.environment.Insert(testCtx, testEnvironment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("commit returns error", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertEnvironmentQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertEnvironmentStates).WillReturnResult(pgxmock.NewResult("INSERT", 3))
+		exec.pgx.ExpectCommit().WillReturnError(errPostgresInternal)
+
+		err := exec.environment.Insert(testCtx, testEnvironment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("success insert a new environment", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertEnvironmentQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertEnvironmentStates).WillReturnResult(pgxmock.NewResult("INSERT", 3))
+		exec.pgx.ExpectCommit()
+
+		err := exec.environment.Insert(testCtx, testEnvironment)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestEnvironment_GetAll(t *testing.T) {
+	t.Run("select all query returns error", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.ExpectQuery(testSelectAllEnvironmentsQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.environment.GetAll(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("select all rows scan returns error", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllEnvironmentsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at"}).
+				AddRow("production", "production environment", time.Now()).
+				AddRow("staging", "staging environment", "time.Now()"),
+			)
+
+		res, err := exec.environment.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+	})
+
+	t.Run("select all rows error occurs after scanning", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllEnvironmentsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at"}).
+				AddRow("production", "production environment", time.Now()).
+				AddRow("staging", "staging environment", time.Now()).
+				RowError(2, errPostgresInternal),
+			)
+
+		res, err := exec.environment.GetAll(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve all rows", func(t *testing.T) {
+		exec := createEnvironmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllEnvironmentsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at"}).
+				AddRow("production", "production environment", time.Now()).
+				AddRow("staging", "staging environment", time.Now()),
+			)
+
+		res, err := exec.environment.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "production", res[0].Name)
+	})
+}
+
+func createEnvironmentExecutor() *EnvironmentExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	environment := postgres.NewEnvironment(mock)
+	return &EnvironmentExecutor{
+		environment: environment,
+		pgx:         mock,
+	}
+}
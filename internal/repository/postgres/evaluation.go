@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// Evaluation is responsible to connect evaluation count entity with toggle_evaluations table in PostgreSQL.
+type Evaluation struct {
+	pool PgxPoolIface
+}
+
+// NewEvaluation creates an instance of Evaluation.
+func NewEvaluation(pool PgxPoolIface) *Evaluation {
+	return &Evaluation{pool: pool}
+}
+
+// Increment adds the counts to the counts of the same toggle, variant, and time window in the project's environment
+// in a single transaction. The last evaluation time becomes the latest of both.
+func (e *Evaluation) Increment(ctx context.Context, project, env string, counts []*entity.EvaluationCount) error {
+	query := "INSERT INTO " +
+		"toggle_evaluations (project, environment, toggle_key, variant, window_start, count, last_evaluated_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7) " +
+		"ON CONFLICT (project, environment, toggle_key, variant, window_start) DO UPDATE SET " +
+		"count = toggle_evaluations.count + EXCLUDED.count, " +
+		"last_evaluated_at = GREATEST(toggle_evaluations.last_evaluated_at, EXCLUDED.last_evaluated_at)"
+
+	err := withTransaction(ctx, e.pool, func(tx pgx.Tx) error {
+		for _, count := range counts {
+			if _, err := tx.Exec(ctx, query, project, env, count.Key, count.Variant, count.WindowStart.UTC(), count.Count, count.LastEvaluatedAt.UTC()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// Sum sums the counts in the project's environment per toggle and variant, ordered by key and variant.
+// Empty keys means all toggles. The time windows start from since, unless it is zero.
+// If there isn't any count, it returns empty list of count and nil error.
+func (e *Evaluation) Sum(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.EvaluationCount, error) {
+	query := "SELECT toggle_key, variant, SUM(count)::BIGINT, MAX(last_evaluated_at) FROM toggle_evaluations WHERE project = $1 AND environment = $2"
+	args := []interface{}{project, env}
+	if len(keys) > 0 {
+		args = append(args, keys)
+		query += fmt.Sprintf(" AND toggle_key = ANY($%d)", len(args))
+	}
+	if !since.IsZero() {
+		args = append(args, since.UTC())
+		query += fmt.Sprintf(" AND window_start >= $%d", len(args))
+	}
+	query += " GROUP BY toggle_key, variant ORDER BY toggle_key, variant"
+
+	rows, err := e.pool.Query(ctx, query, args...)
+	if err != nil {
+		return []*entity.EvaluationCount{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.EvaluationCount{}
+	for rows.Next() {
+		var tmp entity.EvaluationCount
+		if err := rows.Scan(&tmp.Key, &tmp.Variant, &tmp.Count, &tmp.LastEvaluatedAt); err != nil {
+			log.Printf("[Evaluation-Sum] scan rows error: %s", err.Error())
+			continue
+		}
+		res = append(res, &tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.EvaluationCount{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
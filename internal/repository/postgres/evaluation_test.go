@@ -0,0 +1,122 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+)
+
+var (
+	testEvaluationColumns     = []string{"toggle_key", "variant", "sum", "max"}
+	testIncrementEvaluation   = `INSERT INTO toggle_evaluations \(project, environment, toggle_key, variant, window_start, count, last_evaluated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) ON CONFLICT`
+	testSumEvaluationQuery    = `SELECT toggle_key, variant, SUM\(count\)::BIGINT, MAX\(last_evaluated_at\) FROM toggle_evaluations WHERE project = \$1 AND environment = \$2`
+	testSumEvaluationGrouping = ` GROUP BY toggle_key, variant ORDER BY toggle_key, variant`
+)
+
+type EvaluationExecutor struct {
+	evaluation *postgres.Evaluation
+	pgx        pgxmock.PgxPoolIface
+}
+
+func TestNewEvaluation(t *testing.T) {
+	t.Run("successfully create an instance of Evaluation", func(t *testing.T) {
+		exec := createEvaluationExecutor()
+		assert.NotNil(t, exec.evaluation)
+	})
+}
+
+func TestEvaluation_Increment(t *testing.T) {
+	window := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC)
+	counts := []*entity.EvaluationCount{
+		{Key: testToggleKey, Variant: "true", WindowStart: window, Count: 3, LastEvaluatedAt: window.Add(time.Minute)},
+		{Key: testToggleKey, Variant: "false", WindowStart: window, Count: 1, LastEvaluatedAt: window},
+	}
+
+	t.Run("insert query returns error and the transaction is rolled back", func(t *testing.T) {
+		exec := createEvaluationExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testIncrementEvaluation).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testIncrementEvaluation).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.evaluation.Increment(testCtx, testToggleProject, testToggleEnv, counts)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("every count is upserted in a transaction", func(t *testing.T) {
+		exec := createEvaluationExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testIncrementEvaluation).
+			WithArgs(testToggleProject, testToggleEnv, testToggleKey, "true", window, int64(3), window.Add(time.Minute)).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testIncrementEvaluation).
+			WithArgs(testToggleProject, testToggleEnv, testToggleKey, "false", window, int64(1), window).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.evaluation.Increment(testCtx, testToggleProject, testToggleEnv, counts)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestEvaluation_Sum(t *testing.T) {
+	since := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC)
+
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createEvaluationExecutor()
+		exec.pgx.
+			ExpectQuery(testSumEvaluationQuery + testSumEvaluationGrouping).
+			WillReturnError(errPostgresInternal)
+
+		res, err := exec.evaluation.Sum(testCtx, testToggleProject, testToggleEnv, nil, time.Time{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("keys and time are applied in the query", func(t *testing.T) {
+		exec := createEvaluationExecutor()
+		exec.pgx.
+			ExpectQuery(testSumEvaluationQuery+` AND toggle_key = ANY\(\$3\) AND window_start >= \$4`+testSumEvaluationGrouping).
+			WithArgs(testToggleProject, testToggleEnv, []string{testToggleKey}, since).
+			WillReturnRows(pgxmock.
+				NewRows(testEvaluationColumns).
+				AddRow(testToggleKey, "false", int64(2), since).
+				AddRow(testToggleKey, "true", int64(5), since.Add(time.Hour)),
+			)
+
+		res, err := exec.evaluation.Sum(testCtx, testToggleProject, testToggleEnv, []string{testToggleKey}, since)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.EvaluationCount{
+			{Key: testToggleKey, Variant: "false", Count: 2, LastEvaluatedAt: since},
+			{Key: testToggleKey, Variant: "true", Count: 5, LastEvaluatedAt: since.Add(time.Hour)},
+		}, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func createEvaluationExecutor() *EvaluationExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	evaluation := postgres.NewEvaluation(mock)
+	return &EvaluationExecutor{
+		evaluation: evaluation,
+		pgx:        mock,
+	}
+}
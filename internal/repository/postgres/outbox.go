@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// Outbox is responsible to connect outbox event entity with toggle_outbox table in PostgreSQL.
+// Its events are inserted by Toggle in the same transaction as the toggle's change
+// and are removed once they have been published.
+type Outbox struct {
+	pool PgxPoolIface
+}
+
+// NewOutbox creates an instance of Outbox.
+func NewOutbox(pool PgxPoolIface) *Outbox {
+	return &Outbox{pool: pool}
+}
+
+// ClaimPending claims at most limit events which are available at now and returns them sorted by their IDs.
+// Only the oldest event of each toggle is claimed, hence a toggle's event is never claimed before its previous events are removed.
+// The claimed events aren't available until lease has passed, so that the events claimed by a crashed relay are eventually claimed again.
+// Rows locked by another claimer are skipped and the availability is checked once more after locking,
+// hence an event is only ever returned to one claimer even if many server replicas claim concurrently.
+// An event which can't be unmarshalled is still returned as malformed, so that it can be settled as well.
+func (o *Outbox) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	query := "UPDATE toggle_outbox SET attempts = attempts + 1, available_at = $2 " +
+		"WHERE available_at <= $1 AND id IN (" +
+		"SELECT id FROM toggle_outbox AS pending WHERE available_at <= $1 AND NOT EXISTS (" +
+		"SELECT 1 FROM toggle_outbox AS previous WHERE previous.project = pending.project AND previous.toggle_key = pending.toggle_key AND previous.id < pending.id) " +
+		"ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED) " +
+		"RETURNING id, project, toggle_key, event, attempts, created_at"
+	rows, err := o.pool.Query(ctx, query, now.UTC(), now.Add(lease).UTC(), limit)
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.OutboxEvent{}
+	for rows.Next() {
+		var tmp entity.OutboxEvent
+		var event []byte
+		if err := rows.Scan(&tmp.ID, &tmp.Project, &tmp.Key, &event, &tmp.Attempts, &tmp.CreatedAt); err != nil {
+			return nil, entity.ErrInternal(err.Error())
+		}
+		tmp.Event = &togglev1.ToggleEvent{}
+		if err := protojson.Unmarshal(event, tmp.Event); err != nil {
+			log.Printf("[Outbox-ClaimPending] unmarshal event %d error: %s", tmp.ID, err.Error())
+			tmp.Event = nil
+			tmp.Malformed = err.Error()
+		}
+		res = append(res, &tmp)
+	}
+	if rows.Err() != nil {
+		return nil, entity.ErrInternal(rows.Err().Error())
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res, nil
+}
+
+// Delete removes the published event from the toggle_outbox table.
+// If the event doesn't exist, it doesn't returns error.
+func (o *Outbox) Delete(ctx context.Context, id int64) error {
+	query := "DELETE FROM toggle_outbox WHERE id = $1"
+	if _, err := o.pool.Exec(ctx, query, id); err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// Release makes the event which failed to be published available again at the given time.
+// The failure's reason is kept for troubleshooting.
+func (o *Outbox) Release(ctx context.Context, id int64, availableAt time.Time, reason string) error {
+	query := "UPDATE toggle_outbox SET available_at = $1, last_error = $2 WHERE id = $3"
+	if _, err := o.pool.Exec(ctx, query, availableAt.UTC(), reason, id); err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// DeadLetter moves the event which can't be published from the toggle_outbox table to the toggle_outbox_dead_letters table
+// along with the reason, so that it no longer holds back the next events of its toggle.
+// If the event doesn't exist, it doesn't returns error.
+func (o *Outbox) DeadLetter(ctx context.Context, id int64, reason string) error {
+	query := "WITH dead AS (DELETE FROM toggle_outbox WHERE id = $1 RETURNING id, project, toggle_key, event, attempts, created_at) " +
+		"INSERT INTO toggle_outbox_dead_letters (id, project, toggle_key, event, attempts, last_error, created_at, dead_at) " +
+		"SELECT id, project, toggle_key, event, attempts, $2, created_at, $3 FROM dead"
+	if _, err := o.pool.Exec(ctx, query, id, reason, time.Now().UTC()); err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// insertOutbox inserts the events into the toggle_outbox table.
+// It is meant to be called within the transaction which changes the toggle,
+// hence the events are published if and only if the change is committed.
+func insertOutbox(ctx context.Context, db execer, events ...*togglev1.ToggleEvent) error {
+	query := "INSERT INTO " +
+		"toggle_outbox (project, toggle_key, event, available_at, created_at) " +
+		"VALUES ($1, $2, $3, $4, $4)"
+	for _, event := range events {
+		tmp := entity.NewOutboxEvent(event)
+		data, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if _, err := db.Exec(ctx, query, tmp.Project, tmp.Key, data, tmp.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
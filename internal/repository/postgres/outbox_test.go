@@ -0,0 +1,192 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+var (
+	testOutboxColumns     = []string{"id", "project", "toggle_key", "event", "attempts", "created_at"}
+	testClaimOutboxQuery  = `UPDATE toggle_outbox SET attempts = attempts \+ 1, available_at = \$2 WHERE available_at <= \$1 AND id IN \(SELECT id FROM toggle_outbox AS pending .+ FOR UPDATE SKIP LOCKED\) RETURNING id, project, toggle_key, event, attempts, created_at`
+	testDeleteOutboxQuery = `DELETE FROM toggle_outbox WHERE id = \$1`
+	testReleaseOutbox     = `UPDATE toggle_outbox SET available_at = \$1, last_error = \$2 WHERE id = \$3`
+	testDeadLetterOutbox  = `WITH dead AS \(DELETE FROM toggle_outbox WHERE id = \$1 RETURNING id, project, toggle_key, event, attempts, created_at\) INSERT INTO toggle_outbox_dead_letters \(id, project, toggle_key, event, attempts, last_error, created_at, dead_at\) SELECT id, project, toggle_key, event, attempts, \$2, created_at, \$3 FROM dead`
+)
+
+type OutboxExecutor struct {
+	outbox *postgres.Outbox
+	pgx    pgxmock.PgxPoolIface
+}
+
+func TestNewOutbox(t *testing.T) {
+	t.Run("successfully create an instance of Outbox", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		assert.NotNil(t, exec.outbox)
+	})
+}
+
+func TestOutbox_ClaimPending(t *testing.T) {
+	now := time.Date(2022, time.February, 10, 8, 0, 0, 0, time.UTC)
+	lease := 30 * time.Second
+
+	t.Run("claim query returns error", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectQuery(testClaimOutboxQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.outbox.ClaimPending(testCtx, now, lease, 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rows error after scanning", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.
+			ExpectQuery(testClaimOutboxQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testOutboxColumns).
+				AddRow(int64(1), testToggleProject, testToggleKey, []byte(`{"name":"TOGGLE_EVENT_NAME_ENABLED"}`), 1, now).
+				RowError(1, errPostgresInternal),
+			)
+
+		res, err := exec.outbox.ClaimPending(testCtx, now, lease, 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("scan rows returns error", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.
+			ExpectQuery(testClaimOutboxQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testOutboxColumns).
+				AddRow("id", testToggleProject, testToggleKey, []byte(`{}`), 1, now),
+			)
+
+		res, err := exec.outbox.ClaimPending(testCtx, now, lease, 10)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("malformed events are returned without event and all events are sorted by id", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.
+			ExpectQuery(testClaimOutboxQuery).
+			WithArgs(now, now.Add(lease), 10).
+			WillReturnRows(pgxmock.
+				NewRows(testOutboxColumns).
+				AddRow(int64(3), testToggleProject, "toggle-3", []byte(`{"name":"TOGGLE_EVENT_NAME_DISABLED"}`), 2, now).
+				AddRow(int64(2), testToggleProject, "toggle-2", []byte(`{`), 1, now).
+				AddRow(int64(1), testToggleProject, "toggle-1", []byte(`{"name":"TOGGLE_EVENT_NAME_ENABLED"}`), 1, now),
+			)
+
+		res, err := exec.outbox.ClaimPending(testCtx, now, lease, 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(res))
+		assert.Equal(t, int64(1), res[0].ID)
+		assert.Equal(t, "toggle-1", res[0].Key)
+		assert.Equal(t, togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED, res[0].Event.GetName())
+		assert.Empty(t, res[0].Malformed)
+		assert.Equal(t, int64(2), res[1].ID)
+		assert.Nil(t, res[1].Event)
+		assert.NotEmpty(t, res[1].Malformed)
+		assert.Equal(t, int64(3), res[2].ID)
+		assert.Equal(t, 2, res[2].Attempts)
+		assert.Equal(t, togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DISABLED, res[2].Event.GetName())
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestOutbox_Delete(t *testing.T) {
+	t.Run("delete query returns error", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectExec(testDeleteOutboxQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.outbox.Delete(testCtx, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("successfully delete the event", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectExec(testDeleteOutboxQuery).WithArgs(int64(1)).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		err := exec.outbox.Delete(testCtx, 1)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestOutbox_Release(t *testing.T) {
+	availableAt := time.Date(2022, time.February, 10, 8, 0, 0, 0, time.UTC)
+
+	t.Run("update query returns error", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectExec(testReleaseOutbox).WillReturnError(errPostgresInternal)
+
+		err := exec.outbox.Release(testCtx, 1, availableAt, "broker down")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("successfully release the event", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectExec(testReleaseOutbox).WithArgs(availableAt, "broker down", int64(1)).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := exec.outbox.Release(testCtx, 1, availableAt, "broker down")
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestOutbox_DeadLetter(t *testing.T) {
+	t.Run("dead letter query returns error", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectExec(testDeadLetterOutbox).WillReturnError(errPostgresInternal)
+
+		err := exec.outbox.DeadLetter(testCtx, 1, "unexpected EOF")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("successfully move the event to the dead letters", func(t *testing.T) {
+		exec := createOutboxExecutor()
+		exec.pgx.ExpectExec(testDeadLetterOutbox).WithArgs(int64(1), "unexpected EOF", pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := exec.outbox.DeadLetter(testCtx, 1, "unexpected EOF")
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func createOutboxExecutor() *OutboxExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	outbox := postgres.NewOutbox(mock)
+	return &OutboxExecutor{
+		outbox: outbox,
+		pgx:    mock,
+	}
+}
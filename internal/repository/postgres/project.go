@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	selectProjectQuery = "SELECT name, description, created_at, updated_at FROM projects"
+)
+
+// Project is responsible to connect project entity with projects table in PostgreSQL.
+type Project struct {
+	pool PgxPoolIface
+}
+
+// NewProject creates an instance of Project.
+func NewProject(pool PgxPoolIface) *Project {
+	return &Project{pool: pool}
+}
+
+// Insert inserts the project into the projects table.
+func (p *Project) Insert(ctx context.Context, project *entity.Project) error {
+	if project == nil {
+		return entity.ErrInvalidProject()
+	}
+	project.CreatedAt = time.Now().UTC()
+	project.UpdatedAt = time.Now().UTC()
+
+	query := "INSERT INTO projects (name, description, created_at, updated_at) VALUES ($1, $2, $3, $4)"
+	_, err := p.pool.Exec(ctx, query, project.Name, project.Description, project.CreatedAt, project.UpdatedAt)
+	if err != nil && isUniqueViolationErr(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// GetByName gets a project from database.
+// It returns entity.ErrProjectNotFound if project can't be found.
+func (p *Project) GetByName(ctx context.Context, name string) (*entity.Project, error) {
+	query := selectProjectQuery + " WHERE name = $1 LIMIT 1"
+	row := p.pool.QueryRow(ctx, query, name)
+
+	var res entity.Project
+	err := row.Scan(&res.Name, &res.Description, &res.CreatedAt, &res.UpdatedAt)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrProjectNotFound()
+	}
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return &res, nil
+}
+
+// GetAll gets all available projects from storage.
+// If there isn't any project in repository, it returns empty list of project and nil error.
+func (p *Project) GetAll(ctx context.Context) ([]*entity.Project, error) {
+	query := selectProjectQuery + " ORDER BY id"
+	rows, err := p.pool.Query(ctx, query)
+	if err != nil {
+		return []*entity.Project{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.Project{}
+	for rows.Next() {
+		var tmp entity.Project
+		if err := rows.Scan(&tmp.Name, &tmp.Description, &tmp.CreatedAt, &tmp.UpdatedAt); err != nil {
+			log.Printf("[Project-GetAll] scan rows error: %s", err.Error())
+			continue
+		}
+		res = append(res, &tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.Project{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
+
+// Update updates the project's description in the projects table.
+// It returns entity.ErrProjectNotFound if project can't be found.
+func (p *Project) Update(ctx context.Context, project *entity.Project) error {
+	if project == nil {
+		return entity.ErrInvalidProject()
+	}
+	project.UpdatedAt = time.Now().UTC()
+
+	query := "UPDATE projects SET description = $1, updated_at = $2 WHERE name = $3"
+	tag, err := p.pool.Exec(ctx, query, project.Description, project.UpdatedAt, project.Name)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrProjectNotFound()
+	}
+	return nil
+}
+
+// DeleteByName deletes a project from the projects table.
+// It returns entity.ErrProjectNotFound if project can't be found
+// and entity.ErrProjectNotEmpty if the project still owns toggles or segments.
+func (p *Project) DeleteByName(ctx context.Context, name string) error {
+	query := "DELETE FROM projects WHERE name = $1"
+	tag, err := p.pool.Exec(ctx, query, name)
+	if err != nil && isForeignKeyViolationErr(err) {
+		return entity.ErrProjectNotEmpty()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrProjectNotFound()
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+)
+
+var (
+	testProject                = &entity.Project{Name: "payment", Description: "payment team"}
+	testInsertProjectQuery     = `INSERT INTO projects \(name, description, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4\)`
+	testSelectProjectQuery     = `SELECT name, description, created_at, updated_at FROM projects WHERE name = \$1 LIMIT 1`
+	testSelectAllProjectsQuery = `SELECT name, description, created_at, updated_at FROM projects ORDER BY id`
+	testUpdateProjectQuery     = `UPDATE projects SET description = \$1, updated_at = \$2 WHERE name = \$3`
+	testDeleteProjectQuery     = `DELETE FROM projects WHERE name = \$1`
+)
+
+type ProjectExecutor struct {
+	project *postgres.Project
+	pgx     pgxmock.PgxPoolIface
+}
+
+func TestNewProject(t *testing.T) {
+	t.Run("successfully create an instance of Project", func(t *testing.T) {
+		exec := createProjectExecutor()
+		assert.NotNil(t, exec.project)
+	})
+}
+
+func TestProject_Insert(t *testing.T) {
+	t.Run("nil project is prohibited", func(t *testing.T) {
+		exec := createProjectExecutor()
+
+		err := exec.project.Insert(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+	})
+
+	t.Run("insert duplicate project", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testInsertProjectQuery).WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		err := exec.project.Insert(testCtx, testProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testInsertProjectQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.project.Insert(testCtx, testProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("success insert a new project", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testInsertProjectQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := exec.project.Insert(testCtx, testProject)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestProject_GetByName(t *testing.T) {
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectQuery(testSelectProjectQuery).WillReturnError(pgx.ErrNoRows)
+
+		res, err := exec.project.GetByName(testCtx, testProject.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectQuery(testSelectProjectQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.project.GetByName(testCtx, testProject.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully retrieve a project", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectProjectQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at", "updated_at"}).
+				AddRow(testProject.Name, testProject.Description, time.Now(), time.Now()),
+			)
+
+		res, err := exec.project.GetByName(testCtx, testProject.Name)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testProject.Name, res.Name)
+	})
+}
+
+func TestProject_GetAll(t *testing.T) {
+	t.Run("select all query returns error", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectQuery(testSelectAllProjectsQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.project.GetAll(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("select all rows scan returns error", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllProjectsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at", "updated_at"}).
+				AddRow("default", "default project", time.Now(), time.Now()).
+				AddRow("payment", "payment team", "time.Now()", time.Now()),
+			)
+
+		res, err := exec.project.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+	})
+
+	t.Run("select all rows error occurs after scanning", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllProjectsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at", "updated_at"}).
+				AddRow("default", "default project", time.Now(), time.Now()).
+				AddRow("payment", "payment team", time.Now(), time.Now()).
+				RowError(2, errPostgresInternal),
+			)
+
+		res, err := exec.project.GetAll(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve all rows", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllProjectsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"name", "description", "created_at", "updated_at"}).
+				AddRow("default", "default project", time.Now(), time.Now()).
+				AddRow("payment", "payment team", time.Now(), time.Now()),
+			)
+
+		res, err := exec.project.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "default", res[0].Name)
+	})
+}
+
+func TestProject_Update(t *testing.T) {
+	t.Run("nil project is prohibited", func(t *testing.T) {
+		exec := createProjectExecutor()
+
+		err := exec.project.Update(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testUpdateProjectQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.project.Update(testCtx, testProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testUpdateProjectQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := exec.project.Update(testCtx, testProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+	})
+
+	t.Run("success update a project", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testUpdateProjectQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := exec.project.Update(testCtx, testProject)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestProject_DeleteByName(t *testing.T) {
+	t.Run("project still owns toggles", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testDeleteProjectQuery).WillReturnError(&pgconn.PgError{Code: "23503"})
+
+		err := exec.project.DeleteByName(testCtx, testProject.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotEmpty(), err)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testDeleteProjectQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.project.DeleteByName(testCtx, testProject.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testDeleteProjectQuery).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := exec.project.DeleteByName(testCtx, testProject.Name)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+	})
+
+	t.Run("success delete a project", func(t *testing.T) {
+		exec := createProjectExecutor()
+		exec.pgx.ExpectExec(testDeleteProjectQuery).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		err := exec.project.DeleteByName(testCtx, testProject.Name)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createProjectExecutor() *ProjectExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	project := postgres.NewProject(mock)
+	return &ProjectExecutor{
+		project: project,
+		pgx:     mock,
+	}
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	scheduleStatusPending  = "PENDING"
+	scheduleStatusExecuted = "EXECUTED"
+	scheduleStatusFailed   = "FAILED"
+
+	scheduleColumns = "id, project, toggle_key, environment, action, execute_at, created_at"
+)
+
+// Schedule is responsible to connect schedule entity with toggle_schedules table in PostgreSQL.
+type Schedule struct {
+	pool PgxPoolIface
+}
+
+// NewSchedule creates an instance of Schedule.
+func NewSchedule(pool PgxPoolIface) *Schedule {
+	return &Schedule{pool: pool}
+}
+
+// Insert inserts the schedule into the toggle_schedules table as a pending schedule.
+// It sets the schedule's ID and CreatedAt.
+// It returns entity.ErrNotFound if the toggle doesn't exist in the project's environment.
+func (s *Schedule) Insert(ctx context.Context, schedule *entity.Schedule) error {
+	if schedule == nil {
+		return entity.ErrInvalidSchedule("empty or nil")
+	}
+	schedule.CreatedAt = time.Now().UTC()
+
+	query := "INSERT INTO " +
+		"toggle_schedules (project, toggle_key, environment, action, status, execute_at, created_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id"
+	row := s.pool.QueryRow(ctx, query, schedule.Project, schedule.Key, schedule.Environment, string(schedule.Action), scheduleStatusPending, schedule.ExecuteAt.UTC(), schedule.CreatedAt)
+
+	err := row.Scan(&schedule.ID)
+	if err != nil && isForeignKeyViolationErr(err) {
+		return entity.ErrNotFound()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// GetAllPending gets all pending schedules of the toggle in the project's environment ordered by their execution time.
+// If there isn't any pending schedule, it returns empty list of schedule and nil error.
+func (s *Schedule) GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error) {
+	query := "SELECT " + scheduleColumns + " FROM toggle_schedules " +
+		"WHERE project = $1 AND environment = $2 AND toggle_key = $3 AND status = $4 ORDER BY execute_at, id"
+	return s.getMany(ctx, "GetAllPending", query, project, env, key, scheduleStatusPending)
+}
+
+// DeletePending deletes a pending schedule of the toggle in the project's environment.
+// It returns entity.ErrScheduleNotFound if the schedule can't be found or it isn't pending anymore.
+func (s *Schedule) DeletePending(ctx context.Context, project, env, key string, id int64) error {
+	query := "DELETE FROM toggle_schedules WHERE id = $1 AND project = $2 AND environment = $3 AND toggle_key = $4 AND status = $5"
+	tag, err := s.pool.Exec(ctx, query, id, project, env, key, scheduleStatusPending)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrScheduleNotFound()
+	}
+	return nil
+}
+
+// ClaimDue marks at most limit pending schedules whose execution time has passed as executed and returns them.
+// Rows locked by another claimer are skipped and the status is checked once more after locking,
+// hence a schedule is only ever returned to one claimer even if many server replicas claim concurrently.
+func (s *Schedule) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.Schedule, error) {
+	query := "UPDATE toggle_schedules SET status = $1, executed_at = $2 " +
+		"WHERE status = $3 AND id IN (" +
+		"SELECT id FROM toggle_schedules WHERE status = $3 AND execute_at <= $2 ORDER BY execute_at, id LIMIT $4 FOR UPDATE SKIP LOCKED) " +
+		"RETURNING " + scheduleColumns
+	res, err := s.getMany(ctx, "ClaimDue", query, scheduleStatusExecuted, now.UTC(), scheduleStatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	sortSchedules(res)
+	return res, nil
+}
+
+// MarkFailed marks the claimed schedule as failed.
+func (s *Schedule) MarkFailed(ctx context.Context, id int64) error {
+	query := "UPDATE toggle_schedules SET status = $1 WHERE id = $2"
+	if _, err := s.pool.Exec(ctx, query, scheduleStatusFailed, id); err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+func (s *Schedule) getMany(ctx context.Context, method, query string, args ...interface{}) ([]*entity.Schedule, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return []*entity.Schedule{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.Schedule{}
+	for rows.Next() {
+		tmp, err := scanSchedule(rows)
+		if err != nil {
+			log.Printf("[Schedule-%s] scan rows error: %s", method, err.Error())
+			continue
+		}
+		res = append(res, tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.Schedule{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
+
+func scanSchedule(row pgx.Row) (*entity.Schedule, error) {
+	var res entity.Schedule
+	var action string
+	if err := row.Scan(&res.ID, &res.Project, &res.Key, &res.Environment, &action, &res.ExecuteAt, &res.CreatedAt); err != nil {
+		return nil, err
+	}
+	res.Action = entity.ScheduleAction(action)
+	return &res, nil
+}
+
+// sortSchedules sorts the schedules by their execution time
+// since RETURNING doesn't guarantee the order of the updated rows.
+func sortSchedules(schedules []*entity.Schedule) {
+	sort.SliceStable(schedules, func(i, j int) bool {
+		if schedules[i].ExecuteAt.Equal(schedules[j].ExecuteAt) {
+			return schedules[i].ID < schedules[j].ID
+		}
+		return schedules[i].ExecuteAt.Before(schedules[j].ExecuteAt)
+	})
+}
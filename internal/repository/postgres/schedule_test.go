@@ -0,0 +1,249 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+)
+
+var (
+	testSchedule = &entity.Schedule{
+		Key:         "toggle-1",
+		Project:     "payment",
+		Environment: "production",
+		Action:      entity.ScheduleActionEnable,
+		ExecuteAt:   time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC),
+	}
+	testScheduleColumns         = []string{"id", "project", "toggle_key", "environment", "action", "execute_at", "created_at"}
+	testInsertScheduleQuery     = `INSERT INTO toggle_schedules \(project, toggle_key, environment, action, status, execute_at, created_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\) RETURNING id`
+	testSelectPendingSchedules  = `SELECT id, project, toggle_key, environment, action, execute_at, created_at FROM toggle_schedules WHERE project = \$1 AND environment = \$2 AND toggle_key = \$3 AND status = \$4 ORDER BY execute_at, id`
+	testDeletePendingSchedule   = `DELETE FROM toggle_schedules WHERE id = \$1 AND project = \$2 AND environment = \$3 AND toggle_key = \$4 AND status = \$5`
+	testClaimDueSchedulesQuery  = `UPDATE toggle_schedules SET status = \$1, executed_at = \$2 WHERE status = \$3 AND id IN \(SELECT id FROM toggle_schedules WHERE status = \$3 AND execute_at <= \$2 ORDER BY execute_at, id LIMIT \$4 FOR UPDATE SKIP LOCKED\) RETURNING id, project, toggle_key, environment, action, execute_at, created_at`
+	testMarkFailedScheduleQuery = `UPDATE toggle_schedules SET status = \$1 WHERE id = \$2`
+)
+
+type ScheduleExecutor struct {
+	schedule *postgres.Schedule
+	pgx      pgxmock.PgxPoolIface
+}
+
+func TestNewSchedule(t *testing.T) {
+	t.Run("successfully create an instance of Schedule", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		assert.NotNil(t, exec.schedule)
+	})
+}
+
+func TestSchedule_Insert(t *testing.T) {
+	t.Run("nil schedule is prohibited", func(t *testing.T) {
+		exec := createScheduleExecutor()
+
+		err := exec.schedule.Insert(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("empty or nil"), err)
+	})
+
+	t.Run("toggle doesn't exist in the environment", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectQuery(testInsertScheduleQuery).WillReturnError(&pgconn.PgError{Code: "23503"})
+
+		err := exec.schedule.Insert(testCtx, testSchedule)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectQuery(testInsertScheduleQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.schedule.Insert(testCtx, testSchedule)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("success insert a new schedule", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectQuery(testInsertScheduleQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+
+		schedule := *testSchedule
+		err := exec.schedule.Insert(testCtx, &schedule)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(7), schedule.ID)
+		assert.False(t, schedule.CreatedAt.IsZero())
+	})
+}
+
+func TestSchedule_GetAllPending(t *testing.T) {
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectQuery(testSelectPendingSchedules).WillReturnError(errPostgresInternal)
+
+		res, err := exec.schedule.GetAllPending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("select rows scan returns error", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectPendingSchedules).
+			WillReturnRows(pgxmock.
+				NewRows(testScheduleColumns).
+				AddRow(int64(1), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "ENABLE", time.Now(), time.Now()).
+				AddRow(int64(2), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "DISABLE", "time.Now()", time.Now()),
+			)
+
+		res, err := exec.schedule.GetAllPending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+	})
+
+	t.Run("select rows error occurs after scanning", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectPendingSchedules).
+			WillReturnRows(pgxmock.
+				NewRows(testScheduleColumns).
+				AddRow(int64(1), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "ENABLE", time.Now(), time.Now()).
+				AddRow(int64(2), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "DISABLE", time.Now(), time.Now()).
+				RowError(2, errPostgresInternal),
+			)
+
+		res, err := exec.schedule.GetAllPending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve all pending schedules", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectPendingSchedules).
+			WillReturnRows(pgxmock.
+				NewRows(testScheduleColumns).
+				AddRow(int64(1), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "ENABLE", time.Now(), time.Now()).
+				AddRow(int64(2), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "DISABLE", time.Now(), time.Now()),
+			)
+
+		res, err := exec.schedule.GetAllPending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, entity.ScheduleActionEnable, res[0].Action)
+		assert.Equal(t, entity.ScheduleActionDisable, res[1].Action)
+	})
+}
+
+func TestSchedule_DeletePending(t *testing.T) {
+	t.Run("delete query returns error", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectExec(testDeletePendingSchedule).WillReturnError(errPostgresInternal)
+
+		err := exec.schedule.DeletePending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("pending schedule is not found", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectExec(testDeletePendingSchedule).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := exec.schedule.DeletePending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrScheduleNotFound(), err)
+	})
+
+	t.Run("successfully delete a pending schedule", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectExec(testDeletePendingSchedule).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		err := exec.schedule.DeletePending(testCtx, testSchedule.Project, testSchedule.Environment, testSchedule.Key, 1)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestSchedule_ClaimDue(t *testing.T) {
+	t.Run("update query returns error", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectQuery(testClaimDueSchedulesQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.schedule.ClaimDue(testCtx, time.Now(), 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully claim due schedules ordered by execution time", func(t *testing.T) {
+		now := time.Now()
+		exec := createScheduleExecutor()
+		exec.pgx.
+			ExpectQuery(testClaimDueSchedulesQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testScheduleColumns).
+				AddRow(int64(3), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "DISABLE", now.Add(-time.Minute), now).
+				AddRow(int64(2), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "ENABLE", now.Add(-time.Hour), now).
+				AddRow(int64(1), testSchedule.Project, testSchedule.Key, testSchedule.Environment, "DISABLE", now.Add(-time.Minute), now),
+			)
+
+		res, err := exec.schedule.ClaimDue(testCtx, now, 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(res))
+		assert.Equal(t, int64(2), res[0].ID)
+		assert.Equal(t, int64(1), res[1].ID)
+		assert.Equal(t, int64(3), res[2].ID)
+	})
+}
+
+func TestSchedule_MarkFailed(t *testing.T) {
+	t.Run("update query returns error", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectExec(testMarkFailedScheduleQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.schedule.MarkFailed(testCtx, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("successfully mark a schedule as failed", func(t *testing.T) {
+		exec := createScheduleExecutor()
+		exec.pgx.ExpectExec(testMarkFailedScheduleQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := exec.schedule.MarkFailed(testCtx, 1)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createScheduleExecutor() *ScheduleExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	schedule := postgres.NewSchedule(mock)
+	return &ScheduleExecutor{
+		schedule: schedule,
+		pgx:      mock,
+	}
+}
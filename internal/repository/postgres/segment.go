@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	selectSegmentQuery = "SELECT key, project, description, included, excluded, rules, created_at, updated_at FROM segments"
+)
+
+// Segment is responsible to connect segment entity with segments table in PostgreSQL.
+type Segment struct {
+	pool PgxPoolIface
+}
+
+// NewSegment creates an instance of Segment.
+func NewSegment(pool PgxPoolIface) *Segment {
+	return &Segment{pool: pool}
+}
+
+// Insert inserts the segment into the segments table.
+// It returns entity.ErrProjectNotFound if the segment's project doesn't exist.
+func (s *Segment) Insert(ctx context.Context, segment *entity.Segment) error {
+	if segment == nil {
+		return entity.ErrInvalidSegment("empty or nil")
+	}
+	segment.CreatedAt = time.Now().UTC()
+	segment.UpdatedAt = time.Now().UTC()
+
+	rules, err := marshalRules(segment.Rules)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+
+	query := "INSERT INTO " +
+		"segments (project, key, description, included, excluded, rules, created_at, updated_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	_, err = s.pool.Exec(ctx, query, segment.Project, segment.Key, segment.Description, subjects(segment.Included), subjects(segment.Excluded), rules, segment.CreatedAt, segment.UpdatedAt)
+	if err != nil && isUniqueViolationErr(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil && isForeignKeyViolationErr(err) {
+		return entity.ErrProjectNotFound()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// GetByKey gets a segment in the project from database.
+// It returns entity.ErrSegmentNotFound if segment can't be found.
+func (s *Segment) GetByKey(ctx context.Context, project, key string) (*entity.Segment, error) {
+	query := selectSegmentQuery + " WHERE project = $1 AND key = $2 LIMIT 1"
+	row := s.pool.QueryRow(ctx, query, project, key)
+
+	res, err := scanSegment(row)
+	if err == pgx.ErrNoRows {
+		return nil, entity.ErrSegmentNotFound()
+	}
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// GetAll gets all available segments in the project from storage.
+// If there isn't any segment in repository, it returns empty list of segment and nil error.
+func (s *Segment) GetAll(ctx context.Context, project string) ([]*entity.Segment, error) {
+	query := selectSegmentQuery + " WHERE project = $1 ORDER BY id"
+	return s.getMany(ctx, "GetAll", query, project)
+}
+
+// GetByKeys gets the segments in the project by their keys from storage.
+// The segments which can't be found are omitted from the result.
+func (s *Segment) GetByKeys(ctx context.Context, project string, keys []string) ([]*entity.Segment, error) {
+	query := selectSegmentQuery + " WHERE project = $1 AND key = ANY($2)"
+	return s.getMany(ctx, "GetByKeys", query, project, keys)
+}
+
+// Update replaces the segment's description, included list, excluded list, and rules in the segments table.
+// It returns entity.ErrSegmentNotFound if segment can't be found.
+func (s *Segment) Update(ctx context.Context, segment *entity.Segment) error {
+	if segment == nil {
+		return entity.ErrInvalidSegment("empty or nil")
+	}
+	segment.UpdatedAt = time.Now().UTC()
+
+	rules, err := marshalRules(segment.Rules)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+
+	query := "UPDATE segments SET description = $1, included = $2, excluded = $3, rules = $4, updated_at = $5 WHERE project = $6 AND key = $7"
+	tag, err := s.pool.Exec(ctx, query, segment.Description, subjects(segment.Included), subjects(segment.Excluded), rules, segment.UpdatedAt, segment.Project, segment.Key)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrSegmentNotFound()
+	}
+	return nil
+}
+
+// IsReferenced checks whether any toggle's rule in any environment of the project references the segment.
+func (s *Segment) IsReferenced(ctx context.Context, project, key string) (bool, error) {
+	query := "SELECT EXISTS (" +
+		"SELECT 1 FROM toggle_states, jsonb_array_elements(toggle_states.rules) AS rule " +
+		"WHERE toggle_states.project = $1 AND rule->>'operator' IN ($2, $3) AND rule->'values' ? $4)"
+	row := s.pool.QueryRow(ctx, query, project, string(entity.RuleOperatorInSegment), string(entity.RuleOperatorNotInSegment), key)
+
+	var res bool
+	if err := row.Scan(&res); err != nil {
+		return false, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// DeleteByKey deletes a segment in the project from the segments table.
+// It returns entity.ErrSegmentNotFound if segment can't be found.
+func (s *Segment) DeleteByKey(ctx context.Context, project, key string) error {
+	query := "DELETE FROM segments WHERE project = $1 AND key = $2"
+	tag, err := s.pool.Exec(ctx, query, project, key)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	if tag.RowsAffected() == 0 {
+		return entity.ErrSegmentNotFound()
+	}
+	return nil
+}
+
+func (s *Segment) getMany(ctx context.Context, method, query string, args ...interface{}) ([]*entity.Segment, error) {
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return []*entity.Segment{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.Segment{}
+	for rows.Next() {
+		tmp, err := scanSegment(rows)
+		if err != nil {
+			log.Printf("[Segment-%s] scan rows error: %s", method, err.Error())
+			continue
+		}
+		res = append(res, tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.Segment{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
+
+func scanSegment(row pgx.Row) (*entity.Segment, error) {
+	var res entity.Segment
+	var rules []byte
+	if err := row.Scan(&res.Key, &res.Project, &res.Description, &res.Included, &res.Excluded, &rules, &res.CreatedAt, &res.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(rules, &res.Rules); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// subjects converts nil subjects to empty list, hence it is stored as empty array instead of NULL.
+func subjects(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
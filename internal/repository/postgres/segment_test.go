@@ -0,0 +1,368 @@
+package postgres_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/pashagolub/pgxmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository/postgres"
+)
+
+var (
+	testSegment = &entity.Segment{
+		Key:      "beta-testers",
+		Project:  "payment",
+		Included: []string{"user-1"},
+		Rules:    []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}}},
+	}
+	testSegmentColumns          = []string{"key", "project", "description", "included", "excluded", "rules", "created_at", "updated_at"}
+	testSegmentRules            = []byte(`[{"attribute":"country","operator":"in","values":["ID","SG"],"value":false}]`)
+	testInsertSegmentQuery      = `INSERT INTO segments \(project, key, description, included, excluded, rules, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`
+	testSelectSegmentQuery      = `SELECT key, project, description, included, excluded, rules, created_at, updated_at FROM segments WHERE project = \$1 AND key = \$2 LIMIT 1`
+	testSelectAllSegmentsQuery  = `SELECT key, project, description, included, excluded, rules, created_at, updated_at FROM segments WHERE project = \$1 ORDER BY id`
+	testSelectSegmentsByKeys    = `SELECT key, project, description, included, excluded, rules, created_at, updated_at FROM segments WHERE project = \$1 AND key = ANY\(\$2\)`
+	testUpdateSegmentQuery      = `UPDATE segments SET description = \$1, included = \$2, excluded = \$3, rules = \$4, updated_at = \$5 WHERE project = \$6 AND key = \$7`
+	testSegmentReferencedQuery  = `SELECT EXISTS \(SELECT 1 FROM toggle_states, jsonb_array_elements\(toggle_states.rules\) AS rule WHERE toggle_states.project = \$1 AND rule->>'operator' IN \(\$2, \$3\) AND rule->'values' \? \$4\)`
+	testDeleteSegmentQuery      = `DELETE FROM segments WHERE project = \$1 AND key = \$2`
+	testSegmentReferencedColumn = []string{"exists"}
+)
+
+type SegmentExecutor struct {
+	segment *postgres.Segment
+	pgx     pgxmock.PgxPoolIface
+}
+
+func TestNewSegment(t *testing.T) {
+	t.Run("successfully create an instance of Segment", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		assert.NotNil(t, exec.segment)
+	})
+}
+
+func TestSegment_Insert(t *testing.T) {
+	t.Run("nil segment is prohibited", func(t *testing.T) {
+		exec := createSegmentExecutor()
+
+		err := exec.segment.Insert(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+	})
+
+	t.Run("insert duplicate segment", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testInsertSegmentQuery).WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		err := exec.segment.Insert(testCtx, testSegment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("project doesn't exist", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testInsertSegmentQuery).WillReturnError(&pgconn.PgError{Code: "23503"})
+
+		err := exec.segment.Insert(testCtx, testSegment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testInsertSegmentQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.segment.Insert(testCtx, testSegment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("success insert a new segment", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testInsertSegmentQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+		err := exec.segment.Insert(testCtx, testSegment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestSegment_GetByKey(t *testing.T) {
+	t.Run("segment is not found", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectQuery(testSelectSegmentQuery).WillReturnError(pgx.ErrNoRows)
+
+		res, err := exec.segment.GetByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectQuery(testSelectSegmentQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.segment.GetByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rules can't be unmarshaled", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectSegmentQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testSegmentColumns).
+				AddRow(testSegment.Key, testSegment.Project, "", []string{}, []string{}, []byte(`{`), time.Now(), time.Now()),
+			)
+
+		res, err := exec.segment.GetByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully retrieve a segment", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectSegmentQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testSegmentColumns).
+				AddRow(testSegment.Key, testSegment.Project, "", []string{"user-1"}, []string{}, testSegmentRules, time.Now(), time.Now()),
+			)
+
+		res, err := exec.segment.GetByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testSegment.Key, res.Key)
+		assert.Equal(t, []string{"user-1"}, res.Included)
+		assert.Equal(t, entity.RuleOperatorIn, res.Rules[0].Operator)
+	})
+}
+
+func TestSegment_GetAll(t *testing.T) {
+	t.Run("select all query returns error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectQuery(testSelectAllSegmentsQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.segment.GetAll(testCtx, testSegment.Project)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("select all rows scan returns error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllSegmentsQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testSegmentColumns).
+				AddRow("beta-testers", testSegment.Project, "", []string{}, []string{}, testSegmentRules, time.Now(), time.Now()).
+				AddRow("employees", testSegment.Project, "", []string{}, []string{}, testSegmentRules, "time.Now()", time.Now()),
+			)
+
+		res, err := exec.segment.GetAll(testCtx, testSegment.Project)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+	})
+
+	t.Run("select all rows error occurs after scanning", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllSegmentsQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testSegmentColumns).
+				AddRow("beta-testers", testSegment.Project, "", []string{}, []string{}, testSegmentRules, time.Now(), time.Now()).
+				AddRow("employees", testSegment.Project, "", []string{}, []string{}, testSegmentRules, time.Now(), time.Now()).
+				RowError(2, errPostgresInternal),
+			)
+
+		res, err := exec.segment.GetAll(testCtx, testSegment.Project)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve all rows", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectAllSegmentsQuery).
+			WillReturnRows(pgxmock.
+				NewRows(testSegmentColumns).
+				AddRow("beta-testers", testSegment.Project, "", []string{}, []string{}, testSegmentRules, time.Now(), time.Now()).
+				AddRow("employees", testSegment.Project, "", []string{}, []string{}, testSegmentRules, time.Now(), time.Now()),
+			)
+
+		res, err := exec.segment.GetAll(testCtx, testSegment.Project)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "beta-testers", res[0].Key)
+	})
+}
+
+func TestSegment_GetByKeys(t *testing.T) {
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectQuery(testSelectSegmentsByKeys).WillReturnError(errPostgresInternal)
+
+		res, err := exec.segment.GetByKeys(testCtx, testSegment.Project, []string{testSegment.Key})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve segments by keys", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectSegmentsByKeys).
+			WithArgs(testSegment.Project, []string{testSegment.Key, "unknown"}).
+			WillReturnRows(pgxmock.
+				NewRows(testSegmentColumns).
+				AddRow(testSegment.Key, testSegment.Project, "", []string{"user-1"}, []string{}, testSegmentRules, time.Now(), time.Now()),
+			)
+
+		res, err := exec.segment.GetByKeys(testCtx, testSegment.Project, []string{testSegment.Key, "unknown"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, testSegment.Key, res[0].Key)
+	})
+}
+
+func TestSegment_Update(t *testing.T) {
+	t.Run("nil segment is prohibited", func(t *testing.T) {
+		exec := createSegmentExecutor()
+
+		err := exec.segment.Update(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testUpdateSegmentQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.segment.Update(testCtx, testSegment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("segment is not found", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testUpdateSegmentQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+		err := exec.segment.Update(testCtx, testSegment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+	})
+
+	t.Run("success update a segment", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testUpdateSegmentQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+		err := exec.segment.Update(testCtx, testSegment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestSegment_IsReferenced(t *testing.T) {
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectQuery(testSegmentReferencedQuery).WillReturnError(errPostgresInternal)
+
+		res, err := exec.segment.IsReferenced(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.False(t, res)
+	})
+
+	t.Run("segment is referenced by toggle", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSegmentReferencedQuery).
+			WithArgs(testSegment.Project, "in-segment", "not-in-segment", testSegment.Key).
+			WillReturnRows(pgxmock.NewRows(testSegmentReferencedColumn).AddRow(true))
+
+		res, err := exec.segment.IsReferenced(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.Nil(t, err)
+		assert.True(t, res)
+	})
+
+	t.Run("segment isn't referenced by any toggle", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.
+			ExpectQuery(testSegmentReferencedQuery).
+			WillReturnRows(pgxmock.NewRows(testSegmentReferencedColumn).AddRow(false))
+
+		res, err := exec.segment.IsReferenced(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.Nil(t, err)
+		assert.False(t, res)
+	})
+}
+
+func TestSegment_DeleteByKey(t *testing.T) {
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testDeleteSegmentQuery).WillReturnError(errPostgresInternal)
+
+		err := exec.segment.DeleteByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("segment is not found", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testDeleteSegmentQuery).WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+		err := exec.segment.DeleteByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+	})
+
+	t.Run("success delete a segment", func(t *testing.T) {
+		exec := createSegmentExecutor()
+		exec.pgx.ExpectExec(testDeleteSegmentQuery).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+
+		err := exec.segment.DeleteByKey(testCtx, testSegment.Project, testSegment.Key)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createSegmentExecutor() *SegmentExecutor {
+	mock, err := pgxmock.NewPool(pgxmock.MonitorPingsOption(true))
+	if err != nil {
+		log.Panicf("error opening a stub database connection: %v\n", err)
+	}
+
+	segment := postgres.NewSegment(mock)
+	return &SegmentExecutor{
+		segment: segment,
+		pgx:     mock,
+	}
+}
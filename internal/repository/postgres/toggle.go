@@ -2,21 +2,50 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
+	"google.golang.org/grpc/status"
 
 	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
 const (
 	// errCodeUniqueViolation is derived from https://www.postgresql.org/docs/11/errcodes-appendix.html
 	errCodeUniqueViolation = "23505"
+	// errCodeForeignKeyViolation is derived from https://www.postgresql.org/docs/11/errcodes-appendix.html
+	errCodeForeignKeyViolation = "23503"
+
+	// selectToggleQuery reports the later of the definition's and the state's update time as the toggle's updated_at,
+	// so that editing the toggle's metadata counts as an update in every environment.
+	selectToggleQuery = "SELECT toggles.key, toggle_states.is_enabled, toggles.description, toggles.created_at, GREATEST(toggles.updated_at, toggle_states.updated_at), " +
+		"toggle_states.rules, toggle_states.default_value, toggle_states.rollout, toggles.variants, toggles.default_variant, toggles.off_variant, toggle_states.environment, toggles.project, toggles.prerequisites, toggles.owner, toggles.expires_at, " +
+		"COALESCE((SELECT json_agg(toggle_tags.tag ORDER BY toggle_tags.tag) FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key), '[]'), toggles.version, toggles.deleted_at " +
+		"FROM toggles JOIN toggle_states ON toggle_states.project = toggles.project AND toggle_states.toggle_key = toggles.key"
+
+	// constraintToggleProject is the foreign key constraint from toggles to projects.
+	constraintToggleProject = "toggles_project_fkey"
+)
+
+var (
+	toggleOrderColumns = map[entity.ToggleOrderField]string{
+		entity.ToggleOrderByKey:       "toggles.key",
+		entity.ToggleOrderByCreatedAt: "toggles.created_at",
+		entity.ToggleOrderByUpdatedAt: "GREATEST(toggles.updated_at, toggle_states.updated_at)",
+	}
+	likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
 )
 
-// Toggle is responsible to connect toggle entity with toggles table in PostgreSQL.
+// Toggle is responsible to connect toggle entity with toggles and toggle_states tables in PostgreSQL.
+// The toggles table holds the toggle's definition while the toggle_states table holds the toggle's state per environment.
+// A toggle is identified by its project and key.
+// A soft-deleted toggle keeps its rows, hence its key stays reserved, but it is hidden from the rest of the operations.
 type Toggle struct {
 	pool PgxPoolIface
 }
@@ -27,105 +56,904 @@ func NewToggle(pool PgxPoolIface) *Toggle {
 }
 
 // Insert inserts the toggle into the toggles table.
+// The toggle's state is inserted for the toggle's environment.
+// The rest of the environments get the default state: disabled, without rules, and without rollout.
+// The creation is recorded in the audit log and its event is written to the outbox within the same transaction.
 func (t *Toggle) Insert(ctx context.Context, toggle *entity.Toggle) error {
 	if toggle == nil {
 		return entity.ErrEmptyToggle()
 	}
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		return t.insert(ctx, tx, toggle)
+	})
+	return toInsertError(err)
+}
+
+// InsertAll inserts the toggles within a single transaction in the same way Insert does
+// and returns the result of each toggle in the same order.
+// If atomic is true, the first failure rolls back all toggles and it is returned along with the toggle's index.
+// Otherwise, a failure only rolls back its toggle and it is returned in the toggle's result.
+func (t *Toggle) InsertAll(ctx context.Context, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	res := make([]*entity.BatchResult, len(toggles))
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		for i, toggle := range toggles {
+			res[i] = &entity.BatchResult{Key: toggle.Key}
+			err := withBatchItem(ctx, tx, atomic, func(tx pgx.Tx) error {
+				return t.insert(ctx, tx, toggle)
+			})
+			if err != nil && atomic {
+				return entity.ErrBatchItemFailed(i, toInsertError(err))
+			}
+			if err != nil {
+				res[i].Err = toInsertError(err)
+				continue
+			}
+			res[i].Version = toggle.Version
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, toBatchError(err)
+	}
+	return res, nil
+}
+
+// insert inserts the toggle, its tags, and its states within the transaction.
+// The toggle's prerequisites are checked against the project's prerequisite graph once more under the graph's lock.
+// The creation is recorded in the audit log and its event is written to the outbox.
+func (t *Toggle) insert(ctx context.Context, tx pgx.Tx, toggle *entity.Toggle) error {
+	if err := checkPrerequisites(ctx, tx, toggle.Project, toggle.Key, toggle.Prerequisites); err != nil {
+		return err
+	}
+
 	toggle.CreatedAt = time.Now().UTC()
 	toggle.UpdatedAt = time.Now().UTC()
+	toggle.Version = 1
+
+	rules, err := marshalRules(toggle.Rules)
+	if err != nil {
+		return err
+	}
+	rollout, err := marshalRollout(toggle.Rollout)
+	if err != nil {
+		return err
+	}
+	variants, err := marshalVariants(toggle.Variants)
+	if err != nil {
+		return err
+	}
+	prerequisites, err := marshalPrerequisites(toggle.Prerequisites)
+	if err != nil {
+		return err
+	}
 
 	query := "INSERT INTO " +
-		"toggles (key, is_enabled, description, created_at, updated_at) " +
-		"VALUES ($1, $2, $3, $4, $5)"
+		"toggles (project, key, description, created_at, updated_at, variants, default_variant, off_variant, prerequisites, owner, expires_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)"
+	if _, err := tx.Exec(ctx, query, toggle.Project, toggle.Key, toggle.Description, toggle.CreatedAt, toggle.UpdatedAt, variants, toggle.DefaultVariant, toggle.OffVariant, prerequisites, toggle.Owner, toggle.ExpiresAt); err != nil {
+		return err
+	}
 
-	_, err := t.pool.Exec(ctx, query,
-		toggle.Key,
-		toggle.IsEnabled,
-		toggle.Description,
-		toggle.CreatedAt,
-		toggle.UpdatedAt,
-	)
+	if len(toggle.Tags) > 0 {
+		query = "INSERT INTO toggle_tags (project, toggle_key, tag) SELECT $1, $2, UNNEST($3::TEXT[])"
+		if _, err := tx.Exec(ctx, query, toggle.Project, toggle.Key, toggle.Tags); err != nil {
+			return err
+		}
+	}
 
-	if err != nil && isUniqueViolationErr(err) {
-		return entity.ErrAlreadyExists()
+	query = "INSERT INTO " +
+		"toggle_states (project, toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at) " +
+		"VALUES ($1, $2, $3, $4, $5, $6, $7, $8)"
+	if _, err := tx.Exec(ctx, query, toggle.Project, toggle.Key, toggle.Environment, toggle.IsEnabled, rules, toggle.DefaultValue, rollout, toggle.UpdatedAt); err != nil {
+		return err
 	}
-	if err != nil {
-		return entity.ErrInternal(err.Error())
+
+	query = "INSERT INTO " +
+		"toggle_states (project, toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at) " +
+		"SELECT $1, $2, name, FALSE, '[]', TRUE, NULL, $3 FROM environments WHERE name <> $4"
+	if _, err := tx.Exec(ctx, query, toggle.Project, toggle.Key, toggle.UpdatedAt, toggle.Environment); err != nil {
+		return err
 	}
-	return nil
+
+	if err := insertAudit(ctx, tx, entity.NewAudit(ctx, entity.AuditActionCreate, nil, toggle)); err != nil {
+		return err
+	}
+	return insertOutbox(ctx, tx, entity.EventToggleCreated(toggle))
 }
 
-// GetByKey gets a toggle from database.
-// It returns entity.ErrNotFound if toggle can't be found.
-func (t *Toggle) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
-	query := "SELECT key, is_enabled, description, created_at, updated_at FROM toggles WHERE key = $1 LIMIT 1"
-	row := t.pool.QueryRow(ctx, query, key)
+// GetByKey gets a toggle in the project's environment from database.
+// It returns entity.ErrNotFound if toggle can't be found or if it has been soft-deleted.
+func (t *Toggle) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	query := selectToggleQuery + " WHERE toggles.project = $1 AND toggle_states.environment = $2 AND toggles.key = $3 AND toggles.deleted_at IS NULL LIMIT 1"
+	row := t.pool.QueryRow(ctx, query, project, env, key)
 
-	res := entity.Toggle{}
-	err := row.Scan(&res.Key, &res.IsEnabled, &res.Description, &res.CreatedAt, &res.UpdatedAt)
+	res, err := scanToggle(row)
 	if err == pgx.ErrNoRows {
 		return nil, entity.ErrNotFound()
 	}
 	if err != nil {
 		return nil, entity.ErrInternal(err.Error())
 	}
-	return &res, nil
+	return res, nil
 }
 
-// GetAll gets all available toggles from storage.
+// GetAll gets the toggles in the project's environment which satisfy the filter from storage.
+// The filter is resolved in the query. Nil filter means all toggles except the soft-deleted ones.
+// The toggles are paginated using keyset pagination: they are sorted by the pagination's field and then by key,
+// and the page starts right after the pagination's cursor. Nil pagination means all toggles sorted by key.
 // If there isn't any toggle in repository, it returns empty list of toggle and nil error.
-func (t *Toggle) GetAll(ctx context.Context, limit uint) ([]*entity.Toggle, error) {
-	query := "SELECT key, is_enabled, description, created_at, updated_at FROM toggles LIMIT $1"
-	rows, err := t.pool.Query(ctx, query, limit)
+func (t *Toggle) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	conds, args := createToggleConditions(project, env, filter)
+	if page == nil {
+		page = &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey}
+	}
+
+	column := toggleOrderColumns[page.OrderBy]
+	if column == "" {
+		column = toggleOrderColumns[entity.ToggleOrderByKey]
+	}
+	comparator, direction := ">", "ASC"
+	if page.Descending {
+		comparator, direction = "<", "DESC"
+	}
+	if page.After != nil && page.OrderBy == entity.ToggleOrderByKey {
+		args = append(args, page.After.Key)
+		conds += fmt.Sprintf(" AND toggles.key %s $%d", comparator, len(args))
+	}
+	if page.After != nil && page.OrderBy != entity.ToggleOrderByKey {
+		args = append(args, page.After.Time, page.After.Key)
+		conds += fmt.Sprintf(" AND (%s, toggles.key) %s ($%d, $%d)", column, comparator, len(args)-1, len(args))
+	}
+
+	query := selectToggleQuery + conds
+	if page.OrderBy == entity.ToggleOrderByKey {
+		query += fmt.Sprintf(" ORDER BY toggles.key %s", direction)
+	} else {
+		query += fmt.Sprintf(" ORDER BY %s %s, toggles.key %s", column, direction, direction)
+	}
+	if page.Limit > 0 {
+		args = append(args, page.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+	return t.getAll(ctx, t.pool, query, args...)
+}
+
+// Count counts the toggles in the project's environment which satisfy the filter in storage.
+// Nil filter means all toggles except the soft-deleted ones.
+func (t *Toggle) Count(ctx context.Context, project, env string, filter *entity.ToggleFilter) (int, error) {
+	conds, args := createToggleConditions(project, env, filter)
+	query := "SELECT COUNT(*) FROM toggles JOIN toggle_states ON toggle_states.project = toggles.project AND toggle_states.toggle_key = toggles.key" + conds
+	row := t.pool.QueryRow(ctx, query, args...)
+
+	var res int
+	if err := row.Scan(&res); err != nil {
+		return 0, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// GetAllByKey gets a toggle in all of the project's environments from storage.
+// It returns entity.ErrNotFound if toggle can't be found or if it has been soft-deleted.
+func (t *Toggle) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	query := selectToggleQuery + " WHERE toggles.project = $1 AND toggles.key = $2 AND toggles.deleted_at IS NULL"
+	res, err := t.getAll(ctx, t.pool, query, project, key)
 	if err != nil {
-		return []*entity.Toggle{}, entity.ErrInternal(err.Error())
+		return nil, err
 	}
-	defer rows.Close()
+	if len(res) == 0 {
+		return nil, entity.ErrNotFound()
+	}
+	return res, nil
+}
 
-	res := []*entity.Toggle{}
-	for rows.Next() {
-		var tmp entity.Toggle
-		if err := rows.Scan(&tmp.Key, &tmp.IsEnabled, &tmp.Description, &tmp.CreatedAt, &tmp.UpdatedAt); err != nil {
-			log.Printf("[Toggle-GetAll] scan rows error: %s", err.Error())
-			continue
+// GetAllByKeys gets the toggles in all of the project's environments from storage.
+// The toggles which can't be found or have been soft-deleted are omitted.
+func (t *Toggle) GetAllByKeys(ctx context.Context, project string, keys []string) ([]*entity.Toggle, error) {
+	query := selectToggleQuery + " WHERE toggles.project = $1 AND toggles.key = ANY($2) AND toggles.deleted_at IS NULL"
+	return t.getAll(ctx, t.pool, query, project, keys)
+}
+
+// GetAllDeletedByKey gets a soft-deleted toggle in all of the project's environments from storage.
+// It returns entity.ErrNotFound if toggle can't be found or if it hasn't been soft-deleted.
+func (t *Toggle) GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	query := selectToggleQuery + " WHERE toggles.project = $1 AND toggles.key = $2 AND toggles.deleted_at IS NOT NULL"
+	res, err := t.getAll(ctx, t.pool, query, project, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, entity.ErrNotFound()
+	}
+	return res, nil
+}
+
+// UpdateIsEnabled updates the toggle's is_enabled value in the project's environment in the storage
+// and returns the toggle's new version.
+// It should handle if the toggle doesn't exist in the project's environment.
+// If version is not zero, the toggle is only updated if its current version equals version,
+// otherwise it returns entity.ErrVersionConflict.
+// The change is recorded in the audit log and its event is written to the outbox within the same transaction.
+func (t *Toggle) UpdateIsEnabled(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
+	if err := t.checkIfToggleExists(ctx, project, env, key); err != nil {
+		return 0, err
+	}
+
+	var res int64
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) (err error) {
+		res, err = t.updateIsEnabled(ctx, tx, project, env, key, value, version)
+		return err
+	})
+
+	if err == pgx.ErrNoRows {
+		return 0, entity.ErrVersionConflict()
+	}
+	if err != nil {
+		return 0, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// UpdateAll applies the operations on the toggles in the project's environment in order within a single transaction
+// and returns the result of each operation in the same order.
+// Enabling and disabling is done in the same way UpdateIsEnabled does, while deletion is done in the same way Delete does.
+// The toggles must exist, otherwise the operation fails with entity.ErrNotFound.
+// If atomic is true, the first failure rolls back all operations and it is returned along with the operation's index.
+// Otherwise, a failure only rolls back its operation and it is returned in the operation's result.
+func (t *Toggle) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	res := make([]*entity.BatchResult, len(operations))
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		for i, op := range operations {
+			res[i] = &entity.BatchResult{Key: op.Key}
+			var version int64
+			err := withBatchItem(ctx, tx, atomic, func(tx pgx.Tx) (err error) {
+				version, err = t.applyOperation(ctx, tx, project, env, op)
+				return err
+			})
+			if err != nil && atomic {
+				return entity.ErrBatchItemFailed(i, toOperationError(err, op.Version))
+			}
+			if err != nil {
+				res[i].Err = toOperationError(err, op.Version)
+				continue
+			}
+			res[i].Version = version
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, toBatchError(err)
+	}
+	return res, nil
+}
+
+func (t *Toggle) applyOperation(ctx context.Context, tx pgx.Tx, project, env string, op *entity.ToggleOperation) (int64, error) {
+	switch op.Type {
+	case entity.ToggleOperationEnable:
+		return t.updateIsEnabled(ctx, tx, project, env, op.Key, true, op.Version)
+	case entity.ToggleOperationDisable:
+		return t.updateIsEnabled(ctx, tx, project, env, op.Key, false, op.Version)
+	case entity.ToggleOperationDelete:
+		return t.softDelete(ctx, tx, project, op.Key, op.Version)
+	}
+	return 0, entity.ErrInvalidBatch(fmt.Sprintf("operation type %q is unknown", op.Type))
+}
+
+// updateIsEnabled updates the toggle's is_enabled value in the project's environment within the transaction
+// and returns the toggle's new version.
+// It returns pgx.ErrNoRows if the toggle can't be found or version is not zero and doesn't match the toggle's current version.
+// The change is recorded in the audit log and its event is written to the outbox.
+func (t *Toggle) updateIsEnabled(ctx context.Context, tx pgx.Tx, project, env, key string, value bool, version int64) (int64, error) {
+	before, after, err := t.lockState(ctx, tx, project, env, key, version)
+	if err != nil {
+		return 0, err
+	}
+	after.IsEnabled = value
+
+	query := "UPDATE toggle_states SET is_enabled = $1, updated_at = $2 WHERE project = $3 AND toggle_key = $4 AND environment = $5"
+	if _, err := tx.Exec(ctx, query, value, after.UpdatedAt, project, key, env); err != nil {
+		return 0, err
+	}
+
+	action, event := entity.AuditActionDisable, entity.EventToggleDisabled(after)
+	if value {
+		action, event = entity.AuditActionEnable, entity.EventToggleEnabled(after)
+	}
+	if err := insertAudit(ctx, tx, entity.NewAudit(ctx, action, before, after)); err != nil {
+		return 0, err
+	}
+	return after.Version, insertOutbox(ctx, tx, event)
+}
+
+// UpdateRollout replaces the toggle's rollout in the project's environment in the storage
+// and returns the toggle's new version. Nil rollout removes the rollout.
+// It returns entity.ErrNotFound if the toggle doesn't exist in the project's environment.
+// If version is not zero, the toggle is only updated if its current version equals version,
+// otherwise it returns entity.ErrVersionConflict.
+// The change is recorded in the audit log and its event is written to the outbox within the same transaction.
+func (t *Toggle) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	if err := t.checkIfToggleExists(ctx, project, env, key); err != nil {
+		return 0, err
+	}
+	value, err := marshalRollout(rollout)
+	if err != nil {
+		return 0, entity.ErrInternal(err.Error())
+	}
+
+	var res int64
+	err = withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		before, after, err := t.lockState(ctx, tx, project, env, key, version)
+		if err != nil {
+			return err
+		}
+		after.Rollout = rollout
+
+		query := "UPDATE toggle_states SET rollout = $1, updated_at = $2 WHERE project = $3 AND toggle_key = $4 AND environment = $5"
+		if _, err := tx.Exec(ctx, query, value, after.UpdatedAt, project, key, env); err != nil {
+			return err
 		}
-		res = append(res, &tmp)
+		if err := insertAudit(ctx, tx, entity.NewAudit(ctx, entity.AuditActionUpdate, before, after)); err != nil {
+			return err
+		}
+		res = after.Version
+		return insertOutbox(ctx, tx, entity.EventToggleUpdated(after))
+	})
+
+	if err == pgx.ErrNoRows {
+		return 0, entity.ErrVersionConflict()
 	}
-	if rows.Err() != nil {
-		return []*entity.Toggle{}, entity.ErrInternal(rows.Err().Error())
+	if err != nil {
+		return 0, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// UpdateRules replaces the toggle's rules and default value in the project's environment in the storage
+// and returns the toggle's new version.
+// It returns entity.ErrNotFound if the toggle doesn't exist in the project's environment.
+// If version is not zero, the toggle is only updated if its current version equals version,
+// otherwise it returns entity.ErrVersionConflict.
+// The change is recorded in the audit log and its event is written to the outbox within the same transaction.
+func (t *Toggle) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	if err := t.checkIfToggleExists(ctx, project, env, key); err != nil {
+		return 0, err
+	}
+	value, err := marshalRules(rules)
+	if err != nil {
+		return 0, entity.ErrInternal(err.Error())
+	}
+
+	var res int64
+	err = withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		before, after, err := t.lockState(ctx, tx, project, env, key, version)
+		if err != nil {
+			return err
+		}
+		after.Rules = rules
+		after.DefaultValue = defaultValue
+
+		query := "UPDATE toggle_states SET rules = $1, default_value = $2, updated_at = $3 WHERE project = $4 AND toggle_key = $5 AND environment = $6"
+		if _, err := tx.Exec(ctx, query, value, defaultValue, after.UpdatedAt, project, key, env); err != nil {
+			return err
+		}
+		if err := insertAudit(ctx, tx, entity.NewAudit(ctx, entity.AuditActionUpdate, before, after)); err != nil {
+			return err
+		}
+		res = after.Version
+		return insertOutbox(ctx, tx, entity.EventToggleUpdated(after))
+	})
+
+	if err == pgx.ErrNoRows {
+		return 0, entity.ErrVersionConflict()
+	}
+	if err != nil {
+		return 0, entity.ErrInternal(err.Error())
 	}
 	return res, nil
 }
 
-// UpdateIsEnabled updates the toggle's is_enabled value in the storage.
-// It should handle if the toggle doesn't exist.
-func (t *Toggle) UpdateIsEnabled(ctx context.Context, key string, value bool) error {
-	if err := t.checkIfToggleExists(ctx, key); err != nil {
+// lockState bumps the toggle's version within the transaction and returns the toggle in the project's environment
+// as it was before the bump, along with its copy which has the new version and update time to be changed by the caller.
+// It returns pgx.ErrNoRows if the toggle can't be found or version is not zero and doesn't match the toggle's current version.
+func (t *Toggle) lockState(ctx context.Context, tx pgx.Tx, project, env, key string, version int64) (*entity.Toggle, *entity.Toggle, error) {
+	var res int64
+	query := "UPDATE toggles SET version = version + 1 WHERE project = $1 AND key = $2 AND deleted_at IS NULL AND ($3::BIGINT = 0 OR version = $3) RETURNING version"
+	if err := tx.QueryRow(ctx, query, project, key, version).Scan(&res); err != nil {
+		return nil, nil, err
+	}
+
+	// The toggle's row is locked by the version's update,
+	// hence nobody else can change the toggle until the transaction ends.
+	query = selectToggleQuery + " WHERE toggles.project = $1 AND toggle_states.environment = $2 AND toggles.key = $3 LIMIT 1"
+	before, err := scanToggle(tx.QueryRow(ctx, query, project, env, key))
+	if err != nil {
+		return nil, nil, err
+	}
+	before.Version = res - 1
+	after := *before
+	after.UpdatedAt = time.Now().UTC()
+	after.Version = res
+	return before, &after, nil
+}
+
+// GetAllPrerequisites gets the prerequisites of all toggles in the project from storage, keyed by the toggle's key.
+// A toggle without any prerequisite is still included with empty prerequisites.
+// The soft-deleted toggles are excluded.
+func (t *Toggle) GetAllPrerequisites(ctx context.Context, project string) (map[string][]*entity.Prerequisite, error) {
+	res, err := getAllPrerequisites(ctx, t.pool, project)
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// UpdatePrerequisites replaces the toggle's prerequisites in the storage.
+// The prerequisites are shared by all of the toggle's environments.
+// It returns entity.ErrNotFound if toggle can't be found.
+// The prerequisites are checked against the project's prerequisite graph once more under the graph's lock,
+// hence concurrent updates can't create a dependency cycle.
+// The update is recorded in the audit log and its event is written to the outbox
+// for every environment within the same transaction.
+func (t *Toggle) UpdatePrerequisites(ctx context.Context, project, key string, prerequisites []*entity.Prerequisite) error {
+	value, err := marshalPrerequisites(prerequisites)
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+
+	err = withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		if err := checkPrerequisites(ctx, tx, project, key, prerequisites); err != nil {
+			return err
+		}
+
+		var id int64
+		query := "SELECT id FROM toggles WHERE project = $1 AND key = $2 AND deleted_at IS NULL FOR UPDATE"
+		if err := tx.QueryRow(ctx, query, project, key).Scan(&id); err != nil {
+			return err
+		}
+
+		query = selectToggleQuery + " WHERE toggles.id = $1"
+		before, err := t.getAll(ctx, tx, query, id)
+		if err != nil {
+			return err
+		}
+
+		var version int64
+		now := time.Now().UTC()
+		query = "UPDATE toggles SET prerequisites = $1, updated_at = $2, version = version + 1 WHERE id = $3 RETURNING version"
+		if err := tx.QueryRow(ctx, query, value, now, id).Scan(&version); err != nil {
+			return err
+		}
+
+		var audits []*entity.Audit
+		var events []*togglev1.ToggleEvent
+		for _, toggle := range before {
+			after := *toggle
+			after.Prerequisites = prerequisites
+			after.UpdatedAt = now
+			after.Version = version
+			audits = append(audits, entity.NewAudit(ctx, entity.AuditActionUpdate, toggle, &after))
+			events = append(events, entity.EventToggleUpdated(&after))
+		}
+		if err := insertAudit(ctx, tx, audits...); err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, events...)
+	})
+
+	if err == pgx.ErrNoRows {
+		return entity.ErrNotFound()
+	}
+	if _, ok := status.FromError(err); ok {
 		return err
 	}
+	return entity.ErrInternal(err.Error())
+}
+
+// Update replaces the toggle's description, owner, expiry time, and tags in the storage.
+// Those fields are shared by all of the toggle's environments.
+// If the expiry time changes, the toggle will be notified again once the new expiry time has passed.
+// The toggle is only updated if its current version equals the toggle's version, otherwise it returns entity.ErrVersionConflict.
+// The toggle's version is then set to the new version.
+// The update is recorded in the audit log and its event is written to the outbox for every environment within the same transaction.
+func (t *Toggle) Update(ctx context.Context, toggle *entity.Toggle) error {
+	if toggle == nil {
+		return entity.ErrEmptyToggle()
+	}
+
+	var version int64
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		var id int64
+		query := "SELECT id FROM toggles WHERE project = $1 AND key = $2 AND version = $3 AND deleted_at IS NULL FOR UPDATE"
+		if err := tx.QueryRow(ctx, query, toggle.Project, toggle.Key, toggle.Version).Scan(&id); err != nil {
+			return err
+		}
+
+		query = selectToggleQuery + " WHERE toggles.id = $1"
+		before, err := t.getAll(ctx, tx, query, id)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		query = "UPDATE toggles SET description = $1, owner = $2, " +
+			"expiry_notified_at = CASE WHEN expires_at IS NOT DISTINCT FROM $3 THEN expiry_notified_at ELSE NULL END, " +
+			"expires_at = $3, updated_at = $4, version = version + 1 WHERE id = $5 RETURNING version"
+		if err := tx.QueryRow(ctx, query, toggle.Description, toggle.Owner, toggle.ExpiresAt, now, id).Scan(&version); err != nil {
+			return err
+		}
+
+		query = "DELETE FROM toggle_tags WHERE project = $1 AND toggle_key = $2"
+		if _, err := tx.Exec(ctx, query, toggle.Project, toggle.Key); err != nil {
+			return err
+		}
+		if len(toggle.Tags) > 0 {
+			query = "INSERT INTO toggle_tags (project, toggle_key, tag) SELECT $1, $2, UNNEST($3::TEXT[])"
+			if _, err := tx.Exec(ctx, query, toggle.Project, toggle.Key, toggle.Tags); err != nil {
+				return err
+			}
+		}
+
+		var audits []*entity.Audit
+		var events []*togglev1.ToggleEvent
+		for _, tmp := range before {
+			after := *tmp
+			after.Description = toggle.Description
+			after.Owner = toggle.Owner
+			after.ExpiresAt = toggle.ExpiresAt
+			after.Tags = toggle.Tags
+			after.UpdatedAt = now
+			after.Version = version
+			audits = append(audits, entity.NewAudit(ctx, entity.AuditActionUpdate, tmp, &after))
+			events = append(events, entity.EventToggleUpdated(&after))
+		}
+		if err := insertAudit(ctx, tx, audits...); err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, events...)
+	})
+
+	if err == pgx.ErrNoRows {
+		return entity.ErrVersionConflict()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	toggle.Version = version
+	return nil
+}
+
+// ClaimExpired marks at most limit toggles whose expiry time has passed as notified and returns them.
+// Toggles which have been notified are never returned again.
+// Rows locked by another claimer are skipped and the notification is checked once more after locking,
+// hence a toggle is only ever returned to one claimer even if many server replicas claim concurrently.
+// The soft-deleted toggles are never claimed.
+// The expired event of each claimed toggle is written to the outbox within the same transaction.
+// The returned toggles only contain the toggle's definition without any environment's state.
+func (t *Toggle) ClaimExpired(ctx context.Context, now time.Time, limit int) ([]*entity.Toggle, error) {
+	res := []*entity.Toggle{}
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		query := "UPDATE toggles SET expiry_notified_at = $1 " +
+			"WHERE expiry_notified_at IS NULL AND id IN (" +
+			"SELECT id FROM toggles WHERE expiry_notified_at IS NULL AND deleted_at IS NULL AND expires_at <= $1 ORDER BY expires_at LIMIT $2 FOR UPDATE SKIP LOCKED) " +
+			"RETURNING key, project, description, owner, expires_at"
+		rows, err := tx.Query(ctx, query, now.UTC(), limit)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var tmp entity.Toggle
+			if err := rows.Scan(&tmp.Key, &tmp.Project, &tmp.Description, &tmp.Owner, &tmp.ExpiresAt); err != nil {
+				log.Printf("[Toggle-ClaimExpired] scan rows error: %s", err.Error())
+				continue
+			}
+			res = append(res, &tmp)
+		}
+		rows.Close()
+		if rows.Err() != nil {
+			return rows.Err()
+		}
+
+		var events []*togglev1.ToggleEvent
+		for _, toggle := range res {
+			events = append(events, entity.EventToggleExpired(toggle))
+		}
+		return insertOutbox(ctx, tx, events...)
+	})
+
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// Delete soft-deletes a toggle in the project in PostgreSQL.
+// The toggle's rows are kept along with the deletion time, hence the toggle can be restored until it is purged.
+// If the toggle doesn't exist or has been soft-deleted, it doesn't returns error.
+// If version is not zero, the toggle is only deleted if its current version equals version,
+// otherwise it returns entity.ErrVersionConflict.
+// The deletion is recorded in the audit log and its deleted event is written to the outbox
+// for every environment within the same transaction.
+func (t *Toggle) Delete(ctx context.Context, project, key string, version int64) error {
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		_, err := t.softDelete(ctx, tx, project, key, version)
+		return err
+	})
 
-	query := "UPDATE toggles SET is_enabled = $1, updated_at = $2 WHERE key = $3"
-	_, err := t.pool.Exec(ctx, query, value, time.Now().UTC(), key)
+	if err == pgx.ErrNoRows && version != 0 {
+		return entity.ErrVersionConflict()
+	}
+	if err == pgx.ErrNoRows {
+		return nil
+	}
 	if err != nil {
 		return entity.ErrInternal(err.Error())
 	}
 	return nil
 }
 
-// Delete deletes a toggle from PostgreSQL.
-// If the group doesn't exist, it doesn't returns error.
-func (t *Toggle) Delete(ctx context.Context, key string) error {
-	query := "DELETE FROM toggles WHERE key = $1"
-	_, err := t.pool.Exec(ctx, query, key)
+// softDelete soft-deletes the toggle in the project within the transaction and returns the toggle's new version.
+// It returns pgx.ErrNoRows if the toggle can't be found or version is not zero and doesn't match the toggle's current version.
+// The deletion is recorded in the audit log and its deleted event is written to the outbox for every environment.
+func (t *Toggle) softDelete(ctx context.Context, tx pgx.Tx, project, key string, version int64) (int64, error) {
+	var id int64
+	query := "SELECT id FROM toggles WHERE project = $1 AND key = $2 AND deleted_at IS NULL AND ($3::BIGINT = 0 OR version = $3) FOR UPDATE"
+	if err := tx.QueryRow(ctx, query, project, key, version).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	query = selectToggleQuery + " WHERE toggles.id = $1"
+	before, err := t.getAll(ctx, tx, query, id)
+	if err != nil {
+		return 0, err
+	}
+
+	var res int64
+	now := time.Now().UTC()
+	query = "UPDATE toggles SET deleted_at = $1, version = version + 1 WHERE id = $2 RETURNING version"
+	if err := tx.QueryRow(ctx, query, now, id).Scan(&res); err != nil {
+		return 0, err
+	}
+
+	var audits []*entity.Audit
+	var events []*togglev1.ToggleEvent
+	for _, toggle := range before {
+		after := *toggle
+		after.DeletedAt = &now
+		after.Version = res
+		audits = append(audits, entity.NewAudit(ctx, entity.AuditActionDelete, toggle, &after))
+		events = append(events, entity.EventToggleDeleted(&after))
+	}
+	if err := insertAudit(ctx, tx, audits...); err != nil {
+		return 0, err
+	}
+	return res, insertOutbox(ctx, tx, events...)
+}
+
+// Restore brings back a soft-deleted toggle in the project in PostgreSQL and returns the toggle's new version.
+// It returns entity.ErrNotFound if the toggle can't be found or if it hasn't been soft-deleted.
+// If version is not zero, the toggle is only restored if its current version equals version,
+// otherwise it returns entity.ErrVersionConflict.
+// The restoration is recorded in the audit log and its created event is written to the outbox
+// for every environment within the same transaction.
+func (t *Toggle) Restore(ctx context.Context, project, key string, version int64) (int64, error) {
+	var res int64
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		var id int64
+		query := "SELECT id FROM toggles WHERE project = $1 AND key = $2 AND deleted_at IS NOT NULL AND ($3::BIGINT = 0 OR version = $3) FOR UPDATE"
+		if err := tx.QueryRow(ctx, query, project, key, version).Scan(&id); err != nil {
+			return err
+		}
+
+		query = selectToggleQuery + " WHERE toggles.id = $1"
+		before, err := t.getAll(ctx, tx, query, id)
+		if err != nil {
+			return err
+		}
+
+		query = "UPDATE toggles SET deleted_at = NULL, version = version + 1 WHERE id = $1 RETURNING version"
+		if err := tx.QueryRow(ctx, query, id).Scan(&res); err != nil {
+			return err
+		}
+
+		var audits []*entity.Audit
+		var events []*togglev1.ToggleEvent
+		for _, toggle := range before {
+			after := *toggle
+			after.DeletedAt = nil
+			after.Version = res
+			audits = append(audits, entity.NewAudit(ctx, entity.AuditActionRestore, toggle, &after))
+			events = append(events, entity.EventToggleCreated(&after))
+		}
+		if err := insertAudit(ctx, tx, audits...); err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, events...)
+	})
+
+	if err == pgx.ErrNoRows && version != 0 {
+		return 0, entity.ErrVersionConflict()
+	}
+	if err == pgx.ErrNoRows {
+		return 0, entity.ErrNotFound()
+	}
+	if err != nil {
+		return 0, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// Purge deletes a toggle in the project from PostgreSQL, regardless of whether it has been soft-deleted.
+// The toggle's states in all environments are deleted as well, thus the toggle is gone forever.
+// If the toggle doesn't exist, it doesn't returns error.
+// If version is not zero, the toggle is only purged if its current version equals version,
+// otherwise it returns entity.ErrVersionConflict.
+// The purge is recorded in the audit log and its deleted event is written to the outbox
+// for every environment within the same transaction.
+func (t *Toggle) Purge(ctx context.Context, project, key string, version int64) error {
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		var id int64
+		query := "SELECT id FROM toggles WHERE project = $1 AND key = $2 AND ($3::BIGINT = 0 OR version = $3) FOR UPDATE"
+		if err := tx.QueryRow(ctx, query, project, key, version).Scan(&id); err != nil {
+			return err
+		}
+		_, err := t.purge(ctx, tx, []int64{id})
+		return err
+	})
+
+	if err == pgx.ErrNoRows && version != 0 {
+		return entity.ErrVersionConflict()
+	}
+	if err == pgx.ErrNoRows {
+		return nil
+	}
 	if err != nil {
 		return entity.ErrInternal(err.Error())
 	}
 	return nil
 }
 
-func (t *Toggle) checkIfToggleExists(ctx context.Context, key string) error {
-	query := "SELECT EXISTS(SELECT 1 FROM toggles WHERE key = $1)"
-	row := t.pool.QueryRow(ctx, query, key)
+// ClaimDeleted purges at most limit toggles which were soft-deleted at or before deletedBefore
+// and returns them in all of their environments.
+// Rows locked by another claimer are skipped, hence a toggle is only ever returned to one claimer
+// even if many server replicas claim concurrently.
+// The purge is recorded in the audit log and its deleted event is written to the outbox
+// for every environment within the same transaction.
+func (t *Toggle) ClaimDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]*entity.Toggle, error) {
+	res := []*entity.Toggle{}
+	err := withTransaction(ctx, t.pool, func(tx pgx.Tx) error {
+		query := "SELECT id FROM toggles WHERE deleted_at <= $1 ORDER BY deleted_at LIMIT $2 FOR UPDATE SKIP LOCKED"
+		rows, err := tx.Query(ctx, query, deletedBefore.UTC(), limit)
+		if err != nil {
+			return err
+		}
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+		if rows.Err() != nil {
+			return rows.Err()
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		res, err = t.purge(ctx, tx, ids)
+		return err
+	})
+
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	return res, nil
+}
+
+// purge deletes the locked toggles along with their states, records the purge in the audit log,
+// and writes the deleted events to the outbox.
+// It returns the toggles as they were before the purge in all of their environments.
+func (t *Toggle) purge(ctx context.Context, tx pgx.Tx, ids []int64) ([]*entity.Toggle, error) {
+	query := selectToggleQuery + " WHERE toggles.id = ANY($1)"
+	before, err := t.getAll(ctx, tx, query, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	query = "DELETE FROM toggles WHERE id = ANY($1)"
+	if _, err := tx.Exec(ctx, query, ids); err != nil {
+		return nil, err
+	}
+
+	var audits []*entity.Audit
+	var events []*togglev1.ToggleEvent
+	for _, toggle := range before {
+		audits = append(audits, entity.NewAudit(ctx, entity.AuditActionPurge, toggle, nil))
+		events = append(events, entity.EventToggleDeleted(toggle))
+	}
+	if err := insertAudit(ctx, tx, audits...); err != nil {
+		return nil, err
+	}
+	if err := insertOutbox(ctx, tx, events...); err != nil {
+		return nil, err
+	}
+	return before, nil
+}
+
+// checkPrerequisites locks the project's prerequisite graph until the transaction ends
+// and checks the toggle's prerequisites against the graph read under the lock.
+// The project's row is the graph's lock. It is locked without blocking the toggles which refer to the project.
+// Nothing is locked if the toggle doesn't have any prerequisite since it can't create a dependency cycle.
+func checkPrerequisites(ctx context.Context, tx pgx.Tx, project, key string, prerequisites []*entity.Prerequisite) error {
+	if len(prerequisites) == 0 {
+		return nil
+	}
+	query := "SELECT name FROM projects WHERE name = $1 FOR NO KEY UPDATE"
+	if _, err := tx.Exec(ctx, query, project); err != nil {
+		return err
+	}
+	graph, err := getAllPrerequisites(ctx, tx, project)
+	if err != nil {
+		return err
+	}
+	return entity.CheckPrerequisiteGraph(key, prerequisites, graph)
+}
+
+// getAllPrerequisites gets the prerequisites of all toggles in the project which aren't soft-deleted, keyed by the toggle's key.
+func getAllPrerequisites(ctx context.Context, db querier, project string) (map[string][]*entity.Prerequisite, error) {
+	query := "SELECT key, prerequisites FROM toggles WHERE project = $1 AND deleted_at IS NULL"
+	rows, err := db.Query(ctx, query, project)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	res := make(map[string][]*entity.Prerequisite)
+	for rows.Next() {
+		var key string
+		var prerequisites []byte
+		if err := rows.Scan(&key, &prerequisites); err != nil {
+			return nil, err
+		}
+		var tmp []*entity.Prerequisite
+		if err := json.Unmarshal(prerequisites, &tmp); err != nil {
+			return nil, err
+		}
+		res[key] = tmp
+	}
+	return res, rows.Err()
+}
+
+func (t *Toggle) getAll(ctx context.Context, db querier, query string, args ...interface{}) ([]*entity.Toggle, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return []*entity.Toggle{}, entity.ErrInternal(err.Error())
+	}
+	defer rows.Close()
+
+	res := []*entity.Toggle{}
+	for rows.Next() {
+		tmp, err := scanToggle(rows)
+		if err != nil {
+			log.Printf("[Toggle-GetAll] scan rows error: %s", err.Error())
+			continue
+		}
+		res = append(res, tmp)
+	}
+	if rows.Err() != nil {
+		return []*entity.Toggle{}, entity.ErrInternal(rows.Err().Error())
+	}
+	return res, nil
+}
+
+func (t *Toggle) checkIfToggleExists(ctx context.Context, project, env, key string) error {
+	query := "SELECT EXISTS(SELECT 1 FROM toggle_states JOIN toggles ON toggles.project = toggle_states.project AND toggles.key = toggle_states.toggle_key " +
+		"WHERE toggle_states.project = $1 AND toggle_states.toggle_key = $2 AND toggle_states.environment = $3 AND toggles.deleted_at IS NULL)"
+	row := t.pool.QueryRow(ctx, query, project, key, env)
 
 	var found bool
 	if err := row.Scan(&found); err != nil {
@@ -137,10 +965,183 @@ func (t *Toggle) checkIfToggleExists(ctx context.Context, key string) error {
 	return nil
 }
 
+func scanToggle(row pgx.Row) (*entity.Toggle, error) {
+	var res entity.Toggle
+	var rules, rollout, variants, prerequisites, tags []byte
+	if err := row.Scan(&res.Key, &res.IsEnabled, &res.Description, &res.CreatedAt, &res.UpdatedAt, &rules, &res.DefaultValue, &rollout, &variants, &res.DefaultVariant, &res.OffVariant, &res.Environment, &res.Project, &prerequisites, &res.Owner, &res.ExpiresAt, &tags, &res.Version, &res.DeletedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(tags, &res.Tags); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(prerequisites, &res.Prerequisites); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(rules, &res.Rules); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(variants, &res.Variants); err != nil {
+		return nil, err
+	}
+	if len(rollout) == 0 {
+		return &res, nil
+	}
+	if err := json.Unmarshal(rollout, &res.Rollout); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// createToggleConditions creates the WHERE clause which selects the toggles in the project's environment
+// that satisfy the filter, along with its arguments.
+// The soft-deleted toggles are excluded unless the filter includes them.
+func createToggleConditions(project, env string, filter *entity.ToggleFilter) (string, []interface{}) {
+	conds := " WHERE toggles.project = $1 AND toggle_states.environment = $2"
+	args := []interface{}{project, env}
+	if filter == nil || !filter.IncludeDeleted {
+		conds += " AND toggles.deleted_at IS NULL"
+	}
+	if filter == nil {
+		return conds, args
+	}
+
+	if len(filter.AnyTags) > 0 {
+		args = append(args, filter.AnyTags)
+		conds += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key AND toggle_tags.tag = ANY($%d))", len(args))
+	}
+	if len(filter.AllTags) > 0 {
+		tags := uniqueTags(filter.AllTags)
+		args = append(args, tags, len(tags))
+		conds += fmt.Sprintf(" AND (SELECT COUNT(*) FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key AND toggle_tags.tag = ANY($%d)) = $%d", len(args)-1, len(args))
+	}
+	if filter.KeyPrefix != "" {
+		args = append(args, escapeLike(filter.KeyPrefix)+"%")
+		conds += fmt.Sprintf(" AND toggles.key LIKE $%d", len(args))
+	}
+	if filter.Search != "" {
+		args = append(args, filter.Search)
+		conds += fmt.Sprintf(" AND to_tsvector('simple', toggles.description) @@ plainto_tsquery('simple', $%d)", len(args))
+	}
+	return conds, args
+}
+
+// escapeLike escapes the LIKE pattern's special characters so that they are matched literally.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
+// uniqueTags removes the duplicated tags so that they can be counted.
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]bool)
+	var res []string
+	for _, tag := range tags {
+		if !seen[tag] {
+			seen[tag] = true
+			res = append(res, tag)
+		}
+	}
+	return res
+}
+
+// marshalRules marshals rules to JSON array.
+// Nil rules is marshaled to empty array instead of null.
+func marshalRules(rules []*entity.Rule) ([]byte, error) {
+	if rules == nil {
+		rules = []*entity.Rule{}
+	}
+	return json.Marshal(rules)
+}
+
+// marshalVariants marshals variants to JSON array.
+// Nil variants is marshaled to empty array instead of null.
+func marshalVariants(variants []*entity.Variant) ([]byte, error) {
+	if variants == nil {
+		variants = []*entity.Variant{}
+	}
+	return json.Marshal(variants)
+}
+
+// marshalPrerequisites marshals prerequisites to JSON array.
+// Nil prerequisites is marshaled to empty array instead of null.
+func marshalPrerequisites(prerequisites []*entity.Prerequisite) ([]byte, error) {
+	if prerequisites == nil {
+		prerequisites = []*entity.Prerequisite{}
+	}
+	return json.Marshal(prerequisites)
+}
+
+// marshalRollout marshals rollout to JSON object.
+// Nil rollout is marshaled to nil, hence it is stored as NULL.
+func marshalRollout(rollout *entity.Rollout) ([]byte, error) {
+	if rollout == nil {
+		return nil, nil
+	}
+	return json.Marshal(rollout)
+}
+
+// toInsertError converts the error from inserting a toggle to the toggle's error.
+func toInsertError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if err != nil && isUniqueViolationErr(err) {
+		return entity.ErrAlreadyExists()
+	}
+	if err != nil && isForeignKeyViolationErr(err) && violatesConstraint(err, constraintToggleProject) {
+		return entity.ErrProjectNotFound()
+	}
+	if err != nil && isForeignKeyViolationErr(err) {
+		return entity.ErrEnvironmentNotFound()
+	}
+	if err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
+// toOperationError converts the error from applying an operation on a toggle to the toggle's error.
+func toOperationError(err error, version int64) error {
+	if err == pgx.ErrNoRows && version != 0 {
+		return entity.ErrVersionConflict()
+	}
+	if err == pgx.ErrNoRows {
+		return entity.ErrNotFound()
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return entity.ErrInternal(err.Error())
+}
+
+// toBatchError converts the error from a batch's transaction to the batch's error.
+// The error of an item is returned as is.
+func toBatchError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return entity.ErrInternal(err.Error())
+}
+
 func isUniqueViolationErr(err error) bool {
+	return hasPgErrorCode(err, errCodeUniqueViolation)
+}
+
+func isForeignKeyViolationErr(err error) bool {
+	return hasPgErrorCode(err, errCodeForeignKeyViolation)
+}
+
+func violatesConstraint(err error, constraint string) bool {
+	pgerr, ok := err.(*pgconn.PgError)
+	if !ok {
+		return false
+	}
+	return pgerr.ConstraintName == constraint
+}
+
+func hasPgErrorCode(err error, code string) bool {
 	pgerr, ok := err.(*pgconn.PgError)
 	if !ok {
 		return false
 	}
-	return pgerr.Code == errCodeUniqueViolation
+	return pgerr.Code == code
 }
@@ -15,18 +15,37 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/indrasaputra/toggle/entity"
-	"github.com/indrasaputra/toggle/internal/repository"
 	"github.com/indrasaputra/toggle/internal/repository/postgres"
 )
 
 var (
 	testCtx                 = context.Background()
 	testToggleKey           = "toggle-1"
+	testToggleProject       = "default"
+	testToggleEnv           = "production"
 	testToggleDescription   = "description"
 	testToggleIsEnabledTrue = true
-	testToggle              = &entity.Toggle{Key: testToggleKey, Description: testToggleDescription}
+	testToggle              = &entity.Toggle{Key: testToggleKey, Description: testToggleDescription, Project: testToggleProject, Environment: testToggleEnv}
+	testToggleRules         = []byte(`[{"attribute":"country","operator":"in","values":["ID","SG"],"value":true}]`)
+	testToggleRollout       = []byte(`{"percentage":10,"bucket_by":"user_id"}`)
+	testToggleVariants      = []byte(`[{"name":"on","type":"string","value":"on"},{"name":"off","type":"string","value":"off"}]`)
+	testTogglePrerequisites = []byte(`[{"key":"toggle-0","value":true}]`)
+	testToggleOwner         = "team-checkout"
+	testToggleTags          = []byte(`["kind:kill-switch","team:payments"]`)
 	errPostgresInternalMsg  = "database down"
 	errPostgresInternal     = errors.New(errPostgresInternalMsg)
+
+	testSelectToggleQuery = `SELECT toggles.key, toggle_states.is_enabled, toggles.description, toggles.created_at, GREATEST\(toggles.updated_at, toggle_states.updated_at\), ` +
+		`toggle_states.rules, toggle_states.default_value, toggle_states.rollout, toggles.variants, toggles.default_variant, toggles.off_variant, toggle_states.environment, toggles.project, toggles.prerequisites, toggles.owner, toggles.expires_at, ` +
+		`COALESCE\(\(SELECT json_agg\(toggle_tags.tag ORDER BY toggle_tags.tag\) FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key\), '\[\]'\), toggles.version, toggles.deleted_at ` +
+		`FROM toggles JOIN toggle_states ON toggle_states.project = toggles.project AND toggle_states.toggle_key = toggles.key`
+	testInsertToggleQuery      = `INSERT INTO toggles \(project, key, description, created_at, updated_at, variants, default_variant, off_variant, prerequisites, owner, expires_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11\)`
+	testInsertToggleTagsQuery  = `INSERT INTO toggle_tags \(project, toggle_key, tag\) SELECT \$1, \$2, UNNEST\(\$3::TEXT\[\]\)`
+	testInsertToggleStateQuery = `INSERT INTO toggle_states \(project, toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8\)`
+	testInsertDefaultStates    = `INSERT INTO toggle_states \(project, toggle_key, environment, is_enabled, rules, default_value, rollout, updated_at\) SELECT \$1, \$2, name, FALSE, '\[\]', TRUE, NULL, \$3 FROM environments WHERE name <> \$4`
+	testInsertAuditQuery       = `INSERT INTO toggle_audits \(project, toggle_key, environment, action, actor, reason, request_id, before, after, created_at\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10\)`
+	testInsertOutboxQuery      = `INSERT INTO toggle_outbox \(project, toggle_key, event, available_at, created_at\) VALUES \(\$1, \$2, \$3, \$4, \$4\)`
+	testToggleColumns          = []string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}
 )
 
 type ToggleExecutor struct {
@@ -51,11 +70,21 @@ func TestToggle_Insert(t *testing.T) {
 		assert.Equal(t, entity.ErrEmptyToggle(), err)
 	})
 
+	t.Run("begin transaction returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin().WillReturnError(errPostgresInternal)
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
 	t.Run("postgres database returns internal error", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.pgx.
-			ExpectExec(`INSERT INTO toggles \(key, is_enabled, description, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
-			WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
 
 		err := exec.toggle.Insert(testCtx, testToggle)
 
@@ -63,11 +92,27 @@ func TestToggle_Insert(t *testing.T) {
 		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
 	})
 
+	t.Run("prerequisite is deleted before the prerequisite graph is locked", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(`SELECT name FROM projects WHERE name = \$1 FOR NO KEY UPDATE`).WithArgs(testToggleProject).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		exec.pgx.ExpectQuery(`SELECT key, prerequisites FROM toggles WHERE project = \$1 AND deleted_at IS NULL`).WithArgs(testToggleProject).
+			WillReturnRows(pgxmock.NewRows([]string{"key", "prerequisites"}))
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, toggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
 	t.Run("insert duplicate toggle", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.pgx.
-			ExpectExec(`INSERT INTO toggles \(key, is_enabled, description, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
-			WillReturnError(&pgconn.PgError{Code: "23505"})
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnError(&pgconn.PgError{Code: "23505"})
+		exec.pgx.ExpectRollback()
 
 		err := exec.toggle.Insert(testCtx, testToggle)
 
@@ -75,15 +120,144 @@ func TestToggle_Insert(t *testing.T) {
 		assert.Equal(t, entity.ErrAlreadyExists(), err)
 	})
 
+	t.Run("insert toggle state returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "toggles_project_fkey"})
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+	})
+
+	t.Run("environment is not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnError(&pgconn.PgError{Code: "23503"})
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEnvironmentNotFound(), err)
+	})
+
+	t.Run("insert default states returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertDefaultStates).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("insert tags returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleTagsQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		toggle := *testToggle
+		toggle.Tags = []string{"team:payments"}
+		err := exec.toggle.Insert(testCtx, &toggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("insert audit returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertDefaultStates).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("insert outbox returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertDefaultStates).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Insert(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("success insert a new toggle with tags", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleTagsQuery).WithArgs(testToggleProject, testToggleKey, []string{"team:payments", "kind:kill-switch"}).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertDefaultStates).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		toggle := *testToggle
+		toggle.Tags = []string{"team:payments", "kind:kill-switch"}
+		err := exec.toggle.Insert(testCtx, &toggle)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
 	t.Run("success insert a new toggle", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.pgx.
-			ExpectExec(`INSERT INTO toggles \(key, is_enabled, description, created_at, updated_at\) VALUES \(\$1, \$2, \$3, \$4, \$5\)`).
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertDefaultStates).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "CREATE", "jane", "launch", "req-1", []byte(nil), pgxmock.AnyArg(), pgxmock.AnyArg()).
 			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
 
-		err := exec.toggle.Insert(testCtx, testToggle)
+		ctx := entity.ContextWithAuditMetadata(testCtx, &entity.AuditMetadata{Actor: "jane", Reason: "launch", RequestID: "req-1"})
+		err := exec.toggle.Insert(ctx, testToggle)
 
 		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
 	})
 }
 
@@ -91,10 +265,10 @@ func TestToggle_GetByKey(t *testing.T) {
 	t.Run("select by key query returns empty row", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles WHERE key = \$1 LIMIT 1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
 			WillReturnError(pgx.ErrNoRows)
 
-		res, err := exec.toggle.GetByKey(testCtx, testToggleKey)
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
@@ -104,10 +278,10 @@ func TestToggle_GetByKey(t *testing.T) {
 	t.Run("select by key query returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles WHERE key = \$1 LIMIT 1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
 			WillReturnError(errPostgresInternal)
 
-		res, err := exec.toggle.GetByKey(testCtx, testToggleKey)
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
@@ -117,16 +291,105 @@ func TestToggle_GetByKey(t *testing.T) {
 	t.Run("successfully retrieve row", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles WHERE key = \$1 LIMIT 1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, 1, len(res.Rules))
+		assert.Equal(t, entity.RuleOperatorIn, res.Rules[0].Operator)
+		assert.True(t, res.DefaultValue)
+		assert.Equal(t, &entity.Rollout{Percentage: 10, BucketBy: "user_id"}, res.Rollout)
+		assert.Equal(t, 2, len(res.Variants))
+		assert.Equal(t, entity.VariantTypeString, res.Variants[0].Type)
+		assert.Equal(t, "on", res.DefaultVariant)
+		assert.Equal(t, "off", res.OffVariant)
+		assert.Equal(t, []*entity.Prerequisite{{Key: "toggle-0", Value: true}}, res.Prerequisites)
+	})
+
+	t.Run("variants can't be unmarshaled", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, []byte(`{`), "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully retrieve row without rollout", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
 			WillReturnRows(pgxmock.
-				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at"}).
-				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now()),
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, nil, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
 			)
 
-		res, err := exec.toggle.GetByKey(testCtx, testToggleKey)
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.Nil(t, err)
 		assert.NotNil(t, res)
+		assert.Nil(t, res.Rollout)
+	})
+
+	t.Run("rollout can't be unmarshaled", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, []byte(`[`), testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("prerequisites can't be unmarshaled", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, []byte(`{`), testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("rules can't be unmarshaled", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 AND toggles.deleted_at IS NULL LIMIT 1`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), []byte(`{`), true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
 	})
 }
 
@@ -134,10 +397,10 @@ func TestToggle_GetAll(t *testing.T) {
 	t.Run("select all query returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles LIMIT \$1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL ORDER BY toggles.key ASC`).
 			WillReturnError(errPostgresInternal)
 
-		res, err := exec.toggle.GetAll(testCtx, repository.DefaultToggleLimit)
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
@@ -147,14 +410,14 @@ func TestToggle_GetAll(t *testing.T) {
 	t.Run("select all rows scan returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles LIMIT \$1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL ORDER BY toggles.key ASC`).
 			WillReturnRows(pgxmock.
-				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at"}).
-				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now()).
-				AddRow("1$%", true, testToggleDescription, "time.Now()", "time.Now()"),
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+				AddRow("1$%", true, testToggleDescription, "time.Now()", "time.Now()", testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
 			)
 
-		res, err := exec.toggle.GetAll(testCtx, repository.DefaultToggleLimit)
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
 
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(res))
@@ -163,15 +426,15 @@ func TestToggle_GetAll(t *testing.T) {
 	t.Run("select all rows error occurs after scanning", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles LIMIT \$1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL ORDER BY toggles.key ASC`).
 			WillReturnRows(pgxmock.
-				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at"}).
-				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now()).
-				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now()).
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
 				RowError(2, errPostgresInternal),
 			)
 
-		res, err := exec.toggle.GetAll(testCtx, repository.DefaultToggleLimit)
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
@@ -181,106 +444,1505 @@ func TestToggle_GetAll(t *testing.T) {
 	t.Run("successfully retrieve all rows", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT key, is_enabled, description, created_at, updated_at FROM toggles LIMIT \$1`).
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL ORDER BY toggles.key ASC`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, []string{"kind:kill-switch", "team:payments"}, res[0].Tags)
+	})
+
+	t.Run("tags filter is resolved in query", func(t *testing.T) {
+		anyTags := []string{"team:payments", "team:checkout"}
+		allTags := []string{"kind:kill-switch", "kind:kill-switch"}
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery+` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL`+
+				` AND EXISTS \(SELECT 1 FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key AND toggle_tags.tag = ANY\(\$3\)\)`+
+				` AND \(SELECT COUNT\(\*\) FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key AND toggle_tags.tag = ANY\(\$4\)\) = \$5`+
+				` ORDER BY toggles.key ASC`).
+			WithArgs(testToggleProject, testToggleEnv, anyTags, []string{"kind:kill-switch"}, 1).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, &entity.ToggleFilter{AnyTags: anyTags, AllTags: allTags}, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("only all-of tags filter is resolved in query", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery+` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL`+
+				` AND \(SELECT COUNT\(\*\) FROM toggle_tags WHERE toggle_tags.project = toggles.project AND toggle_tags.toggle_key = toggles.key AND toggle_tags.tag = ANY\(\$3\)\) = \$4`+
+				` ORDER BY toggles.key ASC`).
+			WithArgs(testToggleProject, testToggleEnv, []string{"team:payments", "kind:kill-switch"}, 2).
+			WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, &entity.ToggleFilter{AllTags: []string{"team:payments", "kind:kill-switch"}}, nil)
+
+		assert.NotNil(t, err)
+		assert.Empty(t, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+	t.Run("key prefix and search filter are resolved in query", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery+` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL`+
+				` AND toggles.key LIKE \$3`+
+				` AND to_tsvector\('simple', toggles.description\) @@ plainto_tsquery\('simple', \$4\)`+
+				` ORDER BY toggles.key ASC LIMIT \$5`).
+			WithArgs(testToggleProject, testToggleEnv, `checkout\_v2\%%`, "new flow", uint(10)).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		filter := &entity.ToggleFilter{KeyPrefix: "checkout_v2%", Search: "new flow"}
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, filter, &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 10})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("page after key cursor is resolved in query", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery+` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL`+
+				` AND toggles.key < \$3 ORDER BY toggles.key DESC LIMIT \$4`).
+			WithArgs(testToggleProject, testToggleEnv, testToggleKey, uint(10)).
+			WillReturnError(errPostgresInternal)
+
+		page := &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Descending: true, After: &entity.ToggleCursor{Key: testToggleKey}, Limit: 10}
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, nil, page)
+
+		assert.NotNil(t, err)
+		assert.Empty(t, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("page after time cursor is resolved in query", func(t *testing.T) {
+		after := time.Date(2022, time.February, 1, 10, 0, 0, 0, time.UTC)
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery+` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL`+
+				` AND \(GREATEST\(toggles.updated_at, toggle_states.updated_at\), toggles.key\) > \(\$3, \$4\) ORDER BY GREATEST\(toggles.updated_at, toggle_states.updated_at\) ASC, toggles.key ASC LIMIT \$5`).
+			WithArgs(testToggleProject, testToggleEnv, after, testToggleKey, uint(10)).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		page := &entity.TogglePagination{OrderBy: entity.ToggleOrderByUpdatedAt, After: &entity.ToggleCursor{Key: testToggleKey, Time: after}, Limit: 10}
+		res, err := exec.toggle.GetAll(testCtx, testToggleProject, testToggleEnv, nil, page)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(res))
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_Count(t *testing.T) {
+	t.Run("count query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(`SELECT COUNT\(\*\) FROM toggles JOIN toggle_states ON toggle_states.project = toggles.project AND toggle_states.toggle_key = toggles.key WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL`).
+			WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.Count(testCtx, testToggleProject, testToggleEnv, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("successfully count toggles which satisfy the filter", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(`SELECT COUNT\(\*\) FROM toggles JOIN toggle_states ON toggle_states.project = toggles.project AND toggle_states.toggle_key = toggles.key WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.deleted_at IS NULL AND toggles.key LIKE \$3`).
+			WithArgs(testToggleProject, testToggleEnv, "checkout%").
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(3))
+
+		res, err := exec.toggle.Count(testCtx, testToggleProject, testToggleEnv, &entity.ToggleFilter{KeyPrefix: "checkout"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_GetAllByKey(t *testing.T) {
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggles.key = \$2 AND toggles.deleted_at IS NULL`).
+			WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.GetAllByKey(testCtx, testToggleProject, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("toggle not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggles.key = \$2 AND toggles.deleted_at IS NULL`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}),
+			)
+
+		res, err := exec.toggle.GetAllByKey(testCtx, testToggleProject, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve toggle in all environments", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggles.key = \$2 AND toggles.deleted_at IS NULL`).
 			WillReturnRows(pgxmock.
-				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at"}).
-				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now()).
-				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now()),
+				NewRows([]string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version", "deleted_at"}).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+				AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", "staging", testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
 			)
 
-		res, err := exec.toggle.GetAll(testCtx, repository.DefaultToggleLimit)
+		res, err := exec.toggle.GetAllByKey(testCtx, testToggleProject, testToggleKey)
 
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(res))
+		assert.Equal(t, testToggleEnv, res[0].Environment)
+		assert.Equal(t, "staging", res[1].Environment)
 	})
 }
 
 func TestToggle_UpdateIsEnabled(t *testing.T) {
+	existsQuery := `SELECT EXISTS\(SELECT 1 FROM toggle_states JOIN toggles ON toggles.project = toggle_states.project AND toggles.key = toggle_states.toggle_key WHERE toggle_states.project = \$1 AND toggle_states.toggle_key = \$2 AND toggle_states.environment = \$3 AND toggles.deleted_at IS NULL\)`
+	versionQuery := `UPDATE toggles SET version = version \+ 1 WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL AND \(\$3::BIGINT = 0 OR version = \$3\) RETURNING version`
+	stateQuery := `UPDATE toggle_states SET is_enabled = \$1, updated_at = \$2 WHERE project = \$3 AND toggle_key = \$4 AND environment = \$5`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 LIMIT 1`
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), nil)
+	}
+
 	t.Run("check exists returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM toggles WHERE key = \$1\)`).
+			ExpectQuery(existsQuery).
 			WillReturnError(errPostgresInternal)
 
-		err := exec.toggle.UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Equal(t, int64(0), res)
 	})
 
 	t.Run("toggle not found", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM toggles WHERE key = \$1\)`).
+			ExpectQuery(existsQuery).
 			WillReturnRows(pgxmock.
 				NewRows([]string{"exists"}).
 				AddRow(false),
 			)
 
-		err := exec.toggle.UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("postgres database returns internal error", func(t *testing.T) {
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM toggles WHERE key = \$1\)`).
+			ExpectQuery(existsQuery).
 			WillReturnRows(pgxmock.
 				NewRows([]string{"exists"}).
 				AddRow(true),
 			)
-		exec.pgx.
-			ExpectExec(`UPDATE toggles SET is_enabled = \$1, updated_at = \$2 WHERE key = \$3`).
-			WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(3)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
 
-		err := exec.toggle.UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 3)
 
 		assert.NotNil(t, err)
-		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("success update a toggle", func(t *testing.T) {
+	t.Run("select toggle returns internal error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectQuery(`SELECT EXISTS\(SELECT 1 FROM toggles WHERE key = \$1\)`).
+			ExpectQuery(existsQuery).
 			WillReturnRows(pgxmock.
 				NewRows([]string{"exists"}).
 				AddRow(true),
 			)
-		exec.pgx.
-			ExpectExec(`UPDATE toggles SET is_enabled = \$1, updated_at = \$2 WHERE key = \$3`).
-			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
 
-		err := exec.toggle.UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 0)
 
-		assert.Nil(t, err)
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, int64(0), res)
 	})
-}
 
-func TestToggle_Delete(t *testing.T) {
-	t.Run("postgres database returns internal error", func(t *testing.T) {
+	t.Run("insert audit returns internal error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectExec(`DELETE FROM toggles WHERE key = \$1`).
-			WillReturnError(errPostgresInternal)
+			ExpectQuery(existsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"exists"}).
+				AddRow(true),
+			)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
 
-		err := exec.toggle.Delete(testCtx, testToggleKey)
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("success delete a toggle", func(t *testing.T) {
+	t.Run("postgres database returns internal error", func(t *testing.T) {
 		exec := createToggleExecutor()
 		exec.pgx.
-			ExpectExec(`DELETE FROM toggles WHERE key = \$1`).
-			WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			ExpectQuery(existsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"exists"}).
+				AddRow(true),
+			)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, int64(0), res)
+	})
+
+	t.Run("success update a toggle", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(existsQuery).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"exists"}).
+				AddRow(true),
+			)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(1)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(testToggleProject, testToggleEnv, testToggleKey).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "ENABLE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_UpdateRollout(t *testing.T) {
+	existsQuery := `SELECT EXISTS\(SELECT 1 FROM toggle_states JOIN toggles ON toggles.project = toggle_states.project AND toggles.key = toggle_states.toggle_key WHERE toggle_states.project = \$1 AND toggle_states.toggle_key = \$2 AND toggle_states.environment = \$3 AND toggles.deleted_at IS NULL\)`
+	versionQuery := `UPDATE toggles SET version = version \+ 1 WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL AND \(\$3::BIGINT = 0 OR version = \$3\) RETURNING version`
+	stateQuery := `UPDATE toggle_states SET rollout = \$1, updated_at = \$2 WHERE project = \$3 AND toggle_key = \$4 AND environment = \$5`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 LIMIT 1`
+	rollout := &entity.Rollout{Percentage: 30, BucketBy: "user_id"}
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), nil)
+	}
+	expectExists := func(exec *ToggleExecutor) {
+		exec.pgx.ExpectQuery(existsQuery).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+	}
+
+	t.Run("toggle not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectQuery(existsQuery).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+		res, err := exec.toggle.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleExecutor()
+		expectExists(exec)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(3)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		expectExists(exec)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Zero(t, res)
+	})
+
+	t.Run("success update rollout", func(t *testing.T) {
+		exec := createToggleExecutor()
+		expectExists(exec)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(1)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(testToggleProject, testToggleEnv, testToggleKey).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).
+			WithArgs([]byte(`{"percentage":30,"bucket_by":"user_id"}`), pgxmock.AnyArg(), testToggleProject, testToggleKey, testToggleEnv).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "UPDATE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_UpdateRules(t *testing.T) {
+	existsQuery := `SELECT EXISTS\(SELECT 1 FROM toggle_states JOIN toggles ON toggles.project = toggle_states.project AND toggles.key = toggle_states.toggle_key WHERE toggle_states.project = \$1 AND toggle_states.toggle_key = \$2 AND toggle_states.environment = \$3 AND toggles.deleted_at IS NULL\)`
+	versionQuery := `UPDATE toggles SET version = version \+ 1 WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL AND \(\$3::BIGINT = 0 OR version = \$3\) RETURNING version`
+	stateQuery := `UPDATE toggle_states SET rules = \$1, default_value = \$2, updated_at = \$3 WHERE project = \$4 AND toggle_key = \$5 AND environment = \$6`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 LIMIT 1`
+	rules := []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}}
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), nil)
+	}
+	expectExists := func(exec *ToggleExecutor) {
+		exec.pgx.ExpectQuery(existsQuery).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(true))
+	}
+
+	t.Run("toggle not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectQuery(existsQuery).WillReturnRows(pgxmock.NewRows([]string{"exists"}).AddRow(false))
+
+		res, err := exec.toggle.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleExecutor()
+		expectExists(exec)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(3)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		expectExists(exec)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Zero(t, res)
+	})
+
+	t.Run("success update rules", func(t *testing.T) {
+		exec := createToggleExecutor()
+		expectExists(exec)
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(1)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(testToggleProject, testToggleEnv, testToggleKey).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).
+			WithArgs(pgxmock.AnyArg(), false, pgxmock.AnyArg(), testToggleProject, testToggleKey, testToggleEnv).
+			WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "UPDATE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_GetAllPrerequisites(t *testing.T) {
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(`SELECT key, prerequisites FROM toggles WHERE project = \$1 AND deleted_at IS NULL`).
+			WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.GetAllPrerequisites(testCtx, testToggleProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("prerequisites can't be unmarshaled", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(`SELECT key, prerequisites FROM toggles WHERE project = \$1 AND deleted_at IS NULL`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "prerequisites"}).
+				AddRow(testToggleKey, []byte(`{`)),
+			)
+
+		res, err := exec.toggle.GetAllPrerequisites(testCtx, testToggleProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("rows error occurs", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(`SELECT key, prerequisites FROM toggles WHERE project = \$1 AND deleted_at IS NULL`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "prerequisites"}).
+				AddRow(testToggleKey, testTogglePrerequisites).
+				RowError(0, errPostgresInternal),
+			)
+
+		res, err := exec.toggle.GetAllPrerequisites(testCtx, testToggleProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get all prerequisites", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(`SELECT key, prerequisites FROM toggles WHERE project = \$1 AND deleted_at IS NULL`).
+			WillReturnRows(pgxmock.
+				NewRows([]string{"key", "prerequisites"}).
+				AddRow(testToggleKey, testTogglePrerequisites).
+				AddRow("toggle-0", []byte(`[]`)),
+			)
+
+		res, err := exec.toggle.GetAllPrerequisites(testCtx, testToggleProject)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, []*entity.Prerequisite{{Key: "toggle-0", Value: true}}, res[testToggleKey])
+		assert.Empty(t, res["toggle-0"])
+	})
+}
+
+func TestToggle_UpdatePrerequisites(t *testing.T) {
+	lockQuery := `SELECT id FROM toggles WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL FOR UPDATE`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.id = \$1`
+	updateQuery := `UPDATE toggles SET prerequisites = \$1, updated_at = \$2, version = version \+ 1 WHERE id = \$3 RETURNING version`
+	lockProjectQuery := `SELECT name FROM projects WHERE name = \$1 FOR NO KEY UPDATE`
+	graphQuery := `SELECT key, prerequisites FROM toggles WHERE project = \$1 AND deleted_at IS NULL`
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), nil)
+	}
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("toggle is not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("audit returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(updateQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("prerequisites create a cycle once the graph is locked", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(lockProjectQuery).WithArgs(testToggleProject).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		exec.pgx.ExpectQuery(graphQuery).WithArgs(testToggleProject).
+			WillReturnRows(pgxmock.NewRows([]string{"key", "prerequisites"}).
+				AddRow(testToggleKey, []byte(`[]`)).
+				AddRow("toggle-0", []byte(`[{"key":"toggle-1","value":true}]`)))
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, []*entity.Prerequisite{{Key: "toggle-0", Value: true}})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrPrerequisiteCycle([]string{testToggleKey, "toggle-0", testToggleKey}), err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("success update prerequisites", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(lockProjectQuery).WithArgs(testToggleProject).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+		exec.pgx.ExpectQuery(graphQuery).WithArgs(testToggleProject).
+			WillReturnRows(pgxmock.NewRows([]string{"key", "prerequisites"}).AddRow(testToggleKey, []byte(`[]`)).AddRow("toggle-0", []byte(`[]`)))
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(int64(7)).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(updateQuery).WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), int64(7)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "UPDATE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.toggle.UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, []*entity.Prerequisite{{Key: "toggle-0", Value: true}})
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_Update(t *testing.T) {
+	lockQuery := `SELECT id FROM toggles WHERE project = \$1 AND key = \$2 AND version = \$3 AND deleted_at IS NULL FOR UPDATE`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.id = \$1`
+	updateQuery := `UPDATE toggles SET description = \$1, owner = \$2, expiry_notified_at = CASE WHEN expires_at IS NOT DISTINCT FROM \$3 THEN expiry_notified_at ELSE NULL END, expires_at = \$3, updated_at = \$4, version = version \+ 1 WHERE id = \$5 RETURNING version`
+	deleteTagsQuery := `DELETE FROM toggle_tags WHERE project = \$1 AND toggle_key = \$2`
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil)
+	}
+	expectLock := func(exec *ToggleExecutor) {
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+	}
+
+	t.Run("nil toggle is prohibited", func(t *testing.T) {
+		exec := createToggleExecutor()
+
+		err := exec.toggle.Update(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+	})
+
+	t.Run("lock query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+	})
+
+	t.Run("update query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectLock(exec)
+		exec.pgx.ExpectQuery(updateQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("delete tags query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectLock(exec)
+		exec.pgx.ExpectQuery(updateQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(deleteTagsQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("audit returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectLock(exec)
+		exec.pgx.ExpectQuery(updateQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(deleteTagsQuery).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("insert outbox returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectLock(exec)
+		exec.pgx.ExpectQuery(updateQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(deleteTagsQuery).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+	})
+
+	t.Run("success update toggle without tags", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectLock(exec)
+		exec.pgx.ExpectQuery(updateQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(deleteTagsQuery).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("success update toggle and replace its tags", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Tags: []string{"team:payments"}, Version: 1}
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(1)).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(int64(7)).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(updateQuery).WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), int64(7)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(deleteTagsQuery).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		exec.pgx.ExpectExec(testInsertToggleTagsQuery).WithArgs(testToggleProject, testToggleKey, []string{"team:payments"}).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "UPDATE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.toggle.Update(testCtx, toggle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), toggle.Version)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("success update toggle in every environment", func(t *testing.T) {
+		rows := toggleRows().
+			AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", "staging", testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil)
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(rows)
+		exec.pgx.ExpectQuery(updateQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(deleteTagsQuery).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "UPDATE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, "staging", "UPDATE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.toggle.Update(testCtx, testToggle)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_ClaimExpired(t *testing.T) {
+	query := `UPDATE toggles SET expiry_notified_at = \$1 WHERE expiry_notified_at IS NULL AND id IN \(SELECT id FROM toggles WHERE expiry_notified_at IS NULL AND deleted_at IS NULL AND expires_at <= \$1 ORDER BY expires_at LIMIT \$2 FOR UPDATE SKIP LOCKED\) RETURNING key, project, description, owner, expires_at`
+	columns := []string{"key", "project", "description", "owner", "expires_at"}
+
+	t.Run("update query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(query).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.ClaimExpired(testCtx, time.Now(), 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("insert outbox returns error", func(t *testing.T) {
+		now := time.Now()
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(query).WillReturnRows(pgxmock.NewRows(columns).AddRow("toggle-1", testToggleProject, testToggleDescription, testToggleOwner, &now))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.ClaimExpired(testCtx, now, 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rows error after scanning", func(t *testing.T) {
+		now := time.Now()
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.
+			ExpectQuery(query).
+			WillReturnRows(pgxmock.
+				NewRows(columns).
+				AddRow("toggle-1", testToggleProject, testToggleDescription, testToggleOwner, &now).
+				AddRow("toggle-2", testToggleProject, testToggleDescription, testToggleOwner, &now).
+				RowError(2, errPostgresInternal),
+			)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.ClaimExpired(testCtx, now, 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully claim expired toggles", func(t *testing.T) {
+		now := time.Now()
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.
+			ExpectQuery(query).
+			WillReturnRows(pgxmock.
+				NewRows(columns).
+				AddRow("toggle-1", testToggleProject, testToggleDescription, testToggleOwner, &now).
+				AddRow("toggle-2", testToggleProject, testToggleDescription, "", &now),
+			)
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, "toggle-1", pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, "toggle-2", pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.ClaimExpired(testCtx, now, 10)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "toggle-1", res[0].Key)
+		assert.Equal(t, testToggleOwner, res[0].Owner)
+		assert.Equal(t, now, *res[1].ExpiresAt)
+	})
+}
+
+func TestToggle_GetAllDeletedByKey(t *testing.T) {
+	query := testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggles.key = \$2 AND toggles.deleted_at IS NOT NULL`
+
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectQuery(query).WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("deleted toggle not found", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectQuery(query).WillReturnRows(pgxmock.NewRows(testToggleColumns))
+
+		res, err := exec.toggle.GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully retrieve deleted toggle in all environments", func(t *testing.T) {
+		now := time.Now().UTC()
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(query).
+			WithArgs(testToggleProject, testToggleKey).
+			WillReturnRows(pgxmock.NewRows(testToggleColumns).
+				AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), &now).
+				AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", "staging", testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), &now),
+			)
+
+		res, err := exec.toggle.GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, now, *res[0].DeletedAt)
+		assert.True(t, res[1].IsDeleted())
+	})
+}
+
+func TestToggle_Delete(t *testing.T) {
+	lockQuery := `SELECT id FROM toggles WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL AND \(\$3::BIGINT = 0 OR version = \$3\) FOR UPDATE`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.id = \$1`
+	deleteQuery := `UPDATE toggles SET deleted_at = \$1, version = version \+ 1 WHERE id = \$2 RETURNING version`
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", "staging", testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil)
+	}
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("toggle doesn't exist", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(0)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(3)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleKey, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+	})
+
+	t.Run("soft-delete returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(deleteQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("insert outbox returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(deleteQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("success soft-delete a toggle", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(int64(7)).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(deleteQuery).WithArgs(pgxmock.AnyArg(), int64(7)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "DELETE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, "staging", "DELETE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_Restore(t *testing.T) {
+	lockQuery := `SELECT id FROM toggles WHERE project = \$1 AND key = \$2 AND deleted_at IS NOT NULL AND \(\$3::BIGINT = 0 OR version = \$3\) FOR UPDATE`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.id = \$1`
+	restoreQuery := `UPDATE toggles SET deleted_at = NULL, version = version \+ 1 WHERE id = \$1 RETURNING version`
+	toggleRows := func() *pgxmock.Rows {
+		now := time.Now()
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), &now)
+	}
+
+	t.Run("postgres database returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		version, err := exec.toggle.Restore(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Zero(t, version)
+	})
+
+	t.Run("deleted toggle doesn't exist", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(0)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		version, err := exec.toggle.Restore(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(3)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		version, err := exec.toggle.Restore(testCtx, testToggleProject, testToggleKey, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("audit returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(restoreQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		version, err := exec.toggle.Restore(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Zero(t, version)
+	})
+
+	t.Run("insert outbox returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(restoreQuery).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		version, err := exec.toggle.Restore(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Zero(t, version)
+	})
+
+	t.Run("success restore a toggle", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(2)).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(int64(7)).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(restoreQuery).WithArgs(int64(7)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "RESTORE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		version, err := exec.toggle.Restore(testCtx, testToggleProject, testToggleKey, 2)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), version)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_Purge(t *testing.T) {
+	lockQuery := `SELECT id FROM toggles WHERE project = \$1 AND key = \$2 AND \(\$3::BIGINT = 0 OR version = \$3\) FOR UPDATE`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.id = ANY\(\$1\)`
+	deleteQuery := `DELETE FROM toggles WHERE id = ANY\(\$1\)`
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+			AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", "staging", testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil)
+	}
+
+	t.Run("toggle doesn't exist", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(0)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Purge(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(3)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Purge(testCtx, testToggleProject, testToggleKey, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+	})
+
+	t.Run("delete returns internal error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(deleteQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		err := exec.toggle.Purge(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("success purge a toggle", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs([]int64{7}).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(deleteQuery).WithArgs([]int64{7}).WillReturnResult(pgxmock.NewResult("DELETE", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "PURGE", "", "", "", pgxmock.AnyArg(), []byte(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, "staging", "PURGE", "", "", "", pgxmock.AnyArg(), []byte(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		err := exec.toggle.Purge(testCtx, testToggleProject, testToggleKey, 0)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_ClaimDeleted(t *testing.T) {
+	claimQuery := `SELECT id FROM toggles WHERE deleted_at <= \$1 ORDER BY deleted_at LIMIT \$2 FOR UPDATE SKIP LOCKED`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.id = ANY\(\$1\)`
+	deleteQuery := `DELETE FROM toggles WHERE id = ANY\(\$1\)`
+
+	t.Run("claim query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(claimQuery).WillReturnError(errPostgresInternal)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.ClaimDeleted(testCtx, time.Now(), 10)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("there isn't any toggle to purge", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(claimQuery).WithArgs(pgxmock.AnyArg(), 10).WillReturnRows(pgxmock.NewRows([]string{"id"}))
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.ClaimDeleted(testCtx, time.Now(), 10)
+
+		assert.Nil(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully purge the claimed toggles", func(t *testing.T) {
+		now := time.Now().UTC()
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(claimQuery).WithArgs(pgxmock.AnyArg(), 10).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)).AddRow(int64(8)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs([]int64{7, 8}).WillReturnRows(pgxmock.NewRows(testToggleColumns).
+			AddRow("toggle-1", false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), &now).
+			AddRow("toggle-2", false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(2), &now))
+		exec.pgx.ExpectExec(deleteQuery).WithArgs([]int64{7, 8}).WillReturnResult(pgxmock.NewResult("DELETE", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, "toggle-1", testToggleEnv, "PURGE", "", "", "", pgxmock.AnyArg(), []byte(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, "toggle-2", testToggleEnv, "PURGE", "", "", "", pgxmock.AnyArg(), []byte(nil), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, "toggle-1", pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WithArgs(testToggleProject, "toggle-2", pgxmock.AnyArg(), pgxmock.AnyArg()).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.ClaimDeleted(testCtx, now, 10)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, "toggle-1", res[0].Key)
+		assert.Equal(t, "toggle-2", res[1].Key)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_GetAllByKeys(t *testing.T) {
+	query := testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggles.key = ANY\(\$2\) AND toggles.deleted_at IS NULL`
+
+	t.Run("select query returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectQuery(query).WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.GetAllByKeys(testCtx, testToggleProject, []string{testToggleKey})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully retrieve toggles in all environments", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.
+			ExpectQuery(query).
+			WithArgs(testToggleProject, []string{testToggleKey, "toggle-2"}).
+			WillReturnRows(pgxmock.NewRows(testToggleColumns).
+				AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil).
+				AddRow(testToggleKey, false, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", "staging", testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil),
+			)
+
+		res, err := exec.toggle.GetAllByKeys(testCtx, testToggleProject, []string{testToggleKey, "toggle-2"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(res))
+	})
+}
+
+func TestToggle_InsertAll(t *testing.T) {
+	expectInsert := func(exec *ToggleExecutor) {
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertToggleStateQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertDefaultStates).WillReturnResult(pgxmock.NewResult("INSERT", 2))
+		exec.pgx.ExpectExec(testInsertAuditQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	}
+	createToggles := func() []*entity.Toggle {
+		return []*entity.Toggle{
+			{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv},
+			{Key: "toggle-2", Project: testToggleProject, Environment: testToggleEnv},
+		}
+	}
+
+	t.Run("begin transaction returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin().WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.InsertAll(testCtx, createToggles(), true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("atomic batch is rolled back on the first failure", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectInsert(exec)
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnError(&pgconn.PgError{Code: "23505"})
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.InsertAll(testCtx, createToggles(), true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrBatchItemFailed(1, entity.ErrAlreadyExists()).Error(), err.Error())
+		assert.Nil(t, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("non-atomic batch only rolls back the failed toggle", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectExec(testInsertToggleQuery).WillReturnError(&pgconn.PgError{Code: "23505"})
+		exec.pgx.ExpectRollback()
+		exec.pgx.ExpectBegin()
+		expectInsert(exec)
+		exec.pgx.ExpectCommit()
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.InsertAll(testCtx, createToggles(), false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{
+			{Key: testToggleKey, Err: entity.ErrAlreadyExists()},
+			{Key: "toggle-2", Version: 1},
+		}, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("commit returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectInsert(exec)
+		exec.pgx.ExpectCommit().WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.InsertAll(testCtx, createToggles()[:1], true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success insert all toggles atomically", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectInsert(exec)
+		expectInsert(exec)
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.InsertAll(testCtx, createToggles(), true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{{Key: testToggleKey, Version: 1}, {Key: "toggle-2", Version: 1}}, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_UpdateAll(t *testing.T) {
+	versionQuery := `UPDATE toggles SET version = version \+ 1 WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL AND \(\$3::BIGINT = 0 OR version = \$3\) RETURNING version`
+	stateQuery := `UPDATE toggle_states SET is_enabled = \$1, updated_at = \$2 WHERE project = \$3 AND toggle_key = \$4 AND environment = \$5`
+	selectQuery := testSelectToggleQuery + ` WHERE toggles.project = \$1 AND toggle_states.environment = \$2 AND toggles.key = \$3 LIMIT 1`
+	lockQuery := `SELECT id FROM toggles WHERE project = \$1 AND key = \$2 AND deleted_at IS NULL AND \(\$3::BIGINT = 0 OR version = \$3\) FOR UPDATE`
+	selectByIDQuery := testSelectToggleQuery + ` WHERE toggles.id = \$1`
+	deleteQuery := `UPDATE toggles SET deleted_at = \$1, version = version \+ 1 WHERE id = \$2 RETURNING version`
+	toggleRows := func() *pgxmock.Rows {
+		return pgxmock.NewRows(testToggleColumns).
+			AddRow(testToggleKey, true, testToggleDescription, time.Now(), time.Now(), testToggleRules, true, testToggleRollout, testToggleVariants, "on", "off", testToggleEnv, testToggleProject, testTogglePrerequisites, testToggleOwner, nil, testToggleTags, int64(1), nil)
+	}
+	expectDisable := func(exec *ToggleExecutor) {
+		exec.pgx.ExpectQuery(versionQuery).WithArgs(testToggleProject, testToggleKey, int64(1)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(2)))
+		exec.pgx.ExpectQuery(selectQuery).WithArgs(testToggleProject, testToggleEnv, testToggleKey).WillReturnRows(toggleRows())
+		exec.pgx.ExpectExec(stateQuery).WithArgs(false, pgxmock.AnyArg(), testToggleProject, testToggleKey, testToggleEnv).WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "DISABLE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	}
+	ops := []*entity.ToggleOperation{
+		{Key: testToggleKey, Type: entity.ToggleOperationDisable, Version: 1},
+		{Key: testToggleKey, Type: entity.ToggleOperationDelete, Version: 2},
+	}
+
+	t.Run("begin transaction returns error", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin().WillReturnError(errPostgresInternal)
+
+		res, err := exec.toggle.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(errPostgresInternalMsg), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("atomic batch is rolled back on the first failure", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectDisable(exec)
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(2)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+
+		res, err := exec.toggle.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Equal(t, entity.ErrBatchItemFailed(1, entity.ErrVersionConflict()).Error(), err.Error())
+		assert.Nil(t, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("non-atomic batch only rolls back the failed operation", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectBegin()
+		expectDisable(exec)
+		exec.pgx.ExpectCommit()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, "toggle-2", int64(0)).WillReturnError(pgx.ErrNoRows)
+		exec.pgx.ExpectRollback()
+		exec.pgx.ExpectBegin()
+		exec.pgx.ExpectRollback()
+		exec.pgx.ExpectCommit()
+
+		res, err := exec.toggle.UpdateAll(testCtx, testToggleProject, testToggleEnv, []*entity.ToggleOperation{
+			{Key: testToggleKey, Type: entity.ToggleOperationDisable, Version: 1},
+			{Key: "toggle-2", Type: entity.ToggleOperationDelete},
+			{Key: "toggle-3", Type: "PURGE"},
+		}, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(res))
+		assert.Equal(t, &entity.BatchResult{Key: testToggleKey, Version: 2}, res[0])
+		assert.Equal(t, &entity.BatchResult{Key: "toggle-2", Err: entity.ErrNotFound()}, res[1])
+		assert.Equal(t, codes.InvalidArgument, status.Code(res[2].Err))
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
+	})
+
+	t.Run("success apply all operations atomically", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.pgx.ExpectBegin()
+		expectDisable(exec)
+		exec.pgx.ExpectQuery(lockQuery).WithArgs(testToggleProject, testToggleKey, int64(2)).WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(int64(7)))
+		exec.pgx.ExpectQuery(selectByIDQuery).WithArgs(int64(7)).WillReturnRows(toggleRows())
+		exec.pgx.ExpectQuery(deleteQuery).WithArgs(pgxmock.AnyArg(), int64(7)).WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(int64(3)))
+		exec.pgx.ExpectExec(testInsertAuditQuery).
+			WithArgs(testToggleProject, testToggleKey, testToggleEnv, "DELETE", "", "", "", pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectExec(testInsertOutboxQuery).
+			WithArgs(testToggleProject, testToggleKey, pgxmock.AnyArg(), pgxmock.AnyArg()).
+			WillReturnResult(pgxmock.NewResult("INSERT", 1))
+		exec.pgx.ExpectCommit()
 
-		err := exec.toggle.Delete(testCtx, testToggleKey)
+		res, err := exec.toggle.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
 
 		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{{Key: testToggleKey, Version: 2}, {Key: testToggleKey, Version: 3}}, res)
+		assert.Nil(t, exec.pgx.ExpectationsWereMet())
 	})
 }
 
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
@@ -16,7 +17,7 @@ const (
 )
 
 var (
-	attributes        = []string{"key", "is_enabled", "description", "created_at", "updated_at"}
+	attributes        = []string{"key", "is_enabled", "description", "created_at", "updated_at", "rules", "default_value", "rollout", "variants", "default_variant", "off_variant", "environment", "project", "prerequisites", "owner", "expires_at", "tags", "version"}
 	numberOfAttribute = len(attributes)
 )
 
@@ -36,13 +37,15 @@ func NewToggle(client goredis.Cmdable, ttl time.Duration) *Toggle {
 }
 
 // Set sets the toggle in redis using hash (https://redis.io/commands/hset).
+// The toggle is stored under its project and environment, so each project's environment has its own hash.
 // It only sets the toggle for a certain time. It is set in ttl parameter in constructor.
 func (t *Toggle) Set(ctx context.Context, toggle *entity.Toggle) error {
 	hash := createToggleHash(toggle)
+	key := createCacheKey(toggle.Project, toggle.Environment, toggle.Key)
 
 	pipe := t.client.Pipeline()
-	res := pipe.HSet(ctx, toggle.Key, hash)
-	pipe.Expire(ctx, toggle.Key, t.ttl)
+	res := pipe.HSet(ctx, key, hash)
+	pipe.Expire(ctx, key, t.ttl)
 	_, err := pipe.Exec(ctx)
 
 	if int(res.Val()) != numberOfAttribute {
@@ -54,21 +57,30 @@ func (t *Toggle) Set(ctx context.Context, toggle *entity.Toggle) error {
 	return nil
 }
 
-// SetIsEnabled sets the toggle's is_enabled field in redis.
-// It doesn't change the current expire time.
-func (t *Toggle) SetIsEnabled(ctx context.Context, key string, value bool) error {
-	err := t.client.HSet(ctx, key, "is_enabled", strconv.FormatBool(value), "updated_at", time.Now().UTC().Format(time.RFC3339)).Err()
-	if err != nil {
+// SetAll sets the toggles in redis in the same way Set does using a single pipeline,
+// thus all toggles are sent to redis in one round trip.
+func (t *Toggle) SetAll(ctx context.Context, toggles []*entity.Toggle) error {
+	if len(toggles) == 0 {
+		return nil
+	}
+
+	pipe := t.client.Pipeline()
+	for _, toggle := range toggles {
+		key := createCacheKey(toggle.Project, toggle.Environment, toggle.Key)
+		pipe.HSet(ctx, key, createToggleHash(toggle))
+		pipe.Expire(ctx, key, t.ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
 		return entity.ErrInternal(err.Error())
 	}
 	return nil
 }
 
-// Get gets a toggle in cache.
+// Get gets a toggle in the project's environment in cache.
 // It only returns error of there is error in the system or toggle value can't be processed.
 // If the data can't be found but the system is fine, it returns nil.
-func (t *Toggle) Get(ctx context.Context, key string) (*entity.Toggle, error) {
-	res, err := t.client.HGetAll(ctx, key).Result()
+func (t *Toggle) Get(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	res, err := t.client.HGetAll(ctx, createCacheKey(project, env, key)).Result()
 	if err != nil && err.Error() == redisNotFound {
 		return nil, nil
 	}
@@ -81,17 +93,39 @@ func (t *Toggle) Get(ctx context.Context, key string) (*entity.Toggle, error) {
 	return createToggleFromHash(res)
 }
 
-// Delete deletes a toggle from redis.
+// Delete deletes a toggle in the project's environment from redis.
 // It doesn't return error if toggle doesn't exist.
-func (t *Toggle) Delete(ctx context.Context, key string) error {
-	err := t.client.Del(ctx, key).Err()
+func (t *Toggle) Delete(ctx context.Context, project, env, key string) error {
+	err := t.client.Del(ctx, createCacheKey(project, env, key)).Err()
 	if err != nil {
 		return entity.ErrInternal(err.Error())
 	}
 	return nil
 }
 
+// DeleteAll deletes the toggles in their project's environment from redis in one round trip.
+// It doesn't return error if any of the toggles doesn't exist.
+func (t *Toggle) DeleteAll(ctx context.Context, toggles []*entity.Toggle) error {
+	if len(toggles) == 0 {
+		return nil
+	}
+
+	var keys []string
+	for _, toggle := range toggles {
+		keys = append(keys, createCacheKey(toggle.Project, toggle.Environment, toggle.Key))
+	}
+	if err := t.client.Del(ctx, keys...).Err(); err != nil {
+		return entity.ErrInternal(err.Error())
+	}
+	return nil
+}
+
 func createToggleHash(toggle *entity.Toggle) []string {
+	rules, _ := json.Marshal(toggle.Rules)                 // error is impossible, hence ignored.
+	rollout, _ := json.Marshal(toggle.Rollout)             // error is impossible, hence ignored.
+	variants, _ := json.Marshal(toggle.Variants)           // error is impossible, hence ignored.
+	prerequisites, _ := json.Marshal(toggle.Prerequisites) // error is impossible, hence ignored.
+	tags, _ := json.Marshal(toggle.Tags)                   // error is impossible, hence ignored.
 	return []string{
 		"key",
 		toggle.Key,
@@ -103,7 +137,46 @@ func createToggleHash(toggle *entity.Toggle) []string {
 		toggle.CreatedAt.Format(time.RFC3339),
 		"updated_at",
 		toggle.UpdatedAt.Format(time.RFC3339),
+		"rules",
+		string(rules),
+		"default_value",
+		strconv.FormatBool(toggle.DefaultValue),
+		"rollout",
+		string(rollout),
+		"variants",
+		string(variants),
+		"default_variant",
+		toggle.DefaultVariant,
+		"off_variant",
+		toggle.OffVariant,
+		"environment",
+		toggle.Environment,
+		"project",
+		toggle.Project,
+		"prerequisites",
+		string(prerequisites),
+		"owner",
+		toggle.Owner,
+		"expires_at",
+		formatExpiresAt(toggle.ExpiresAt),
+		"tags",
+		string(tags),
+		"version",
+		strconv.FormatInt(toggle.Version, 10),
+	}
+}
+
+// formatExpiresAt formats the expiry time as RFC3339. A toggle without expiry time is stored as empty string.
+func formatExpiresAt(expiresAt *time.Time) string {
+	if expiresAt == nil {
+		return ""
 	}
+	return expiresAt.Format(time.RFC3339)
+}
+
+// createCacheKey creates the redis key of a toggle in a project's environment.
+func createCacheKey(project, env, key string) string {
+	return project + ":" + env + ":" + key
 }
 
 func createToggleFromHash(hash map[string]string) (*entity.Toggle, error) {
@@ -124,6 +197,47 @@ func createToggleFromHash(hash map[string]string) (*entity.Toggle, error) {
 	if err != nil {
 		return nil, entity.ErrInternal(err.Error())
 	}
+	if err = json.Unmarshal([]byte(hash["rules"]), &toggle.Rules); err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	toggle.DefaultValue, err = strconv.ParseBool(hash["default_value"])
+	if err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	if err = json.Unmarshal([]byte(hash["rollout"]), &toggle.Rollout); err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	if err = json.Unmarshal([]byte(hash["variants"]), &toggle.Variants); err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	toggle.DefaultVariant = hash["default_variant"]
+	toggle.OffVariant = hash["off_variant"]
+	toggle.Environment = hash["environment"]
+	toggle.Project = hash["project"]
+	if err = json.Unmarshal([]byte(hash["prerequisites"]), &toggle.Prerequisites); err != nil {
+		return nil, entity.ErrInternal(err.Error())
+	}
+	toggle.Owner = hash["owner"]
+	if hash["expires_at"] != "" {
+		expiresAt, err := time.Parse(time.RFC3339, hash["expires_at"])
+		if err != nil {
+			return nil, entity.ErrInternal(err.Error())
+		}
+		toggle.ExpiresAt = &expiresAt
+	}
+	// toggle cached before tags are introduced doesn't have tags.
+	if hash["tags"] != "" {
+		if err = json.Unmarshal([]byte(hash["tags"]), &toggle.Tags); err != nil {
+			return nil, entity.ErrInternal(err.Error())
+		}
+	}
+	// toggle cached before versions are introduced doesn't have version, hence its version is unknown (zero).
+	if hash["version"] != "" {
+		toggle.Version, err = strconv.ParseInt(hash["version"], 10, 64)
+		if err != nil {
+			return nil, entity.ErrInternal(err.Error())
+		}
+	}
 
 	return toggle, nil
 }
@@ -19,17 +19,47 @@ var (
 	testCtx               = context.Background()
 	testTTL               = 5 * time.Minute
 	testToggleKey         = "toggle-1"
+	testToggleProject     = "default"
+	testToggleEnv         = "production"
+	testToggleCacheKey    = "default:production:toggle-1"
 	testToggleDescription = "description"
 	testToggleCreatedAt   = time.Now()
 	testToggleUpdatedAt   = time.Now()
+	testToggleOwner       = "team-checkout"
+	testToggleExpiresAt   = time.Now().Add(24 * time.Hour)
 	testToggle            = &entity.Toggle{
 		Key:         testToggleKey,
 		IsEnabled:   false,
 		Description: testToggleDescription,
 		CreatedAt:   testToggleCreatedAt,
 		UpdatedAt:   testToggleUpdatedAt,
+		Rules: []*entity.Rule{
+			{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}, Value: true},
+		},
+		DefaultValue: true,
+		Rollout:      &entity.Rollout{Percentage: 10, BucketBy: "user_id"},
+		Variants: []*entity.Variant{
+			{Name: "on", Type: entity.VariantTypeString, Value: "on"},
+			{Name: "off", Type: entity.VariantTypeString, Value: "off"},
+		},
+		DefaultVariant: "on",
+		OffVariant:     "off",
+		Environment:    testToggleEnv,
+		Project:        testToggleProject,
+		Prerequisites: []*entity.Prerequisite{
+			{Key: "toggle-0", Value: true},
+		},
+		Owner:     testToggleOwner,
+		ExpiresAt: &testToggleExpiresAt,
+		Tags:      []string{"team:payments", "kind:kill-switch"},
+		Version:   3,
 	}
-	testHSetInput = []string{
+	testToggleRules         = `[{"attribute":"country","operator":"in","values":["ID","SG"],"value":true}]`
+	testToggleRollout       = `{"percentage":10,"bucket_by":"user_id"}`
+	testToggleVariants      = `[{"name":"on","type":"string","value":"on"},{"name":"off","type":"string","value":"off"}]`
+	testTogglePrerequisites = `[{"key":"toggle-0","value":true}]`
+	testToggleTags          = `["team:payments","kind:kill-switch"]`
+	testHSetInput           = []string{
 		"key",
 		testToggleKey,
 		"is_enabled",
@@ -40,14 +70,53 @@ var (
 		testToggleCreatedAt.Format(time.RFC3339),
 		"updated_at",
 		testToggleUpdatedAt.Format(time.RFC3339),
+		"rules",
+		testToggleRules,
+		"default_value",
+		"true",
+		"rollout",
+		testToggleRollout,
+		"variants",
+		testToggleVariants,
+		"default_variant",
+		"on",
+		"off_variant",
+		"off",
+		"environment",
+		testToggleEnv,
+		"project",
+		testToggleProject,
+		"prerequisites",
+		testTogglePrerequisites,
+		"owner",
+		testToggleOwner,
+		"expires_at",
+		testToggleExpiresAt.Format(time.RFC3339),
+		"tags",
+		testToggleTags,
+		"version",
+		"3",
 	}
 	testEmptyMapResult = make(map[string]string)
 	testValidMapResult = map[string]string{
-		"key":         testToggleKey,
-		"is_enabled":  "true",
-		"description": testToggleDescription,
-		"created_at":  testToggleCreatedAt.Format(time.RFC3339),
-		"updated_at":  testToggleUpdatedAt.Format(time.RFC3339),
+		"key":             testToggleKey,
+		"is_enabled":      "true",
+		"description":     testToggleDescription,
+		"created_at":      testToggleCreatedAt.Format(time.RFC3339),
+		"updated_at":      testToggleUpdatedAt.Format(time.RFC3339),
+		"rules":           testToggleRules,
+		"default_value":   "true",
+		"rollout":         testToggleRollout,
+		"variants":        testToggleVariants,
+		"default_variant": "on",
+		"off_variant":     "off",
+		"environment":     testToggleEnv,
+		"project":         testToggleProject,
+		"prerequisites":   testTogglePrerequisites,
+		"owner":           testToggleOwner,
+		"expires_at":      testToggleExpiresAt.Format(time.RFC3339),
+		"tags":            testToggleTags,
+		"version":         "3",
 	}
 	testRedisDownMessage = "redis down"
 )
@@ -67,19 +136,19 @@ func TestNewToggle(t *testing.T) {
 func TestToggle_Set(t *testing.T) {
 	t.Run("not all attributes are saved", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHSet(testToggleKey, testHSetInput).SetVal(2)
-		exec.mock.ExpectExpire(testToggleKey, testTTL).SetVal(true)
+		exec.mock.ExpectHSet(testToggleCacheKey, testHSetInput).SetVal(2)
+		exec.mock.ExpectExpire(testToggleCacheKey, testTTL).SetVal(true)
 
 		err := exec.toggle.Set(testCtx, testToggle)
 
 		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "only success to save 2 out of 5 attributes")
+		assert.Contains(t, err.Error(), "only success to save 2 out of 18 attributes")
 	})
 
 	t.Run("redis is down", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHSet(testToggleKey, testHSetInput).SetVal(5)
-		exec.mock.ExpectExpire(testToggleKey, testTTL).SetErr(errors.New(testRedisDownMessage))
+		exec.mock.ExpectHSet(testToggleCacheKey, testHSetInput).SetVal(18)
+		exec.mock.ExpectExpire(testToggleCacheKey, testTTL).SetErr(errors.New(testRedisDownMessage))
 
 		err := exec.toggle.Set(testCtx, testToggle)
 
@@ -89,8 +158,8 @@ func TestToggle_Set(t *testing.T) {
 
 	t.Run("success save res in redis hash", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHSet(testToggleKey, testHSetInput).SetVal(5)
-		exec.mock.ExpectExpire(testToggleKey, testTTL).SetVal(true)
+		exec.mock.ExpectHSet(testToggleCacheKey, testHSetInput).SetVal(18)
+		exec.mock.ExpectExpire(testToggleCacheKey, testTTL).SetVal(true)
 
 		err := exec.toggle.Set(testCtx, testToggle)
 
@@ -101,9 +170,9 @@ func TestToggle_Set(t *testing.T) {
 func TestToggle_Get(t *testing.T) {
 	t.Run("redis hgetall returns not found (redis: nil)", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHGetAll(testToggleKey).SetErr(errors.New("redis: nil"))
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetErr(errors.New("redis: nil"))
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.Nil(t, err)
 		assert.Nil(t, res)
@@ -111,9 +180,9 @@ func TestToggle_Get(t *testing.T) {
 
 	t.Run("redis hgetall returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHGetAll(testToggleKey).SetErr(errors.New(testRedisDownMessage))
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetErr(errors.New(testRedisDownMessage))
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(testRedisDownMessage), err)
@@ -122,9 +191,9 @@ func TestToggle_Get(t *testing.T) {
 
 	t.Run("redis hgetall returns empty hash", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHGetAll(testToggleKey).SetVal(testEmptyMapResult)
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(testEmptyMapResult)
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
@@ -135,9 +204,9 @@ func TestToggle_Get(t *testing.T) {
 		exec := createToggleExecutor()
 		hash := make(map[string]string)
 		hash["is_enabled"] = "no-value"
-		exec.mock.ExpectHGetAll(testToggleKey).SetVal(hash)
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, codes.Internal, status.Code(err))
@@ -149,9 +218,9 @@ func TestToggle_Get(t *testing.T) {
 		hash := make(map[string]string)
 		hash["is_enabled"] = "false"
 		hash["created_at"] = ""
-		exec.mock.ExpectHGetAll(testToggleKey).SetVal(hash)
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, codes.Internal, status.Code(err))
@@ -164,9 +233,164 @@ func TestToggle_Get(t *testing.T) {
 		hash["is_enabled"] = "false"
 		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
 		hash["updated_at"] = ""
-		exec.mock.ExpectHGetAll(testToggleKey).SetVal(hash)
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle rules is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "{"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle default_value is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "no-value"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle rollout is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "true"
+		hash["rollout"] = "{"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle variants is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "true"
+		hash["rollout"] = "null"
+		hash["variants"] = "{"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle prerequisites is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "true"
+		hash["rollout"] = "null"
+		hash["variants"] = "[]"
+		hash["prerequisites"] = "{"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle expires_at is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "true"
+		hash["rollout"] = "null"
+		hash["variants"] = "[]"
+		hash["prerequisites"] = "[]"
+		hash["expires_at"] = "tomorrow"
+		hash["tags"] = "[]"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle tags is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "true"
+		hash["rollout"] = "null"
+		hash["variants"] = "[]"
+		hash["prerequisites"] = "[]"
+		hash["tags"] = "{"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle version is invalid", func(t *testing.T) {
+		exec := createToggleExecutor()
+		hash := make(map[string]string)
+		hash["is_enabled"] = "false"
+		hash["created_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["updated_at"] = "2021-04-04T12:05:38.728727+07:00"
+		hash["rules"] = "[]"
+		hash["default_value"] = "true"
+		hash["rollout"] = "null"
+		hash["variants"] = "[]"
+		hash["prerequisites"] = "[]"
+		hash["tags"] = "[]"
+		hash["version"] = "first"
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(hash)
+
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, codes.Internal, status.Code(err))
@@ -175,52 +399,114 @@ func TestToggle_Get(t *testing.T) {
 
 	t.Run("success get toggle from redis hash", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHGetAll(testToggleKey).SetVal(testValidMapResult)
+		exec.mock.ExpectHGetAll(testToggleCacheKey).SetVal(testValidMapResult)
 
-		res, err := exec.toggle.Get(testCtx, testToggleKey)
+		res, err := exec.toggle.Get(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.Nil(t, err)
 		assert.NotNil(t, res)
+		assert.Equal(t, testToggle.Rules, res.Rules)
+		assert.True(t, res.DefaultValue)
+		assert.Equal(t, testToggle.Rollout, res.Rollout)
+		assert.Equal(t, testToggle.Variants, res.Variants)
+		assert.Equal(t, testToggle.Prerequisites, res.Prerequisites)
+		assert.Equal(t, testToggle.DefaultVariant, res.DefaultVariant)
+		assert.Equal(t, testToggle.OffVariant, res.OffVariant)
+		assert.Equal(t, testToggleEnv, res.Environment)
+		assert.Equal(t, testToggleProject, res.Project)
+		assert.Equal(t, testToggleOwner, res.Owner)
+		assert.Equal(t, testToggle.Tags, res.Tags)
+		assert.Equal(t, testToggle.Version, res.Version)
+		assert.Equal(t, testToggleExpiresAt.Format(time.RFC3339), res.ExpiresAt.Format(time.RFC3339))
 	})
 }
 
-func TestToggle_SetIsEnabled(t *testing.T) {
-	t.Run("set returns error", func(t *testing.T) {
+func TestToggle_Delete(t *testing.T) {
+	t.Run("delete returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHSet(testToggleKey, "is_enabled", "false", "updated_at", time.Now().UTC().Format(time.RFC3339)).SetErr(errors.New(testRedisDownMessage))
+		exec.mock.ExpectDel(testToggleCacheKey).SetErr(errors.New(testRedisDownMessage))
 
-		err := exec.toggle.SetIsEnabled(testCtx, testToggleKey, false)
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(testRedisDownMessage), err)
 	})
 
-	t.Run("success set is_enabled field", func(t *testing.T) {
+	t.Run("success delete", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectHSet(testToggleKey, "is_enabled", "true", "updated_at", time.Now().UTC().Format(time.RFC3339)).SetVal(0)
+		exec.mock.ExpectDel(testToggleCacheKey).SetVal(1)
 
-		err := exec.toggle.SetIsEnabled(testCtx, testToggleKey, true)
+		err := exec.toggle.Delete(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.Nil(t, err)
 	})
 }
 
-func TestToggle_Delete(t *testing.T) {
+func TestToggle_SetAll(t *testing.T) {
+	t.Run("empty toggles doesn't call redis", func(t *testing.T) {
+		exec := createToggleExecutor()
+
+		err := exec.toggle.SetAll(testCtx, nil)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.mock.ExpectationsWereMet())
+	})
+
+	t.Run("redis is down", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.mock.ExpectHSet(testToggleCacheKey, testHSetInput).SetVal(18)
+		exec.mock.ExpectExpire(testToggleCacheKey, testTTL).SetErr(errors.New(testRedisDownMessage))
+
+		err := exec.toggle.SetAll(testCtx, []*entity.Toggle{testToggle})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("success save all toggles in one pipeline", func(t *testing.T) {
+		exec := createToggleExecutor()
+		exec.mock.ExpectHSet(testToggleCacheKey, testHSetInput).SetVal(18)
+		exec.mock.ExpectExpire(testToggleCacheKey, testTTL).SetVal(true)
+		exec.mock.ExpectHSet(testToggleCacheKey, testHSetInput).SetVal(0)
+		exec.mock.ExpectExpire(testToggleCacheKey, testTTL).SetVal(true)
+
+		err := exec.toggle.SetAll(testCtx, []*entity.Toggle{testToggle, testToggle})
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.mock.ExpectationsWereMet())
+	})
+}
+
+func TestToggle_DeleteAll(t *testing.T) {
+	toggles := []*entity.Toggle{
+		{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv},
+		{Key: testToggleKey, Project: testToggleProject, Environment: "staging"},
+	}
+
+	t.Run("empty toggles doesn't call redis", func(t *testing.T) {
+		exec := createToggleExecutor()
+
+		err := exec.toggle.DeleteAll(testCtx, nil)
+
+		assert.Nil(t, err)
+		assert.Nil(t, exec.mock.ExpectationsWereMet())
+	})
+
 	t.Run("delete returns error", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectDel(testToggleKey).SetErr(errors.New(testRedisDownMessage))
+		exec.mock.ExpectDel(testToggleCacheKey, "default:staging:toggle-1").SetErr(errors.New(testRedisDownMessage))
 
-		err := exec.toggle.Delete(testCtx, testToggleKey)
+		err := exec.toggle.DeleteAll(testCtx, toggles)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(testRedisDownMessage), err)
 	})
 
-	t.Run("success delete", func(t *testing.T) {
+	t.Run("success delete all toggles", func(t *testing.T) {
 		exec := createToggleExecutor()
-		exec.mock.ExpectDel(testToggleKey).SetVal(1)
+		exec.mock.ExpectDel(testToggleCacheKey, "default:staging:toggle-1").SetVal(2)
 
-		err := exec.toggle.Delete(testCtx, testToggleKey)
+		err := exec.toggle.DeleteAll(testCtx, toggles)
 
 		assert.Nil(t, err)
 	})
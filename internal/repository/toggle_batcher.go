@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// BatchToggleDatabase defines the interface to change many toggles in database.
+type BatchToggleDatabase interface {
+	// InsertAll inserts the toggles within a single transaction and returns the result of each toggle in the same order.
+	// If atomic is true, it must roll back all toggles once any of them fails and return the failure.
+	InsertAll(ctx context.Context, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error)
+	// UpdateAll applies the operations on the toggles in the project's environment in order within a single transaction
+	// and returns the result of each operation in the same order.
+	// If atomic is true, it must roll back all operations once any of them fails and return the failure.
+	UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error)
+	// GetAllByKey gets a toggle in all of the project's environments from database.
+	// It must return codes.NotFound from package package google.golang.org/grpc/codes if data can't be found.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// GetAllByKeys gets the toggles in all of the project's environments from database.
+	// The toggles which can't be found are omitted.
+	GetAllByKeys(ctx context.Context, project string, keys []string) ([]*entity.Toggle, error)
+}
+
+// BatchToggleCache defines the interface to change many toggles in cache.
+type BatchToggleCache interface {
+	// SetAll sets the toggles in cache.
+	// Each toggle is cached for its project and environment.
+	SetAll(ctx context.Context, toggles []*entity.Toggle) error
+	// DeleteAll deletes the toggles in their project's environment from cache.
+	// It doesn't return any error if any of the toggles is not found.
+	DeleteAll(ctx context.Context, toggles []*entity.Toggle) error
+}
+
+// ToggleBatcher is responsible to change many toggles in storage at once.
+// It uses database and cache.
+type ToggleBatcher struct {
+	database BatchToggleDatabase
+	cache    BatchToggleCache
+}
+
+// NewToggleBatcher creates an instance of ToggleBatcher.
+func NewToggleBatcher(database BatchToggleDatabase, cache BatchToggleCache) *ToggleBatcher {
+	return &ToggleBatcher{database: database, cache: cache}
+}
+
+// InsertAll inserts the toggles into the storage and returns the result of each toggle in the same order.
+// First, it inserts to database within a single transaction. Then, the inserted toggles are set to cache at once.
+// It ignores the error from cache since it can always be generated when retrieving the data.
+// But, it doesn't ignore the error from the database.
+func (tb *ToggleBatcher) InsertAll(ctx context.Context, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	res, err := tb.database.InsertAll(ctx, toggles, atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	var inserted []*entity.Toggle
+	for i, result := range res {
+		if result.Err == nil {
+			inserted = append(inserted, toggles[i])
+		}
+	}
+	_ = tb.cache.SetAll(ctx, inserted)
+	return res, nil
+}
+
+// UpdateAll applies the operations on the toggles in the project's environment in the storage
+// and returns the result of each operation in the same order.
+// The cache of the toggles to delete is deleted for every environment before the database, as ToggleDeleter does.
+// Then, the operations are applied to database within a single transaction.
+// Finally, the toggles which are still there are written to cache in every environment at once,
+// since the version is shared by all environments.
+// It ignores the error from the last cache write since the cache expires by itself.
+func (tb *ToggleBatcher) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	if err := tb.deleteCache(ctx, project, operations); err != nil {
+		return nil, err
+	}
+
+	res, err := tb.database.UpdateAll(ctx, project, env, operations, atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, result := range res {
+		if result.Err == nil {
+			keys = append(keys, result.Key)
+		}
+	}
+	if len(keys) > 0 {
+		toggles, _ := tb.database.GetAllByKeys(ctx, project, keys) // the cache expires by itself if it fails, hence ignored.
+		_ = tb.cache.SetAll(ctx, toggles)
+	}
+	return res, nil
+}
+
+// GetAllByKey gets the toggle in all of the project's environments from the storage.
+// It accessess the database directly without checking the cache,
+// so that the toggle is always up to date before it is changed.
+func (tb *ToggleBatcher) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	return tb.database.GetAllByKey(ctx, project, key)
+}
+
+func (tb *ToggleBatcher) deleteCache(ctx context.Context, project string, operations []*entity.ToggleOperation) error {
+	var keys []string
+	for _, op := range operations {
+		if op.Type == entity.ToggleOperationDelete {
+			keys = append(keys, op.Key)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	toggles, err := tb.database.GetAllByKeys(ctx, project, keys)
+	if err != nil {
+		return err
+	}
+	return tb.cache.DeleteAll(ctx, toggles)
+}
@@ -0,0 +1,158 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository"
+	mock_repository "github.com/indrasaputra/toggle/test/mock/repository"
+)
+
+type ToggleBatcherExecutor struct {
+	batcher  *repository.ToggleBatcher
+	database *mock_repository.MockBatchToggleDatabase
+	cache    *mock_repository.MockBatchToggleCache
+}
+
+func TestNewToggleBatcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleBatcher", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		assert.NotNil(t, exec.batcher)
+	})
+}
+
+func TestToggleBatcher_InsertAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	toggle2 := &entity.Toggle{Key: "toggle-2", Project: testToggleProject, Environment: testToggleEnv}
+	toggles := []*entity.Toggle{testToggle, toggle2}
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		exec.database.EXPECT().InsertAll(testCtx, toggles, true).Return(nil, entity.ErrAlreadyExists())
+
+		res, err := exec.batcher.InsertAll(testCtx, toggles, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("only inserted toggles are set to cache and cache error is ignored", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		results := []*entity.BatchResult{{Key: testToggleKey, Err: entity.ErrAlreadyExists()}, {Key: "toggle-2", Version: 1}}
+		exec.database.EXPECT().InsertAll(testCtx, toggles, false).Return(results, nil)
+		exec.cache.EXPECT().SetAll(testCtx, []*entity.Toggle{toggle2}).Return(entity.ErrInternal(""))
+
+		res, err := exec.batcher.InsertAll(testCtx, toggles, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+	})
+}
+
+func TestToggleBatcher_UpdateAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ops := []*entity.ToggleOperation{
+		{Key: "toggle-2", Type: entity.ToggleOperationEnable},
+		{Key: testToggleKey, Type: entity.ToggleOperationDisable},
+		{Key: testToggleKey, Type: entity.ToggleOperationDelete},
+	}
+
+	t.Run("get toggles to delete returns error", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKeys(testCtx, testToggleProject, []string{testToggleKey}).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.batcher.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("cache returns error", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKeys(testCtx, testToggleProject, []string{testToggleKey}).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+		exec.cache.EXPECT().DeleteAll(testCtx, []*entity.Toggle{testToggle, testToggleStaging}).Return(entity.ErrInternal(""))
+
+		res, err := exec.batcher.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKeys(testCtx, testToggleProject, []string{testToggleKey}).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().DeleteAll(testCtx, []*entity.Toggle{testToggle}).Return(nil)
+		exec.database.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true).Return(nil, entity.ErrVersionConflict())
+
+		res, err := exec.batcher.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("changed toggles are written to cache in all environments", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		toggle2 := &entity.Toggle{Key: "toggle-2", Project: testToggleProject, Environment: testToggleEnv}
+		results := []*entity.BatchResult{{Key: "toggle-2", Version: 2}, {Key: testToggleKey, Version: 3}, {Key: testToggleKey, Err: entity.ErrToggleHasDependents([]string{"toggle-3"})}}
+		exec.database.EXPECT().GetAllByKeys(testCtx, testToggleProject, []string{testToggleKey}).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().DeleteAll(testCtx, []*entity.Toggle{testToggle}).Return(nil)
+		exec.database.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false).Return(results, nil)
+		exec.database.EXPECT().GetAllByKeys(testCtx, testToggleProject, []string{"toggle-2", testToggleKey}).Return([]*entity.Toggle{toggle2, testToggle, testToggleStaging}, nil)
+		exec.cache.EXPECT().SetAll(testCtx, []*entity.Toggle{toggle2, testToggle, testToggleStaging}).Return(entity.ErrInternal(""))
+
+		res, err := exec.batcher.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+	})
+
+	t.Run("nothing is written to cache if all operations fail", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		enable := ops[:1]
+		results := []*entity.BatchResult{{Key: "toggle-2", Err: entity.ErrNotFound()}}
+		exec.database.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, enable, false).Return(results, nil)
+
+		res, err := exec.batcher.UpdateAll(testCtx, testToggleProject, testToggleEnv, enable, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+	})
+}
+
+func TestToggleBatcher_GetAllByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully get toggle from database", func(t *testing.T) {
+		exec := createToggleBatcherExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+
+		res, err := exec.batcher.GetAllByKey(testCtx, testToggleProject, testToggleKey)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Toggle{testToggle}, res)
+	})
+}
+
+func createToggleBatcherExecutor(ctrl *gomock.Controller) *ToggleBatcherExecutor {
+	d := mock_repository.NewMockBatchToggleDatabase(ctrl)
+	c := mock_repository.NewMockBatchToggleCache(ctrl)
+	r := repository.NewToggleBatcher(d, c)
+	return &ToggleBatcherExecutor{
+		batcher:  r,
+		database: d,
+		cache:    c,
+	}
+}
@@ -3,24 +3,35 @@ package repository
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/indrasaputra/toggle/entity"
 )
 
 // DeleteToggleDatabase defines the interface to delete toggle from database.
 type DeleteToggleDatabase interface {
-	// GetByKey gets a toggle from database.
+	// GetAllByKey gets a toggle in all of the project's environments from database.
 	// It must return codes.NotFound from package package google.golang.org/grpc/codes if data can't be found.
-	GetByKey(ctx context.Context, key string) (*entity.Toggle, error)
-	// Delete deletes a toggle from database.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// GetAllDeletedByKey gets a soft-deleted toggle in all of the project's environments from database.
+	// It must return codes.NotFound if data can't be found or if it hasn't been soft-deleted.
+	GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// Delete soft-deletes a toggle from all environments in database.
+	// It doesn't return any error if toggle is not found.
+	// It must return codes.Aborted if version is not zero and doesn't match the toggle's current version.
+	Delete(ctx context.Context, project, key string, version int64) error
+	// Purge deletes a toggle from all environments in database forever, regardless of whether it has been soft-deleted.
 	// It doesn't return any error if toggle is not found.
-	Delete(ctx context.Context, key string) error
+	// It must return codes.Aborted if version is not zero and doesn't match the toggle's current version.
+	Purge(ctx context.Context, project, key string, version int64) error
 }
 
 // DeleteToggleCache defines the interface to delete a toggle in cache.
 type DeleteToggleCache interface {
-	// Delete deletes a toggle from database.
+	// Delete deletes a toggle in the project's environment from cache.
 	// It doesn't return any error if toggle is not found.
-	Delete(ctx context.Context, key string) error
+	Delete(ctx context.Context, project, env, key string) error
 }
 
 // ToggleDeleter is responsible to delete the toggle from storage.
@@ -35,21 +46,57 @@ func NewToggleDeleter(database DeleteToggleDatabase, cache DeleteToggleCache) *T
 	return &ToggleDeleter{database: database, cache: cache}
 }
 
-// GetByKey gets the toggle from the storage.
+// GetAllByKey gets the toggle in all of the project's environments from the storage.
 // It accessess the database directly without checking the cache.
-func (td *ToggleDeleter) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
-	toggle, err := td.database.GetByKey(ctx, key)
+func (td *ToggleDeleter) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	toggles, err := td.database.GetAllByKey(ctx, project, key)
 	if err != nil {
 		return nil, err
 	}
-	return toggle, nil
+	return toggles, nil
 }
 
-// DeleteByKey deletes the toggle from the storage.
+// GetAllDeletedByKey gets the soft-deleted toggle in all of the project's environments from the storage.
+// Soft-deleted toggles are never cached, hence it accessess the database directly.
+func (td *ToggleDeleter) GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	toggles, err := td.database.GetAllDeletedByKey(ctx, project, key)
+	if err != nil {
+		return nil, err
+	}
+	return toggles, nil
+}
+
+// DeleteByKey soft-deletes the toggle from all environments in the storage.
+// The cache is deleted for every environment the toggle exists in before the database.
 // It doesn't return any error if toggle is not found.
-func (td *ToggleDeleter) DeleteByKey(ctx context.Context, key string) error {
-	if err := td.cache.Delete(ctx, key); err != nil {
+// If version is not zero, the toggle is only deleted if its current version equals version.
+func (td *ToggleDeleter) DeleteByKey(ctx context.Context, project, key string, version int64) error {
+	if err := td.deleteCache(ctx, project, key); err != nil {
 		return err
 	}
-	return td.database.Delete(ctx, key)
+	return td.database.Delete(ctx, project, key, version)
+}
+
+// PurgeByKey deletes the toggle from all environments in the storage forever.
+// The cache is deleted for every environment the toggle exists in before the database.
+// It doesn't return any error if toggle is not found.
+// If version is not zero, the toggle is only purged if its current version equals version.
+func (td *ToggleDeleter) PurgeByKey(ctx context.Context, project, key string, version int64) error {
+	if err := td.deleteCache(ctx, project, key); err != nil {
+		return err
+	}
+	return td.database.Purge(ctx, project, key, version)
+}
+
+func (td *ToggleDeleter) deleteCache(ctx context.Context, project, key string) error {
+	toggles, err := td.database.GetAllByKey(ctx, project, key)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	for _, toggle := range toggles {
+		if err := td.cache.Delete(ctx, project, toggle.Environment, key); err != nil {
+			return err
+		}
+	}
+	return nil
 }
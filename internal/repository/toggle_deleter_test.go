@@ -12,6 +12,10 @@ import (
 	mock_repository "github.com/indrasaputra/toggle/test/mock/repository"
 )
 
+var (
+	testToggleStaging = &entity.Toggle{Key: testToggleKey, Description: testToggleDescription, Project: testToggleProject, Environment: "staging"}
+)
+
 type ToggleDeleterExecutor struct {
 	deleter  *repository.ToggleDeleter
 	database *mock_repository.MockDeleteToggleDatabase
@@ -28,15 +32,15 @@ func TestNewToggleDeleter(t *testing.T) {
 	})
 }
 
-func TestToggleDeleter_GetByKey(t *testing.T) {
+func TestToggleDeleter_GetAllByKey(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	t.Run("database returns error", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.database.EXPECT().GetByKey(context.Background(), testToggle.Key).Return(nil, entity.ErrInternal(""))
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrInternal(""))
 
-		res, err := exec.deleter.GetByKey(context.Background(), testToggle.Key)
+		res, err := exec.deleter.GetAllByKey(context.Background(), testToggleProject, testToggle.Key)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
@@ -45,9 +49,9 @@ func TestToggleDeleter_GetByKey(t *testing.T) {
 
 	t.Run("toggle not found", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.database.EXPECT().GetByKey(context.Background(), testToggle.Key).Return(nil, entity.ErrNotFound())
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrNotFound())
 
-		res, err := exec.deleter.GetByKey(context.Background(), testToggle.Key)
+		res, err := exec.deleter.GetAllByKey(context.Background(), testToggleProject, testToggle.Key)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
@@ -56,12 +60,12 @@ func TestToggleDeleter_GetByKey(t *testing.T) {
 
 	t.Run("success get toggle from db", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.database.EXPECT().GetByKey(context.Background(), testToggle.Key).Return(testToggle, nil)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
 
-		res, err := exec.deleter.GetByKey(context.Background(), testToggle.Key)
+		res, err := exec.deleter.GetAllByKey(context.Background(), testToggleProject, testToggle.Key)
 
 		assert.Nil(t, err)
-		assert.Equal(t, testToggle, res)
+		assert.Equal(t, []*entity.Toggle{testToggle, testToggleStaging}, res)
 	})
 }
 
@@ -69,11 +73,22 @@ func TestToggleDeleter_DeleteByKey(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	t.Run("database returns error when getting toggles", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrInternal(""))
+
+		err := exec.deleter.DeleteByKey(context.Background(), testToggleProject, testToggle.Key, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
 	t.Run("cache returns error", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.cache.EXPECT().Delete(context.Background(), testToggle.Key).Return(entity.ErrInternal(""))
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(entity.ErrInternal(""))
 
-		err := exec.deleter.DeleteByKey(context.Background(), testToggle.Key)
+		err := exec.deleter.DeleteByKey(context.Background(), testToggleProject, testToggle.Key, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
@@ -81,21 +96,109 @@ func TestToggleDeleter_DeleteByKey(t *testing.T) {
 
 	t.Run("database returns error", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.cache.EXPECT().Delete(context.Background(), testToggle.Key).Return(nil)
-		exec.database.EXPECT().Delete(context.Background(), testToggle.Key).Return(entity.ErrInternal(""))
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(nil)
+		exec.database.EXPECT().Delete(context.Background(), testToggleProject, testToggle.Key, int64(0)).Return(entity.ErrInternal(""))
 
-		err := exec.deleter.DeleteByKey(context.Background(), testToggle.Key)
+		err := exec.deleter.DeleteByKey(context.Background(), testToggleProject, testToggle.Key, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
 	})
 
-	t.Run("success delete toggle from cache and db ", func(t *testing.T) {
+	t.Run("toggle not found is not an error", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrNotFound())
+		exec.database.EXPECT().Delete(context.Background(), testToggleProject, testToggle.Key, int64(0)).Return(nil)
+
+		err := exec.deleter.DeleteByKey(context.Background(), testToggleProject, testToggle.Key, 0)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("success delete toggle from cache of all environments and db", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, "staging", testToggle.Key).Return(nil)
+		exec.database.EXPECT().Delete(context.Background(), testToggleProject, testToggle.Key, int64(0)).Return(nil)
+
+		err := exec.deleter.DeleteByKey(context.Background(), testToggleProject, testToggle.Key, 0)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestToggleDeleter_GetAllDeletedByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllDeletedByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.deleter.GetAllDeletedByKey(context.Background(), testToggleProject, testToggle.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get deleted toggle from db", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllDeletedByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+
+		res, err := exec.deleter.GetAllDeletedByKey(context.Background(), testToggleProject, testToggle.Key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Toggle{testToggle, testToggleStaging}, res)
+	})
+}
+
+func TestToggleDeleter_PurgeByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error when getting toggles", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrInternal(""))
+
+		err := exec.deleter.PurgeByKey(context.Background(), testToggleProject, testToggle.Key, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(nil)
+		exec.database.EXPECT().Purge(context.Background(), testToggleProject, testToggle.Key, int64(0)).Return(entity.ErrInternal(""))
+
+		err := exec.deleter.PurgeByKey(context.Background(), testToggleProject, testToggle.Key, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("soft-deleted toggle isn't in cache", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrNotFound())
+		exec.database.EXPECT().Purge(context.Background(), testToggleProject, testToggle.Key, int64(2)).Return(nil)
+
+		err := exec.deleter.PurgeByKey(context.Background(), testToggleProject, testToggle.Key, 2)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("success purge toggle from cache of all environments and db", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.cache.EXPECT().Delete(context.Background(), testToggle.Key).Return(nil)
-		exec.database.EXPECT().Delete(context.Background(), testToggle.Key).Return(nil)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, "staging", testToggle.Key).Return(nil)
+		exec.database.EXPECT().Purge(context.Background(), testToggleProject, testToggle.Key, int64(0)).Return(nil)
 
-		err := exec.deleter.DeleteByKey(context.Background(), testToggle.Key)
+		err := exec.deleter.PurgeByKey(context.Background(), testToggleProject, testToggle.Key, 0)
 
 		assert.Nil(t, err)
 	})
@@ -6,29 +6,28 @@ import (
 	"github.com/indrasaputra/toggle/entity"
 )
 
-const (
-	defaultLimit = 10
-	// DefaultToggleLimit is deliberately set to 10 because it is enough for simple application.
-	DefaultToggleLimit = uint(defaultLimit)
-)
-
 // GetToggleDatabase defines the interface to get toggle from database.
 type GetToggleDatabase interface {
-	// GetByKey gets a toggle from database.
+	// GetByKey gets a toggle in the project's environment from database.
 	// It must return codes.NotFound from package package google.golang.org/grpc/codes if data can't be found.
-	GetByKey(ctx context.Context, key string) (*entity.Toggle, error)
-	// GetAll gets all available toggles from database.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+	// GetAll gets the toggles in the project's environment which satisfy the filter from database.
+	// Nil filter means all toggles. Nil pagination means all toggles sorted by key.
 	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
-	GetAll(ctx context.Context, limit uint) ([]*entity.Toggle, error)
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+	// Count counts the toggles in the project's environment which satisfy the filter in database.
+	// Nil filter means all toggles.
+	Count(ctx context.Context, project, env string, filter *entity.ToggleFilter) (int, error)
 }
 
 // GetToggleCache defines the interface to get a toggle in cache.
 type GetToggleCache interface {
-	// Get gets a toggle in cache.
+	// Get gets a toggle in the project's environment in cache.
 	// It only returns error of there is error in the system.
 	// If the data can't be found but the system is fine, it returns nil.
-	Get(ctx context.Context, key string) (*entity.Toggle, error)
+	Get(ctx context.Context, project, env, key string) (*entity.Toggle, error)
 	// Set sets a toggle in cache.
+	// The toggle is cached for its project and environment.
 	Set(ctx context.Context, toggle *entity.Toggle) error
 }
 
@@ -47,8 +46,8 @@ func NewToggleGetter(database GetToggleDatabase, cache GetToggleCache) *ToggleGe
 // GetByKey gets the toggle from the storage.
 // First, it accessess the cache. If success, the data will be returned instantly..
 // Otherwise, it checks the data in database.
-func (tg *ToggleGetter) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
-	toggle, err := tg.cache.Get(ctx, key)
+func (tg *ToggleGetter) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	toggle, err := tg.cache.Get(ctx, project, env, key)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +55,7 @@ func (tg *ToggleGetter) GetByKey(ctx context.Context, key string) (*entity.Toggl
 		return toggle, nil
 	}
 
-	toggle, err = tg.database.GetByKey(ctx, key)
+	toggle, err = tg.database.GetByKey(ctx, project, env, key)
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +63,14 @@ func (tg *ToggleGetter) GetByKey(ctx context.Context, key string) (*entity.Toggl
 	return toggle, nil
 }
 
-// GetAll gets all available toggles from storage.
+// GetAll gets the toggles which satisfy the filter from storage, one page at a time.
+// The toggles are always retrieved from database since the filter and the pagination can't be resolved in cache.
 // If there isn't any toggle in repository, it returns empty list of toggle and nil error.
-func (tg *ToggleGetter) GetAll(ctx context.Context) ([]*entity.Toggle, error) {
-	return tg.database.GetAll(ctx, DefaultToggleLimit)
+func (tg *ToggleGetter) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	return tg.database.GetAll(ctx, project, env, filter, page)
+}
+
+// Count counts the toggles which satisfy the filter in storage.
+func (tg *ToggleGetter) Count(ctx context.Context, project, env string, filter *entity.ToggleFilter) (int, error) {
+	return tg.database.Count(ctx, project, env, filter)
 }
@@ -11,6 +11,11 @@ import (
 	mock_repository "github.com/indrasaputra/toggle/test/mock/repository"
 )
 
+var (
+	testToggleFilter     = &entity.ToggleFilter{AnyTags: []string{"team:payments"}, AllTags: []string{"kind:kill-switch"}}
+	testTogglePagination = &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 11}
+)
+
 type ToggleGetterExecutor struct {
 	getter   *repository.ToggleGetter
 	database *mock_repository.MockGetToggleDatabase
@@ -33,9 +38,9 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("cache returns error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.cache.EXPECT().Get(testCtx, testToggle.Key).Return(nil, entity.ErrInternal(""))
+		exec.cache.EXPECT().Get(testCtx, testToggleProject, testToggleEnv, testToggle.Key).Return(nil, entity.ErrInternal(""))
 
-		res, err := exec.getter.GetByKey(testCtx, testToggle.Key)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggle.Key)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
@@ -44,9 +49,9 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("toggle found in cache", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.cache.EXPECT().Get(testCtx, testToggle.Key).Return(testToggle, nil)
+		exec.cache.EXPECT().Get(testCtx, testToggleProject, testToggleEnv, testToggle.Key).Return(testToggle, nil)
 
-		res, err := exec.getter.GetByKey(testCtx, testToggle.Key)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggle.Key)
 
 		assert.Nil(t, err)
 		assert.Equal(t, testToggle, res)
@@ -54,10 +59,10 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("database returns error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.cache.EXPECT().Get(testCtx, testToggle.Key).Return(nil, nil)
-		exec.database.EXPECT().GetByKey(testCtx, testToggle.Key).Return(nil, entity.ErrInternal(""))
+		exec.cache.EXPECT().Get(testCtx, testToggleProject, testToggleEnv, testToggle.Key).Return(nil, nil)
+		exec.database.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggle.Key).Return(nil, entity.ErrInternal(""))
 
-		res, err := exec.getter.GetByKey(testCtx, testToggle.Key)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggle.Key)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
@@ -66,11 +71,11 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("success get toggle from db and save to cache", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.cache.EXPECT().Get(testCtx, testToggle.Key).Return(nil, nil)
-		exec.database.EXPECT().GetByKey(testCtx, testToggle.Key).Return(testToggle, nil)
+		exec.cache.EXPECT().Get(testCtx, testToggleProject, testToggleEnv, testToggle.Key).Return(nil, nil)
+		exec.database.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggle.Key).Return(testToggle, nil)
 		exec.cache.EXPECT().Set(testCtx, testToggle).Return(nil)
 
-		res, err := exec.getter.GetByKey(testCtx, testToggle.Key)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggle.Key)
 
 		assert.Nil(t, err)
 		assert.Equal(t, testToggle, res)
@@ -83,9 +88,9 @@ func TestToggleGetter_GetAll(t *testing.T) {
 
 	t.Run("database returns error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.database.EXPECT().GetAll(testCtx, repository.DefaultToggleLimit).Return([]*entity.Toggle{}, entity.ErrInternal(""))
+		exec.database.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, testToggleFilter, testTogglePagination).Return([]*entity.Toggle{}, entity.ErrInternal(""))
 
-		res, err := exec.getter.GetAll(testCtx)
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, testToggleFilter, testTogglePagination)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
@@ -94,9 +99,9 @@ func TestToggleGetter_GetAll(t *testing.T) {
 
 	t.Run("database returns empty list and nil error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.database.EXPECT().GetAll(testCtx, repository.DefaultToggleLimit).Return([]*entity.Toggle{}, nil)
+		exec.database.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, testToggleFilter, testTogglePagination).Return([]*entity.Toggle{}, nil)
 
-		res, err := exec.getter.GetAll(testCtx)
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, testToggleFilter, testTogglePagination)
 
 		assert.Nil(t, err)
 		assert.Empty(t, res)
@@ -104,15 +109,41 @@ func TestToggleGetter_GetAll(t *testing.T) {
 
 	t.Run("success get toggle from db", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.database.EXPECT().GetAll(testCtx, repository.DefaultToggleLimit).Return([]*entity.Toggle{testToggle}, nil)
+		exec.database.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, testToggleFilter, testTogglePagination).Return([]*entity.Toggle{testToggle}, nil)
 
-		res, err := exec.getter.GetAll(testCtx)
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, testToggleFilter, testTogglePagination)
 
 		assert.Nil(t, err)
 		assert.NotEmpty(t, testToggle, res)
 	})
 }
 
+func TestToggleGetter_Count(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleGetterExecutor(ctrl)
+		exec.database.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, testToggleFilter).Return(0, entity.ErrInternal(""))
+
+		res, err := exec.getter.Count(testCtx, testToggleProject, testToggleEnv, testToggleFilter)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("success count toggles in db", func(t *testing.T) {
+		exec := createToggleGetterExecutor(ctrl)
+		exec.database.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, testToggleFilter).Return(3, nil)
+
+		res, err := exec.getter.Count(testCtx, testToggleProject, testToggleEnv, testToggleFilter)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, res)
+	})
+}
+
 func createToggleGetterExecutor(ctrl *gomock.Controller) *ToggleGetterExecutor {
 	d := mock_repository.NewMockGetToggleDatabase(ctrl)
 	c := mock_repository.NewMockGetToggleCache(ctrl)
@@ -15,8 +15,10 @@ import (
 var (
 	testCtx                = context.Background()
 	testToggleKey          = "toggle-1"
+	testToggleProject      = "default"
+	testToggleEnv          = "production"
 	testToggleDescription  = "description"
-	testToggle             = &entity.Toggle{Key: testToggleKey, Description: testToggleDescription}
+	testToggle             = &entity.Toggle{Key: testToggleKey, Description: testToggleDescription, Project: testToggleProject, Environment: testToggleEnv}
 	errPostgresInternalMsg = "database down"
 )
 
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// UpdatePrerequisitesDatabase defines the interface to update a toggle's prerequisites in database.
+type UpdatePrerequisitesDatabase interface {
+	// GetAllByKey gets a toggle in all of the project's environments from database.
+	// It must return codes.NotFound from package package google.golang.org/grpc/codes if data can't be found.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// UpdatePrerequisites replaces the toggle's prerequisites in database.
+	// It should handle if the toggle doesn't exist.
+	// It must check the prerequisites against the project's prerequisite graph atomically with the update.
+	UpdatePrerequisites(ctx context.Context, project, key string, prerequisites []*entity.Prerequisite) error
+}
+
+// UpdatePrerequisitesCache defines the interface to invalidate a toggle in cache.
+type UpdatePrerequisitesCache interface {
+	// Delete deletes a toggle in the project's environment from cache.
+	// It doesn't return any error if toggle is not found.
+	Delete(ctx context.Context, project, env, key string) error
+}
+
+// TogglePrerequisiteUpdater is responsible to update the toggle's prerequisites in storage.
+// It uses database and cache.
+type TogglePrerequisiteUpdater struct {
+	database UpdatePrerequisitesDatabase
+	cache    UpdatePrerequisitesCache
+}
+
+// NewTogglePrerequisiteUpdater creates an instance of TogglePrerequisiteUpdater.
+func NewTogglePrerequisiteUpdater(database UpdatePrerequisitesDatabase, cache UpdatePrerequisitesCache) *TogglePrerequisiteUpdater {
+	return &TogglePrerequisiteUpdater{database: database, cache: cache}
+}
+
+// GetAllByKey gets the toggle in all of the project's environments from the storage.
+// It accessess the database directly without checking the cache.
+func (tu *TogglePrerequisiteUpdater) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	return tu.database.GetAllByKey(ctx, project, key)
+}
+
+// UpdatePrerequisites replaces the toggle's prerequisites in the storage.
+// First, it updates the data in database. If success, the toggle is deleted from cache
+// in every environment the toggle exists in, since the prerequisites are shared by all environments.
+// It ignores the error from cache since the toggle will be set to cache again when it is retrieved.
+// But, it doesn't ignore the error from the database.
+func (tu *TogglePrerequisiteUpdater) UpdatePrerequisites(ctx context.Context, project, key string, prerequisites []*entity.Prerequisite) error {
+	if err := tu.database.UpdatePrerequisites(ctx, project, key, prerequisites); err != nil {
+		return err
+	}
+	toggles, _ := tu.database.GetAllByKey(ctx, project, key) // the cache expires by itself if it fails, hence ignored.
+	for _, toggle := range toggles {
+		_ = tu.cache.Delete(ctx, project, toggle.Environment, key)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/repository"
+	mock_repository "github.com/indrasaputra/toggle/test/mock/repository"
+)
+
+var (
+	testTogglePrerequisites = []*entity.Prerequisite{{Key: "toggle-0", Value: true}}
+)
+
+type TogglePrerequisiteUpdaterExecutor struct {
+	updater  *repository.TogglePrerequisiteUpdater
+	database *mock_repository.MockUpdatePrerequisitesDatabase
+	cache    *mock_repository.MockUpdatePrerequisitesCache
+}
+
+func TestNewTogglePrerequisiteUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of TogglePrerequisiteUpdater", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestTogglePrerequisiteUpdater_GetAllByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.updater.GetAllByKey(context.Background(), testToggleProject, testToggle.Key)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get toggle from db", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+
+		res, err := exec.updater.GetAllByKey(context.Background(), testToggleProject, testToggle.Key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Toggle{testToggle, testToggleStaging}, res)
+	})
+}
+
+func TestTogglePrerequisiteUpdater_UpdatePrerequisites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites).Return(entity.ErrInternal(""))
+
+		err := exec.updater.UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("error when getting environments is ignored", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites).Return(nil)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return(nil, entity.ErrInternal(""))
+
+		err := exec.updater.UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("cache error is ignored", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites).Return(nil)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(entity.ErrInternal(""))
+
+		err := exec.updater.UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("success update prerequisites and delete toggle from cache of all environments", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites).Return(nil)
+		exec.database.EXPECT().GetAllByKey(context.Background(), testToggleProject, testToggle.Key).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, testToggleEnv, testToggle.Key).Return(nil)
+		exec.cache.EXPECT().Delete(context.Background(), testToggleProject, "staging", testToggle.Key).Return(nil)
+
+		err := exec.updater.UpdatePrerequisites(context.Background(), testToggleProject, testToggle.Key, testTogglePrerequisites)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createTogglePrerequisiteUpdaterExecutor(ctrl *gomock.Controller) *TogglePrerequisiteUpdaterExecutor {
+	d := mock_repository.NewMockUpdatePrerequisitesDatabase(ctrl)
+	c := mock_repository.NewMockUpdatePrerequisitesCache(ctrl)
+	r := repository.NewTogglePrerequisiteUpdater(d, c)
+	return &TogglePrerequisiteUpdaterExecutor{
+		updater:  r,
+		database: d,
+		cache:    c,
+	}
+}
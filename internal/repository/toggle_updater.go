@@ -2,19 +2,44 @@ package repository
 
 import (
 	"context"
+
+	"github.com/indrasaputra/toggle/entity"
 )
 
 // UpdateToggleDatabase defines the interface to update a toggle in database.
 type UpdateToggleDatabase interface {
-	// UpdateIsEnabled updates the toggle's is_enabled value in the repository.
-	// It should handle if the toggle doesn't exist.
-	UpdateIsEnabled(ctx context.Context, key string, value bool) error
+	// UpdateIsEnabled updates the toggle's is_enabled value in the project's environment in the repository
+	// and returns the toggle's new version.
+	// It should handle if the toggle doesn't exist in the project's environment.
+	// It must return codes.Aborted if version is not zero and doesn't match the toggle's current version.
+	UpdateIsEnabled(ctx context.Context, project, env, key string, value bool, version int64) (int64, error)
+	// UpdateRollout replaces the toggle's rollout in the project's environment in the repository
+	// and returns the toggle's new version.
+	// It should handle if the toggle doesn't exist in the project's environment.
+	// It must return codes.Aborted if version is not zero and doesn't match the toggle's current version.
+	UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error)
+	// UpdateRules replaces the toggle's rules and default value in the project's environment in the repository
+	// and returns the toggle's new version.
+	// It should handle if the toggle doesn't exist in the project's environment.
+	// It must return codes.Aborted if version is not zero and doesn't match the toggle's current version.
+	UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error)
+	// GetByKey gets a toggle in the project's environment from database.
+	// It must return codes.NotFound from package package google.golang.org/grpc/codes if data can't be found.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+	// GetAllByKey gets a toggle in all of the project's environments from database.
+	// It must return codes.NotFound from package package google.golang.org/grpc/codes if data can't be found.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// Update replaces the toggle's description, owner, expiry time, and tags in database.
+	// It must return codes.Aborted if the toggle's version doesn't match the toggle's current version.
+	// The toggle's version is then set to the new version.
+	Update(ctx context.Context, toggle *entity.Toggle) error
 }
 
 // UpdateToggleCache defines the interface to set (there is no update in cache) a toggle in cache.
 type UpdateToggleCache interface {
-	// SetIsEnabled sets is_enabled field in cache.
-	SetIsEnabled(ctx context.Context, key string, value bool) error
+	// Set sets a toggle in cache.
+	// The toggle is cached for its project and environment.
+	Set(ctx context.Context, toggle *entity.Toggle) error
 }
 
 // ToggleUpdater is responsible to update the toggle in storage.
@@ -29,26 +54,86 @@ func NewToggleUpdater(database UpdateToggleDatabase, cache UpdateToggleCache) *T
 	return &ToggleUpdater{database: database, cache: cache}
 }
 
-// Enable updates the toggle's is_enabled value to be true in the storage.
-// First, it updates the data in database. If success, the data will be set to cache.
+// Enable updates the toggle's is_enabled value to be true in the storage and returns the toggle's new version.
+// First, it updates the data in database. If success, the toggle is written to cache
+// in every environment the toggle exists in, since the version is shared by all environments.
+// It ignores the error from cache since it can always be generated when retrieving the data.
+// But, it doesn't ignore the error from the database.
+func (ti *ToggleUpdater) Enable(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
+	return ti.updateIsEnabled(ctx, project, env, key, value, version)
+}
+
+// Disable updates the toggle's is_enabled value to be false in the storage and returns the toggle's new version.
+// First, it updates the data in database. If success, the toggle is written to cache
+// in every environment the toggle exists in, since the version is shared by all environments.
+// It ignores the error from cache since it can always be generated when retrieving the data.
+// But, it doesn't ignore the error from the database.
+func (ti *ToggleUpdater) Disable(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
+	return ti.updateIsEnabled(ctx, project, env, key, value, version)
+}
+
+func (ti *ToggleUpdater) updateIsEnabled(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
+	res, err := ti.database.UpdateIsEnabled(ctx, project, env, key, value, version)
+	if err != nil {
+		return 0, err
+	}
+	ti.setAllToCache(ctx, project, key)
+	return res, nil
+}
+
+// UpdateRollout replaces the toggle's rollout in the project's environment in the storage and returns the toggle's new version.
+// First, it updates the data in database. If success, the toggle is written to cache
+// in every environment the toggle exists in, since the version is shared by all environments.
 // It ignores the error from cache since it can always be generated when retrieving the data.
 // But, it doesn't ignore the error from the database.
-func (ti *ToggleUpdater) Enable(ctx context.Context, key string, value bool) error {
-	return ti.updateIsEnabled(ctx, key, value)
+func (ti *ToggleUpdater) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	res, err := ti.database.UpdateRollout(ctx, project, env, key, rollout, version)
+	if err != nil {
+		return 0, err
+	}
+	ti.setAllToCache(ctx, project, key)
+	return res, nil
 }
 
-// Disable updates the toggle's is_enabled value to be false in the storage.
-// First, it updates the data in database. If success, the data will be set to cache.
+// UpdateRules replaces the toggle's rules and default value in the project's environment in the storage
+// and returns the toggle's new version.
+// First, it updates the data in database. If success, the toggle is written to cache
+// in every environment the toggle exists in, since the version is shared by all environments.
 // It ignores the error from cache since it can always be generated when retrieving the data.
 // But, it doesn't ignore the error from the database.
-func (ti *ToggleUpdater) Disable(ctx context.Context, key string, value bool) error {
-	return ti.updateIsEnabled(ctx, key, value)
+func (ti *ToggleUpdater) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	res, err := ti.database.UpdateRules(ctx, project, env, key, rules, defaultValue, version)
+	if err != nil {
+		return 0, err
+	}
+	ti.setAllToCache(ctx, project, key)
+	return res, nil
+}
+
+// GetByKey gets the toggle in the project's environment from the storage.
+// It accessess the database directly without checking the cache,
+// so that the toggle is always up to date before it is updated.
+func (ti *ToggleUpdater) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	return ti.database.GetByKey(ctx, project, env, key)
 }
 
-func (ti *ToggleUpdater) updateIsEnabled(ctx context.Context, key string, value bool) error {
-	if err := ti.database.UpdateIsEnabled(ctx, key, value); err != nil {
+// Update replaces the toggle's description, owner, expiry time, and tags in the storage.
+// First, it updates the data in database. If success, the toggle is written to cache
+// in every environment the toggle exists in, since those fields are shared by all environments.
+// It ignores the error from cache since the toggle will be set to cache again when it is retrieved.
+// But, it doesn't ignore the error from the database.
+func (ti *ToggleUpdater) Update(ctx context.Context, toggle *entity.Toggle) error {
+	if err := ti.database.Update(ctx, toggle); err != nil {
 		return err
 	}
-	_ = ti.cache.SetIsEnabled(ctx, key, value)
+	ti.setAllToCache(ctx, toggle.Project, toggle.Key)
 	return nil
 }
+
+// setAllToCache writes the toggle in every environment it exists in from database to cache.
+func (ti *ToggleUpdater) setAllToCache(ctx context.Context, project, key string) {
+	toggles, _ := ti.database.GetAllByKey(ctx, project, key) // the cache expires by itself if it fails, hence ignored.
+	for _, t := range toggles {
+		_ = ti.cache.Set(ctx, t)
+	}
+}
@@ -38,31 +38,39 @@ func TestToggleUpdater_Enable(t *testing.T) {
 
 	t.Run("database returns error", func(t *testing.T) {
 		exec := createToggleUpdaterExecutor(ctrl)
-		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(entity.ErrInternal(""))
+		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(1)).Return(int64(0), entity.ErrVersionConflict())
 
-		err := exec.updater.Enable(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.updater.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 1)
 
 		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
 	t.Run("cache error is ignored", func(t *testing.T) {
 		exec := createToggleUpdaterExecutor(ctrl)
-		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(nil)
-		exec.cache.EXPECT().SetIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(entity.ErrInternal(""))
+		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(1)).Return(int64(2), nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(entity.ErrInternal(""))
 
-		err := exec.updater.Enable(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.updater.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 
 	t.Run("all steps are successful", func(t *testing.T) {
+		staging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging"}
 		exec := createToggleUpdaterExecutor(ctrl)
-		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(nil)
-		exec.cache.EXPECT().SetIsEnabled(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(nil)
+		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(0)).Return(int64(2), nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle, staging}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(nil)
+		exec.cache.EXPECT().Set(testCtx, staging).Return(nil)
 
-		err := exec.updater.Enable(testCtx, testToggleKey, testToggleIsEnabledTrue)
+		res, err := exec.updater.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, 0)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 }
 
@@ -72,29 +80,164 @@ func TestToggleUpdater_Disable(t *testing.T) {
 
 	t.Run("database returns error", func(t *testing.T) {
 		exec := createToggleUpdaterExecutor(ctrl)
-		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(entity.ErrInternal(""))
+		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(1)).Return(int64(0), entity.ErrVersionConflict())
 
-		err := exec.updater.Disable(testCtx, testToggleKey, testToggleIsEnabledFalse)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, 1)
 
 		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
 	t.Run("cache error is ignored", func(t *testing.T) {
 		exec := createToggleUpdaterExecutor(ctrl)
-		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(nil)
-		exec.cache.EXPECT().SetIsEnabled(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(entity.ErrInternal(""))
+		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(1)).Return(int64(2), nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(entity.ErrInternal(""))
 
-		err := exec.updater.Disable(testCtx, testToggleKey, testToggleIsEnabledFalse)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, 1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 
 	t.Run("all steps are successful", func(t *testing.T) {
+		staging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging"}
 		exec := createToggleUpdaterExecutor(ctrl)
-		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(nil)
-		exec.cache.EXPECT().SetIsEnabled(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(nil)
+		exec.database.EXPECT().UpdateIsEnabled(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(0)).Return(int64(2), nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle, staging}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(nil)
+		exec.cache.EXPECT().Set(testCtx, staging).Return(nil)
 
-		err := exec.updater.Disable(testCtx, testToggleKey, testToggleIsEnabledFalse)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
+	})
+}
+
+func TestToggleUpdater_UpdateRollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rollout := &entity.Rollout{Percentage: 30, BucketBy: "user_id"}
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(1)).Return(int64(0), entity.ErrVersionConflict())
+
+		res, err := exec.updater.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Equal(t, int64(0), res)
+	})
+
+	t.Run("toggle is written to cache in all environments", func(t *testing.T) {
+		staging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging"}
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(0)).Return(int64(2), nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle, staging}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(nil)
+		exec.cache.EXPECT().Set(testCtx, staging).Return(entity.ErrInternal(""))
+
+		res, err := exec.updater.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
+	})
+}
+
+func TestToggleUpdater_UpdateRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	rules := []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}}
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, int64(1)).Return(int64(0), entity.ErrNotFound())
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Equal(t, int64(0), res)
+	})
+
+	t.Run("toggle is written to cache in all environments", func(t *testing.T) {
+		staging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging"}
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, int64(0)).Return(int64(2), nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle, staging}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(nil)
+		exec.cache.EXPECT().Set(testCtx, staging).Return(nil)
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
+	})
+}
+
+func TestToggleUpdater_GetByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.updater.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success get toggle from database", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(testToggle, nil)
+
+		res, err := exec.updater.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testToggle, res)
+	})
+}
+
+func TestToggleUpdater_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("database returns error", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().Update(testCtx, testToggle).Return(entity.ErrInternal(""))
+
+		err := exec.updater.Update(testCtx, testToggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("cache error is ignored", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().Update(testCtx, testToggle).Return(nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggle.Project, testToggle.Key).Return([]*entity.Toggle{testToggle}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(entity.ErrInternal(""))
+
+		err := exec.updater.Update(testCtx, testToggle)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("toggle is written to cache in all environments", func(t *testing.T) {
+		staging := &entity.Toggle{Key: testToggle.Key, Project: testToggle.Project, Environment: "staging"}
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.database.EXPECT().Update(testCtx, testToggle).Return(nil)
+		exec.database.EXPECT().GetAllByKey(testCtx, testToggle.Project, testToggle.Key).Return([]*entity.Toggle{testToggle, staging}, nil)
+		exec.cache.EXPECT().Set(testCtx, testToggle).Return(nil)
+		exec.cache.EXPECT().Set(testCtx, staging).Return(nil)
+
+		err := exec.updater.Update(testCtx, testToggle)
 
 		assert.Nil(t, err)
 	})
@@ -0,0 +1,3 @@
+// Package scheduler provides a server which periodically runs jobs,
+// such as executing due toggle's schedules and notifying expired toggles.
+package scheduler
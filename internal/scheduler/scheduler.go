@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	schedulerPort = "-"
+)
+
+// Job defines a periodic job run by the scheduler.
+type Job struct {
+	// Name defines the job's name. It is used in the log.
+	Name string
+	// Run runs the job once. It returns the number of processed items.
+	Run func(ctx context.Context) (int, error)
+}
+
+// Scheduler periodically runs its jobs, such as executing due toggle's schedules, notifying expired toggles,
+// and relaying the outbox's events.
+// It acts as a server so that it can be managed along with the other servers.
+type Scheduler struct {
+	name     string
+	jobs     []Job
+	interval time.Duration
+	quit     chan struct{}
+	once     sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewScheduler creates an instance of Scheduler.
+// It runs all jobs sequentially once in every interval.
+// The name tells the schedulers apart in the log.
+func NewScheduler(name string, interval time.Duration, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		name:     name,
+		jobs:     jobs,
+		interval: interval,
+		quit:     make(chan struct{}),
+	}
+}
+
+// Name returns scheduler's name.
+func (s *Scheduler) Name() string {
+	return s.name
+}
+
+// Port returns scheduler's port.
+// Scheduler doesn't listen to any port.
+func (s *Scheduler) Port() string {
+	return schedulerPort
+}
+
+// Serve runs the scheduler.
+// It blocks until GracefulStop is called.
+func (s *Scheduler) Serve() error {
+	s.wg.Add(1)
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.quit:
+			return nil
+		default:
+		}
+
+		for _, job := range s.jobs {
+			if _, err := job.Run(context.Background()); err != nil {
+				log.Printf("[%s] %s error: %v", s.name, job.Name, err)
+			}
+		}
+
+		select {
+		case <-s.quit:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// GracefulStop stops the scheduler.
+// It waits for the running execution to finish.
+func (s *Scheduler) GracefulStop() {
+	s.once.Do(func() { close(s.quit) })
+	s.wg.Wait()
+}
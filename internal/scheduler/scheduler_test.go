@@ -0,0 +1,101 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/internal/scheduler"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSchedulerName = "toggle scheduler"
+	testInterval      = 10 * time.Millisecond
+)
+
+type SchedulerExecutor struct {
+	scheduler *scheduler.Scheduler
+	executor  *mock_service.MockExecuteSchedule
+	notifier  *mock_service.MockNotifyExpiredToggle
+}
+
+func TestNewScheduler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of Scheduler", func(t *testing.T) {
+		exec := createSchedulerExecutor(ctrl)
+		assert.NotNil(t, exec.scheduler)
+		assert.Equal(t, testSchedulerName, exec.scheduler.Name())
+		assert.NotEmpty(t, exec.scheduler.Port())
+	})
+}
+
+func TestScheduler_Serve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("periodically run all jobs until stopped", func(t *testing.T) {
+		exec := createSchedulerExecutor(ctrl)
+		executed := make(chan struct{}, 1)
+		exec.executor.EXPECT().ExecuteDue(gomock.Any()).MinTimes(2).Return(0, entity.ErrInternal(""))
+		exec.notifier.EXPECT().NotifyExpired(gomock.Any()).MinTimes(2).DoAndReturn(func(context.Context) (int, error) {
+			select {
+			case executed <- struct{}{}:
+			default:
+			}
+			return 1, nil
+		})
+
+		stopped := make(chan error)
+		go func() { stopped <- exec.scheduler.Serve() }()
+		<-executed
+		<-executed
+		exec.scheduler.GracefulStop()
+
+		assert.Nil(t, <-stopped)
+	})
+
+	t.Run("stopped scheduler doesn't run any job", func(t *testing.T) {
+		exec := createSchedulerExecutor(ctrl)
+		exec.scheduler.GracefulStop()
+
+		err := exec.scheduler.Serve()
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestScheduler_GracefulStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("stop scheduler more than once", func(t *testing.T) {
+		exec := createSchedulerExecutor(ctrl)
+		assert.NotPanics(t, func() {
+			exec.scheduler.GracefulStop()
+			exec.scheduler.GracefulStop()
+		})
+	})
+}
+
+func createSchedulerExecutor(ctrl *gomock.Controller) *SchedulerExecutor {
+	e := mock_service.NewMockExecuteSchedule(ctrl)
+	n := mock_service.NewMockNotifyExpiredToggle(ctrl)
+	s := scheduler.NewScheduler(
+		testSchedulerName,
+		testInterval,
+		scheduler.Job{Name: "execute due schedules", Run: e.ExecuteDue},
+		scheduler.Job{Name: "notify expired toggles", Run: n.NotifyExpired},
+	)
+	return &SchedulerExecutor{
+		scheduler: s,
+		executor:  e,
+		notifier:  n,
+	}
+}
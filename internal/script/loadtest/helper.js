@@ -21,7 +21,7 @@ export function createRandomKey(length) {
 }
 
 export function createToggle() {
-    var url = `${BASE_URL}v1/toggles`;
+    var url = `${BASE_URL}v1/projects/default/environments/production/toggles`;
     var payload = JSON.stringify({
         key: createRandomKey(KEY_LENGTH),
     });
@@ -39,7 +39,7 @@ export function createToggle() {
 
 export function enableToggle() {
     var key = getKey();
-    var url = `${BASE_URL}v1/toggles/${key}/enable`;
+    var url = `${BASE_URL}v1/projects/default/environments/production/toggles/${key}/enable`;
 
     let resp = http.put(url);
     check(resp, {
@@ -49,7 +49,7 @@ export function enableToggle() {
 
 export function disableToggle() {
     var key = getKey();
-    var url = `${BASE_URL}v1/toggles/${key}/disable`;
+    var url = `${BASE_URL}v1/projects/default/environments/production/toggles/${key}/disable`;
 
     let resp = http.put(url);
     check(resp, {
@@ -59,7 +59,7 @@ export function disableToggle() {
 
 export function getToggle() {
     var key = getKey();
-    var url = `${BASE_URL}v1/toggles/${key}`;
+    var url = `${BASE_URL}v1/projects/default/environments/production/toggles/${key}`;
 
     let resp = http.get(url);
     check(resp, {
@@ -0,0 +1,2 @@
+// Package openfeature provides OpenFeature provider backed by the toggle's client SDK.
+package openfeature
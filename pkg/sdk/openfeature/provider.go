@@ -0,0 +1,374 @@
+package openfeature
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	of "github.com/open-feature/go-sdk/openfeature"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/pkg/sdk/toggle"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// ProviderName is the name of the provider in its metadata.
+	ProviderName = "toggle"
+
+	// eventBufferSize is the number of provider's events which can wait to be consumed.
+	eventBufferSize = 100
+	// defaultInitTimeout is how long Init waits for the client to load all toggles unless WithInitTimeout is given.
+	defaultInitTimeout = 30 * time.Second
+)
+
+// Option configures the Provider.
+type Option func(*Provider)
+
+// WithTargetingKeyAttribute sets the name of the attribute in which the evaluation context's targeting key is passed to the toggle's rules.
+// It should be the rollout's bucket_by attribute, such as user_id.
+// If it isn't set, the targeting key is passed as targetingKey attribute.
+func WithTargetingKeyAttribute(name string) Option {
+	return func(p *Provider) {
+		p.targetingKeyAttribute = name
+	}
+}
+
+// WithInitTimeout sets how long Init waits for the client to load all toggles.
+// If it isn't set or isn't positive, Init waits for 30 seconds.
+func WithInitTimeout(timeout time.Duration) Option {
+	return func(p *Provider) {
+		p.initTimeout = timeout
+	}
+}
+
+// Provider is OpenFeature provider which evaluates the toggles using Client.
+//
+// Its events are driven by the subscriber given to Subscribe.
+// Every change of a toggle is emitted as PROVIDER_CONFIGURATION_CHANGED event.
+// If the subscription fails, PROVIDER_STALE event is emitted since the toggles in memory may be outdated,
+// and PROVIDER_READY event is emitted once a change is received again.
+type Provider struct {
+	client                *toggle.Client
+	targetingKeyAttribute string
+	initTimeout           time.Duration
+	events                chan of.Event
+	mutex                 sync.RWMutex
+	state                 of.State
+}
+
+// NewProvider creates an instance of Provider.
+// The client is still owned by the caller, hence the caller must close it once the provider is no longer used.
+func NewProvider(client *toggle.Client, opts ...Option) *Provider {
+	p := &Provider{
+		client:                client,
+		targetingKeyAttribute: of.TargetingKey,
+		initTimeout:           defaultInitTimeout,
+		events:                make(chan of.Event, eventBufferSize),
+		state:                 of.NotReadyState,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.initTimeout <= 0 {
+		p.initTimeout = defaultInitTimeout
+	}
+	return p
+}
+
+// Metadata returns the provider's metadata.
+func (p *Provider) Metadata() of.Metadata {
+	return of.Metadata{Name: ProviderName}
+}
+
+// Hooks returns the provider's hooks. The provider doesn't have any hook.
+func (p *Provider) Hooks() []of.Hook {
+	return []of.Hook{}
+}
+
+// Init blocks until the client has loaded all toggles.
+// If the client hasn't loaded them within the init timeout, the provider is in error state
+// and it returns context.DeadlineExceeded.
+func (p *Provider) Init(_ of.EvaluationContext) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.initTimeout)
+	defer cancel()
+
+	if err := p.client.WaitForInit(ctx); err != nil {
+		p.setState(of.ErrorState)
+		return err
+	}
+	p.setState(of.ReadyState)
+	return nil
+}
+
+// Shutdown marks the provider as not ready.
+// It doesn't close the client.
+func (p *Provider) Shutdown() {
+	p.setState(of.NotReadyState)
+}
+
+// Status returns the provider's state.
+func (p *Provider) Status() of.State {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.state
+}
+
+// EventChannel returns the channel of the provider's events.
+// If the events aren't consumed fast enough, the latest events are dropped.
+func (p *Provider) EventChannel() <-chan of.Event {
+	return p.events
+}
+
+// Subscribe subscribes to the toggles' changes using Client.Subscribe and emits the provider's events accordingly.
+// Only the changes of the given keys are followed. If keys is empty, the changes of all toggles are followed.
+// It should be run in a separate goroutine.
+func (p *Provider) Subscribe(ctx context.Context, subscriber toggle.Subscriber, keys []string) error {
+	err := p.client.Subscribe(ctx, &eventSubscriber{Subscriber: subscriber, provider: p, keys: keys}, keys)
+	if err != nil && ctx.Err() == nil {
+		p.stale(err)
+	}
+	return err
+}
+
+// BooleanEvaluation evaluates the toggle using Client.BoolVariationDetail.
+func (p *Provider) BooleanEvaluation(ctx context.Context, flag string, defaultValue bool, evalCtx of.FlattenedContext) of.BoolResolutionDetail {
+	detail := p.client.BoolVariationDetail(ctx, flag, p.createEvaluationContext(evalCtx), defaultValue)
+	res := of.BoolResolutionDetail{
+		Value: detail.Value,
+		ProviderResolutionDetail: of.ProviderResolutionDetail{
+			Reason:       mapDetailReason(detail),
+			FlagMetadata: of.FlagMetadata{"version": detail.Version},
+		},
+	}
+	if detail.Err != nil {
+		res.ResolutionError = createResolutionError(detail.Err)
+	}
+	return res
+}
+
+// StringEvaluation evaluates the toggle and returns the value of its string variant.
+func (p *Provider) StringEvaluation(ctx context.Context, flag string, defaultValue string, evalCtx of.FlattenedContext) of.StringResolutionDetail {
+	variant, detail := p.evaluateVariant(ctx, flag, evalCtx, entity.VariantTypeString)
+	if variant == nil {
+		return of.StringResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	return of.StringResolutionDetail{Value: variant.Value, ProviderResolutionDetail: detail}
+}
+
+// FloatEvaluation evaluates the toggle and returns the value of its number variant.
+func (p *Provider) FloatEvaluation(ctx context.Context, flag string, defaultValue float64, evalCtx of.FlattenedContext) of.FloatResolutionDetail {
+	variant, detail := p.evaluateVariant(ctx, flag, evalCtx, entity.VariantTypeNumber)
+	if variant == nil {
+		return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	val, err := strconv.ParseFloat(variant.Value, 64)
+	if err != nil {
+		return of.FloatResolutionDetail{Value: defaultValue, ProviderResolutionDetail: createParseErrorDetail(variant)}
+	}
+	return of.FloatResolutionDetail{Value: val, ProviderResolutionDetail: detail}
+}
+
+// IntEvaluation evaluates the toggle and returns the value of its number variant as integer.
+func (p *Provider) IntEvaluation(ctx context.Context, flag string, defaultValue int64, evalCtx of.FlattenedContext) of.IntResolutionDetail {
+	variant, detail := p.evaluateVariant(ctx, flag, evalCtx, entity.VariantTypeNumber)
+	if variant == nil {
+		return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	val, err := strconv.ParseFloat(variant.Value, 64)
+	if err != nil || val != math.Trunc(val) || val >= math.MaxInt64 || val < math.MinInt64 {
+		return of.IntResolutionDetail{Value: defaultValue, ProviderResolutionDetail: createParseErrorDetail(variant)}
+	}
+	return of.IntResolutionDetail{Value: int64(val), ProviderResolutionDetail: detail}
+}
+
+// ObjectEvaluation evaluates the toggle and returns the decoded value of its JSON variant.
+func (p *Provider) ObjectEvaluation(ctx context.Context, flag string, defaultValue interface{}, evalCtx of.FlattenedContext) of.InterfaceResolutionDetail {
+	variant, detail := p.evaluateVariant(ctx, flag, evalCtx, entity.VariantTypeJSON)
+	if variant == nil {
+		return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: detail}
+	}
+	var val interface{}
+	if err := json.Unmarshal([]byte(variant.Value), &val); err != nil {
+		return of.InterfaceResolutionDetail{Value: defaultValue, ProviderResolutionDetail: createParseErrorDetail(variant)}
+	}
+	return of.InterfaceResolutionDetail{Value: val, ProviderResolutionDetail: detail}
+}
+
+// evaluateVariant evaluates the toggle and returns its variant if the variant has the given type.
+// Otherwise, it returns nil variant and the detail holds the error.
+func (p *Provider) evaluateVariant(ctx context.Context, flag string, evalCtx of.FlattenedContext, typ entity.VariantType) (*entity.Variant, of.ProviderResolutionDetail) {
+	eval, err := p.client.Evaluate(ctx, flag, p.createEvaluationContext(evalCtx))
+	if err != nil {
+		return nil, of.ProviderResolutionDetail{Reason: of.ErrorReason, ResolutionError: createResolutionError(err)}
+	}
+	if eval.Variant == nil {
+		msg := fmt.Sprintf("toggle %s doesn't serve any variant", flag)
+		return nil, of.ProviderResolutionDetail{Reason: of.ErrorReason, ResolutionError: of.NewTypeMismatchResolutionError(msg)}
+	}
+	if eval.Variant.Type != typ {
+		msg := fmt.Sprintf("variant %s is %s, not %s", eval.Variant.Name, eval.Variant.Type, typ)
+		return nil, of.ProviderResolutionDetail{Reason: of.ErrorReason, ResolutionError: of.NewTypeMismatchResolutionError(msg)}
+	}
+	return eval.Variant, of.ProviderResolutionDetail{
+		Reason:       mapEvaluationReason(eval.Reason),
+		Variant:      eval.Variant.Name,
+		FlagMetadata: of.FlagMetadata{"version": eval.Version},
+	}
+}
+
+// createEvaluationContext converts the evaluation context's attributes to strings.
+// Attributes which aren't a string, a boolean, a number, or a time are ignored.
+func (p *Provider) createEvaluationContext(evalCtx of.FlattenedContext) map[string]string {
+	res := make(map[string]string, len(evalCtx))
+	for key, val := range evalCtx {
+		if key == of.TargetingKey {
+			key = p.targetingKeyAttribute
+		}
+		switch tmp := val.(type) {
+		case string:
+			res[key] = tmp
+		case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			res[key] = fmt.Sprint(tmp)
+		case time.Time:
+			res[key] = tmp.Format(time.RFC3339)
+		}
+	}
+	return res
+}
+
+// changed emits PROVIDER_CONFIGURATION_CHANGED event, preceded by PROVIDER_READY event if the provider is stale.
+func (p *Provider) changed(key string) {
+	p.mutex.Lock()
+	wasStale := p.state == of.StaleState
+	if wasStale {
+		p.state = of.ReadyState
+	}
+	p.mutex.Unlock()
+
+	if wasStale {
+		p.emit(of.ProviderReady, of.ProviderEventDetails{Message: "subscription is restored"})
+	}
+	p.emit(of.ProviderConfigChange, of.ProviderEventDetails{Message: "toggle is changed", FlagChanges: []string{key}})
+}
+
+// stale marks the provider as stale and emits PROVIDER_STALE event.
+func (p *Provider) stale(err error) {
+	p.setState(of.StaleState)
+	p.emit(of.ProviderStale, of.ProviderEventDetails{Message: fmt.Sprintf("subscription fails: %v", err)})
+}
+
+func (p *Provider) emit(typ of.EventType, details of.ProviderEventDetails) {
+	select {
+	case p.events <- of.Event{ProviderName: ProviderName, EventType: typ, ProviderEventDetails: details}:
+	default:
+	}
+}
+
+func (p *Provider) setState(state of.State) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.state = state
+}
+
+// eventSubscriber notifies the provider about every toggle's event the client accepts.
+type eventSubscriber struct {
+	toggle.Subscriber
+	provider *Provider
+	keys     []string
+}
+
+// Subscribe subscribes using the underlying subscriber.
+// The event is passed to fn before the provider is notified, so that the change is visible once the event is emitted.
+func (s *eventSubscriber) Subscribe(ctx context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+	return s.Subscriber.Subscribe(ctx, func(event *togglev1.ToggleEvent) error {
+		if err := fn(event); err != nil {
+			return err
+		}
+		if s.isFollowed(event) {
+			s.provider.changed(event.GetToggle().GetKey())
+		}
+		return nil
+	})
+}
+
+func (s *eventSubscriber) isFollowed(event *togglev1.ToggleEvent) bool {
+	client := s.provider.client
+	if event.GetProject() != client.Project() || event.GetEnvironment() != client.Environment() {
+		return false
+	}
+	if len(s.keys) == 0 {
+		return true
+	}
+	for _, key := range s.keys {
+		if key == event.GetToggle().GetKey() {
+			return true
+		}
+	}
+	return false
+}
+
+// mapDetailReason maps the reason of Client.BoolVariationDetail to OpenFeature's reason.
+// The value decided by the percentage rollout is SPLIT and the value served since the toggle is off is DISABLED,
+// the same as mapEvaluationReason.
+func mapDetailReason(detail *toggle.EvaluationDetail) of.Reason {
+	switch detail.Reason {
+	case toggle.DetailReasonRuleMatch:
+		return of.TargetingMatchReason
+	case toggle.DetailReasonFallthrough:
+		if detail.Rollout {
+			return of.SplitReason
+		}
+		return of.DefaultReason
+	case toggle.DetailReasonDefault:
+		if detail.Disabled {
+			return of.DisabledReason
+		}
+		return of.DefaultReason
+	case toggle.DetailReasonCached:
+		return of.CachedReason
+	case toggle.DetailReasonError:
+		return of.ErrorReason
+	default:
+		return of.UnknownReason
+	}
+}
+
+// mapEvaluationReason maps the reason of Client.Evaluate to OpenFeature's reason.
+// Only a toggle which is off is DISABLED. Since the prerequisites are only checked once the toggle is on,
+// the value served since a prerequisite isn't satisfied is DEFAULT.
+func mapEvaluationReason(reason entity.EvaluationReason) of.Reason {
+	switch reason {
+	case entity.EvaluationReasonRuleMatch:
+		return of.TargetingMatchReason
+	case entity.EvaluationReasonRollout:
+		return of.SplitReason
+	case entity.EvaluationReasonFallthrough, entity.EvaluationReasonPrerequisiteFailed:
+		return of.DefaultReason
+	case entity.EvaluationReasonDisabled:
+		return of.DisabledReason
+	default:
+		return of.UnknownReason
+	}
+}
+
+// createResolutionError maps the toggle's error code to OpenFeature's resolution error.
+func createResolutionError(err error) of.ResolutionError {
+	switch toggle.ErrorKind(err) {
+	case togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_NOT_FOUND:
+		return of.NewFlagNotFoundResolutionError(err.Error())
+	case togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_VARIANT:
+		return of.NewTypeMismatchResolutionError(err.Error())
+	default:
+		return of.NewGeneralResolutionError(err.Error())
+	}
+}
+
+func createParseErrorDetail(variant *entity.Variant) of.ProviderResolutionDetail {
+	msg := fmt.Sprintf("value %q of variant %s can't be parsed", variant.Value, variant.Name)
+	return of.ProviderResolutionDetail{Reason: of.ErrorReason, ResolutionError: of.NewParseErrorResolutionError(msg)}
+}
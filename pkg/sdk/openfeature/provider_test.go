@@ -0,0 +1,444 @@
+package openfeature_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	of "github.com/open-feature/go-sdk/openfeature"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/pkg/sdk/openfeature"
+	"github.com/indrasaputra/toggle/pkg/sdk/toggle"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	mock_toggle "github.com/indrasaputra/toggle/test/mock/pkg/sdk/toggle"
+	mock_server "github.com/indrasaputra/toggle/test/mock/proto/grpc/server"
+)
+
+var (
+	testCtx         = context.Background()
+	testToggleRules = []*entity.Rule{{Attribute: "user_id", Operator: entity.RuleOperatorEquals, Values: []string{"user-1"}, Value: true}}
+	testToggles     = []*entity.Toggle{
+		{Key: "toggle-bool", IsEnabled: true, Rules: testToggleRules, Version: 2},
+		{Key: "toggle-disabled", IsEnabled: false, DefaultValue: true, Version: 1},
+		{
+			Key:            "toggle-string",
+			IsEnabled:      true,
+			DefaultValue:   true,
+			Rules:          []*entity.Rule{{Attribute: "user_id", Operator: entity.RuleOperatorEquals, Values: []string{"user-1"}, Value: true, Variant: "red"}},
+			Variants:       []*entity.Variant{{Name: "blue", Type: entity.VariantTypeString, Value: "#0000ff"}, {Name: "red", Type: entity.VariantTypeString, Value: "#ff0000"}},
+			DefaultVariant: "blue",
+			OffVariant:     "blue",
+			Version:        3,
+		},
+		{
+			Key:            "toggle-number",
+			IsEnabled:      true,
+			DefaultValue:   true,
+			Variants:       []*entity.Variant{{Name: "ten", Type: entity.VariantTypeNumber, Value: "10"}, {Name: "half", Type: entity.VariantTypeNumber, Value: "0.5"}},
+			DefaultVariant: "ten",
+			OffVariant:     "half",
+		},
+		{
+			Key:            "toggle-half",
+			IsEnabled:      false,
+			Variants:       []*entity.Variant{{Name: "ten", Type: entity.VariantTypeNumber, Value: "10"}, {Name: "half", Type: entity.VariantTypeNumber, Value: "0.5"}},
+			DefaultVariant: "ten",
+			OffVariant:     "half",
+		},
+		{
+			Key:            "toggle-rollout",
+			IsEnabled:      true,
+			Rollout:        &entity.Rollout{Percentage: 100, BucketBy: "user_id"},
+			Variants:       []*entity.Variant{{Name: "blue", Type: entity.VariantTypeString, Value: "#0000ff"}, {Name: "red", Type: entity.VariantTypeString, Value: "#ff0000"}},
+			DefaultVariant: "red",
+			OffVariant:     "blue",
+		},
+		{
+			Key:            "toggle-prerequisite",
+			IsEnabled:      true,
+			DefaultValue:   true,
+			Prerequisites:  []*entity.Prerequisite{{Key: "toggle-disabled", Value: true}},
+			Variants:       []*entity.Variant{{Name: "blue", Type: entity.VariantTypeString, Value: "#0000ff"}, {Name: "red", Type: entity.VariantTypeString, Value: "#ff0000"}},
+			DefaultVariant: "red",
+			OffVariant:     "blue",
+		},
+		{
+			Key:            "toggle-json",
+			IsEnabled:      true,
+			DefaultValue:   true,
+			Variants:       []*entity.Variant{{Name: "config", Type: entity.VariantTypeJSON, Value: `{"limit": 10}`}},
+			DefaultVariant: "config",
+			OffVariant:     "config",
+		},
+	}
+)
+
+type ProviderExecutor struct {
+	provider *openfeature.Provider
+	source   *toggle.TestDataSource
+}
+
+func TestNewProvider(t *testing.T) {
+	t.Run("successfully create an instance of Provider", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		assert.NotNil(t, exec.provider)
+		assert.Equal(t, openfeature.ProviderName, exec.provider.Metadata().Name)
+		assert.Empty(t, exec.provider.Hooks())
+		assert.Equal(t, of.NotReadyState, exec.provider.Status())
+	})
+}
+
+func TestProvider_Init(t *testing.T) {
+	t.Run("provider is ready once the client is ready", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		err := exec.provider.Init(of.EvaluationContext{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, of.ReadyState, exec.provider.Status())
+	})
+
+	t.Run("provider is in error state if the client isn't ready within the timeout", func(t *testing.T) {
+		client, err := toggle.NewClient(nil, nil, toggle.WithDataSource(pendingDataSource{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = client.Close() })
+		provider := openfeature.NewProvider(client, openfeature.WithInitTimeout(10*time.Millisecond))
+
+		err = provider.Init(of.EvaluationContext{})
+
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Equal(t, of.ErrorState, provider.Status())
+	})
+
+	t.Run("provider is not ready after shutdown", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+		_ = exec.provider.Init(of.EvaluationContext{})
+
+		exec.provider.Shutdown()
+
+		assert.Equal(t, of.NotReadyState, exec.provider.Status())
+	})
+}
+
+func TestProvider_BooleanEvaluation(t *testing.T) {
+	t.Run("targeting key is passed as the configured attribute", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.BooleanEvaluation(testCtx, "toggle-bool", false, of.FlattenedContext{of.TargetingKey: "user-1"})
+
+		assert.True(t, res.Value)
+		assert.Equal(t, of.TargetingMatchReason, res.Reason)
+		assert.Nil(t, res.Error())
+		assert.Equal(t, of.FlagMetadata{"version": int64(2)}, res.FlagMetadata)
+	})
+
+	t.Run("toggle which doesn't match any rule serves its default value", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.BooleanEvaluation(testCtx, "toggle-bool", true, of.FlattenedContext{of.TargetingKey: "user-2", "age": 20, "beta": true})
+
+		assert.False(t, res.Value)
+		assert.Equal(t, of.DefaultReason, res.Reason)
+	})
+
+	t.Run("value decided by the rollout is split", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.BooleanEvaluation(testCtx, "toggle-rollout", false, of.FlattenedContext{of.TargetingKey: "user-1"})
+
+		assert.True(t, res.Value)
+		assert.Equal(t, of.SplitReason, res.Reason)
+	})
+
+	t.Run("disabled toggle is evaluated to false", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.BooleanEvaluation(testCtx, "toggle-disabled", true, nil)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, of.DisabledReason, res.Reason)
+	})
+
+	t.Run("enabled toggle whose prerequisite isn't satisfied serves the default", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.BooleanEvaluation(testCtx, "toggle-prerequisite", true, nil)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, of.DefaultReason, res.Reason)
+	})
+
+	t.Run("unknown toggle is not found", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.BooleanEvaluation(testCtx, "unknown", true, nil)
+
+		assert.True(t, res.Value)
+		assert.Equal(t, of.ErrorReason, res.Reason)
+		assert.Equal(t, of.FlagNotFoundCode, res.ResolutionDetail().ErrorCode)
+	})
+
+	t.Run("internal error is a general error", func(t *testing.T) {
+		provider := openfeature.NewProvider(createServerClient(t))
+		ctx := metadata.NewOutgoingContext(testCtx, metadata.Pairs("has-error", "true"))
+
+		res := provider.BooleanEvaluation(ctx, "toggle-bool", true, nil)
+
+		assert.True(t, res.Value)
+		assert.Equal(t, of.ErrorReason, res.Reason)
+		assert.Equal(t, of.GeneralCode, res.ResolutionDetail().ErrorCode)
+	})
+}
+
+func TestProvider_StringEvaluation(t *testing.T) {
+	t.Run("variant decided by the rollout is split", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.StringEvaluation(testCtx, "toggle-rollout", "grey", of.FlattenedContext{of.TargetingKey: "user-1"})
+
+		assert.Equal(t, "#ff0000", res.Value)
+		assert.Equal(t, of.SplitReason, res.Reason)
+	})
+
+	t.Run("matched rule's variant is served", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.StringEvaluation(testCtx, "toggle-string", "grey", of.FlattenedContext{of.TargetingKey: "user-1"})
+
+		assert.Equal(t, "#ff0000", res.Value)
+		assert.Equal(t, "red", res.Variant)
+		assert.Equal(t, of.TargetingMatchReason, res.Reason)
+		assert.Equal(t, of.FlagMetadata{"version": int64(3)}, res.FlagMetadata)
+	})
+
+	t.Run("off variant of enabled toggle whose prerequisite isn't satisfied is default", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.StringEvaluation(testCtx, "toggle-prerequisite", "grey", nil)
+
+		assert.Equal(t, "#0000ff", res.Value)
+		assert.Equal(t, of.DefaultReason, res.Reason)
+	})
+
+	t.Run("variant of other type is type mismatch", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.StringEvaluation(testCtx, "toggle-number", "grey", nil)
+
+		assert.Equal(t, "grey", res.Value)
+		assert.Equal(t, of.TypeMismatchCode, res.ResolutionDetail().ErrorCode)
+	})
+
+	t.Run("toggle without variant is type mismatch", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.StringEvaluation(testCtx, "toggle-bool", "grey", nil)
+
+		assert.Equal(t, "grey", res.Value)
+		assert.Equal(t, of.TypeMismatchCode, res.ResolutionDetail().ErrorCode)
+	})
+
+	t.Run("unknown toggle is not found", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.StringEvaluation(testCtx, "unknown", "grey", nil)
+
+		assert.Equal(t, "grey", res.Value)
+		assert.Equal(t, of.FlagNotFoundCode, res.ResolutionDetail().ErrorCode)
+	})
+}
+
+func TestProvider_FloatEvaluation(t *testing.T) {
+	t.Run("number variant is served", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.FloatEvaluation(testCtx, "toggle-half", 1, nil)
+
+		assert.Equal(t, 0.5, res.Value)
+		assert.Equal(t, of.DisabledReason, res.Reason)
+	})
+
+	t.Run("variant of other type is type mismatch", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.FloatEvaluation(testCtx, "toggle-string", 1, nil)
+
+		assert.Equal(t, float64(1), res.Value)
+		assert.Equal(t, of.TypeMismatchCode, res.ResolutionDetail().ErrorCode)
+	})
+}
+
+func TestProvider_IntEvaluation(t *testing.T) {
+	t.Run("integer variant is served", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.IntEvaluation(testCtx, "toggle-number", 1, nil)
+
+		assert.Equal(t, int64(10), res.Value)
+		assert.Equal(t, "ten", res.Variant)
+		assert.Equal(t, of.DefaultReason, res.Reason)
+	})
+
+	t.Run("fractional variant can't be parsed", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.IntEvaluation(testCtx, "toggle-half", 1, nil)
+
+		assert.Equal(t, int64(1), res.Value)
+		assert.Equal(t, of.ParseErrorCode, res.ResolutionDetail().ErrorCode)
+	})
+}
+
+func TestProvider_ObjectEvaluation(t *testing.T) {
+	t.Run("JSON variant is decoded", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.ObjectEvaluation(testCtx, "toggle-json", nil, nil)
+
+		assert.Equal(t, map[string]interface{}{"limit": float64(10)}, res.Value)
+	})
+
+	t.Run("variant of other type is type mismatch", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+
+		res := exec.provider.ObjectEvaluation(testCtx, "toggle-number", "default", nil)
+
+		assert.Equal(t, "default", res.Value)
+		assert.Equal(t, of.TypeMismatchCode, res.ResolutionDetail().ErrorCode)
+	})
+}
+
+func TestProvider_Subscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("change of followed toggle emits configuration changed event", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			_ = fn(createToggleEvent("toggle-disabled", toggle.DefaultEnvironment))
+			_ = fn(createToggleEvent("toggle-bool", toggle.DefaultEnvironment))
+			_ = fn(createToggleEvent("toggle-disabled", "staging"))
+			return nil
+		})
+
+		err := exec.provider.Subscribe(testCtx, subs, []string{"toggle-disabled"})
+
+		assert.Nil(t, err)
+		event := receiveEvent(t, exec.provider)
+		assert.Equal(t, of.ProviderConfigChange, event.EventType)
+		assert.Equal(t, []string{"toggle-disabled"}, event.FlagChanges)
+		assertNoEvent(t, exec.provider)
+		assert.True(t, exec.provider.BooleanEvaluation(testCtx, "toggle-disabled", false, nil).Value)
+	})
+
+	t.Run("failed subscription makes the provider stale until the next change", func(t *testing.T) {
+		exec := createProviderExecutor(t)
+		_ = exec.provider.Init(of.EvaluationContext{})
+		failed := mock_toggle.NewMockSubscriber(ctrl)
+		failed.EXPECT().Subscribe(testCtx, gomock.Any()).Return(errors.New("connection is closed"))
+		restored := mock_toggle.NewMockSubscriber(ctrl)
+		restored.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			return fn(createToggleEvent("toggle-bool", toggle.DefaultEnvironment))
+		})
+
+		err := exec.provider.Subscribe(testCtx, failed, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, of.StaleState, exec.provider.Status())
+		assert.Equal(t, of.ProviderStale, receiveEvent(t, exec.provider).EventType)
+
+		err = exec.provider.Subscribe(testCtx, restored, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, of.ReadyState, exec.provider.Status())
+		assert.Equal(t, of.ProviderReady, receiveEvent(t, exec.provider).EventType)
+		assert.Equal(t, of.ProviderConfigChange, receiveEvent(t, exec.provider).EventType)
+	})
+}
+
+// pendingDataSource never loads any toggle.
+type pendingDataSource struct{}
+
+func (pendingDataSource) Start(context.Context, string, string, toggle.DataStore) {}
+
+// createToggleEvent creates the enabled event of the toggle which evaluates to true.
+func createToggleEvent(key, env string) *togglev1.ToggleEvent {
+	defaultValue := true
+	return &togglev1.ToggleEvent{
+		Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+		Toggle:      &togglev1.Toggle{Key: key, IsEnabled: true, DefaultValue: &defaultValue, Version: 2},
+		Environment: env,
+		Project:     toggle.DefaultProject,
+	}
+}
+
+func receiveEvent(t *testing.T, provider *openfeature.Provider) of.Event {
+	select {
+	case event := <-provider.EventChannel():
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event is emitted")
+		return of.Event{}
+	}
+}
+
+func assertNoEvent(t *testing.T, provider *openfeature.Provider) {
+	select {
+	case event := <-provider.EventChannel():
+		t.Errorf("unexpected event %v", event)
+	default:
+	}
+}
+
+func createProviderExecutor(t *testing.T) *ProviderExecutor {
+	source := toggle.NewTestDataSource(testToggles...)
+	client, err := toggle.NewClient(nil, nil, toggle.WithDataSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	return &ProviderExecutor{
+		provider: openfeature.NewProvider(client, openfeature.WithTargetingKeyAttribute("user_id")),
+		source:   source,
+	}
+}
+
+func createServerClient(t *testing.T) *toggle.Client {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	togglev1.RegisterToggleQueryServiceServer(grpcServer, &mock_server.MockToggleServiceServer{})
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	cfg := &toggle.DialConfig{
+		Options: []grpc.DialOption{
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return listener.Dial()
+			}),
+			grpc.WithInsecure(),
+		},
+	}
+	client, err := toggle.NewClient(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+		grpcServer.Stop()
+	})
+	return client
+}
@@ -2,7 +2,12 @@ package toggle
 
 import (
 	"context"
-	"sync"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -10,10 +15,20 @@ import (
 
 	"github.com/indrasaputra/toggle/entity"
 	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+)
+
+const (
+	// DefaultProject is the project used when DialConfig.Project is empty.
+	DefaultProject = "default"
+	// DefaultEnvironment is the environment used when DialConfig.Environment is empty.
+	DefaultEnvironment = "production"
+	// DefaultPollInterval is the interval used when DialConfig.PollInterval is zero.
+	DefaultPollInterval = 30 * time.Second
 )
 
 var (
-	globalRepositories map[string]bool
+	errReadOnly = status.New(codes.FailedPrecondition, "client doesn't connect to server").Err()
 )
 
 // DialConfig defines configuration to work with Client.
@@ -22,6 +37,23 @@ type DialConfig struct {
 	Host string
 	// Options defines list of dial option used to make a connection to server.
 	Options []grpc.DialOption
+	// Project defines the project the client works on, usually the project of a team.
+	// All toggles are read and written in this project.
+	// If it is empty, DefaultProject is used.
+	Project string
+	// Environment defines the environment the client works on, such as development, staging, or production.
+	// All toggles are read and written in this environment.
+	// If it is empty, DefaultEnvironment is used.
+	Environment string
+	// PollInterval defines how often the client reloads all toggles from server
+	// while no subscriber is attached via Subscribe.
+	// If it is zero, DefaultPollInterval is used.
+	PollInterval time.Duration
+	// FlushInterval defines how often the client reports the evaluation counts to server.
+	// If it is zero, DefaultFlushInterval is used.
+	FlushInterval time.Duration
+	// DisableEvaluationReport defines whether the client doesn't report the evaluation counts to server.
+	DisableEvaluationReport bool
 }
 
 // CircuitBreaker defines interface for circuit breaker.
@@ -39,38 +71,144 @@ type Subscriber interface {
 }
 
 // Client acts as a client to connect to Toggle.
+//
+// It keeps all toggles of its project and environment in memory, filled by its data source.
+// By default, the data source is server: the toggles are loaded in the background when the client is created
+// and reloaded every poll interval while no subscriber is attached.
+// If the reload fails, the last known toggles are kept.
+//
+// Every evaluation is counted per toggle, served variant, and hour.
+// The counts are reported to server in the background every flush interval and once more when the client is closed,
+// unless DisableEvaluationReport is set. If server can't be reached, the counts are reported again in the next flush.
+//
+// If another data source is given using WithDataSource, the client doesn't connect to server.
+// Get, IsEnabled, Evaluate, and the variations are served from memory,
+// while Create, Enable, Disable, and Delete return FailedPrecondition error.
+// The evaluations aren't reported.
 type Client struct {
-	command togglev1.ToggleCommandServiceClient
-	query   togglev1.ToggleQueryServiceClient
-	mtx     *sync.Mutex
-	breaker CircuitBreaker
+	conn        *grpc.ClientConn
+	command     togglev1.ToggleCommandServiceClient
+	query       togglev1.ToggleQueryServiceClient
+	store       *store
+	recorder    *recorder
+	breaker     CircuitBreaker
+	project     string
+	env         string
+	subscribers int32
+	cancel      context.CancelFunc
 }
 
 // NewClient creates an instance of Client.
-func NewClient(dialCfg *DialConfig, breaker CircuitBreaker) (*Client, error) {
-	conn, err := grpc.DialContext(context.Background(), dialCfg.Host, dialCfg.Options...)
-	if err != nil {
-		return nil, status.New(codes.Unavailable, "").Err()
+// It starts loading all toggles from the data source in the background. Use WaitForInit to wait until they are loaded.
+// The dialCfg can be nil if another data source than server is given using WithDataSource.
+// Close must be called once the client is no longer used.
+func NewClient(dialCfg *DialConfig, breaker CircuitBreaker, opts ...Option) (*Client, error) {
+	options := &clientOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if dialCfg == nil {
+		dialCfg = &DialConfig{}
 	}
 
 	if breaker == nil {
 		breaker = noBreaker{}
 	}
+	project := dialCfg.Project
+	if project == "" {
+		project = DefaultProject
+	}
+	env := dialCfg.Environment
+	if env == "" {
+		env = DefaultEnvironment
+	}
 
-	globalRepositories = make(map[string]bool)
-	return &Client{
-		command: togglev1.NewToggleCommandServiceClient(conn),
-		query:   togglev1.NewToggleQueryServiceClient(conn),
-		mtx:     &sync.Mutex{},
+	client := &Client{
+		store:   newStore(),
 		breaker: breaker,
-	}, nil
+		project: project,
+		env:     env,
+	}
+	source := options.dataSource
+	if source == nil {
+		conn, err := grpc.DialContext(context.Background(), dialCfg.Host, dialCfg.Options...)
+		if err != nil {
+			return nil, status.New(codes.Unavailable, "").Err()
+		}
+		client.conn = conn
+		client.command = togglev1.NewToggleCommandServiceClient(conn)
+		client.query = togglev1.NewToggleQueryServiceClient(conn)
+		source = newServerDataSource(client.query, breaker, dialCfg.PollInterval, client.hasSubscriber)
+		if !dialCfg.DisableEvaluationReport {
+			client.recorder = newRecorder(client.command, breaker, project, env, dialCfg.FlushInterval)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client.cancel = cancel
+	source.Start(ctx, project, env, client.store)
+	if client.recorder != nil {
+		client.recorder.start(ctx)
+	}
+	return client, nil
+}
+
+// Project returns the project the client works on.
+func (c *Client) Project() string {
+	return c.project
+}
+
+// Environment returns the environment the client works on.
+func (c *Client) Environment() string {
+	return c.env
+}
+
+// Ready tells whether all toggles have been loaded at least once.
+func (c *Client) Ready() bool {
+	return c.store.isReady()
+}
+
+// WaitForInit blocks until all toggles have been loaded at least once.
+// It returns the context's error if the context is done before that.
+func (c *Client) WaitForInit(ctx context.Context) error {
+	return c.store.wait(ctx)
+}
+
+// Close stops the data source and closes the connection to server, if any.
+// The evaluation counts which haven't been reported are reported before the connection is closed.
+func (c *Client) Close() error {
+	c.cancel()
+	if c.conn == nil {
+		return nil
+	}
+	if c.recorder != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), closeFlushTimeout)
+		_ = c.recorder.flush(ctx)
+		cancel()
+	}
+	return c.conn.Close()
 }
 
 // Create creates a new toggle.
+// The created toggle is then fetched and saved in memory, since the server fills the attributes which aren't given.
+// The toggle isn't saved if it can't be fetched.
 func (c *Client) Create(ctx context.Context, toggle *entity.Toggle) error {
-	req := &togglev1.CreateToggleRequest{Toggle: &togglev1.Toggle{
-		Key:         toggle.Key,
-		Description: toggle.Description,
+	if c.conn == nil {
+		return errReadOnly
+	}
+	req := &togglev1.CreateToggleRequest{Project: c.project, Environment: c.env, Toggle: &togglev1.Toggle{
+		Key:            toggle.Key,
+		Description:    toggle.Description,
+		Rules:          entity.RulesToProto(toggle.Rules),
+		DefaultValue:   &toggle.DefaultValue,
+		Rollout:        entity.RolloutToProto(toggle.Rollout),
+		Variants:       entity.VariantsToProto(toggle.Variants),
+		DefaultVariant: toggle.DefaultVariant,
+		OffVariant:     toggle.OffVariant,
+		Prerequisites:  entity.PrerequisitesToProto(toggle.Prerequisites),
+		Owner:          toggle.Owner,
+		ExpiresAt:      entity.TimeToProto(toggle.ExpiresAt),
+		Tags:           toggle.Tags,
 	}}
 
 	_, err := c.breaker.Execute(func() (interface{}, error) {
@@ -80,13 +218,20 @@ func (c *Client) Create(ctx context.Context, toggle *entity.Toggle) error {
 		}
 		return nil, nil
 	})
-	c.setGlobalRepositories(toggle.Key, false)
-	return err
+	if err != nil {
+		return err
+	}
+	_, _ = c.Get(ctx, toggle.Key)
+	return nil
 }
 
 // Get gets a single toggle by its key.
+// If the client doesn't connect to server, the toggle is read from memory.
 func (c *Client) Get(ctx context.Context, key string) (*entity.Toggle, error) {
-	req := &togglev1.GetToggleByKeyRequest{Key: key}
+	if c.conn == nil {
+		return c.getLocally(key)
+	}
+	req := &togglev1.GetToggleByKeyRequest{Project: c.project, Environment: c.env, Key: key}
 
 	tmp, err := c.breaker.Execute(func() (interface{}, error) {
 		x, err := c.query.GetToggleByKey(ctx, req)
@@ -104,56 +249,209 @@ func (c *Client) Get(ctx context.Context, key string) (*entity.Toggle, error) {
 		return nil, entity.ErrNotFound()
 	}
 
-	toggle := &entity.Toggle{
-		Key:         resp.GetToggle().GetKey(),
-		IsEnabled:   resp.GetToggle().GetIsEnabled(),
-		Description: resp.GetToggle().GetDescription(),
-		CreatedAt:   resp.GetToggle().GetCreatedAt().AsTime(),
-		UpdatedAt:   resp.GetToggle().GetUpdatedAt().AsTime(),
+	c.store.Upsert(createToggleFromProto(resp.GetToggle()))
+	return createToggleFromProto(resp.GetToggle()), nil
+}
+
+// Evaluate evaluates a toggle against the evaluation context in server.
+// It returns the resolved value and the rule that matched, if any.
+// If the client doesn't connect to server, the toggle in memory is evaluated using EvaluateLocally.
+func (c *Client) Evaluate(ctx context.Context, key string, evalCtx map[string]string) (*entity.Evaluation, error) {
+	if c.conn == nil {
+		toggle, err := c.getLocally(key)
+		if err != nil {
+			return nil, err
+		}
+		return c.EvaluateLocally(toggle, evalCtx), nil
+	}
+	req := &togglev1.EvaluateToggleRequest{Project: c.project, Environment: c.env, Key: key, Context: evalCtx}
+
+	var clientErr error
+	tmp, err := c.breaker.Execute(func() (interface{}, error) {
+		x, err := c.query.EvaluateToggle(ctx, req)
+		if isServerError(err) {
+			return nil, err
+		}
+		clientErr = err
+		return x, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if clientErr != nil {
+		return nil, clientErr
+	}
+
+	resp := tmp.(*togglev1.EvaluateToggleResponse)
+	eval := &entity.Evaluation{
+		Key:                key,
+		Value:              resp.GetValue(),
+		Reason:             entity.EvaluationReasonFromProto(resp.GetReason()),
+		MatchedRule:        entity.RuleFromProto(resp.GetMatchedRule()),
+		Variant:            entity.VariantFromProto(resp.GetVariant()),
+		FailedPrerequisite: resp.GetFailedPrerequisite(),
+		Version:            resp.GetVersion(),
+	}
+	c.record(eval)
+	return eval, nil
+}
+
+// BoolVariation evaluates a toggle in server and returns its value.
+// It never fails: fallback is returned if the toggle can't be evaluated.
+// Use BoolVariationDetail to know why the value is returned.
+func (c *Client) BoolVariation(ctx context.Context, key string, evalCtx map[string]string, fallback bool) bool {
+	return c.BoolVariationDetail(ctx, key, evalCtx, fallback).Value
+}
+
+// BoolVariationDetail evaluates a toggle in server and returns its value along with the reason.
+// If server can't be reached, the last known toggle in memory is evaluated using EvaluateLocally and the reason is CACHED.
+// If the toggle still can't be evaluated, fallback is returned, the reason is ERROR,
+// and the error is classified by the toggle's error code.
+func (c *Client) BoolVariationDetail(ctx context.Context, key string, evalCtx map[string]string, fallback bool) *EvaluationDetail {
+	eval, err := c.Evaluate(ctx, key, evalCtx)
+	if err == nil {
+		return createEvaluationDetail(eval)
+	}
+	if isDefiniteError(err) {
+		return createErrorDetail(err, fallback)
+	}
+
+	toggle, ok := c.store.get(key)
+	if !ok {
+		return createErrorDetail(err, fallback)
+	}
+	eval = c.EvaluateLocally(toggle, evalCtx)
+	return &EvaluationDetail{Value: eval.Value, Reason: DetailReasonCached, Version: eval.Version, Rollout: eval.Reason == entity.EvaluationReasonRollout}
+}
+
+// StringVariation evaluates a multivariate toggle in server and returns the value of its string variant.
+// It returns fallback and error if the toggle can't be evaluated or the resolved variant isn't a string.
+func (c *Client) StringVariation(ctx context.Context, key string, evalCtx map[string]string, fallback string) (string, error) {
+	variant, err := c.variation(ctx, key, evalCtx, entity.VariantTypeString)
+	if err != nil {
+		return fallback, err
+	}
+	return variant.Value, nil
+}
+
+// IntVariation evaluates a multivariate toggle in server and returns the value of its number variant as integer.
+// It returns fallback and error if the toggle can't be evaluated or the resolved variant isn't an integer.
+func (c *Client) IntVariation(ctx context.Context, key string, evalCtx map[string]string, fallback int) (int, error) {
+	val, err := c.Float64Variation(ctx, key, evalCtx, float64(fallback))
+	if err != nil {
+		return fallback, err
+	}
+	if val != math.Trunc(val) || val >= math.MaxInt64 || val < math.MinInt64 {
+		return fallback, entity.ErrInvalidVariant(fmt.Sprintf("value %v is not an integer", val))
+	}
+	return int(val), nil
+}
+
+// Float64Variation evaluates a multivariate toggle in server and returns the value of its number variant.
+// It returns fallback and error if the toggle can't be evaluated or the resolved variant isn't a number.
+func (c *Client) Float64Variation(ctx context.Context, key string, evalCtx map[string]string, fallback float64) (float64, error) {
+	variant, err := c.variation(ctx, key, evalCtx, entity.VariantTypeNumber)
+	if err != nil {
+		return fallback, err
+	}
+	val, err := strconv.ParseFloat(variant.Value, 64)
+	if err != nil {
+		return fallback, entity.ErrInvalidVariant(fmt.Sprintf("value %q is not a number", variant.Value))
+	}
+	return val, nil
+}
+
+// JSONVariation evaluates a multivariate toggle in server and returns the value of its JSON variant.
+// The value can be decoded using json.Unmarshal.
+// It returns fallback and error if the toggle can't be evaluated or the resolved variant isn't a JSON.
+func (c *Client) JSONVariation(ctx context.Context, key string, evalCtx map[string]string, fallback json.RawMessage) (json.RawMessage, error) {
+	variant, err := c.variation(ctx, key, evalCtx, entity.VariantTypeJSON)
+	if err != nil {
+		return fallback, err
+	}
+	return json.RawMessage(variant.Value), nil
+}
+
+func (c *Client) variation(ctx context.Context, key string, evalCtx map[string]string, typ entity.VariantType) (*entity.Variant, error) {
+	eval, err := c.Evaluate(ctx, key, evalCtx)
+	if err != nil {
+		return nil, err
 	}
-	c.setGlobalRepositories(toggle.Key, toggle.IsEnabled)
-	return toggle, nil
+	if eval.Variant == nil {
+		return nil, entity.ErrInvalidVariant(fmt.Sprintf("toggle %s doesn't serve any variant", key))
+	}
+	if eval.Variant.Type != typ {
+		return nil, entity.ErrInvalidVariant(fmt.Sprintf("variant %s is %s, not %s", eval.Variant.Name, eval.Variant.Type, typ))
+	}
+	return eval.Variant, nil
+}
+
+// EvaluateLocally evaluates a toggle against the evaluation context without calling server.
+// The toggle is usually obtained from Get.
+// It uses the same rules and bucketing algorithm as server,
+// hence it resolves the same value as Evaluate for the same toggle.
+// The only exceptions are rule referencing segments which never matches since segments aren't fetched
+// and toggle with prerequisites which is never satisfied since prerequisites aren't evaluated.
+func (c *Client) EvaluateLocally(toggle *entity.Toggle, evalCtx map[string]string) *entity.Evaluation {
+	eval := service.Evaluate(toggle, evalCtx)
+	c.record(eval)
+	return eval
 }
 
 // Enable enables a toggle.
 // It sets toggle's `is_enabled` attribute to be true.
 func (c *Client) Enable(ctx context.Context, key string) error {
-	req := &togglev1.EnableToggleRequest{Key: key}
+	if c.conn == nil {
+		return errReadOnly
+	}
+	req := &togglev1.EnableToggleRequest{Project: c.project, Environment: c.env, Key: key}
 
-	_, err := c.breaker.Execute(func() (interface{}, error) {
-		_, err := c.command.EnableToggle(ctx, req)
+	tmp, err := c.breaker.Execute(func() (interface{}, error) {
+		x, err := c.command.EnableToggle(ctx, req)
 		if isServerError(err) {
 			return nil, err
 		}
-		return nil, nil
+		return x, nil
 	})
-	if err == nil {
-		c.setGlobalRepositories(key, true)
+	if err != nil {
+		return err
 	}
-	return err
+	if resp, ok := tmp.(*togglev1.EnableToggleResponse); ok && resp != nil {
+		c.store.setEnabled(key, true, resp.GetVersion())
+	}
+	return nil
 }
 
 // Disable disables a toggle.
 // It sets toggle's `is_enabled` attribute to be false.
 func (c *Client) Disable(ctx context.Context, key string) error {
-	req := &togglev1.DisableToggleRequest{Key: key}
-	_, err := c.breaker.Execute(func() (interface{}, error) {
-		_, err := c.command.DisableToggle(ctx, req)
+	if c.conn == nil {
+		return errReadOnly
+	}
+	req := &togglev1.DisableToggleRequest{Project: c.project, Environment: c.env, Key: key}
+	tmp, err := c.breaker.Execute(func() (interface{}, error) {
+		x, err := c.command.DisableToggle(ctx, req)
 		if isServerError(err) {
 			return nil, err
 		}
-		return nil, nil
+		return x, nil
 	})
-	if err == nil {
-		c.setGlobalRepositories(key, false)
+	if err != nil {
+		return err
 	}
-	return err
+	if resp, ok := tmp.(*togglev1.DisableToggleResponse); ok && resp != nil {
+		c.store.setEnabled(key, false, resp.GetVersion())
+	}
+	return nil
 }
 
 // Delete deletes a toggle.
 // It only deletes a nonactive toggle (is_enabled == false).
 func (c *Client) Delete(ctx context.Context, key string) error {
-	req := &togglev1.DeleteToggleRequest{Key: key}
+	if c.conn == nil {
+		return errReadOnly
+	}
+	req := &togglev1.DeleteToggleRequest{Project: c.project, Environment: c.env, Key: key}
 	_, err := c.breaker.Execute(func() (interface{}, error) {
 		_, err := c.command.DeleteToggle(ctx, req)
 		if isServerError(err) {
@@ -162,9 +460,7 @@ func (c *Client) Delete(ctx context.Context, key string) error {
 		return nil, nil
 	})
 	if err == nil {
-		c.mtx.Lock()
-		delete(globalRepositories, key)
-		c.mtx.Unlock()
+		c.store.Delete(key)
 	}
 	return err
 }
@@ -172,14 +468,21 @@ func (c *Client) Delete(ctx context.Context, key string) error {
 // Subscribe subscribes to a subscription.
 // It is used to get the toggles' changes from messaging system
 // and saves them in in-memory.
+// Only the changes of the given keys are saved. If keys is empty, the changes of all toggles are saved.
+// Changes from other projects or environments are ignored.
+// Expired events aren't scoped to any environment, hence they are ignored as well.
+// While it runs, the client doesn't reload the toggles every poll interval.
 // It should be run in a separate goroutine.
 func (c *Client) Subscribe(ctx context.Context, subscriber Subscriber, keys []string) error {
+	atomic.AddInt32(&c.subscribers, 1)
+	defer atomic.AddInt32(&c.subscribers, -1)
+
 	err := subscriber.Subscribe(ctx, func(event *togglev1.ToggleEvent) error {
-		toggleKey := event.GetToggle().GetKey()
-		for _, key := range keys {
-			if key == toggleKey {
-				c.setGlobalRepositories(toggleKey, getIsEnabledFromEventType(event.GetName()))
-			}
+		if event.GetProject() != c.project || event.GetEnvironment() != c.env {
+			return nil
+		}
+		if isSubscribedKey(event.GetToggle().GetKey(), keys) {
+			c.saveEvent(event)
 		}
 		return nil
 	})
@@ -189,9 +492,8 @@ func (c *Client) Subscribe(ctx context.Context, subscriber Subscriber, keys []st
 // IsEnabled gets toggle's is_enabled status.
 // It gets the result from in-memory. If not found, it calls Get(ctx, key) API.
 func (c *Client) IsEnabled(ctx context.Context, key string) (bool, error) {
-	val, ok := globalRepositories[key]
-	if ok {
-		return val, nil
+	if toggle, ok := c.store.get(key); ok {
+		return toggle.IsEnabled, nil
 	}
 
 	toggle, err := c.Get(ctx, key)
@@ -201,10 +503,77 @@ func (c *Client) IsEnabled(ctx context.Context, key string) (bool, error) {
 	return toggle.IsEnabled, nil
 }
 
-func (c *Client) setGlobalRepositories(key string, value bool) {
-	c.mtx.Lock()
-	globalRepositories[key] = value
-	c.mtx.Unlock()
+// getLocally gets a copy of the toggle in memory.
+func (c *Client) getLocally(key string) (*entity.Toggle, error) {
+	toggle, ok := c.store.get(key)
+	if !ok {
+		return nil, entity.ErrNotFound()
+	}
+	tmp := *toggle
+	return &tmp, nil
+}
+
+// record counts the evaluation, unless the evaluations aren't reported.
+func (c *Client) record(eval *entity.Evaluation) {
+	if c.recorder != nil {
+		c.recorder.record(eval)
+	}
+}
+
+func (c *Client) hasSubscriber() bool {
+	return atomic.LoadInt32(&c.subscribers) > 0
+}
+
+// saveEvent applies the toggle's event to the toggles in memory.
+// Since every event carries the whole toggle, the toggle is replaced rather than patched.
+func (c *Client) saveEvent(event *togglev1.ToggleEvent) {
+	key := event.GetToggle().GetKey()
+	switch event.GetName() {
+	case togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DELETED:
+		c.store.Delete(key)
+	default:
+		if event.GetToggle() == nil {
+			return
+		}
+		toggle := createToggleFromProto(event.GetToggle())
+		toggle.IsEnabled = getIsEnabledFromEvent(event)
+		c.store.Upsert(toggle)
+	}
+}
+
+func isSubscribedKey(key string, keys []string) bool {
+	if len(keys) == 0 {
+		return true
+	}
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func createToggleFromProto(toggle *togglev1.Toggle) *entity.Toggle {
+	return &entity.Toggle{
+		Key:            toggle.GetKey(),
+		IsEnabled:      toggle.GetIsEnabled(),
+		Description:    toggle.GetDescription(),
+		CreatedAt:      toggle.GetCreatedAt().AsTime(),
+		UpdatedAt:      toggle.GetUpdatedAt().AsTime(),
+		Rules:          entity.RulesFromProto(toggle.GetRules()),
+		DefaultValue:   toggle.GetDefaultValue(),
+		Rollout:        entity.RolloutFromProto(toggle.GetRollout()),
+		Variants:       entity.VariantsFromProto(toggle.GetVariants()),
+		DefaultVariant: toggle.GetDefaultVariant(),
+		OffVariant:     toggle.GetOffVariant(),
+		Environment:    toggle.GetEnvironment(),
+		Project:        toggle.GetProject(),
+		Prerequisites:  entity.PrerequisitesFromProto(toggle.GetPrerequisites()),
+		Owner:          toggle.GetOwner(),
+		ExpiresAt:      entity.TimeFromProto(toggle.GetExpiresAt()),
+		Tags:           toggle.GetTags(),
+		Version:        toggle.GetVersion(),
+	}
 }
 
 func isServerError(err error) bool {
@@ -216,12 +585,14 @@ func isServerError(err error) bool {
 	}
 }
 
-func getIsEnabledFromEventType(name togglev1.ToggleEventName) bool {
-	switch name {
+func getIsEnabledFromEvent(event *togglev1.ToggleEvent) bool {
+	switch event.GetName() {
 	case togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED:
 		return true
-	default:
+	case togglev1.ToggleEventName_TOGGLE_EVENT_NAME_DISABLED:
 		return false
+	default:
+		return event.GetToggle().GetIsEnabled()
 	}
 }
 
@@ -2,19 +2,26 @@ package toggle_test
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"os"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/indrasaputra/toggle/entity"
 	"github.com/indrasaputra/toggle/pkg/sdk/toggle"
 	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
 	mock_toggle "github.com/indrasaputra/toggle/test/mock/pkg/sdk/toggle"
 	mock_server "github.com/indrasaputra/toggle/test/mock/proto/grpc/server"
 )
@@ -63,6 +70,18 @@ func TestClient_Create(t *testing.T) {
 		err := executor.client.Create(testCtx, testToggle)
 		assert.Nil(t, err)
 	})
+
+	t.Run("created toggle is fetched from server and saved", func(t *testing.T) {
+		key := "toggle-created"
+		ctx := metadata.NewOutgoingContext(testCtx, metadata.Pairs("complete-return", key))
+
+		err := executor.client.Create(ctx, &entity.Toggle{Key: key})
+		assert.Nil(t, err)
+
+		res, err := executor.client.IsEnabled(testCtx, key)
+		assert.Nil(t, err)
+		assert.True(t, res)
+	})
 }
 
 func TestClient_Get(t *testing.T) {
@@ -84,6 +103,133 @@ func TestClient_Get(t *testing.T) {
 		resp, err := executor.client.Get(testCtxReturn, testToggleKey)
 		assert.Nil(t, err)
 		assert.NotNil(t, resp)
+		assert.Equal(t, toggle.DefaultEnvironment, resp.Environment)
+		assert.Equal(t, toggle.DefaultProject, resp.Project)
+	})
+}
+
+func TestClient_Evaluate(t *testing.T) {
+	t.Run("server returns error", func(t *testing.T) {
+		resp, err := executor.client.Evaluate(testCtxError, testToggleKey, map[string]string{"country": "ID"})
+
+		assert.NotNil(t, err)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("success evaluate a toggle", func(t *testing.T) {
+		resp, err := executor.client.Evaluate(testCtx, testToggleKey, map[string]string{"country": "ID"})
+
+		assert.Nil(t, err)
+		assert.True(t, resp.Value)
+		assert.Equal(t, entity.EvaluationReasonFallthrough, resp.Reason)
+		assert.Nil(t, resp.MatchedRule)
+	})
+}
+
+func TestClient_EvaluateLocally(t *testing.T) {
+	t.Run("success evaluate a toggle obtained from server", func(t *testing.T) {
+		tgl, err := executor.client.Get(testCtxReturn, testToggleKey)
+		assert.Nil(t, err)
+
+		resp := executor.client.EvaluateLocally(tgl, map[string]string{"user_id": "user-1"})
+
+		assert.True(t, resp.Value)
+		assert.Equal(t, entity.EvaluationReasonRollout, resp.Reason)
+	})
+
+	t.Run("subject without bucketing attribute is not in rollout", func(t *testing.T) {
+		tgl, err := executor.client.Get(testCtxReturn, testToggleKey)
+		assert.Nil(t, err)
+
+		resp := executor.client.EvaluateLocally(tgl, map[string]string{"country": "ID"})
+
+		assert.False(t, resp.Value)
+		assert.Equal(t, entity.EvaluationReasonRollout, resp.Reason)
+	})
+}
+
+func TestClient_StringVariation(t *testing.T) {
+	t.Run("server returns error", func(t *testing.T) {
+		resp, err := executor.client.StringVariation(testCtxError, testToggleKey, nil, "fallback")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "fallback", resp)
+	})
+
+	t.Run("toggle doesn't serve any variant", func(t *testing.T) {
+		resp, err := executor.client.StringVariation(testCtx, testToggleKey, nil, "fallback")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "fallback", resp)
+	})
+
+	t.Run("variant is not a string", func(t *testing.T) {
+		resp, err := executor.client.StringVariation(createVariantContext("number:10"), testToggleKey, nil, "fallback")
+
+		assert.NotNil(t, err)
+		assert.Equal(t, "fallback", resp)
+	})
+
+	t.Run("success get string variant", func(t *testing.T) {
+		resp, err := executor.client.StringVariation(createVariantContext("string:blue"), testToggleKey, nil, "fallback")
+
+		assert.Nil(t, err)
+		assert.Equal(t, "blue", resp)
+	})
+}
+
+func TestClient_IntVariation(t *testing.T) {
+	t.Run("variant is not a number", func(t *testing.T) {
+		resp, err := executor.client.IntVariation(createVariantContext("string:blue"), testToggleKey, nil, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, resp)
+	})
+
+	t.Run("variant is not an integer", func(t *testing.T) {
+		resp, err := executor.client.IntVariation(createVariantContext("number:1.5"), testToggleKey, nil, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, resp)
+	})
+
+	t.Run("success get integer variant", func(t *testing.T) {
+		resp, err := executor.client.IntVariation(createVariantContext("number:10"), testToggleKey, nil, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 10, resp)
+	})
+}
+
+func TestClient_Float64Variation(t *testing.T) {
+	t.Run("variant value is malformed", func(t *testing.T) {
+		resp, err := executor.client.Float64Variation(createVariantContext("number:ten"), testToggleKey, nil, 0.5)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0.5, resp)
+	})
+
+	t.Run("success get number variant", func(t *testing.T) {
+		resp, err := executor.client.Float64Variation(createVariantContext("number:1.5"), testToggleKey, nil, 0.5)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1.5, resp)
+	})
+}
+
+func TestClient_JSONVariation(t *testing.T) {
+	t.Run("variant is not a JSON", func(t *testing.T) {
+		resp, err := executor.client.JSONVariation(createVariantContext("string:blue"), testToggleKey, nil, json.RawMessage(`{}`))
+
+		assert.NotNil(t, err)
+		assert.Equal(t, json.RawMessage(`{}`), resp)
+	})
+
+	t.Run("success get JSON variant", func(t *testing.T) {
+		resp, err := executor.client.JSONVariation(createVariantContext(`json:{"color":"blue"}`), testToggleKey, nil, json.RawMessage(`{}`))
+
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"color":"blue"}`, string(resp))
 	})
 }
 
@@ -97,6 +243,16 @@ func TestClient_Enable(t *testing.T) {
 		err := executor.client.Enable(testCtx, testToggleKey)
 		assert.Nil(t, err)
 	})
+
+	t.Run("unknown toggle isn't saved after it is enabled", func(t *testing.T) {
+		key := "toggle-enabled"
+
+		err := executor.client.Enable(testCtx, key)
+		assert.Nil(t, err)
+
+		_, err = executor.client.IsEnabled(testCtx, key)
+		assert.NotNil(t, err)
+	})
 }
 
 func TestClient_Disable(t *testing.T) {
@@ -135,6 +291,132 @@ func TestClient_Subscribe(t *testing.T) {
 
 		assert.Nil(t, err)
 	})
+
+	t.Run("events from other environments are ignored", func(t *testing.T) {
+		key := "toggle-subscribe"
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			return fn(&togglev1.ToggleEvent{
+				Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+				Toggle:      &togglev1.Toggle{Key: key},
+				Environment: "staging",
+				Project:     toggle.DefaultProject,
+			})
+		})
+
+		err := executor.client.Subscribe(testCtx, subs, []string{key})
+		assert.Nil(t, err)
+
+		_, err = executor.client.IsEnabled(testCtx, key)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("events from other projects are ignored", func(t *testing.T) {
+		key := "toggle-subscribe"
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			return fn(&togglev1.ToggleEvent{
+				Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+				Toggle:      &togglev1.Toggle{Key: key},
+				Environment: toggle.DefaultEnvironment,
+				Project:     "payment",
+			})
+		})
+
+		err := executor.client.Subscribe(testCtx, subs, []string{key})
+		assert.Nil(t, err)
+
+		_, err = executor.client.IsEnabled(testCtx, key)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("expired events are ignored", func(t *testing.T) {
+		key := "toggle-subscribe"
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			return fn(&togglev1.ToggleEvent{
+				Name:    togglev1.ToggleEventName_TOGGLE_EVENT_NAME_EXPIRED,
+				Toggle:  &togglev1.Toggle{Key: key},
+				Project: toggle.DefaultProject,
+			})
+		})
+
+		err := executor.client.Subscribe(testCtx, subs, []string{key})
+		assert.Nil(t, err)
+
+		_, err = executor.client.IsEnabled(testCtx, key)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("events from client's environment are saved", func(t *testing.T) {
+		key := "toggle-subscribe"
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			return fn(&togglev1.ToggleEvent{
+				Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+				Toggle:      &togglev1.Toggle{Key: key},
+				Environment: toggle.DefaultEnvironment,
+				Project:     toggle.DefaultProject,
+			})
+		})
+
+		err := executor.client.Subscribe(testCtx, subs, []string{key})
+		assert.Nil(t, err)
+
+		val, err := executor.client.IsEnabled(testCtx, key)
+		assert.Nil(t, err)
+		assert.True(t, val)
+	})
+
+	t.Run("events replace the whole toggle", func(t *testing.T) {
+		key := "toggle-replaced"
+		rules := entity.RulesToProto([]*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}})
+		srv := &fakeQueryServer{}
+		client := createCacheClient(t, srv, time.Minute)
+		_ = client.WaitForInit(testCtx)
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			_ = fn(&togglev1.ToggleEvent{
+				Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_CREATED,
+				Toggle:      &togglev1.Toggle{Key: key, Rules: rules, Version: 1},
+				Environment: toggle.DefaultEnvironment,
+				Project:     toggle.DefaultProject,
+			})
+			return fn(&togglev1.ToggleEvent{
+				Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+				Toggle:      &togglev1.Toggle{Key: key, IsEnabled: true, Rules: rules, Version: 2},
+				Environment: toggle.DefaultEnvironment,
+				Project:     toggle.DefaultProject,
+			})
+		})
+
+		err := client.Subscribe(testCtx, subs, nil)
+		assert.Nil(t, err)
+
+		srv.setError(errCacheServer)
+		res := client.BoolVariationDetail(testCtx, key, map[string]string{"country": "ID"}, false)
+		assert.Equal(t, &toggle.EvaluationDetail{Value: true, Reason: toggle.DetailReasonCached, Version: 2}, res)
+	})
+
+	t.Run("updated events keep the toggle's state", func(t *testing.T) {
+		key := "toggle-updated"
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(testCtx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(event *togglev1.ToggleEvent) error) error {
+			return fn(&togglev1.ToggleEvent{
+				Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_UPDATED,
+				Toggle:      &togglev1.Toggle{Key: key, IsEnabled: true, Description: "new description"},
+				Environment: toggle.DefaultEnvironment,
+				Project:     toggle.DefaultProject,
+			})
+		})
+
+		err := executor.client.Subscribe(testCtx, subs, []string{key})
+		assert.Nil(t, err)
+
+		val, err := executor.client.IsEnabled(testCtx, key)
+		assert.Nil(t, err)
+		assert.True(t, val)
+	})
 }
 
 func TestClient_IsEnabled(t *testing.T) {
@@ -161,6 +443,318 @@ func TestClient_IsEnabled(t *testing.T) {
 	})
 }
 
+func TestClient_WaitForInit(t *testing.T) {
+	t.Run("all toggles are loaded page by page", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: []*togglev1.Toggle{{Key: "toggle-1", IsEnabled: true}, {Key: "toggle-2"}, {Key: "toggle-3", IsEnabled: true}}}
+		client := createCacheClient(t, srv, time.Minute)
+
+		err := client.WaitForInit(testCtx)
+
+		assert.Nil(t, err)
+		assert.True(t, client.Ready())
+		for _, tg := range srv.toggles {
+			val, err := client.IsEnabled(testCtx, tg.Key)
+			assert.Nil(t, err)
+			assert.Equal(t, tg.IsEnabled, val)
+		}
+	})
+
+	t.Run("context is done before the toggles are loaded", func(t *testing.T) {
+		srv := &fakeQueryServer{err: errCacheServer}
+		client := createCacheClient(t, srv, time.Minute)
+
+		ctx, cancel := context.WithTimeout(testCtx, 50*time.Millisecond)
+		defer cancel()
+		err := client.WaitForInit(ctx)
+
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.False(t, client.Ready())
+	})
+
+	t.Run("toggles are loaded once the server recovers", func(t *testing.T) {
+		srv := &fakeQueryServer{err: errCacheServer, toggles: []*togglev1.Toggle{{Key: "toggle-1", IsEnabled: true}}}
+		client := createCacheClient(t, srv, 10*time.Millisecond)
+		time.Sleep(30 * time.Millisecond)
+		srv.setError(nil)
+
+		ctx, cancel := context.WithTimeout(testCtx, time.Second)
+		defer cancel()
+		err := client.WaitForInit(ctx)
+
+		assert.Nil(t, err)
+		val, err := client.IsEnabled(testCtx, "toggle-1")
+		assert.Nil(t, err)
+		assert.True(t, val)
+	})
+}
+
+func TestClient_Poll(t *testing.T) {
+	t.Run("toggles are reloaded every poll interval", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: []*togglev1.Toggle{{Key: "toggle-1"}, {Key: "toggle-2"}}}
+		client := createCacheClient(t, srv, 10*time.Millisecond)
+		_ = client.WaitForInit(testCtx)
+
+		srv.setToggles([]*togglev1.Toggle{{Key: "toggle-1", IsEnabled: true}})
+
+		assert.Eventually(t, func() bool {
+			val, _ := client.IsEnabled(testCtx, "toggle-1")
+			return val
+		}, time.Second, 10*time.Millisecond)
+		_, err := client.IsEnabled(testCtx, "toggle-2")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("last known toggles are kept while server fails", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: []*togglev1.Toggle{{Key: "toggle-1", IsEnabled: true}}}
+		client := createCacheClient(t, srv, 10*time.Millisecond)
+		_ = client.WaitForInit(testCtx)
+
+		srv.setError(errCacheServer)
+		time.Sleep(50 * time.Millisecond)
+
+		val, err := client.IsEnabled(testCtx, "toggle-1")
+		assert.Nil(t, err)
+		assert.True(t, val)
+		assert.True(t, client.Ready())
+	})
+
+	t.Run("toggles aren't reloaded while a subscriber is attached", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: []*togglev1.Toggle{{Key: "toggle-1"}}}
+		client := createCacheClient(t, srv, 10*time.Millisecond)
+		_ = client.WaitForInit(testCtx)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx, cancel := context.WithCancel(testCtx)
+		subs := mock_toggle.NewMockSubscriber(ctrl)
+		subs.EXPECT().Subscribe(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, _ func(event *togglev1.ToggleEvent) error) error {
+			<-ctx.Done()
+			return nil
+		})
+		done := make(chan struct{})
+		go func() {
+			_ = client.Subscribe(ctx, subs, nil)
+			close(done)
+		}()
+		time.Sleep(20 * time.Millisecond)
+
+		srv.setToggles([]*togglev1.Toggle{{Key: "toggle-1", IsEnabled: true}})
+		time.Sleep(50 * time.Millisecond)
+		val, _ := client.IsEnabled(testCtx, "toggle-1")
+		assert.False(t, val)
+
+		cancel()
+		<-done
+		assert.Eventually(t, func() bool {
+			val, _ := client.IsEnabled(testCtx, "toggle-1")
+			return val
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestClient_WithDataSource(t *testing.T) {
+	t.Run("client which doesn't connect to server can't change toggles", func(t *testing.T) {
+		client := createTestDataClient(t, toggle.NewTestDataSource(testToggle))
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(client.Create(testCtx, testToggle)))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(client.Enable(testCtx, testToggleKey)))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(client.Disable(testCtx, testToggleKey)))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(client.Delete(testCtx, testToggleKey)))
+	})
+
+	t.Run("unknown toggle is not found", func(t *testing.T) {
+		client := createTestDataClient(t, toggle.NewTestDataSource())
+
+		_, err := client.Get(testCtx, testToggleKey)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		_, err = client.Evaluate(testCtx, testToggleKey, nil)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+}
+
+func TestClient_BoolVariationDetail(t *testing.T) {
+	rules := entity.RulesToProto([]*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}})
+	defaultValue := true
+	toggles := []*togglev1.Toggle{
+		{Key: "toggle-1", IsEnabled: true, Rules: rules, Version: 3},
+		{Key: "toggle-2", IsEnabled: false, DefaultValue: &defaultValue, Rules: rules, Version: 4},
+	}
+
+	t.Run("evaluated toggle returns the reason and the version", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: toggles}
+		client := createCacheClient(t, srv, time.Minute)
+
+		tables := []struct {
+			key      string
+			evalCtx  map[string]string
+			expected *toggle.EvaluationDetail
+		}{
+			{"toggle-1", map[string]string{"country": "ID"}, &toggle.EvaluationDetail{Value: true, Reason: toggle.DetailReasonRuleMatch, Version: 3}},
+			{"toggle-1", map[string]string{"country": "SG"}, &toggle.EvaluationDetail{Value: false, Reason: toggle.DetailReasonFallthrough, Version: 3}},
+			{"toggle-2", map[string]string{"country": "ID"}, &toggle.EvaluationDetail{Value: false, Reason: toggle.DetailReasonDefault, Version: 4, Disabled: true}},
+		}
+		for _, table := range tables {
+			res := client.BoolVariationDetail(testCtx, table.key, table.evalCtx, true)
+			assert.Equal(t, table.expected, res)
+		}
+	})
+
+	t.Run("unknown toggle returns fallback and not found error", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: toggles}
+		client := createCacheClient(t, srv, time.Minute)
+
+		res := client.BoolVariationDetail(testCtx, "toggle-3", nil, true)
+
+		assert.True(t, res.Value)
+		assert.Equal(t, toggle.DetailReasonError, res.Reason)
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_NOT_FOUND, res.ErrorKind)
+		assert.NotNil(t, res.Err)
+		assert.True(t, client.BoolVariation(testCtx, "toggle-3", nil, true))
+	})
+
+	t.Run("last known toggle is evaluated while server can't be reached", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: toggles}
+		client := createCacheClient(t, srv, time.Minute)
+		_ = client.WaitForInit(testCtx)
+		srv.setError(errCacheServer)
+
+		res := client.BoolVariationDetail(testCtx, "toggle-1", map[string]string{"country": "ID"}, false)
+
+		assert.Equal(t, &toggle.EvaluationDetail{Value: true, Reason: toggle.DetailReasonCached, Version: 3}, res)
+	})
+
+	t.Run("unknown toggle returns fallback while server can't be reached", func(t *testing.T) {
+		srv := &fakeQueryServer{err: entity.ErrInternal("")}
+		client := createCacheClient(t, srv, time.Minute)
+
+		res := client.BoolVariationDetail(testCtx, "toggle-1", nil, true)
+
+		assert.True(t, res.Value)
+		assert.Equal(t, toggle.DetailReasonError, res.Reason)
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INTERNAL, res.ErrorKind)
+		assert.True(t, client.BoolVariation(testCtx, "toggle-1", nil, true))
+	})
+
+	t.Run("offline client evaluates the toggle in memory", func(t *testing.T) {
+		client := createTestDataClient(t, toggle.NewTestDataSource(&entity.Toggle{Key: "toggle-1", IsEnabled: true, DefaultValue: true, Version: 2}))
+
+		res := client.BoolVariationDetail(testCtx, "toggle-1", nil, false)
+
+		assert.Equal(t, &toggle.EvaluationDetail{Value: true, Reason: toggle.DetailReasonFallthrough, Version: 2}, res)
+	})
+
+	t.Run("value decided by the rollout is told apart", func(t *testing.T) {
+		rollout := &entity.Rollout{Percentage: 100, BucketBy: "user_id"}
+		client := createTestDataClient(t, toggle.NewTestDataSource(&entity.Toggle{Key: "toggle-1", IsEnabled: true, Rollout: rollout, Version: 2}))
+
+		res := client.BoolVariationDetail(testCtx, "toggle-1", map[string]string{"user_id": "user-1"}, false)
+
+		assert.Equal(t, &toggle.EvaluationDetail{Value: true, Reason: toggle.DetailReasonFallthrough, Version: 2, Rollout: true}, res)
+	})
+}
+
+func TestErrorKind(t *testing.T) {
+	t.Run("error is classified by its toggle's error code", func(t *testing.T) {
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED, toggle.ErrorKind(nil))
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_NOT_FOUND, toggle.ErrorKind(entity.ErrNotFound()))
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_VARIANT, toggle.ErrorKind(entity.ErrInvalidVariant("")))
+		assert.Equal(t, togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INTERNAL, toggle.ErrorKind(errCacheServer))
+	})
+}
+
+var errCacheServer = status.New(codes.Unavailable, "").Err()
+
+// fakeQueryServer serves GetAllToggles from its toggles one toggle per page
+// and evaluates its toggles in EvaluateToggle.
+type fakeQueryServer struct {
+	togglev1.UnimplementedToggleQueryServiceServer
+	mutex   sync.Mutex
+	toggles []*togglev1.Toggle
+	err     error
+}
+
+func (s *fakeQueryServer) GetAllToggles(_ context.Context, req *togglev1.GetAllTogglesRequest) (*togglev1.GetAllTogglesResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	idx, _ := strconv.Atoi(req.GetPageToken())
+	if idx >= len(s.toggles) {
+		return &togglev1.GetAllTogglesResponse{}, nil
+	}
+	resp := &togglev1.GetAllTogglesResponse{Toggles: s.toggles[idx : idx+1]}
+	if idx+1 < len(s.toggles) {
+		resp.NextPageToken = strconv.Itoa(idx + 1)
+	}
+	return resp, nil
+}
+
+func (s *fakeQueryServer) EvaluateToggle(_ context.Context, req *togglev1.EvaluateToggleRequest) (*togglev1.EvaluateToggleResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	for _, tg := range s.toggles {
+		if tg.GetKey() != req.GetKey() {
+			continue
+		}
+		eval := service.Evaluate(&entity.Toggle{
+			Key:          tg.GetKey(),
+			IsEnabled:    tg.GetIsEnabled(),
+			DefaultValue: tg.GetDefaultValue(),
+			Rules:        entity.RulesFromProto(tg.GetRules()),
+			Version:      tg.GetVersion(),
+		}, req.GetContext())
+		return &togglev1.EvaluateToggleResponse{Value: eval.Value, Reason: entity.EvaluationReasonToProto(eval.Reason), Version: eval.Version}, nil
+	}
+	return nil, entity.ErrNotFound()
+}
+
+func (s *fakeQueryServer) setToggles(toggles []*togglev1.Toggle) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.toggles = toggles
+}
+
+func (s *fakeQueryServer) setError(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.err = err
+}
+
+func createCacheClient(t *testing.T, srv *fakeQueryServer, interval time.Duration) *toggle.Client {
+	listener := bufconn.Listen(buffer)
+	grpcServer := grpc.NewServer()
+	togglev1.RegisterToggleQueryServiceServer(grpcServer, srv)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	cfg := &toggle.DialConfig{
+		Options: []grpc.DialOption{
+			grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+				return listener.Dial()
+			}),
+			grpc.WithInsecure(),
+		},
+		PollInterval: interval,
+	}
+	client, err := toggle.NewClient(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		grpcServer.Stop()
+	})
+	return client
+}
+
 func createClientExecutor() *ClientExecutor {
 	listener := bufconn.Listen(buffer)
 
@@ -191,8 +785,13 @@ func createClientExecutor() *ClientExecutor {
 		grpcServer.Stop()
 		panic(err)
 	}
+	if err := client.WaitForInit(testCtx); err != nil {
+		grpcServer.Stop()
+		panic(err)
+	}
 
 	closer := func() {
+		_ = client.Close()
 		_ = listener.Close()
 		grpcServer.Stop()
 	}
@@ -202,3 +801,7 @@ func createClientExecutor() *ClientExecutor {
 		closer: closer,
 	}
 }
+
+func createVariantContext(variant string) context.Context {
+	return metadata.NewOutgoingContext(testCtx, metadata.Pairs("variant", variant))
+}
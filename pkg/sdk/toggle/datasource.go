@@ -0,0 +1,141 @@
+package toggle
+
+import (
+	"context"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// pollPageSize is the number of toggles read at once when server data source reloads all toggles.
+	pollPageSize = 100
+)
+
+// DataStore defines the client's in-memory store which is kept up to date by a data source.
+type DataStore interface {
+	// Init replaces all toggles with the given toggles and marks the store as ready.
+	Init(toggles []*entity.Toggle)
+	// Upsert saves the toggle.
+	// The saved toggle must not be modified afterwards.
+	Upsert(toggle *entity.Toggle)
+	// Delete deletes the toggle by its key.
+	Delete(key string)
+}
+
+// DataSource defines the source of the toggles the client keeps in memory.
+type DataSource interface {
+	// Start loads all toggles in the project's environment into the store
+	// and keeps the store up to date until the context is done.
+	// It must not block, the long-running work must be done in the background.
+	Start(ctx context.Context, project, env string, store DataStore)
+}
+
+// Option configures the Client.
+type Option func(*clientOptions)
+
+type clientOptions struct {
+	dataSource DataSource
+}
+
+// WithDataSource sets the source of the toggles the client keeps in memory.
+// The client doesn't connect to server, hence it can be used in unit tests or local development.
+// If it isn't set, the toggles are read from server.
+func WithDataSource(source DataSource) Option {
+	return func(opts *clientOptions) {
+		opts.dataSource = source
+	}
+}
+
+// serverDataSource reads the toggles from server.
+// It reloads all toggles every poll interval, unless it is paused.
+// If the reload fails, the store is left as it is.
+type serverDataSource struct {
+	query    togglev1.ToggleQueryServiceClient
+	breaker  CircuitBreaker
+	interval time.Duration
+	paused   func() bool
+}
+
+func newServerDataSource(query togglev1.ToggleQueryServiceClient, breaker CircuitBreaker, interval time.Duration, paused func() bool) *serverDataSource {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &serverDataSource{
+		query:    query,
+		breaker:  breaker,
+		interval: interval,
+		paused:   paused,
+	}
+}
+
+// Start loads all toggles right away and then reloads them every poll interval until the context is done.
+// The reload is skipped while it is paused, unless the toggles have never been loaded.
+func (s *serverDataSource) Start(ctx context.Context, project, env string, store DataStore) {
+	go func() {
+		loaded := s.refresh(ctx, project, env, store) == nil
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if loaded && s.paused() {
+					continue
+				}
+				if s.refresh(ctx, project, env, store) == nil {
+					loaded = true
+				}
+			}
+		}
+	}()
+}
+
+// refresh replaces the toggles in store with all toggles in server.
+func (s *serverDataSource) refresh(ctx context.Context, project, env string, store DataStore) error {
+	ctx, cancel := context.WithTimeout(ctx, s.interval)
+	defer cancel()
+
+	toggles, err := s.getAll(ctx, project, env)
+	if err != nil {
+		return err
+	}
+	store.Init(toggles)
+	return nil
+}
+
+// getAll gets all toggles in the project's environment page by page.
+func (s *serverDataSource) getAll(ctx context.Context, project, env string) ([]*entity.Toggle, error) {
+	req := &togglev1.GetAllTogglesRequest{Project: project, Environment: env, PageSize: pollPageSize}
+
+	res := []*entity.Toggle{}
+	for {
+		var clientErr error
+		tmp, err := s.breaker.Execute(func() (interface{}, error) {
+			x, err := s.query.GetAllToggles(ctx, req)
+			if isServerError(err) {
+				return nil, err
+			}
+			clientErr = err
+			return x, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if clientErr != nil {
+			return nil, clientErr
+		}
+
+		resp := tmp.(*togglev1.GetAllTogglesResponse)
+		for _, toggle := range resp.GetToggles() {
+			res = append(res, createToggleFromProto(toggle))
+		}
+		if resp.GetNextPageToken() == "" {
+			return res, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+}
@@ -0,0 +1,133 @@
+package toggle
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// DefaultReloadInterval is the interval used when the reload interval of FileDataSource is zero.
+	DefaultReloadInterval = time.Second
+)
+
+// FileDataSource reads the toggles from a JSON or YAML file.
+// The file is read as YAML if its extension is .yaml or .yml, otherwise it is read as JSON.
+// It contains a toggles field which holds the list of toggles in the same format as the REST API, for example:
+//
+//	toggles:
+//	  - key: toggle-1
+//	    isEnabled: true
+//	  - key: toggle-2
+//	    project: checkout
+//	    environment: staging
+//
+// Toggles without project or environment belong to every project or environment.
+//
+// The file is checked every reload interval and it is read again once it changes.
+// If the file can't be read, the last known toggles are kept.
+type FileDataSource struct {
+	path     string
+	interval time.Duration
+}
+
+// NewFileDataSource creates an instance of FileDataSource.
+// If interval is zero, DefaultReloadInterval is used.
+func NewFileDataSource(path string, interval time.Duration) *FileDataSource {
+	if interval <= 0 {
+		interval = DefaultReloadInterval
+	}
+	return &FileDataSource{
+		path:     path,
+		interval: interval,
+	}
+}
+
+// Start reads the file right away and then reads it again every time it changes until the context is done.
+func (f *FileDataSource) Start(ctx context.Context, project, env string, store DataStore) {
+	last, _ := f.load(project, env, store)
+
+	go func() {
+		ticker := time.NewTicker(f.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				info, err := os.Stat(f.path)
+				if err != nil || (last != nil && info.ModTime().Equal(last.ModTime()) && info.Size() == last.Size()) {
+					continue
+				}
+				if tmp, err := f.load(project, env, store); err == nil {
+					last = tmp
+				}
+			}
+		}
+	}()
+}
+
+// load reads the file and replaces the toggles in store with the toggles of the project's environment.
+// It returns the file's info at the time it was read.
+func (f *FileDataSource) load(project, env string, store DataStore) (os.FileInfo, error) {
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, err
+	}
+	toggles, err := parseToggleFile(f.path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*entity.Toggle{}
+	for _, toggle := range toggles {
+		if isToggleInScope(toggle, project, env) {
+			res = append(res, createToggleFromProto(toggle))
+		}
+	}
+	store.Init(res)
+	return info, nil
+}
+
+func parseToggleFile(path string, data []byte) ([]*togglev1.Toggle, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var tmp interface{}
+		if err := yaml.Unmarshal(data, &tmp); err != nil {
+			return nil, err
+		}
+		if tmp == nil {
+			return nil, nil
+		}
+		var err error
+		if data, err = json.Marshal(tmp); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshot := &togglev1.ToggleSnapshot{}
+	if err := protojson.Unmarshal(data, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot.GetToggles(), nil
+}
+
+func isToggleInScope(toggle *togglev1.Toggle, project, env string) bool {
+	if toggle.GetProject() != "" && toggle.GetProject() != project {
+		return false
+	}
+	return toggle.GetEnvironment() == "" || toggle.GetEnvironment() == env
+}
@@ -0,0 +1,130 @@
+package toggle_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/pkg/sdk/toggle"
+)
+
+const (
+	testToggleFileYAML = `
+toggles:
+  - key: toggle-1
+    isEnabled: true
+  - key: toggle-2
+    is_enabled: true
+    environment: staging
+  - key: toggle-3
+    isEnabled: true
+    project: checkout
+  - key: toggle-4
+    isEnabled: true
+    project: default
+    environment: production
+    variants:
+      - name: blue
+        type: VARIANT_TYPE_STRING
+        value: blue
+    defaultValue: true
+    defaultVariant: blue
+`
+	testToggleFileJSON = `{"toggles": [{"key": "toggle-1", "isEnabled": true}]}`
+)
+
+func TestNewFileDataSource(t *testing.T) {
+	t.Run("successfully create an instance of FileDataSource", func(t *testing.T) {
+		source := toggle.NewFileDataSource("toggles.yaml", 0)
+		assert.NotNil(t, source)
+	})
+}
+
+func TestFileDataSource_Start(t *testing.T) {
+	t.Run("file doesn't exist", func(t *testing.T) {
+		client := createFileClient(t, filepath.Join(t.TempDir(), "toggles.yaml"))
+
+		assert.False(t, client.Ready())
+	})
+
+	t.Run("toggles of the client's project and environment are read from YAML file", func(t *testing.T) {
+		path := writeToggleFile(t, "toggles.yaml", testToggleFileYAML)
+		client := createFileClient(t, path)
+
+		assert.True(t, client.Ready())
+		assertEnabled(t, client, "toggle-1", true)
+		assertEnabled(t, client, "toggle-4", true)
+		for _, key := range []string{"toggle-2", "toggle-3"} {
+			_, err := client.IsEnabled(testCtx, key)
+			assert.NotNil(t, err)
+		}
+
+		val, err := client.StringVariation(testCtx, "toggle-4", nil, "red")
+		assert.Nil(t, err)
+		assert.Equal(t, "blue", val)
+	})
+
+	t.Run("toggles are read from JSON file", func(t *testing.T) {
+		path := writeToggleFile(t, "toggles.json", testToggleFileJSON)
+		client := createFileClient(t, path)
+
+		assert.True(t, client.Ready())
+		assertEnabled(t, client, "toggle-1", true)
+	})
+
+	t.Run("invalid file keeps the client not ready", func(t *testing.T) {
+		path := writeToggleFile(t, "toggles.json", "toggles")
+		client := createFileClient(t, path)
+
+		assert.False(t, client.Ready())
+	})
+
+	t.Run("changed file is read again", func(t *testing.T) {
+		path := writeToggleFile(t, "toggles.json", testToggleFileJSON)
+		client := createFileClient(t, path)
+
+		_ = os.WriteFile(path, []byte(`{"toggles": [{"key": "toggle-1", "isEnabled": false}, {"key": "toggle-2", "isEnabled": true}]}`), 0o600)
+
+		assert.Eventually(t, func() bool {
+			val, _ := client.IsEnabled(testCtx, "toggle-2")
+			return val
+		}, time.Second, 10*time.Millisecond)
+		assertEnabled(t, client, "toggle-1", false)
+	})
+
+	t.Run("last known toggles are kept if the changed file is invalid", func(t *testing.T) {
+		path := writeToggleFile(t, "toggles.yaml", testToggleFileYAML)
+		client := createFileClient(t, path)
+
+		_ = os.WriteFile(path, []byte("toggles: [invalid"), 0o600)
+		time.Sleep(50 * time.Millisecond)
+
+		assertEnabled(t, client, "toggle-1", true)
+	})
+}
+
+func createFileClient(t *testing.T, path string) *toggle.Client {
+	client, err := toggle.NewClient(nil, nil, toggle.WithDataSource(toggle.NewFileDataSource(path, 10*time.Millisecond)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func writeToggleFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func assertEnabled(t *testing.T, client *toggle.Client, key string, expected bool) {
+	val, err := client.IsEnabled(testCtx, key)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, val)
+}
@@ -0,0 +1,104 @@
+package toggle
+
+import (
+	"context"
+	"sync"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// TestDataSource keeps the toggles in memory and lets the tests change them.
+// The changes are applied right away to every client which uses it.
+// It ignores the project and environment of the client, hence all its toggles are served to every client.
+type TestDataSource struct {
+	mutex   sync.Mutex
+	toggles map[string]*entity.Toggle
+	stores  map[DataStore]struct{}
+}
+
+// NewTestDataSource creates an instance of TestDataSource which initially has the given toggles.
+func NewTestDataSource(toggles ...*entity.Toggle) *TestDataSource {
+	td := &TestDataSource{
+		toggles: make(map[string]*entity.Toggle),
+		stores:  make(map[DataStore]struct{}),
+	}
+	for _, toggle := range toggles {
+		tmp := *toggle
+		td.toggles[toggle.Key] = &tmp
+	}
+	return td
+}
+
+// Start fills the store with the toggles right away and applies the changes to the store until the context is done.
+func (td *TestDataSource) Start(ctx context.Context, _, _ string, store DataStore) {
+	td.mutex.Lock()
+	defer td.mutex.Unlock()
+
+	toggles := make([]*entity.Toggle, 0, len(td.toggles))
+	for _, toggle := range td.toggles {
+		toggles = append(toggles, toggle)
+	}
+	store.Init(toggles)
+	td.stores[store] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		td.mutex.Lock()
+		defer td.mutex.Unlock()
+		delete(td.stores, store)
+	}()
+}
+
+// Set saves the toggle, replacing the toggle with the same key.
+func (td *TestDataSource) Set(toggle *entity.Toggle) {
+	td.mutex.Lock()
+	defer td.mutex.Unlock()
+
+	tmp := *toggle
+	td.upsert(&tmp)
+}
+
+// Enable enables the toggle.
+// If the toggle doesn't exist, a toggle which only has key and is_enabled status is created.
+func (td *TestDataSource) Enable(key string) {
+	td.setEnabled(key, true)
+}
+
+// Disable disables the toggle.
+// If the toggle doesn't exist, a toggle which only has key and is_enabled status is created.
+func (td *TestDataSource) Disable(key string) {
+	td.setEnabled(key, false)
+}
+
+// Delete deletes the toggle.
+func (td *TestDataSource) Delete(key string) {
+	td.mutex.Lock()
+	defer td.mutex.Unlock()
+
+	delete(td.toggles, key)
+	for store := range td.stores {
+		store.Delete(key)
+	}
+}
+
+func (td *TestDataSource) setEnabled(key string, enabled bool) {
+	td.mutex.Lock()
+	defer td.mutex.Unlock()
+
+	toggle := &entity.Toggle{Key: key}
+	if old, ok := td.toggles[key]; ok {
+		tmp := *old
+		toggle = &tmp
+	}
+	toggle.IsEnabled = enabled
+	td.upsert(toggle)
+}
+
+// upsert saves the toggle and applies it to every store.
+// It must be called while holding the mutex.
+func (td *TestDataSource) upsert(toggle *entity.Toggle) {
+	td.toggles[toggle.Key] = toggle
+	for store := range td.stores {
+		store.Upsert(toggle)
+	}
+}
@@ -0,0 +1,91 @@
+package toggle_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/pkg/sdk/toggle"
+)
+
+func TestNewTestDataSource(t *testing.T) {
+	t.Run("successfully create an instance of TestDataSource", func(t *testing.T) {
+		source := toggle.NewTestDataSource()
+		assert.NotNil(t, source)
+	})
+}
+
+func TestTestDataSource(t *testing.T) {
+	t.Run("initial toggles are served right away", func(t *testing.T) {
+		source := toggle.NewTestDataSource(&entity.Toggle{Key: "toggle-1", IsEnabled: true})
+		client := createTestDataClient(t, source)
+
+		assert.True(t, client.Ready())
+		assertEnabled(t, client, "toggle-1", true)
+	})
+
+	t.Run("changes are applied to the client right away", func(t *testing.T) {
+		source := toggle.NewTestDataSource(&entity.Toggle{Key: "toggle-1", Description: "description-1"})
+		client := createTestDataClient(t, source)
+
+		source.Enable("toggle-1")
+		assertEnabled(t, client, "toggle-1", true)
+		res, err := client.Get(testCtx, "toggle-1")
+		assert.Nil(t, err)
+		assert.Equal(t, "description-1", res.Description)
+
+		source.Disable("toggle-1")
+		assertEnabled(t, client, "toggle-1", false)
+
+		source.Enable("toggle-2")
+		assertEnabled(t, client, "toggle-2", true)
+
+		source.Set(&entity.Toggle{Key: "toggle-3", IsEnabled: true})
+		assertEnabled(t, client, "toggle-3", true)
+
+		source.Delete("toggle-3")
+		_, err = client.IsEnabled(testCtx, "toggle-3")
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("toggle is evaluated locally", func(t *testing.T) {
+		source := toggle.NewTestDataSource(&entity.Toggle{
+			Key:          "toggle-1",
+			IsEnabled:    true,
+			DefaultValue: true,
+			Rules:        []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"id"}, Value: false}},
+		})
+		client := createTestDataClient(t, source)
+
+		res, err := client.Evaluate(testCtx, "toggle-1", map[string]string{"country": "id"})
+
+		assert.Nil(t, err)
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonRuleMatch, res.Reason)
+	})
+
+	t.Run("closed client doesn't receive the changes", func(t *testing.T) {
+		source := toggle.NewTestDataSource()
+		client := createTestDataClient(t, source)
+		_ = client.Close()
+
+		source.Enable("toggle-1")
+
+		assert.Eventually(t, func() bool {
+			source.Enable("toggle-2")
+			_, err := client.IsEnabled(testCtx, "toggle-2")
+			return err != nil
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func createTestDataClient(t *testing.T, source *toggle.TestDataSource) *toggle.Client {
+	client, err := toggle.NewClient(nil, nil, toggle.WithDataSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
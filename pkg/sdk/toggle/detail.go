@@ -0,0 +1,97 @@
+package toggle
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// DetailReason defines why BoolVariationDetail resolves a certain value.
+type DetailReason string
+
+const (
+	// DetailReasonRuleMatch means the toggle is evaluated and one of its rules matches the evaluation context.
+	DetailReasonRuleMatch DetailReason = "RULE_MATCH"
+	// DetailReasonFallthrough means the toggle is evaluated and none of its rules matches,
+	// hence its default value or its percentage rollout is served.
+	DetailReasonFallthrough DetailReason = "FALLTHROUGH"
+	// DetailReasonDefault means the toggle is evaluated to false without looking at its rules
+	// since it is disabled or one of its prerequisites isn't satisfied.
+	DetailReasonDefault DetailReason = "DEFAULT"
+	// DetailReasonCached means server can't be reached, hence the last known toggle in memory is evaluated.
+	DetailReasonCached DetailReason = "CACHED"
+	// DetailReasonError means the toggle can't be evaluated, hence the given default value is served.
+	DetailReasonError DetailReason = "ERROR"
+)
+
+// EvaluationDetail defines the result of BoolVariationDetail.
+type EvaluationDetail struct {
+	// Value defines the resolved value.
+	Value bool
+	// Reason defines why the value is resolved.
+	Reason DetailReason
+	// ErrorKind defines the kind of error which makes the toggle can't be evaluated.
+	// It is TOGGLE_ERROR_CODE_UNSPECIFIED unless the reason is ERROR.
+	ErrorKind togglev1.ToggleErrorCode
+	// Err defines the error which makes the toggle can't be evaluated.
+	// It is nil unless the reason is ERROR.
+	Err error
+	// Version defines the version of the evaluated toggle.
+	// It is zero if the reason is ERROR.
+	Version int64
+	// Rollout tells whether the value is decided by the toggle's percentage rollout.
+	// It can only be true if the reason is FALLTHROUGH or CACHED.
+	Rollout bool
+	// Disabled tells whether the value is served since the toggle itself is disabled,
+	// rather than since one of its prerequisites isn't satisfied.
+	// It can only be true if the reason is DEFAULT.
+	Disabled bool
+}
+
+// ErrorKind returns the toggle's error code carried in the error's details.
+// It returns TOGGLE_ERROR_CODE_UNSPECIFIED if err is nil
+// and TOGGLE_ERROR_CODE_INTERNAL if err doesn't carry any toggle's error code,
+// such as when server can't be reached.
+func ErrorKind(err error) togglev1.ToggleErrorCode {
+	if err == nil {
+		return togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED
+	}
+	st, _ := status.FromError(err)
+	for _, detail := range st.Details() {
+		if te, ok := detail.(*togglev1.ToggleError); ok {
+			return te.GetErrorCode()
+		}
+	}
+	return togglev1.ToggleErrorCode_TOGGLE_ERROR_CODE_INTERNAL
+}
+
+func createEvaluationDetail(eval *entity.Evaluation) *EvaluationDetail {
+	res := &EvaluationDetail{Value: eval.Value, Reason: DetailReasonFallthrough, Version: eval.Version, Rollout: eval.Reason == entity.EvaluationReasonRollout}
+	switch eval.Reason {
+	case entity.EvaluationReasonRuleMatch:
+		res.Reason = DetailReasonRuleMatch
+	case entity.EvaluationReasonDisabled:
+		res.Reason = DetailReasonDefault
+		res.Disabled = true
+	case entity.EvaluationReasonPrerequisiteFailed:
+		res.Reason = DetailReasonDefault
+	}
+	return res
+}
+
+func createErrorDetail(err error, fallback bool) *EvaluationDetail {
+	return &EvaluationDetail{Value: fallback, Reason: DetailReasonError, ErrorKind: ErrorKind(err), Err: err}
+}
+
+// isDefiniteError tells whether the error is the server's answer about the toggle,
+// rather than a failure to reach server.
+func isDefiniteError(err error) bool {
+	switch status.Code(err) {
+	case codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition:
+		return true
+	default:
+		return false
+	}
+}
@@ -19,19 +19,30 @@ func ExampleNewClient() {
 	// start of non-circuit breaker client
 	ctx := context.Background()
 	dialConfig := &toggle.DialConfig{
-		Host:    "localhost:8080",
-		Options: []grpc.DialOption{grpc.WithInsecure()},
+		Host:         "localhost:8080",
+		Options:      []grpc.DialOption{grpc.WithInsecure()},
+		Project:      "default",
+		Environment:  "production",
+		PollInterval: 30 * time.Second,
 	}
 	client, err := toggle.NewClient(dialConfig, nil)
 	if err != nil {
 		log.Printf("err init client: %v\n", err)
 		return
 	}
+	defer func() { _ = client.Close() }()
+
+	// wait until all toggles are loaded in memory
+	initCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := client.WaitForInit(initCtx); err != nil {
+		log.Printf("toggles aren't loaded yet: %v\n", err)
+	}
 
 	redisConfig := &config.Redis{
 		Address: "localhost:6379",
 	}
-	subscriber := messaging.NewRedisSubscriber(redisConfig)
+	subscriber := messaging.NewRedisSubscriber(redisConfig, "default")
 	go func() {
 		_ = client.Subscribe(ctx, subscriber, []string{"toggle-test-1", "toggle-test-2", "toggle-test-3"})
 	}()
@@ -70,5 +81,23 @@ func ExampleNewClient() {
 		log.Printf("err init client: %v\n", err)
 		return
 	}
+	defer func() { _ = client.Close() }()
 	// end of circuit-breaker client
 }
+
+func ExampleTestDataSource() {
+	source := toggle.NewTestDataSource(&entity.Toggle{Key: "toggle-1", IsEnabled: true})
+	client, err := toggle.NewClient(nil, nil, toggle.WithDataSource(source))
+	if err != nil {
+		log.Printf("err init client: %v\n", err)
+		return
+	}
+	defer func() { _ = client.Close() }()
+
+	before, _ := client.IsEnabled(context.Background(), "toggle-1")
+	source.Disable("toggle-1")
+	after, _ := client.IsEnabled(context.Background(), "toggle-1")
+
+	fmt.Println(before, after)
+	// Output: true false
+}
@@ -0,0 +1,181 @@
+package toggle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// DefaultFlushInterval is the interval used when DialConfig.FlushInterval is zero.
+	DefaultFlushInterval = time.Minute
+	// maxReportedCounts is the maximum number of counts sent to server at once.
+	maxReportedCounts = 1000
+	// maxPendingCounts is the maximum number of counts kept in memory.
+	// The evaluations which don't fit are dropped until the counts are flushed.
+	maxPendingCounts = 10000
+	// closeFlushTimeout is the maximum time Close waits for the last counts to be flushed.
+	closeFlushTimeout = 5 * time.Second
+)
+
+// countID identifies the evaluations which are counted together.
+type countID struct {
+	key     string
+	variant string
+	window  time.Time
+}
+
+// recorder counts the evaluations per toggle, served variant, and time window,
+// and reports the counts to server every flush interval.
+// If the report fails temporarily, the counts are kept and reported again in the next flush.
+// The counts which server rejects for good are dropped, so that they don't take the place of the valid counts.
+type recorder struct {
+	mutex    sync.Mutex
+	counts   map[countID]*entity.EvaluationCount
+	command  togglev1.ToggleCommandServiceClient
+	breaker  CircuitBreaker
+	project  string
+	env      string
+	interval time.Duration
+}
+
+func newRecorder(command togglev1.ToggleCommandServiceClient, breaker CircuitBreaker, project, env string, interval time.Duration) *recorder {
+	if interval <= 0 {
+		interval = DefaultFlushInterval
+	}
+	return &recorder{
+		counts:   make(map[countID]*entity.EvaluationCount),
+		command:  command,
+		breaker:  breaker,
+		project:  project,
+		env:      env,
+		interval: interval,
+	}
+}
+
+// start reports the counts every flush interval until the context is done.
+func (r *recorder) start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = r.flush(ctx)
+			}
+		}
+	}()
+}
+
+// record counts the evaluation.
+func (r *recorder) record(eval *entity.Evaluation) {
+	now := time.Now().UTC()
+	r.add(&entity.EvaluationCount{
+		Key:             eval.Key,
+		Variant:         entity.EvaluationVariant(eval),
+		WindowStart:     entity.EvaluationWindowStart(now),
+		Count:           1,
+		LastEvaluatedAt: now,
+	})
+}
+
+// add merges the count into the counts of the same toggle, variant, and time window.
+func (r *recorder) add(count *entity.EvaluationCount) {
+	id := countID{key: count.Key, variant: count.Variant, window: count.WindowStart}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if prev, ok := r.counts[id]; ok {
+		prev.Count += count.Count
+		if count.LastEvaluatedAt.After(prev.LastEvaluatedAt) {
+			prev.LastEvaluatedAt = count.LastEvaluatedAt
+		}
+		return
+	}
+	if len(r.counts) >= maxPendingCounts {
+		return
+	}
+	tmp := *count
+	r.counts[id] = &tmp
+}
+
+// flush reports all counts to server in batches.
+// The counts which can't be reported due to a temporary failure are kept for the next flush,
+// while the batch which server rejects for good is dropped and the next batches are still reported.
+// It returns the first error.
+func (r *recorder) flush(ctx context.Context) error {
+	r.mutex.Lock()
+	counts := make([]*entity.EvaluationCount, 0, len(r.counts))
+	for _, count := range r.counts {
+		counts = append(counts, count)
+	}
+	r.counts = make(map[countID]*entity.EvaluationCount)
+	r.mutex.Unlock()
+
+	var res error
+	for start := 0; start < len(counts); start += maxReportedCounts {
+		end := start + maxReportedCounts
+		if end > len(counts) {
+			end = len(counts)
+		}
+		err := r.report(ctx, counts[start:end])
+		if err == nil {
+			continue
+		}
+		if isRetryableReportError(err) {
+			for _, count := range counts[start:] {
+				r.add(count)
+			}
+			return err
+		}
+		if res == nil {
+			res = err
+		}
+	}
+	return res
+}
+
+// report sends the counts to server.
+// Server's rejection of the report is returned as well, but it doesn't trip the circuit breaker.
+func (r *recorder) report(ctx context.Context, counts []*entity.EvaluationCount) error {
+	req := &togglev1.ReportEvaluationsRequest{Project: r.project, Environment: r.env, Counts: entity.EvaluationCountsToProto(counts)}
+
+	var clientErr error
+	_, err := r.breaker.Execute(func() (interface{}, error) {
+		_, err := r.command.ReportEvaluations(ctx, req)
+		if isServerError(err) {
+			return nil, err
+		}
+		clientErr = err
+		return nil, nil
+	})
+	if err != nil {
+		return err
+	}
+	return clientErr
+}
+
+// isRetryableReportError tells whether the report may succeed if it is sent again.
+// Besides server's unavailability and timeout, an error which isn't server's status,
+// such as a transport failure or the open circuit breaker, is retryable.
+// The report canceled by the client itself is retryable as well, since server hasn't judged it.
+func isRetryableReportError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return true
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,203 @@
+package toggle_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/pkg/sdk/toggle"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+func TestClient_ReportEvaluations(t *testing.T) {
+	toggles := []*togglev1.Toggle{{Key: "toggle-1", IsEnabled: true, DefaultValue: boolPointer(true)}, {Key: "toggle-2"}}
+
+	t.Run("evaluations are counted per key and variant and reported every flush interval", func(t *testing.T) {
+		cmd := &fakeCommandServer{}
+		client := createReportingClient(t, &fakeQueryServer{toggles: toggles}, cmd, &toggle.DialConfig{FlushInterval: 10 * time.Millisecond})
+		_ = client.WaitForInit(testCtx)
+
+		for i := 0; i < 3; i++ {
+			client.BoolVariation(testCtx, "toggle-1", nil, false)
+		}
+		client.BoolVariation(testCtx, "toggle-2", nil, true)
+		client.BoolVariation(testCtx, "unknown", nil, true)
+
+		assert.Eventually(t, func() bool {
+			return cmd.total("toggle-1", "true") == 3 && cmd.total("toggle-2", "false") == 1
+		}, time.Second, 10*time.Millisecond)
+		assert.Equal(t, int64(0), cmd.total("unknown", "true"))
+		for _, req := range cmd.requests() {
+			assert.Equal(t, toggle.DefaultProject, req.GetProject())
+			assert.Equal(t, toggle.DefaultEnvironment, req.GetEnvironment())
+		}
+	})
+
+	t.Run("cached evaluations are counted once", func(t *testing.T) {
+		srv := &fakeQueryServer{toggles: toggles}
+		cmd := &fakeCommandServer{}
+		client := createReportingClient(t, srv, cmd, &toggle.DialConfig{FlushInterval: time.Hour})
+		_ = client.WaitForInit(testCtx)
+		srv.setError(errCacheServer)
+
+		detail := client.BoolVariationDetail(testCtx, "toggle-1", nil, false)
+		_ = client.Close()
+
+		assert.Equal(t, toggle.DetailReasonCached, detail.Reason)
+		assert.Equal(t, int64(1), cmd.total("toggle-1", "true"))
+	})
+
+	t.Run("remaining counts are reported when the client is closed", func(t *testing.T) {
+		cmd := &fakeCommandServer{}
+		client := createReportingClient(t, &fakeQueryServer{toggles: toggles}, cmd, &toggle.DialConfig{FlushInterval: time.Hour})
+		_ = client.WaitForInit(testCtx)
+
+		client.BoolVariation(testCtx, "toggle-1", nil, false)
+		assert.Empty(t, cmd.requests())
+
+		_ = client.Close()
+
+		assert.Equal(t, int64(1), cmd.total("toggle-1", "true"))
+	})
+
+	t.Run("counts are reported again once server recovers", func(t *testing.T) {
+		cmd := &fakeCommandServer{err: errCacheServer}
+		client := createReportingClient(t, &fakeQueryServer{toggles: toggles}, cmd, &toggle.DialConfig{FlushInterval: 10 * time.Millisecond})
+		_ = client.WaitForInit(testCtx)
+
+		client.BoolVariation(testCtx, "toggle-1", nil, false)
+		client.BoolVariation(testCtx, "toggle-1", nil, false)
+		time.Sleep(30 * time.Millisecond)
+		cmd.setError(nil)
+
+		assert.Eventually(t, func() bool {
+			return cmd.total("toggle-1", "true") == 2
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("counts are reported again once server's deadline isn't exceeded", func(t *testing.T) {
+		cmd := &fakeCommandServer{err: status.New(codes.DeadlineExceeded, "").Err()}
+		client := createReportingClient(t, &fakeQueryServer{toggles: toggles}, cmd, &toggle.DialConfig{FlushInterval: 10 * time.Millisecond})
+		_ = client.WaitForInit(testCtx)
+
+		client.BoolVariation(testCtx, "toggle-1", nil, false)
+		time.Sleep(30 * time.Millisecond)
+		cmd.setError(nil)
+
+		assert.Eventually(t, func() bool {
+			return cmd.total("toggle-1", "true") == 1
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("counts rejected by server are dropped", func(t *testing.T) {
+		for _, err := range []error{entity.ErrInvalidEvaluationReport(""), entity.ErrNotFound()} {
+			cmd := &fakeCommandServer{err: err}
+			client := createReportingClient(t, &fakeQueryServer{toggles: toggles}, cmd, &toggle.DialConfig{FlushInterval: 10 * time.Millisecond})
+			_ = client.WaitForInit(testCtx)
+
+			client.BoolVariation(testCtx, "toggle-1", nil, false)
+			time.Sleep(30 * time.Millisecond)
+			cmd.setError(nil)
+			client.BoolVariation(testCtx, "toggle-2", nil, true)
+			_ = client.Close()
+
+			assert.Equal(t, int64(0), cmd.total("toggle-1", "true"))
+			assert.Equal(t, int64(1), cmd.total("toggle-2", "false"))
+		}
+	})
+
+	t.Run("evaluations aren't reported if it is disabled", func(t *testing.T) {
+		cmd := &fakeCommandServer{}
+		client := createReportingClient(t, &fakeQueryServer{toggles: toggles}, cmd, &toggle.DialConfig{FlushInterval: 10 * time.Millisecond, DisableEvaluationReport: true})
+		_ = client.WaitForInit(testCtx)
+
+		client.BoolVariation(testCtx, "toggle-1", nil, false)
+		time.Sleep(30 * time.Millisecond)
+		_ = client.Close()
+
+		assert.Empty(t, cmd.requests())
+	})
+}
+
+// fakeCommandServer keeps the successful ReportEvaluations requests.
+type fakeCommandServer struct {
+	togglev1.UnimplementedToggleCommandServiceServer
+	mutex    sync.Mutex
+	reported []*togglev1.ReportEvaluationsRequest
+	err      error
+}
+
+func (s *fakeCommandServer) ReportEvaluations(_ context.Context, req *togglev1.ReportEvaluationsRequest) (*togglev1.ReportEvaluationsResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.reported = append(s.reported, req)
+	return &togglev1.ReportEvaluationsResponse{}, nil
+}
+
+func (s *fakeCommandServer) setError(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.err = err
+}
+
+func (s *fakeCommandServer) requests() []*togglev1.ReportEvaluationsRequest {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]*togglev1.ReportEvaluationsRequest{}, s.reported...)
+}
+
+// total sums the reported counts of the toggle's variant.
+func (s *fakeCommandServer) total(key, variant string) int64 {
+	var res int64
+	for _, req := range s.requests() {
+		for _, count := range req.GetCounts() {
+			if count.GetKey() == key && count.GetVariant() == variant {
+				res += count.GetCount()
+			}
+		}
+	}
+	return res
+}
+
+func createReportingClient(t *testing.T, query *fakeQueryServer, command *fakeCommandServer, cfg *toggle.DialConfig) *toggle.Client {
+	listener := bufconn.Listen(buffer)
+	grpcServer := grpc.NewServer()
+	togglev1.RegisterToggleQueryServiceServer(grpcServer, query)
+	togglev1.RegisterToggleCommandServiceServer(grpcServer, command)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+
+	cfg.Options = []grpc.DialOption{
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	}
+	client, err := toggle.NewClient(cfg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		grpcServer.Stop()
+	})
+	return client
+}
+
+func boolPointer(val bool) *bool {
+	return &val
+}
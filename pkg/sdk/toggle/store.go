@@ -0,0 +1,97 @@
+package toggle
+
+import (
+	"context"
+	"sync"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// store keeps the toggles of a client's project and environment in memory.
+// It is safe to be used concurrently.
+type store struct {
+	mutex     sync.RWMutex
+	toggles   map[string]*entity.Toggle
+	ready     chan struct{}
+	readyOnce sync.Once
+}
+
+func newStore() *store {
+	return &store{
+		toggles: make(map[string]*entity.Toggle),
+		ready:   make(chan struct{}),
+	}
+}
+
+// get gets the toggle by its key.
+func (s *store) get(key string) (*entity.Toggle, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	toggle, ok := s.toggles[key]
+	return toggle, ok
+}
+
+// Upsert saves the toggle.
+// The saved toggle must not be modified afterwards.
+func (s *store) Upsert(toggle *entity.Toggle) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.toggles[toggle.Key] = toggle
+}
+
+// setEnabled sets the saved toggle's is_enabled status and version without changing the rest of its attributes.
+// Nothing is saved if the toggle isn't saved yet, since the rest of its attributes are unknown.
+func (s *store) setEnabled(key string, enabled bool, version int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	old, ok := s.toggles[key]
+	if !ok {
+		return
+	}
+	toggle := *old
+	toggle.IsEnabled = enabled
+	toggle.Version = version
+	s.toggles[key] = &toggle
+}
+
+// Delete deletes the toggle by its key.
+func (s *store) Delete(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.toggles, key)
+}
+
+// Init replaces all saved toggles with the given toggles and marks the store as ready.
+func (s *store) Init(toggles []*entity.Toggle) {
+	tmp := make(map[string]*entity.Toggle, len(toggles))
+	for _, toggle := range toggles {
+		tmp[toggle.Key] = toggle
+	}
+
+	s.mutex.Lock()
+	s.toggles = tmp
+	s.mutex.Unlock()
+
+	s.readyOnce.Do(func() { close(s.ready) })
+}
+
+// isReady tells whether the store has been filled with all toggles at least once.
+func (s *store) isReady() bool {
+	select {
+	case <-s.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait blocks until the store is ready or the context is done.
+func (s *store) wait(ctx context.Context) error {
+	select {
+	case <-s.ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-grpc-gateway. DO NOT EDIT.
+// source: proto/indrasaputra/toggle/v1/environment.proto
+
+/*
+Package togglev1 is a reverse proxy.
+
+It translates gRPC into RESTful JSON APIs.
+*/
+package togglev1
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Suppress "imported and not used" errors
+var _ codes.Code
+var _ io.Reader
+var _ status.Status
+var _ = runtime.String
+var _ = utilities.NewDoubleArray
+var _ = metadata.Join
+
+func request_EnvironmentCommandService_CreateEnvironment_0(ctx context.Context, marshaler runtime.Marshaler, client EnvironmentCommandServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq CreateEnvironmentRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq.Environment); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.CreateEnvironment(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_EnvironmentCommandService_CreateEnvironment_0(ctx context.Context, marshaler runtime.Marshaler, server EnvironmentCommandServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq CreateEnvironmentRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq.Environment); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.CreateEnvironment(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+func request_EnvironmentQueryService_GetAllEnvironments_0(ctx context.Context, marshaler runtime.Marshaler, client EnvironmentQueryServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetAllEnvironmentsRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := client.GetAllEnvironments(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_EnvironmentQueryService_GetAllEnvironments_0(ctx context.Context, marshaler runtime.Marshaler, server EnvironmentQueryServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetAllEnvironmentsRequest
+	var metadata runtime.ServerMetadata
+
+	msg, err := server.GetAllEnvironments(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
+// RegisterEnvironmentCommandServiceHandlerServer registers the http handlers for service EnvironmentCommandService to "mux".
+// UnaryRPC     :call EnvironmentCommandServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterEnvironmentCommandServiceHandlerFromEndpoint instead.
+func RegisterEnvironmentCommandServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server EnvironmentCommandServiceServer) error {
+
+	mux.Handle("POST", pattern_EnvironmentCommandService_CreateEnvironment_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/proto.indrasaputra.toggle.v1.EnvironmentCommandService/CreateEnvironment", runtime.WithHTTPPathPattern("/v1/environments"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_EnvironmentCommandService_CreateEnvironment_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_EnvironmentCommandService_CreateEnvironment_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+// RegisterEnvironmentQueryServiceHandlerServer registers the http handlers for service EnvironmentQueryService to "mux".
+// UnaryRPC     :call EnvironmentQueryServiceServer directly.
+// StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
+// Note that using this registration option will cause many gRPC library features to stop working. Consider using RegisterEnvironmentQueryServiceHandlerFromEndpoint instead.
+func RegisterEnvironmentQueryServiceHandlerServer(ctx context.Context, mux *runtime.ServeMux, server EnvironmentQueryServiceServer) error {
+
+	mux.Handle("GET", pattern_EnvironmentQueryService_GetAllEnvironments_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/proto.indrasaputra.toggle.v1.EnvironmentQueryService/GetAllEnvironments", runtime.WithHTTPPathPattern("/v1/environments"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_EnvironmentQueryService_GetAllEnvironments_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_EnvironmentQueryService_GetAllEnvironments_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+// RegisterEnvironmentCommandServiceHandlerFromEndpoint is same as RegisterEnvironmentCommandServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterEnvironmentCommandServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterEnvironmentCommandServiceHandler(ctx, mux, conn)
+}
+
+// RegisterEnvironmentCommandServiceHandler registers the http handlers for service EnvironmentCommandService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterEnvironmentCommandServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterEnvironmentCommandServiceHandlerClient(ctx, mux, NewEnvironmentCommandServiceClient(conn))
+}
+
+// RegisterEnvironmentCommandServiceHandlerClient registers the http handlers for service EnvironmentCommandService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "EnvironmentCommandServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "EnvironmentCommandServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "EnvironmentCommandServiceClient" to call the correct interceptors.
+func RegisterEnvironmentCommandServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client EnvironmentCommandServiceClient) error {
+
+	mux.Handle("POST", pattern_EnvironmentCommandService_CreateEnvironment_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/proto.indrasaputra.toggle.v1.EnvironmentCommandService/CreateEnvironment", runtime.WithHTTPPathPattern("/v1/environments"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_EnvironmentCommandService_CreateEnvironment_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_EnvironmentCommandService_CreateEnvironment_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_EnvironmentCommandService_CreateEnvironment_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "environments"}, ""))
+)
+
+var (
+	forward_EnvironmentCommandService_CreateEnvironment_0 = runtime.ForwardResponseMessage
+)
+
+// RegisterEnvironmentQueryServiceHandlerFromEndpoint is same as RegisterEnvironmentQueryServiceHandler but
+// automatically dials to "endpoint" and closes the connection when "ctx" gets done.
+func RegisterEnvironmentQueryServiceHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error) {
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if cerr := conn.Close(); cerr != nil {
+				grpclog.Infof("Failed to close conn to %s: %v", endpoint, cerr)
+			}
+		}()
+	}()
+
+	return RegisterEnvironmentQueryServiceHandler(ctx, mux, conn)
+}
+
+// RegisterEnvironmentQueryServiceHandler registers the http handlers for service EnvironmentQueryService to "mux".
+// The handlers forward requests to the grpc endpoint over "conn".
+func RegisterEnvironmentQueryServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterEnvironmentQueryServiceHandlerClient(ctx, mux, NewEnvironmentQueryServiceClient(conn))
+}
+
+// RegisterEnvironmentQueryServiceHandlerClient registers the http handlers for service EnvironmentQueryService
+// to "mux". The handlers forward requests to the grpc endpoint over the given implementation of "EnvironmentQueryServiceClient".
+// Note: the gRPC framework executes interceptors within the gRPC handler. If the passed in "EnvironmentQueryServiceClient"
+// doesn't go through the normal gRPC flow (creating a gRPC client etc.) then it will be up to the passed in
+// "EnvironmentQueryServiceClient" to call the correct interceptors.
+func RegisterEnvironmentQueryServiceHandlerClient(ctx context.Context, mux *runtime.ServeMux, client EnvironmentQueryServiceClient) error {
+
+	mux.Handle("GET", pattern_EnvironmentQueryService_GetAllEnvironments_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/proto.indrasaputra.toggle.v1.EnvironmentQueryService/GetAllEnvironments", runtime.WithHTTPPathPattern("/v1/environments"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_EnvironmentQueryService_GetAllEnvironments_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_EnvironmentQueryService_GetAllEnvironments_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
+	return nil
+}
+
+var (
+	pattern_EnvironmentQueryService_GetAllEnvironments_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "environments"}, ""))
+)
+
+var (
+	forward_EnvironmentQueryService_GetAllEnvironments_0 = runtime.ForwardResponseMessage
+)
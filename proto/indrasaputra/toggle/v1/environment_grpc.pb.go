@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package togglev1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EnvironmentCommandServiceClient is the client API for EnvironmentCommandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EnvironmentCommandServiceClient interface {
+	// Create a new environment.
+	//
+	// This endpoint creates a new environment with provided name and description.
+	// The name must be unique and it can only contain alphanumeric and dash.
+	// The name will be converted to lower case.
+	// All existing toggles get the default state in the new environment.
+	CreateEnvironment(ctx context.Context, in *CreateEnvironmentRequest, opts ...grpc.CallOption) (*CreateEnvironmentResponse, error)
+}
+
+type environmentCommandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEnvironmentCommandServiceClient(cc grpc.ClientConnInterface) EnvironmentCommandServiceClient {
+	return &environmentCommandServiceClient{cc}
+}
+
+func (c *environmentCommandServiceClient) CreateEnvironment(ctx context.Context, in *CreateEnvironmentRequest, opts ...grpc.CallOption) (*CreateEnvironmentResponse, error) {
+	out := new(CreateEnvironmentResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.EnvironmentCommandService/CreateEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EnvironmentCommandServiceServer is the server API for EnvironmentCommandService service.
+// All implementations must embed UnimplementedEnvironmentCommandServiceServer
+// for forward compatibility
+type EnvironmentCommandServiceServer interface {
+	// Create a new environment.
+	//
+	// This endpoint creates a new environment with provided name and description.
+	// The name must be unique and it can only contain alphanumeric and dash.
+	// The name will be converted to lower case.
+	// All existing toggles get the default state in the new environment.
+	CreateEnvironment(context.Context, *CreateEnvironmentRequest) (*CreateEnvironmentResponse, error)
+	mustEmbedUnimplementedEnvironmentCommandServiceServer()
+}
+
+// UnimplementedEnvironmentCommandServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEnvironmentCommandServiceServer struct {
+}
+
+func (UnimplementedEnvironmentCommandServiceServer) CreateEnvironment(context.Context, *CreateEnvironmentRequest) (*CreateEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnvironment not implemented")
+}
+func (UnimplementedEnvironmentCommandServiceServer) mustEmbedUnimplementedEnvironmentCommandServiceServer() {
+}
+
+// UnsafeEnvironmentCommandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EnvironmentCommandServiceServer will
+// result in compilation errors.
+type UnsafeEnvironmentCommandServiceServer interface {
+	mustEmbedUnimplementedEnvironmentCommandServiceServer()
+}
+
+func RegisterEnvironmentCommandServiceServer(s grpc.ServiceRegistrar, srv EnvironmentCommandServiceServer) {
+	s.RegisterService(&EnvironmentCommandService_ServiceDesc, srv)
+}
+
+func _EnvironmentCommandService_CreateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentCommandServiceServer).CreateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.EnvironmentCommandService/CreateEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentCommandServiceServer).CreateEnvironment(ctx, req.(*CreateEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EnvironmentCommandService_ServiceDesc is the grpc.ServiceDesc for EnvironmentCommandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EnvironmentCommandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.EnvironmentCommandService",
+	HandlerType: (*EnvironmentCommandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEnvironment",
+			Handler:    _EnvironmentCommandService_CreateEnvironment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/environment.proto",
+}
+
+// EnvironmentQueryServiceClient is the client API for EnvironmentQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EnvironmentQueryServiceClient interface {
+	// Get many environments.
+	//
+	// This endpoint gets all available environments in the system.
+	GetAllEnvironments(ctx context.Context, in *GetAllEnvironmentsRequest, opts ...grpc.CallOption) (*GetAllEnvironmentsResponse, error)
+}
+
+type environmentQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEnvironmentQueryServiceClient(cc grpc.ClientConnInterface) EnvironmentQueryServiceClient {
+	return &environmentQueryServiceClient{cc}
+}
+
+func (c *environmentQueryServiceClient) GetAllEnvironments(ctx context.Context, in *GetAllEnvironmentsRequest, opts ...grpc.CallOption) (*GetAllEnvironmentsResponse, error) {
+	out := new(GetAllEnvironmentsResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.EnvironmentQueryService/GetAllEnvironments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EnvironmentQueryServiceServer is the server API for EnvironmentQueryService service.
+// All implementations must embed UnimplementedEnvironmentQueryServiceServer
+// for forward compatibility
+type EnvironmentQueryServiceServer interface {
+	// Get many environments.
+	//
+	// This endpoint gets all available environments in the system.
+	GetAllEnvironments(context.Context, *GetAllEnvironmentsRequest) (*GetAllEnvironmentsResponse, error)
+	mustEmbedUnimplementedEnvironmentQueryServiceServer()
+}
+
+// UnimplementedEnvironmentQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedEnvironmentQueryServiceServer struct {
+}
+
+func (UnimplementedEnvironmentQueryServiceServer) GetAllEnvironments(context.Context, *GetAllEnvironmentsRequest) (*GetAllEnvironmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllEnvironments not implemented")
+}
+func (UnimplementedEnvironmentQueryServiceServer) mustEmbedUnimplementedEnvironmentQueryServiceServer() {
+}
+
+// UnsafeEnvironmentQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EnvironmentQueryServiceServer will
+// result in compilation errors.
+type UnsafeEnvironmentQueryServiceServer interface {
+	mustEmbedUnimplementedEnvironmentQueryServiceServer()
+}
+
+func RegisterEnvironmentQueryServiceServer(s grpc.ServiceRegistrar, srv EnvironmentQueryServiceServer) {
+	s.RegisterService(&EnvironmentQueryService_ServiceDesc, srv)
+}
+
+func _EnvironmentQueryService_GetAllEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentQueryServiceServer).GetAllEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.EnvironmentQueryService/GetAllEnvironments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentQueryServiceServer).GetAllEnvironments(ctx, req.(*GetAllEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EnvironmentQueryService_ServiceDesc is the grpc.ServiceDesc for EnvironmentQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EnvironmentQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.EnvironmentQueryService",
+	HandlerType: (*EnvironmentQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllEnvironments",
+			Handler:    _EnvironmentQueryService_GetAllEnvironments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/environment.proto",
+}
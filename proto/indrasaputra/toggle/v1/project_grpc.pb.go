@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package togglev1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProjectCommandServiceClient is the client API for ProjectCommandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectCommandServiceClient interface {
+	// Create a new project.
+	//
+	// This endpoint creates a new project with provided name and description.
+	// The name must be unique and it can only contain alphanumeric and dash.
+	// The name will be converted to lower case.
+	CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	// Update a project.
+	//
+	// This endpoint updates the project's description.
+	// The name can't be changed.
+	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	// Delete a project.
+	//
+	// This endpoint deletes a project by its name.
+	// The project must not own any toggle.
+	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+}
+
+type projectCommandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectCommandServiceClient(cc grpc.ClientConnInterface) ProjectCommandServiceClient {
+	return &projectCommandServiceClient{cc}
+}
+
+func (c *projectCommandServiceClient) CreateProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ProjectCommandService/CreateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectCommandServiceClient) UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ProjectCommandService/UpdateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectCommandServiceClient) DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ProjectCommandService/DeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectCommandServiceServer is the server API for ProjectCommandService service.
+// All implementations must embed UnimplementedProjectCommandServiceServer
+// for forward compatibility
+type ProjectCommandServiceServer interface {
+	// Create a new project.
+	//
+	// This endpoint creates a new project with provided name and description.
+	// The name must be unique and it can only contain alphanumeric and dash.
+	// The name will be converted to lower case.
+	CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	// Update a project.
+	//
+	// This endpoint updates the project's description.
+	// The name can't be changed.
+	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	// Delete a project.
+	//
+	// This endpoint deletes a project by its name.
+	// The project must not own any toggle.
+	DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	mustEmbedUnimplementedProjectCommandServiceServer()
+}
+
+// UnimplementedProjectCommandServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProjectCommandServiceServer struct {
+}
+
+func (UnimplementedProjectCommandServiceServer) CreateProject(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProject not implemented")
+}
+func (UnimplementedProjectCommandServiceServer) UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProject not implemented")
+}
+func (UnimplementedProjectCommandServiceServer) DeleteProject(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
+}
+func (UnimplementedProjectCommandServiceServer) mustEmbedUnimplementedProjectCommandServiceServer() {}
+
+// UnsafeProjectCommandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectCommandServiceServer will
+// result in compilation errors.
+type UnsafeProjectCommandServiceServer interface {
+	mustEmbedUnimplementedProjectCommandServiceServer()
+}
+
+func RegisterProjectCommandServiceServer(s grpc.ServiceRegistrar, srv ProjectCommandServiceServer) {
+	s.RegisterService(&ProjectCommandService_ServiceDesc, srv)
+}
+
+func _ProjectCommandService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectCommandServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ProjectCommandService/CreateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectCommandServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectCommandService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectCommandServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ProjectCommandService/UpdateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectCommandServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectCommandService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectCommandServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ProjectCommandService/DeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectCommandServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectCommandService_ServiceDesc is the grpc.ServiceDesc for ProjectCommandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectCommandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.ProjectCommandService",
+	HandlerType: (*ProjectCommandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProject",
+			Handler:    _ProjectCommandService_CreateProject_Handler,
+		},
+		{
+			MethodName: "UpdateProject",
+			Handler:    _ProjectCommandService_UpdateProject_Handler,
+		},
+		{
+			MethodName: "DeleteProject",
+			Handler:    _ProjectCommandService_DeleteProject_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/project.proto",
+}
+
+// ProjectQueryServiceClient is the client API for ProjectQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectQueryServiceClient interface {
+	// Get a project.
+	//
+	// This endpoint gets a single project by its name.
+	GetProjectByName(ctx context.Context, in *GetProjectByNameRequest, opts ...grpc.CallOption) (*GetProjectByNameResponse, error)
+	// Get many projects.
+	//
+	// This endpoint gets all available projects in the system.
+	GetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error)
+}
+
+type projectQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectQueryServiceClient(cc grpc.ClientConnInterface) ProjectQueryServiceClient {
+	return &projectQueryServiceClient{cc}
+}
+
+func (c *projectQueryServiceClient) GetProjectByName(ctx context.Context, in *GetProjectByNameRequest, opts ...grpc.CallOption) (*GetProjectByNameResponse, error) {
+	out := new(GetProjectByNameResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ProjectQueryService/GetProjectByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectQueryServiceClient) GetAllProjects(ctx context.Context, in *GetAllProjectsRequest, opts ...grpc.CallOption) (*GetAllProjectsResponse, error) {
+	out := new(GetAllProjectsResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ProjectQueryService/GetAllProjects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectQueryServiceServer is the server API for ProjectQueryService service.
+// All implementations must embed UnimplementedProjectQueryServiceServer
+// for forward compatibility
+type ProjectQueryServiceServer interface {
+	// Get a project.
+	//
+	// This endpoint gets a single project by its name.
+	GetProjectByName(context.Context, *GetProjectByNameRequest) (*GetProjectByNameResponse, error)
+	// Get many projects.
+	//
+	// This endpoint gets all available projects in the system.
+	GetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error)
+	mustEmbedUnimplementedProjectQueryServiceServer()
+}
+
+// UnimplementedProjectQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedProjectQueryServiceServer struct {
+}
+
+func (UnimplementedProjectQueryServiceServer) GetProjectByName(context.Context, *GetProjectByNameRequest) (*GetProjectByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectByName not implemented")
+}
+func (UnimplementedProjectQueryServiceServer) GetAllProjects(context.Context, *GetAllProjectsRequest) (*GetAllProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllProjects not implemented")
+}
+func (UnimplementedProjectQueryServiceServer) mustEmbedUnimplementedProjectQueryServiceServer() {}
+
+// UnsafeProjectQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectQueryServiceServer will
+// result in compilation errors.
+type UnsafeProjectQueryServiceServer interface {
+	mustEmbedUnimplementedProjectQueryServiceServer()
+}
+
+func RegisterProjectQueryServiceServer(s grpc.ServiceRegistrar, srv ProjectQueryServiceServer) {
+	s.RegisterService(&ProjectQueryService_ServiceDesc, srv)
+}
+
+func _ProjectQueryService_GetProjectByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectQueryServiceServer).GetProjectByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ProjectQueryService/GetProjectByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectQueryServiceServer).GetProjectByName(ctx, req.(*GetProjectByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectQueryService_GetAllProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectQueryServiceServer).GetAllProjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ProjectQueryService/GetAllProjects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectQueryServiceServer).GetAllProjects(ctx, req.(*GetAllProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProjectQueryService_ServiceDesc is the grpc.ServiceDesc for ProjectQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProjectQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.ProjectQueryService",
+	HandlerType: (*ProjectQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProjectByName",
+			Handler:    _ProjectQueryService_GetProjectByName_Handler,
+		},
+		{
+			MethodName: "GetAllProjects",
+			Handler:    _ProjectQueryService_GetAllProjects_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/project.proto",
+}
@@ -0,0 +1,251 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package togglev1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ScheduleCommandServiceClient is the client API for ScheduleCommandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScheduleCommandServiceClient interface {
+	// Create a new schedule.
+	//
+	// This endpoint schedules the toggle to be enabled or disabled in the environment at a future time.
+	// The schedule is executed through the same flow as EnableToggle and DisableToggle,
+	// hence the same events are published.
+	CreateToggleSchedule(ctx context.Context, in *CreateToggleScheduleRequest, opts ...grpc.CallOption) (*CreateToggleScheduleResponse, error)
+	// Cancel a schedule.
+	//
+	// This endpoint cancels a pending schedule by its id.
+	// Executed schedules can't be cancelled.
+	CancelToggleSchedule(ctx context.Context, in *CancelToggleScheduleRequest, opts ...grpc.CallOption) (*CancelToggleScheduleResponse, error)
+}
+
+type scheduleCommandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduleCommandServiceClient(cc grpc.ClientConnInterface) ScheduleCommandServiceClient {
+	return &scheduleCommandServiceClient{cc}
+}
+
+func (c *scheduleCommandServiceClient) CreateToggleSchedule(ctx context.Context, in *CreateToggleScheduleRequest, opts ...grpc.CallOption) (*CreateToggleScheduleResponse, error) {
+	out := new(CreateToggleScheduleResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ScheduleCommandService/CreateToggleSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleCommandServiceClient) CancelToggleSchedule(ctx context.Context, in *CancelToggleScheduleRequest, opts ...grpc.CallOption) (*CancelToggleScheduleResponse, error) {
+	out := new(CancelToggleScheduleResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ScheduleCommandService/CancelToggleSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduleCommandServiceServer is the server API for ScheduleCommandService service.
+// All implementations must embed UnimplementedScheduleCommandServiceServer
+// for forward compatibility
+type ScheduleCommandServiceServer interface {
+	// Create a new schedule.
+	//
+	// This endpoint schedules the toggle to be enabled or disabled in the environment at a future time.
+	// The schedule is executed through the same flow as EnableToggle and DisableToggle,
+	// hence the same events are published.
+	CreateToggleSchedule(context.Context, *CreateToggleScheduleRequest) (*CreateToggleScheduleResponse, error)
+	// Cancel a schedule.
+	//
+	// This endpoint cancels a pending schedule by its id.
+	// Executed schedules can't be cancelled.
+	CancelToggleSchedule(context.Context, *CancelToggleScheduleRequest) (*CancelToggleScheduleResponse, error)
+	mustEmbedUnimplementedScheduleCommandServiceServer()
+}
+
+// UnimplementedScheduleCommandServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedScheduleCommandServiceServer struct {
+}
+
+func (UnimplementedScheduleCommandServiceServer) CreateToggleSchedule(context.Context, *CreateToggleScheduleRequest) (*CreateToggleScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateToggleSchedule not implemented")
+}
+func (UnimplementedScheduleCommandServiceServer) CancelToggleSchedule(context.Context, *CancelToggleScheduleRequest) (*CancelToggleScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelToggleSchedule not implemented")
+}
+func (UnimplementedScheduleCommandServiceServer) mustEmbedUnimplementedScheduleCommandServiceServer() {
+}
+
+// UnsafeScheduleCommandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduleCommandServiceServer will
+// result in compilation errors.
+type UnsafeScheduleCommandServiceServer interface {
+	mustEmbedUnimplementedScheduleCommandServiceServer()
+}
+
+func RegisterScheduleCommandServiceServer(s grpc.ServiceRegistrar, srv ScheduleCommandServiceServer) {
+	s.RegisterService(&ScheduleCommandService_ServiceDesc, srv)
+}
+
+func _ScheduleCommandService_CreateToggleSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateToggleScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleCommandServiceServer).CreateToggleSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ScheduleCommandService/CreateToggleSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleCommandServiceServer).CreateToggleSchedule(ctx, req.(*CreateToggleScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleCommandService_CancelToggleSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelToggleScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleCommandServiceServer).CancelToggleSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ScheduleCommandService/CancelToggleSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleCommandServiceServer).CancelToggleSchedule(ctx, req.(*CancelToggleScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduleCommandService_ServiceDesc is the grpc.ServiceDesc for ScheduleCommandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduleCommandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.ScheduleCommandService",
+	HandlerType: (*ScheduleCommandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateToggleSchedule",
+			Handler:    _ScheduleCommandService_CreateToggleSchedule_Handler,
+		},
+		{
+			MethodName: "CancelToggleSchedule",
+			Handler:    _ScheduleCommandService_CancelToggleSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/schedule.proto",
+}
+
+// ScheduleQueryServiceClient is the client API for ScheduleQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScheduleQueryServiceClient interface {
+	// Get many pending schedules.
+	//
+	// This endpoint gets all pending schedules of the toggle in the environment
+	// ordered by their execution time.
+	GetAllToggleSchedules(ctx context.Context, in *GetAllToggleSchedulesRequest, opts ...grpc.CallOption) (*GetAllToggleSchedulesResponse, error)
+}
+
+type scheduleQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduleQueryServiceClient(cc grpc.ClientConnInterface) ScheduleQueryServiceClient {
+	return &scheduleQueryServiceClient{cc}
+}
+
+func (c *scheduleQueryServiceClient) GetAllToggleSchedules(ctx context.Context, in *GetAllToggleSchedulesRequest, opts ...grpc.CallOption) (*GetAllToggleSchedulesResponse, error) {
+	out := new(GetAllToggleSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ScheduleQueryService/GetAllToggleSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduleQueryServiceServer is the server API for ScheduleQueryService service.
+// All implementations must embed UnimplementedScheduleQueryServiceServer
+// for forward compatibility
+type ScheduleQueryServiceServer interface {
+	// Get many pending schedules.
+	//
+	// This endpoint gets all pending schedules of the toggle in the environment
+	// ordered by their execution time.
+	GetAllToggleSchedules(context.Context, *GetAllToggleSchedulesRequest) (*GetAllToggleSchedulesResponse, error)
+	mustEmbedUnimplementedScheduleQueryServiceServer()
+}
+
+// UnimplementedScheduleQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedScheduleQueryServiceServer struct {
+}
+
+func (UnimplementedScheduleQueryServiceServer) GetAllToggleSchedules(context.Context, *GetAllToggleSchedulesRequest) (*GetAllToggleSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllToggleSchedules not implemented")
+}
+func (UnimplementedScheduleQueryServiceServer) mustEmbedUnimplementedScheduleQueryServiceServer() {}
+
+// UnsafeScheduleQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduleQueryServiceServer will
+// result in compilation errors.
+type UnsafeScheduleQueryServiceServer interface {
+	mustEmbedUnimplementedScheduleQueryServiceServer()
+}
+
+func RegisterScheduleQueryServiceServer(s grpc.ServiceRegistrar, srv ScheduleQueryServiceServer) {
+	s.RegisterService(&ScheduleQueryService_ServiceDesc, srv)
+}
+
+func _ScheduleQueryService_GetAllToggleSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllToggleSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleQueryServiceServer).GetAllToggleSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ScheduleQueryService/GetAllToggleSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleQueryServiceServer).GetAllToggleSchedules(ctx, req.(*GetAllToggleSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduleQueryService_ServiceDesc is the grpc.ServiceDesc for ScheduleQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduleQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.ScheduleQueryService",
+	HandlerType: (*ScheduleQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllToggleSchedules",
+			Handler:    _ScheduleQueryService_GetAllToggleSchedules_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/schedule.proto",
+}
@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package togglev1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// SegmentCommandServiceClient is the client API for SegmentCommandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SegmentCommandServiceClient interface {
+	// Create a new segment.
+	//
+	// This endpoint creates a new segment in the project.
+	// The key must be unique within the project and it can only contain alphanumeric and dash.
+	// The key will be converted to lower case.
+	CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error)
+	// Update a segment.
+	//
+	// This endpoint replaces the segment's description, included list, excluded list, and rules.
+	// The key can't be changed.
+	UpdateSegment(ctx context.Context, in *UpdateSegmentRequest, opts ...grpc.CallOption) (*UpdateSegmentResponse, error)
+	// Delete a segment.
+	//
+	// This endpoint deletes a segment by its key.
+	// The segment must not be referenced by any toggle's rule.
+	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error)
+}
+
+type segmentCommandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSegmentCommandServiceClient(cc grpc.ClientConnInterface) SegmentCommandServiceClient {
+	return &segmentCommandServiceClient{cc}
+}
+
+func (c *segmentCommandServiceClient) CreateSegment(ctx context.Context, in *CreateSegmentRequest, opts ...grpc.CallOption) (*CreateSegmentResponse, error) {
+	out := new(CreateSegmentResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.SegmentCommandService/CreateSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentCommandServiceClient) UpdateSegment(ctx context.Context, in *UpdateSegmentRequest, opts ...grpc.CallOption) (*UpdateSegmentResponse, error) {
+	out := new(UpdateSegmentResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.SegmentCommandService/UpdateSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentCommandServiceClient) DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*DeleteSegmentResponse, error) {
+	out := new(DeleteSegmentResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.SegmentCommandService/DeleteSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SegmentCommandServiceServer is the server API for SegmentCommandService service.
+// All implementations must embed UnimplementedSegmentCommandServiceServer
+// for forward compatibility
+type SegmentCommandServiceServer interface {
+	// Create a new segment.
+	//
+	// This endpoint creates a new segment in the project.
+	// The key must be unique within the project and it can only contain alphanumeric and dash.
+	// The key will be converted to lower case.
+	CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error)
+	// Update a segment.
+	//
+	// This endpoint replaces the segment's description, included list, excluded list, and rules.
+	// The key can't be changed.
+	UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error)
+	// Delete a segment.
+	//
+	// This endpoint deletes a segment by its key.
+	// The segment must not be referenced by any toggle's rule.
+	DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error)
+	mustEmbedUnimplementedSegmentCommandServiceServer()
+}
+
+// UnimplementedSegmentCommandServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSegmentCommandServiceServer struct {
+}
+
+func (UnimplementedSegmentCommandServiceServer) CreateSegment(context.Context, *CreateSegmentRequest) (*CreateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+}
+func (UnimplementedSegmentCommandServiceServer) UpdateSegment(context.Context, *UpdateSegmentRequest) (*UpdateSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSegment not implemented")
+}
+func (UnimplementedSegmentCommandServiceServer) DeleteSegment(context.Context, *DeleteSegmentRequest) (*DeleteSegmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSegment not implemented")
+}
+func (UnimplementedSegmentCommandServiceServer) mustEmbedUnimplementedSegmentCommandServiceServer() {}
+
+// UnsafeSegmentCommandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SegmentCommandServiceServer will
+// result in compilation errors.
+type UnsafeSegmentCommandServiceServer interface {
+	mustEmbedUnimplementedSegmentCommandServiceServer()
+}
+
+func RegisterSegmentCommandServiceServer(s grpc.ServiceRegistrar, srv SegmentCommandServiceServer) {
+	s.RegisterService(&SegmentCommandService_ServiceDesc, srv)
+}
+
+func _SegmentCommandService_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentCommandServiceServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.SegmentCommandService/CreateSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentCommandServiceServer).CreateSegment(ctx, req.(*CreateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentCommandService_UpdateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentCommandServiceServer).UpdateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.SegmentCommandService/UpdateSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentCommandServiceServer).UpdateSegment(ctx, req.(*UpdateSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentCommandService_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentCommandServiceServer).DeleteSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.SegmentCommandService/DeleteSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentCommandServiceServer).DeleteSegment(ctx, req.(*DeleteSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SegmentCommandService_ServiceDesc is the grpc.ServiceDesc for SegmentCommandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SegmentCommandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.SegmentCommandService",
+	HandlerType: (*SegmentCommandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSegment",
+			Handler:    _SegmentCommandService_CreateSegment_Handler,
+		},
+		{
+			MethodName: "UpdateSegment",
+			Handler:    _SegmentCommandService_UpdateSegment_Handler,
+		},
+		{
+			MethodName: "DeleteSegment",
+			Handler:    _SegmentCommandService_DeleteSegment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/segment.proto",
+}
+
+// SegmentQueryServiceClient is the client API for SegmentQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SegmentQueryServiceClient interface {
+	// Get a segment.
+	//
+	// This endpoint gets a single segment in the project by its key.
+	GetSegmentByKey(ctx context.Context, in *GetSegmentByKeyRequest, opts ...grpc.CallOption) (*GetSegmentByKeyResponse, error)
+	// Get many segments.
+	//
+	// This endpoint gets all available segments in the project.
+	GetAllSegments(ctx context.Context, in *GetAllSegmentsRequest, opts ...grpc.CallOption) (*GetAllSegmentsResponse, error)
+}
+
+type segmentQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSegmentQueryServiceClient(cc grpc.ClientConnInterface) SegmentQueryServiceClient {
+	return &segmentQueryServiceClient{cc}
+}
+
+func (c *segmentQueryServiceClient) GetSegmentByKey(ctx context.Context, in *GetSegmentByKeyRequest, opts ...grpc.CallOption) (*GetSegmentByKeyResponse, error) {
+	out := new(GetSegmentByKeyResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.SegmentQueryService/GetSegmentByKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentQueryServiceClient) GetAllSegments(ctx context.Context, in *GetAllSegmentsRequest, opts ...grpc.CallOption) (*GetAllSegmentsResponse, error) {
+	out := new(GetAllSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.SegmentQueryService/GetAllSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SegmentQueryServiceServer is the server API for SegmentQueryService service.
+// All implementations must embed UnimplementedSegmentQueryServiceServer
+// for forward compatibility
+type SegmentQueryServiceServer interface {
+	// Get a segment.
+	//
+	// This endpoint gets a single segment in the project by its key.
+	GetSegmentByKey(context.Context, *GetSegmentByKeyRequest) (*GetSegmentByKeyResponse, error)
+	// Get many segments.
+	//
+	// This endpoint gets all available segments in the project.
+	GetAllSegments(context.Context, *GetAllSegmentsRequest) (*GetAllSegmentsResponse, error)
+	mustEmbedUnimplementedSegmentQueryServiceServer()
+}
+
+// UnimplementedSegmentQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSegmentQueryServiceServer struct {
+}
+
+func (UnimplementedSegmentQueryServiceServer) GetSegmentByKey(context.Context, *GetSegmentByKeyRequest) (*GetSegmentByKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSegmentByKey not implemented")
+}
+func (UnimplementedSegmentQueryServiceServer) GetAllSegments(context.Context, *GetAllSegmentsRequest) (*GetAllSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSegments not implemented")
+}
+func (UnimplementedSegmentQueryServiceServer) mustEmbedUnimplementedSegmentQueryServiceServer() {}
+
+// UnsafeSegmentQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SegmentQueryServiceServer will
+// result in compilation errors.
+type UnsafeSegmentQueryServiceServer interface {
+	mustEmbedUnimplementedSegmentQueryServiceServer()
+}
+
+func RegisterSegmentQueryServiceServer(s grpc.ServiceRegistrar, srv SegmentQueryServiceServer) {
+	s.RegisterService(&SegmentQueryService_ServiceDesc, srv)
+}
+
+func _SegmentQueryService_GetSegmentByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSegmentByKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentQueryServiceServer).GetSegmentByKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.SegmentQueryService/GetSegmentByKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentQueryServiceServer).GetSegmentByKey(ctx, req.(*GetSegmentByKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentQueryService_GetAllSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentQueryServiceServer).GetAllSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.SegmentQueryService/GetAllSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentQueryServiceServer).GetAllSegments(ctx, req.(*GetAllSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SegmentQueryService_ServiceDesc is the grpc.ServiceDesc for SegmentQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SegmentQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.indrasaputra.toggle.v1.SegmentQueryService",
+	HandlerType: (*SegmentQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSegmentByKey",
+			Handler:    _SegmentQueryService_GetSegmentByKey_Handler,
+		},
+		{
+			MethodName: "GetAllSegments",
+			Handler:    _SegmentQueryService_GetAllSegments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/indrasaputra/toggle/v1/segment.proto",
+}
@@ -16,6 +16,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -26,6 +27,531 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// AuditAction enumerates what was done to a toggle.
+type AuditAction int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	AuditAction_AUDIT_ACTION_UNSPECIFIED AuditAction = 0
+	// The toggle was created.
+	AuditAction_AUDIT_ACTION_CREATE AuditAction = 1
+	// The toggle was enabled.
+	AuditAction_AUDIT_ACTION_ENABLE AuditAction = 2
+	// The toggle was disabled.
+	AuditAction_AUDIT_ACTION_DISABLE AuditAction = 3
+	// The toggle was soft-deleted.
+	AuditAction_AUDIT_ACTION_DELETE AuditAction = 4
+	// The soft-deleted toggle was restored.
+	AuditAction_AUDIT_ACTION_RESTORE AuditAction = 5
+	// The toggle was gone forever.
+	AuditAction_AUDIT_ACTION_PURGE AuditAction = 6
+	// The toggle's fields, such as its description or prerequisites, were updated.
+	AuditAction_AUDIT_ACTION_UPDATE AuditAction = 7
+)
+
+// Enum value maps for AuditAction.
+var (
+	AuditAction_name = map[int32]string{
+		0: "AUDIT_ACTION_UNSPECIFIED",
+		1: "AUDIT_ACTION_CREATE",
+		2: "AUDIT_ACTION_ENABLE",
+		3: "AUDIT_ACTION_DISABLE",
+		4: "AUDIT_ACTION_DELETE",
+		5: "AUDIT_ACTION_RESTORE",
+		6: "AUDIT_ACTION_PURGE",
+		7: "AUDIT_ACTION_UPDATE",
+	}
+	AuditAction_value = map[string]int32{
+		"AUDIT_ACTION_UNSPECIFIED": 0,
+		"AUDIT_ACTION_CREATE":      1,
+		"AUDIT_ACTION_ENABLE":      2,
+		"AUDIT_ACTION_DISABLE":     3,
+		"AUDIT_ACTION_DELETE":      4,
+		"AUDIT_ACTION_RESTORE":     5,
+		"AUDIT_ACTION_PURGE":       6,
+		"AUDIT_ACTION_UPDATE":      7,
+	}
+)
+
+func (x AuditAction) Enum() *AuditAction {
+	p := new(AuditAction)
+	*p = x
+	return p
+}
+
+func (x AuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditAction) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[0]
+}
+
+func (x AuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditAction.Descriptor instead.
+func (AuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{0}
+}
+
+// StaleReason enumerates why a toggle is stale.
+type StaleReason int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	StaleReason_STALE_REASON_UNSPECIFIED StaleReason = 0
+	// The toggle's expiry time has passed.
+	StaleReason_STALE_REASON_EXPIRED StaleReason = 1
+	// The toggle's state hasn't changed for a long time and it still serves different values.
+	StaleReason_STALE_REASON_UNCHANGED StaleReason = 2
+	// The toggle's state hasn't changed for a long time and it serves true to everyone.
+	StaleReason_STALE_REASON_FULLY_ON StaleReason = 3
+	// The toggle's state hasn't changed for a long time and it serves false to everyone.
+	StaleReason_STALE_REASON_FULLY_OFF StaleReason = 4
+)
+
+// Enum value maps for StaleReason.
+var (
+	StaleReason_name = map[int32]string{
+		0: "STALE_REASON_UNSPECIFIED",
+		1: "STALE_REASON_EXPIRED",
+		2: "STALE_REASON_UNCHANGED",
+		3: "STALE_REASON_FULLY_ON",
+		4: "STALE_REASON_FULLY_OFF",
+	}
+	StaleReason_value = map[string]int32{
+		"STALE_REASON_UNSPECIFIED": 0,
+		"STALE_REASON_EXPIRED":     1,
+		"STALE_REASON_UNCHANGED":   2,
+		"STALE_REASON_FULLY_ON":    3,
+		"STALE_REASON_FULLY_OFF":   4,
+	}
+)
+
+func (x StaleReason) Enum() *StaleReason {
+	p := new(StaleReason)
+	*p = x
+	return p
+}
+
+func (x StaleReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StaleReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[1].Descriptor()
+}
+
+func (StaleReason) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[1]
+}
+
+func (x StaleReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StaleReason.Descriptor instead.
+func (StaleReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{1}
+}
+
+// ToggleOperationType enumerates the operations on a toggle in a batch.
+type ToggleOperationType int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	ToggleOperationType_TOGGLE_OPERATION_TYPE_UNSPECIFIED ToggleOperationType = 0
+	// Enable the toggle in the environment.
+	ToggleOperationType_TOGGLE_OPERATION_TYPE_ENABLE ToggleOperationType = 1
+	// Disable the toggle in the environment.
+	ToggleOperationType_TOGGLE_OPERATION_TYPE_DISABLE ToggleOperationType = 2
+	// Soft-delete the toggle from all environments.
+	ToggleOperationType_TOGGLE_OPERATION_TYPE_DELETE ToggleOperationType = 3
+)
+
+// Enum value maps for ToggleOperationType.
+var (
+	ToggleOperationType_name = map[int32]string{
+		0: "TOGGLE_OPERATION_TYPE_UNSPECIFIED",
+		1: "TOGGLE_OPERATION_TYPE_ENABLE",
+		2: "TOGGLE_OPERATION_TYPE_DISABLE",
+		3: "TOGGLE_OPERATION_TYPE_DELETE",
+	}
+	ToggleOperationType_value = map[string]int32{
+		"TOGGLE_OPERATION_TYPE_UNSPECIFIED": 0,
+		"TOGGLE_OPERATION_TYPE_ENABLE":      1,
+		"TOGGLE_OPERATION_TYPE_DISABLE":     2,
+		"TOGGLE_OPERATION_TYPE_DELETE":      3,
+	}
+)
+
+func (x ToggleOperationType) Enum() *ToggleOperationType {
+	p := new(ToggleOperationType)
+	*p = x
+	return p
+}
+
+func (x ToggleOperationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToggleOperationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[2].Descriptor()
+}
+
+func (ToggleOperationType) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[2]
+}
+
+func (x ToggleOperationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToggleOperationType.Descriptor instead.
+func (ToggleOperationType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{2}
+}
+
+// ImportConflictPolicy enumerates what import does to a toggle which exists with a different content.
+type ImportConflictPolicy int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	// It is treated the same as IMPORT_CONFLICT_POLICY_FAIL.
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED ImportConflictPolicy = 0
+	// Keep the existing toggle as it is.
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_SKIP ImportConflictPolicy = 1
+	// Change the existing toggle to match the snapshot.
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_OVERWRITE ImportConflictPolicy = 2
+	// Fail the whole import before anything is changed.
+	ImportConflictPolicy_IMPORT_CONFLICT_POLICY_FAIL ImportConflictPolicy = 3
+)
+
+// Enum value maps for ImportConflictPolicy.
+var (
+	ImportConflictPolicy_name = map[int32]string{
+		0: "IMPORT_CONFLICT_POLICY_UNSPECIFIED",
+		1: "IMPORT_CONFLICT_POLICY_SKIP",
+		2: "IMPORT_CONFLICT_POLICY_OVERWRITE",
+		3: "IMPORT_CONFLICT_POLICY_FAIL",
+	}
+	ImportConflictPolicy_value = map[string]int32{
+		"IMPORT_CONFLICT_POLICY_UNSPECIFIED": 0,
+		"IMPORT_CONFLICT_POLICY_SKIP":        1,
+		"IMPORT_CONFLICT_POLICY_OVERWRITE":   2,
+		"IMPORT_CONFLICT_POLICY_FAIL":        3,
+	}
+)
+
+func (x ImportConflictPolicy) Enum() *ImportConflictPolicy {
+	p := new(ImportConflictPolicy)
+	*p = x
+	return p
+}
+
+func (x ImportConflictPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConflictPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportConflictPolicy) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[3]
+}
+
+func (x ImportConflictPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportConflictPolicy.Descriptor instead.
+func (ImportConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{3}
+}
+
+// ToggleImportAction enumerates what import does to a toggle.
+type ToggleImportAction int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	ToggleImportAction_TOGGLE_IMPORT_ACTION_UNSPECIFIED ToggleImportAction = 0
+	// The toggle doesn't exist and is created.
+	ToggleImportAction_TOGGLE_IMPORT_ACTION_CREATE ToggleImportAction = 1
+	// The toggle exists with a different content and is changed to match the snapshot.
+	ToggleImportAction_TOGGLE_IMPORT_ACTION_UPDATE ToggleImportAction = 2
+	// The toggle isn't in the snapshot and is deleted.
+	ToggleImportAction_TOGGLE_IMPORT_ACTION_DELETE ToggleImportAction = 3
+	// The toggle already matches the snapshot.
+	ToggleImportAction_TOGGLE_IMPORT_ACTION_UNCHANGED ToggleImportAction = 4
+	// The toggle exists with a different content and is kept as it is.
+	ToggleImportAction_TOGGLE_IMPORT_ACTION_SKIP ToggleImportAction = 5
+)
+
+// Enum value maps for ToggleImportAction.
+var (
+	ToggleImportAction_name = map[int32]string{
+		0: "TOGGLE_IMPORT_ACTION_UNSPECIFIED",
+		1: "TOGGLE_IMPORT_ACTION_CREATE",
+		2: "TOGGLE_IMPORT_ACTION_UPDATE",
+		3: "TOGGLE_IMPORT_ACTION_DELETE",
+		4: "TOGGLE_IMPORT_ACTION_UNCHANGED",
+		5: "TOGGLE_IMPORT_ACTION_SKIP",
+	}
+	ToggleImportAction_value = map[string]int32{
+		"TOGGLE_IMPORT_ACTION_UNSPECIFIED": 0,
+		"TOGGLE_IMPORT_ACTION_CREATE":      1,
+		"TOGGLE_IMPORT_ACTION_UPDATE":      2,
+		"TOGGLE_IMPORT_ACTION_DELETE":      3,
+		"TOGGLE_IMPORT_ACTION_UNCHANGED":   4,
+		"TOGGLE_IMPORT_ACTION_SKIP":        5,
+	}
+)
+
+func (x ToggleImportAction) Enum() *ToggleImportAction {
+	p := new(ToggleImportAction)
+	*p = x
+	return p
+}
+
+func (x ToggleImportAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ToggleImportAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[4].Descriptor()
+}
+
+func (ToggleImportAction) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[4]
+}
+
+func (x ToggleImportAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ToggleImportAction.Descriptor instead.
+func (ToggleImportAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{4}
+}
+
+// VariantType enumerates type of a variant's value.
+type VariantType int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	VariantType_VARIANT_TYPE_UNSPECIFIED VariantType = 0
+	// Value is a plain string.
+	VariantType_VARIANT_TYPE_STRING VariantType = 1
+	// Value is a number.
+	VariantType_VARIANT_TYPE_NUMBER VariantType = 2
+	// Value is a JSON document.
+	VariantType_VARIANT_TYPE_JSON VariantType = 3
+)
+
+// Enum value maps for VariantType.
+var (
+	VariantType_name = map[int32]string{
+		0: "VARIANT_TYPE_UNSPECIFIED",
+		1: "VARIANT_TYPE_STRING",
+		2: "VARIANT_TYPE_NUMBER",
+		3: "VARIANT_TYPE_JSON",
+	}
+	VariantType_value = map[string]int32{
+		"VARIANT_TYPE_UNSPECIFIED": 0,
+		"VARIANT_TYPE_STRING":      1,
+		"VARIANT_TYPE_NUMBER":      2,
+		"VARIANT_TYPE_JSON":        3,
+	}
+)
+
+func (x VariantType) Enum() *VariantType {
+	p := new(VariantType)
+	*p = x
+	return p
+}
+
+func (x VariantType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VariantType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[5].Descriptor()
+}
+
+func (VariantType) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[5]
+}
+
+func (x VariantType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VariantType.Descriptor instead.
+func (VariantType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{5}
+}
+
+// RuleOperator enumerates operator of a rule.
+type RuleOperator int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	RuleOperator_RULE_OPERATOR_UNSPECIFIED RuleOperator = 0
+	// Attribute equals the only value.
+	RuleOperator_RULE_OPERATOR_EQUALS RuleOperator = 1
+	// Attribute doesn't equal the only value.
+	RuleOperator_RULE_OPERATOR_NOT_EQUALS RuleOperator = 2
+	// Attribute equals one of the values.
+	RuleOperator_RULE_OPERATOR_IN RuleOperator = 3
+	// Attribute doesn't equal any of the values.
+	RuleOperator_RULE_OPERATOR_NOT_IN RuleOperator = 4
+	// Attribute matches the regular expression in the only value.
+	RuleOperator_RULE_OPERATOR_REGEX RuleOperator = 5
+	// Attribute is a semantic version greater than the only value.
+	RuleOperator_RULE_OPERATOR_SEMVER_GT RuleOperator = 6
+	// Attribute is a semantic version less than the only value.
+	RuleOperator_RULE_OPERATOR_SEMVER_LT RuleOperator = 7
+	// Attribute belongs to one of the segments whose keys are the values.
+	RuleOperator_RULE_OPERATOR_IN_SEGMENT RuleOperator = 8
+	// Attribute doesn't belong to any of the segments whose keys are the values.
+	RuleOperator_RULE_OPERATOR_NOT_IN_SEGMENT RuleOperator = 9
+)
+
+// Enum value maps for RuleOperator.
+var (
+	RuleOperator_name = map[int32]string{
+		0: "RULE_OPERATOR_UNSPECIFIED",
+		1: "RULE_OPERATOR_EQUALS",
+		2: "RULE_OPERATOR_NOT_EQUALS",
+		3: "RULE_OPERATOR_IN",
+		4: "RULE_OPERATOR_NOT_IN",
+		5: "RULE_OPERATOR_REGEX",
+		6: "RULE_OPERATOR_SEMVER_GT",
+		7: "RULE_OPERATOR_SEMVER_LT",
+		8: "RULE_OPERATOR_IN_SEGMENT",
+		9: "RULE_OPERATOR_NOT_IN_SEGMENT",
+	}
+	RuleOperator_value = map[string]int32{
+		"RULE_OPERATOR_UNSPECIFIED":    0,
+		"RULE_OPERATOR_EQUALS":         1,
+		"RULE_OPERATOR_NOT_EQUALS":     2,
+		"RULE_OPERATOR_IN":             3,
+		"RULE_OPERATOR_NOT_IN":         4,
+		"RULE_OPERATOR_REGEX":          5,
+		"RULE_OPERATOR_SEMVER_GT":      6,
+		"RULE_OPERATOR_SEMVER_LT":      7,
+		"RULE_OPERATOR_IN_SEGMENT":     8,
+		"RULE_OPERATOR_NOT_IN_SEGMENT": 9,
+	}
+)
+
+func (x RuleOperator) Enum() *RuleOperator {
+	p := new(RuleOperator)
+	*p = x
+	return p
+}
+
+func (x RuleOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RuleOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[6].Descriptor()
+}
+
+func (RuleOperator) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[6]
+}
+
+func (x RuleOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RuleOperator.Descriptor instead.
+func (RuleOperator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{6}
+}
+
+// EvaluationReason enumerates the reason of an evaluation result.
+type EvaluationReason int32
+
+const (
+	// Default enum code according to
+	// https://medium.com/@akhaku/protobuf-definition-best-practices-87f281576f31.
+	EvaluationReason_EVALUATION_REASON_UNSPECIFIED EvaluationReason = 0
+	// Toggle is disabled, hence it is evaluated to false.
+	EvaluationReason_EVALUATION_REASON_DISABLED EvaluationReason = 1
+	// One of the toggle's rules matches the evaluation context.
+	EvaluationReason_EVALUATION_REASON_RULE_MATCH EvaluationReason = 2
+	// None of the toggle's rules matches, hence the default value is served.
+	EvaluationReason_EVALUATION_REASON_FALLTHROUGH EvaluationReason = 3
+	// None of the toggle's rules matches and the value is decided by the percentage rollout.
+	EvaluationReason_EVALUATION_REASON_ROLLOUT EvaluationReason = 4
+	// One of the toggle's prerequisites isn't evaluated to its required value, hence it is evaluated to false.
+	EvaluationReason_EVALUATION_REASON_PREREQUISITE_FAILED EvaluationReason = 5
+)
+
+// Enum value maps for EvaluationReason.
+var (
+	EvaluationReason_name = map[int32]string{
+		0: "EVALUATION_REASON_UNSPECIFIED",
+		1: "EVALUATION_REASON_DISABLED",
+		2: "EVALUATION_REASON_RULE_MATCH",
+		3: "EVALUATION_REASON_FALLTHROUGH",
+		4: "EVALUATION_REASON_ROLLOUT",
+		5: "EVALUATION_REASON_PREREQUISITE_FAILED",
+	}
+	EvaluationReason_value = map[string]int32{
+		"EVALUATION_REASON_UNSPECIFIED":         0,
+		"EVALUATION_REASON_DISABLED":            1,
+		"EVALUATION_REASON_RULE_MATCH":          2,
+		"EVALUATION_REASON_FALLTHROUGH":         3,
+		"EVALUATION_REASON_ROLLOUT":             4,
+		"EVALUATION_REASON_PREREQUISITE_FAILED": 5,
+	}
+)
+
+func (x EvaluationReason) Enum() *EvaluationReason {
+	p := new(EvaluationReason)
+	*p = x
+	return p
+}
+
+func (x EvaluationReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvaluationReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[7].Descriptor()
+}
+
+func (EvaluationReason) Type() protoreflect.EnumType {
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[7]
+}
+
+func (x EvaluationReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvaluationReason.Descriptor instead.
+func (EvaluationReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{7}
+}
+
 // ToggleErrorCode enumerates toggle error code.
 type ToggleErrorCode int32
 
@@ -51,29 +577,156 @@ const (
 	// Toggle's value (is_enabled field) is true and it can't be deleted.
 	// It must be disabled (is_enabled set to false) first before deletion.
 	ToggleErrorCode_TOGGLE_ERROR_CODE_PROHIBITED_TO_DELETE ToggleErrorCode = 7
+	// Toggle's rule is invalid.
+	// It can be triggered when the rule's attribute is empty, the operator is unknown,
+	// or the values can't be used with the operator.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_RULE ToggleErrorCode = 8
+	// Toggle's rollout is invalid.
+	// It can be triggered when the percentage is more than 100 or the bucketing attribute is empty.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_ROLLOUT ToggleErrorCode = 9
+	// Toggle's variants are invalid.
+	// It can be triggered when a variant's value doesn't match its type
+	// or the default, off, or rule's variant doesn't exist.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_VARIANT ToggleErrorCode = 10
+	// Environment's name is invalid.
+	// It can be triggered when the name is empty or contains character other than alphanumeric and dash.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_ENVIRONMENT ToggleErrorCode = 11
+	// Environment can't be found.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_ENVIRONMENT_NOT_FOUND ToggleErrorCode = 12
+	// Project's name is invalid.
+	// It can be triggered when the name is empty or contains character other than alphanumeric and dash.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_PROJECT ToggleErrorCode = 13
+	// Project can't be found.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_PROJECT_NOT_FOUND ToggleErrorCode = 14
+	// Project still owns toggles and it can't be deleted.
+	// All of its toggles must be deleted first before deletion.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_PROJECT_NOT_EMPTY ToggleErrorCode = 15
+	// Segment is invalid.
+	// It can be triggered when the key is empty or contains character other than alphanumeric and dash,
+	// or the segment's rules are invalid.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_SEGMENT ToggleErrorCode = 16
+	// Segment can't be found.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_SEGMENT_NOT_FOUND ToggleErrorCode = 17
+	// Segment is still referenced by toggle's rules and it can't be deleted.
+	// All rules referencing the segment must be removed first before deletion.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_SEGMENT_IN_USE ToggleErrorCode = 18
+	// Toggle's prerequisite is invalid.
+	// It can be triggered when the prerequisite refers to the toggle itself, a duplicated key,
+	// or a toggle that doesn't exist in the project.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_PREREQUISITE ToggleErrorCode = 19
+	// Toggle's prerequisites create a dependency cycle.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_PREREQUISITE_CYCLE ToggleErrorCode = 20
+	// Toggle is still a prerequisite of other toggles and it can't be deleted.
+	// The dependent toggles must drop it from their prerequisites first before deletion.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_HAS_DEPENDENTS ToggleErrorCode = 21
+	// Schedule is invalid.
+	// It can be triggered when the action is unknown or the execution time isn't in the future.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_SCHEDULE ToggleErrorCode = 22
+	// Schedule can't be found or it isn't pending anymore.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_SCHEDULE_NOT_FOUND ToggleErrorCode = 23
+	// Toggle's tag is invalid.
+	// It can be triggered when the tag has invalid format.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_TAG ToggleErrorCode = 24
+	// Listing query is invalid.
+	// It can be triggered when the order_by is unknown or the page_token is malformed or doesn't match the request.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_QUERY ToggleErrorCode = 25
+	// Update mask is invalid.
+	// It can be triggered when the update mask contains unknown or immutable field.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_UPDATE_MASK ToggleErrorCode = 26
+	// Toggle's version doesn't match the expected version.
+	// It can be triggered when the toggle is changed by someone else after it was read.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_VERSION_CONFLICT ToggleErrorCode = 27
+	// Watcher has fallen too far behind the toggle's events.
+	// The client should reconnect with its last resume token.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_WATCH_LAGGING ToggleErrorCode = 28
+	// Evaluation report is invalid.
+	// It can be triggered when there are too many counts, or a count has invalid key, empty variant,
+	// non-positive count, or empty window start.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_EVALUATION_REPORT ToggleErrorCode = 29
+	// Batch is invalid.
+	// It can be triggered when the batch is empty, has more than 100 items, has duplicate keys to create,
+	// or has an operation with unknown type.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_BATCH ToggleErrorCode = 30
+	// Snapshot is invalid.
+	// It can be triggered when the snapshot is empty, has unsupported format version, has invalid toggles,
+	// or has duplicate keys.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_INVALID_SNAPSHOT ToggleErrorCode = 31
+	// Toggle exists with a different content than the snapshot.
+	// It can be triggered when the conflict policy is fail, or when the conflict can't be overwritten.
+	ToggleErrorCode_TOGGLE_ERROR_CODE_IMPORT_CONFLICT ToggleErrorCode = 32
 )
 
 // Enum value maps for ToggleErrorCode.
 var (
 	ToggleErrorCode_name = map[int32]string{
-		0: "TOGGLE_ERROR_CODE_UNSPECIFIED",
-		1: "TOGGLE_ERROR_CODE_INTERNAL",
-		2: "TOGGLE_ERROR_CODE_EMPTY_TOGGLE",
-		3: "TOGGLE_ERROR_CODE_ALREADY_EXISTS",
-		4: "TOGGLE_ERROR_CODE_INVALID_KEY",
-		5: "TOGGLE_ERROR_CODE_INVALID_VALUE",
-		6: "TOGGLE_ERROR_CODE_NOT_FOUND",
-		7: "TOGGLE_ERROR_CODE_PROHIBITED_TO_DELETE",
+		0:  "TOGGLE_ERROR_CODE_UNSPECIFIED",
+		1:  "TOGGLE_ERROR_CODE_INTERNAL",
+		2:  "TOGGLE_ERROR_CODE_EMPTY_TOGGLE",
+		3:  "TOGGLE_ERROR_CODE_ALREADY_EXISTS",
+		4:  "TOGGLE_ERROR_CODE_INVALID_KEY",
+		5:  "TOGGLE_ERROR_CODE_INVALID_VALUE",
+		6:  "TOGGLE_ERROR_CODE_NOT_FOUND",
+		7:  "TOGGLE_ERROR_CODE_PROHIBITED_TO_DELETE",
+		8:  "TOGGLE_ERROR_CODE_INVALID_RULE",
+		9:  "TOGGLE_ERROR_CODE_INVALID_ROLLOUT",
+		10: "TOGGLE_ERROR_CODE_INVALID_VARIANT",
+		11: "TOGGLE_ERROR_CODE_INVALID_ENVIRONMENT",
+		12: "TOGGLE_ERROR_CODE_ENVIRONMENT_NOT_FOUND",
+		13: "TOGGLE_ERROR_CODE_INVALID_PROJECT",
+		14: "TOGGLE_ERROR_CODE_PROJECT_NOT_FOUND",
+		15: "TOGGLE_ERROR_CODE_PROJECT_NOT_EMPTY",
+		16: "TOGGLE_ERROR_CODE_INVALID_SEGMENT",
+		17: "TOGGLE_ERROR_CODE_SEGMENT_NOT_FOUND",
+		18: "TOGGLE_ERROR_CODE_SEGMENT_IN_USE",
+		19: "TOGGLE_ERROR_CODE_INVALID_PREREQUISITE",
+		20: "TOGGLE_ERROR_CODE_PREREQUISITE_CYCLE",
+		21: "TOGGLE_ERROR_CODE_HAS_DEPENDENTS",
+		22: "TOGGLE_ERROR_CODE_INVALID_SCHEDULE",
+		23: "TOGGLE_ERROR_CODE_SCHEDULE_NOT_FOUND",
+		24: "TOGGLE_ERROR_CODE_INVALID_TAG",
+		25: "TOGGLE_ERROR_CODE_INVALID_QUERY",
+		26: "TOGGLE_ERROR_CODE_INVALID_UPDATE_MASK",
+		27: "TOGGLE_ERROR_CODE_VERSION_CONFLICT",
+		28: "TOGGLE_ERROR_CODE_WATCH_LAGGING",
+		29: "TOGGLE_ERROR_CODE_INVALID_EVALUATION_REPORT",
+		30: "TOGGLE_ERROR_CODE_INVALID_BATCH",
+		31: "TOGGLE_ERROR_CODE_INVALID_SNAPSHOT",
+		32: "TOGGLE_ERROR_CODE_IMPORT_CONFLICT",
 	}
 	ToggleErrorCode_value = map[string]int32{
-		"TOGGLE_ERROR_CODE_UNSPECIFIED":          0,
-		"TOGGLE_ERROR_CODE_INTERNAL":             1,
-		"TOGGLE_ERROR_CODE_EMPTY_TOGGLE":         2,
-		"TOGGLE_ERROR_CODE_ALREADY_EXISTS":       3,
-		"TOGGLE_ERROR_CODE_INVALID_KEY":          4,
-		"TOGGLE_ERROR_CODE_INVALID_VALUE":        5,
-		"TOGGLE_ERROR_CODE_NOT_FOUND":            6,
-		"TOGGLE_ERROR_CODE_PROHIBITED_TO_DELETE": 7,
+		"TOGGLE_ERROR_CODE_UNSPECIFIED":               0,
+		"TOGGLE_ERROR_CODE_INTERNAL":                  1,
+		"TOGGLE_ERROR_CODE_EMPTY_TOGGLE":              2,
+		"TOGGLE_ERROR_CODE_ALREADY_EXISTS":            3,
+		"TOGGLE_ERROR_CODE_INVALID_KEY":               4,
+		"TOGGLE_ERROR_CODE_INVALID_VALUE":             5,
+		"TOGGLE_ERROR_CODE_NOT_FOUND":                 6,
+		"TOGGLE_ERROR_CODE_PROHIBITED_TO_DELETE":      7,
+		"TOGGLE_ERROR_CODE_INVALID_RULE":              8,
+		"TOGGLE_ERROR_CODE_INVALID_ROLLOUT":           9,
+		"TOGGLE_ERROR_CODE_INVALID_VARIANT":           10,
+		"TOGGLE_ERROR_CODE_INVALID_ENVIRONMENT":       11,
+		"TOGGLE_ERROR_CODE_ENVIRONMENT_NOT_FOUND":     12,
+		"TOGGLE_ERROR_CODE_INVALID_PROJECT":           13,
+		"TOGGLE_ERROR_CODE_PROJECT_NOT_FOUND":         14,
+		"TOGGLE_ERROR_CODE_PROJECT_NOT_EMPTY":         15,
+		"TOGGLE_ERROR_CODE_INVALID_SEGMENT":           16,
+		"TOGGLE_ERROR_CODE_SEGMENT_NOT_FOUND":         17,
+		"TOGGLE_ERROR_CODE_SEGMENT_IN_USE":            18,
+		"TOGGLE_ERROR_CODE_INVALID_PREREQUISITE":      19,
+		"TOGGLE_ERROR_CODE_PREREQUISITE_CYCLE":        20,
+		"TOGGLE_ERROR_CODE_HAS_DEPENDENTS":            21,
+		"TOGGLE_ERROR_CODE_INVALID_SCHEDULE":          22,
+		"TOGGLE_ERROR_CODE_SCHEDULE_NOT_FOUND":        23,
+		"TOGGLE_ERROR_CODE_INVALID_TAG":               24,
+		"TOGGLE_ERROR_CODE_INVALID_QUERY":             25,
+		"TOGGLE_ERROR_CODE_INVALID_UPDATE_MASK":       26,
+		"TOGGLE_ERROR_CODE_VERSION_CONFLICT":          27,
+		"TOGGLE_ERROR_CODE_WATCH_LAGGING":             28,
+		"TOGGLE_ERROR_CODE_INVALID_EVALUATION_REPORT": 29,
+		"TOGGLE_ERROR_CODE_INVALID_BATCH":             30,
+		"TOGGLE_ERROR_CODE_INVALID_SNAPSHOT":          31,
+		"TOGGLE_ERROR_CODE_IMPORT_CONFLICT":           32,
 	}
 )
 
@@ -88,11 +741,11 @@ func (x ToggleErrorCode) String() string {
 }
 
 func (ToggleErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[0].Descriptor()
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[8].Descriptor()
 }
 
 func (ToggleErrorCode) Type() protoreflect.EnumType {
-	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[0]
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[8]
 }
 
 func (x ToggleErrorCode) Number() protoreflect.EnumNumber {
@@ -101,7 +754,7 @@ func (x ToggleErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ToggleErrorCode.Descriptor instead.
 func (ToggleErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{0}
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{8}
 }
 
 // ToggleEventName enumerates toggle event name.
@@ -117,8 +770,14 @@ const (
 	ToggleEventName_TOGGLE_EVENT_NAME_ENABLED ToggleEventName = 2
 	// Occur when toggle is disabled.
 	ToggleEventName_TOGGLE_EVENT_NAME_DISABLED ToggleEventName = 3
-	// Occur when toggle is deleted.
+	// Occur when toggle is gone forever.
+	// A soft-deleted toggle is only announced once it is purged, either right away or after the retention period.
 	ToggleEventName_TOGGLE_EVENT_NAME_DELETED ToggleEventName = 4
+	// Occur when toggle's expiry time has passed.
+	// It is published once per toggle and it isn't scoped to any environment.
+	ToggleEventName_TOGGLE_EVENT_NAME_EXPIRED ToggleEventName = 5
+	// Occur when toggle's description, owner, expiry time, or tags are updated.
+	ToggleEventName_TOGGLE_EVENT_NAME_UPDATED ToggleEventName = 6
 )
 
 // Enum value maps for ToggleEventName.
@@ -129,6 +788,8 @@ var (
 		2: "TOGGLE_EVENT_NAME_ENABLED",
 		3: "TOGGLE_EVENT_NAME_DISABLED",
 		4: "TOGGLE_EVENT_NAME_DELETED",
+		5: "TOGGLE_EVENT_NAME_EXPIRED",
+		6: "TOGGLE_EVENT_NAME_UPDATED",
 	}
 	ToggleEventName_value = map[string]int32{
 		"TOGGLE_EVENT_NAME_UNSPECIFIED": 0,
@@ -136,6 +797,8 @@ var (
 		"TOGGLE_EVENT_NAME_ENABLED":     2,
 		"TOGGLE_EVENT_NAME_DISABLED":    3,
 		"TOGGLE_EVENT_NAME_DELETED":     4,
+		"TOGGLE_EVENT_NAME_EXPIRED":     5,
+		"TOGGLE_EVENT_NAME_UPDATED":     6,
 	}
 )
 
@@ -150,11 +813,11 @@ func (x ToggleEventName) String() string {
 }
 
 func (ToggleEventName) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[1].Descriptor()
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[9].Descriptor()
 }
 
 func (ToggleEventName) Type() protoreflect.EnumType {
-	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[1]
+	return &file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes[9]
 }
 
 func (x ToggleEventName) Number() protoreflect.EnumNumber {
@@ -163,7 +826,7 @@ func (x ToggleEventName) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ToggleEventName.Descriptor instead.
 func (ToggleEventName) EnumDescriptor() ([]byte, []int) {
-	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{1}
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{9}
 }
 
 // CreateToggleRequest represents request for create toggle.
@@ -174,6 +837,10 @@ type CreateToggleRequest struct {
 
 	// toggle represents toggle data.
 	Toggle *Toggle `protobuf:"bytes,1,opt,name=toggle,proto3" json:"toggle,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
 }
 
 func (x *CreateToggleRequest) Reset() {
@@ -215,6 +882,20 @@ func (x *CreateToggleRequest) GetToggle() *Toggle {
 	return nil
 }
 
+func (x *CreateToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *CreateToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
 // CreateToggleResponse represents response from create toggle.
 type CreateToggleResponse struct {
 	state         protoimpl.MessageState
@@ -254,18 +935,32 @@ func (*CreateToggleResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{1}
 }
 
-// GetToggleByKeyRequest represents request for get toggle by key.
-type GetToggleByKeyRequest struct {
+// UpdateToggleRequest represents request for update toggle.
+type UpdateToggleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// key represents unique toggle's key.
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// toggle represents toggle data.
+	// Only the fields listed in the update mask are read.
+	Toggle *Toggle `protobuf:"bytes,4,opt,name=toggle,proto3" json:"toggle,omitempty"`
+	// update_mask represents the toggle's fields to update: description, owner, expires_at, and tags.
+	// Empty update mask means all of those fields.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
 }
 
-func (x *GetToggleByKeyRequest) Reset() {
-	*x = GetToggleByKeyRequest{}
+func (x *UpdateToggleRequest) Reset() {
+	*x = UpdateToggleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -273,13 +968,13 @@ func (x *GetToggleByKeyRequest) Reset() {
 	}
 }
 
-func (x *GetToggleByKeyRequest) String() string {
+func (x *UpdateToggleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetToggleByKeyRequest) ProtoMessage() {}
+func (*UpdateToggleRequest) ProtoMessage() {}
 
-func (x *GetToggleByKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateToggleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -291,30 +986,65 @@ func (x *GetToggleByKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetToggleByKeyRequest.ProtoReflect.Descriptor instead.
-func (*GetToggleByKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateToggleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateToggleRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetToggleByKeyRequest) GetKey() string {
+func (x *UpdateToggleRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-// GetToggleByKeyResponse represents response from get toggle by key.
-type GetToggleByKeyResponse struct {
+func (x *UpdateToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *UpdateToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateToggleRequest) GetToggle() *Toggle {
+	if x != nil {
+		return x.Toggle
+	}
+	return nil
+}
+
+func (x *UpdateToggleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateToggleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// UpdateToggleResponse represents response from update toggle.
+type UpdateToggleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// toggle represents toggle data.
+	// toggle represents the updated toggle data.
 	Toggle *Toggle `protobuf:"bytes,1,opt,name=toggle,proto3" json:"toggle,omitempty"`
 }
 
-func (x *GetToggleByKeyResponse) Reset() {
-	*x = GetToggleByKeyResponse{}
+func (x *UpdateToggleResponse) Reset() {
+	*x = UpdateToggleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,13 +1052,13 @@ func (x *GetToggleByKeyResponse) Reset() {
 	}
 }
 
-func (x *GetToggleByKeyResponse) String() string {
+func (x *UpdateToggleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetToggleByKeyResponse) ProtoMessage() {}
+func (*UpdateToggleResponse) ProtoMessage() {}
 
-func (x *GetToggleByKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateToggleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -340,27 +1070,34 @@ func (x *GetToggleByKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetToggleByKeyResponse.ProtoReflect.Descriptor instead.
-func (*GetToggleByKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateToggleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateToggleResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetToggleByKeyResponse) GetToggle() *Toggle {
+func (x *UpdateToggleResponse) GetToggle() *Toggle {
 	if x != nil {
 		return x.Toggle
 	}
 	return nil
 }
 
-// GetAllTogglesRequest represents request for get all toggles.
-type GetAllTogglesRequest struct {
+// GetToggleByKeyRequest represents request for get toggle by key.
+type GetToggleByKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *GetAllTogglesRequest) Reset() {
-	*x = GetAllTogglesRequest{}
+func (x *GetToggleByKeyRequest) Reset() {
+	*x = GetToggleByKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -368,13 +1105,13 @@ func (x *GetAllTogglesRequest) Reset() {
 	}
 }
 
-func (x *GetAllTogglesRequest) String() string {
+func (x *GetToggleByKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTogglesRequest) ProtoMessage() {}
+func (*GetToggleByKeyRequest) ProtoMessage() {}
 
-func (x *GetAllTogglesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetToggleByKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -386,23 +1123,44 @@ func (x *GetAllTogglesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTogglesRequest.ProtoReflect.Descriptor instead.
-func (*GetAllTogglesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetToggleByKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetToggleByKeyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{4}
 }
 
-// GetAllTogglesResponse represents response from get all toggles.
-type GetAllTogglesResponse struct {
+func (x *GetToggleByKeyRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GetToggleByKeyRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *GetToggleByKeyRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// GetToggleByKeyResponse represents response from get toggle by key.
+type GetToggleByKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// toggles represents an array of toggle data.
-	Toggles []*Toggle `protobuf:"bytes,1,rep,name=toggles,proto3" json:"toggles,omitempty"`
+	// toggle represents toggle data.
+	Toggle *Toggle `protobuf:"bytes,1,opt,name=toggle,proto3" json:"toggle,omitempty"`
 }
 
-func (x *GetAllTogglesResponse) Reset() {
-	*x = GetAllTogglesResponse{}
+func (x *GetToggleByKeyResponse) Reset() {
+	*x = GetToggleByKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -410,13 +1168,13 @@ func (x *GetAllTogglesResponse) Reset() {
 	}
 }
 
-func (x *GetAllTogglesResponse) String() string {
+func (x *GetToggleByKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTogglesResponse) ProtoMessage() {}
+func (*GetToggleByKeyResponse) ProtoMessage() {}
 
-func (x *GetAllTogglesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetToggleByKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,30 +1186,57 @@ func (x *GetAllTogglesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTogglesResponse.ProtoReflect.Descriptor instead.
-func (*GetAllTogglesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetToggleByKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetToggleByKeyResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetAllTogglesResponse) GetToggles() []*Toggle {
+func (x *GetToggleByKeyResponse) GetToggle() *Toggle {
 	if x != nil {
-		return x.Toggles
+		return x.Toggle
 	}
 	return nil
 }
 
-// EnableToggleRequest represents request for enable a toggle.
-type EnableToggleRequest struct {
+// GetAllTogglesRequest represents request for get all toggles.
+type GetAllTogglesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// key represents unique toggle's key.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// any_tags represents the tags of which the toggle must have at least one.
+	// It is ignored if it is empty.
+	AnyTags []string `protobuf:"bytes,3,rep,name=any_tags,json=anyTags,proto3" json:"any_tags,omitempty"`
+	// all_tags represents the tags the toggle must have.
+	// It is ignored if it is empty.
+	AllTags []string `protobuf:"bytes,4,rep,name=all_tags,json=allTags,proto3" json:"all_tags,omitempty"`
+	// page_size represents the maximum number of toggles returned in a page.
+	// It defaults to 10 if it is not set and it is capped at 100.
+	PageSize uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token represents the next_page_token returned by the previous page.
+	// The rest of the request, except page_size, must be the same as the previous page's request.
+	// It is empty for the first page.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// order_by represents the field used to sort the toggles: key, created_at, or updated_at.
+	// The field can be followed by " desc" to sort descending. It defaults to key.
+	OrderBy string `protobuf:"bytes,7,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// key_prefix represents the prefix the toggle's key must start with.
+	// It is ignored if it is empty.
+	KeyPrefix string `protobuf:"bytes,8,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// search represents the words the toggle's description must contain.
+	// It is ignored if it is empty.
+	Search string `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
+	// include_deleted represents whether the soft-deleted toggles are listed as well.
+	// The soft-deleted toggles have deleted_at set.
+	IncludeDeleted bool `protobuf:"varint,10,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
 }
 
-func (x *EnableToggleRequest) Reset() {
-	*x = EnableToggleRequest{}
+func (x *GetAllTogglesRequest) Reset() {
+	*x = GetAllTogglesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,13 +1244,13 @@ func (x *EnableToggleRequest) Reset() {
 	}
 }
 
-func (x *EnableToggleRequest) String() string {
+func (x *GetAllTogglesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableToggleRequest) ProtoMessage() {}
+func (*GetAllTogglesRequest) ProtoMessage() {}
 
-func (x *EnableToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAllTogglesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,27 +1262,98 @@ func (x *EnableToggleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableToggleRequest.ProtoReflect.Descriptor instead.
-func (*EnableToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllTogglesRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTogglesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *EnableToggleRequest) GetKey() string {
+func (x *GetAllTogglesRequest) GetEnvironment() string {
 	if x != nil {
-		return x.Key
+		return x.Environment
 	}
 	return ""
 }
 
-// EnableToggleResponse represents request from enable a toggle.
-type EnableToggleResponse struct {
+func (x *GetAllTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetAllTogglesRequest) GetAnyTags() []string {
+	if x != nil {
+		return x.AnyTags
+	}
+	return nil
+}
+
+func (x *GetAllTogglesRequest) GetAllTags() []string {
+	if x != nil {
+		return x.AllTags
+	}
+	return nil
+}
+
+func (x *GetAllTogglesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAllTogglesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetAllTogglesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetAllTogglesRequest) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *GetAllTogglesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetAllTogglesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+// GetAllTogglesResponse represents response from get all toggles.
+type GetAllTogglesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// toggles represents an array of toggle data.
+	Toggles []*Toggle `protobuf:"bytes,1,rep,name=toggles,proto3" json:"toggles,omitempty"`
+	// next_page_token represents the token to retrieve the next page.
+	// It is empty if there isn't any next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// total_size represents the number of toggles which satisfy the request's filter across all pages.
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 }
 
-func (x *EnableToggleResponse) Reset() {
-	*x = EnableToggleResponse{}
+func (x *GetAllTogglesResponse) Reset() {
+	*x = GetAllTogglesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +1361,13 @@ func (x *EnableToggleResponse) Reset() {
 	}
 }
 
-func (x *EnableToggleResponse) String() string {
+func (x *GetAllTogglesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnableToggleResponse) ProtoMessage() {}
+func (*GetAllTogglesResponse) ProtoMessage() {}
 
-func (x *EnableToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAllTogglesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,23 +1379,50 @@ func (x *EnableToggleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnableToggleResponse.ProtoReflect.Descriptor instead.
-func (*EnableToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAllTogglesResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTogglesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{7}
 }
 
-// DisableToggleRequest represents request for disable a toggle.
-type DisableToggleRequest struct {
+func (x *GetAllTogglesResponse) GetToggles() []*Toggle {
+	if x != nil {
+		return x.Toggles
+	}
+	return nil
+}
+
+func (x *GetAllTogglesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetAllTogglesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// EvaluateToggleRequest represents request for evaluate a toggle.
+type EvaluateToggleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// key represents unique toggle's key.
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// context represents the attributes used to evaluate the toggle's rules.
+	Context map[string]string `protobuf:"bytes,2,rep,name=context,proto3" json:"context,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,3,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,4,opt,name=project,proto3" json:"project,omitempty"`
 }
 
-func (x *DisableToggleRequest) Reset() {
-	*x = DisableToggleRequest{}
+func (x *EvaluateToggleRequest) Reset() {
+	*x = EvaluateToggleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,13 +1430,13 @@ func (x *DisableToggleRequest) Reset() {
 	}
 }
 
-func (x *DisableToggleRequest) String() string {
+func (x *EvaluateToggleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableToggleRequest) ProtoMessage() {}
+func (*EvaluateToggleRequest) ProtoMessage() {}
 
-func (x *DisableToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *EvaluateToggleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -565,27 +1448,64 @@ func (x *DisableToggleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableToggleRequest.ProtoReflect.Descriptor instead.
-func (*DisableToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EvaluateToggleRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateToggleRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DisableToggleRequest) GetKey() string {
+func (x *EvaluateToggleRequest) GetKey() string {
 	if x != nil {
 		return x.Key
 	}
 	return ""
 }
 
-// DisableToggleResponse represents request from disable a toggle.
-type DisableToggleResponse struct {
+func (x *EvaluateToggleRequest) GetContext() map[string]string {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *EvaluateToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *EvaluateToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// EvaluateToggleResponse represents response from evaluate a toggle.
+type EvaluateToggleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// value represents the resolved value of the toggle.
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// reason represents why the value is resolved.
+	Reason EvaluationReason `protobuf:"varint,2,opt,name=reason,proto3,enum=proto.indrasaputra.toggle.v1.EvaluationReason" json:"reason,omitempty"`
+	// matched_rule represents the rule that matched the evaluation context.
+	// It is empty if none of the rules matches.
+	MatchedRule *Rule `protobuf:"bytes,3,opt,name=matched_rule,json=matchedRule,proto3" json:"matched_rule,omitempty"`
+	// variant represents the resolved variant of a multivariate toggle.
+	// It is empty if the toggle doesn't have any variant.
+	Variant *Variant `protobuf:"bytes,4,opt,name=variant,proto3" json:"variant,omitempty"`
+	// failed_prerequisite represents the key of the first prerequisite that isn't satisfied.
+	// It is empty if all prerequisites are satisfied.
+	FailedPrerequisite string `protobuf:"bytes,5,opt,name=failed_prerequisite,json=failedPrerequisite,proto3" json:"failed_prerequisite,omitempty"`
+	// version represents the version of the evaluated toggle.
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *DisableToggleResponse) Reset() {
-	*x = DisableToggleResponse{}
+func (x *EvaluateToggleResponse) Reset() {
+	*x = EvaluateToggleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -593,13 +1513,13 @@ func (x *DisableToggleResponse) Reset() {
 	}
 }
 
-func (x *DisableToggleResponse) String() string {
+func (x *EvaluateToggleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisableToggleResponse) ProtoMessage() {}
+func (*EvaluateToggleResponse) ProtoMessage() {}
 
-func (x *DisableToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *EvaluateToggleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -611,37 +1531,84 @@ func (x *DisableToggleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisableToggleResponse.ProtoReflect.Descriptor instead.
-func (*DisableToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EvaluateToggleResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateToggleResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{9}
 }
 
-// DeleteToggleRequest represents request for delete a toggle.
-type DeleteToggleRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *EvaluateToggleResponse) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
 
-	// key represents unique toggle's key.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (x *EvaluateToggleResponse) GetReason() EvaluationReason {
+	if x != nil {
+		return x.Reason
+	}
+	return EvaluationReason_EVALUATION_REASON_UNSPECIFIED
 }
 
-func (x *DeleteToggleRequest) Reset() {
-	*x = DeleteToggleRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[10]
+func (x *EvaluateToggleResponse) GetMatchedRule() *Rule {
+	if x != nil {
+		return x.MatchedRule
+	}
+	return nil
+}
+
+func (x *EvaluateToggleResponse) GetVariant() *Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+func (x *EvaluateToggleResponse) GetFailedPrerequisite() string {
+	if x != nil {
+		return x.FailedPrerequisite
+	}
+	return ""
+}
+
+func (x *EvaluateToggleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// GetStaleTogglesRequest represents request for get stale toggles.
+type GetStaleTogglesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// stale_after_days represents the number of days a toggle's state must stay unchanged to be stale.
+	// It defaults to 30 if it is not set.
+	StaleAfterDays uint32 `protobuf:"varint,3,opt,name=stale_after_days,json=staleAfterDays,proto3" json:"stale_after_days,omitempty"`
+}
+
+func (x *GetStaleTogglesRequest) Reset() {
+	*x = GetStaleTogglesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteToggleRequest) String() string {
+func (x *GetStaleTogglesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteToggleRequest) ProtoMessage() {}
+func (*GetStaleTogglesRequest) ProtoMessage() {}
 
-func (x *DeleteToggleRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStaleTogglesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -653,27 +1620,44 @@ func (x *DeleteToggleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteToggleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteToggleRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStaleTogglesRequest.ProtoReflect.Descriptor instead.
+func (*GetStaleTogglesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteToggleRequest) GetKey() string {
+func (x *GetStaleTogglesRequest) GetEnvironment() string {
 	if x != nil {
-		return x.Key
+		return x.Environment
 	}
 	return ""
 }
 
-// DeleteToggleResponse represents request from delete a toggle.
-type DeleteToggleResponse struct {
+func (x *GetStaleTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetStaleTogglesRequest) GetStaleAfterDays() uint32 {
+	if x != nil {
+		return x.StaleAfterDays
+	}
+	return 0
+}
+
+// GetStaleTogglesResponse represents response from get stale toggles.
+type GetStaleTogglesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// stale_toggles represents an array of stale toggle data.
+	StaleToggles []*StaleToggle `protobuf:"bytes,1,rep,name=stale_toggles,json=staleToggles,proto3" json:"stale_toggles,omitempty"`
 }
 
-func (x *DeleteToggleResponse) Reset() {
-	*x = DeleteToggleResponse{}
+func (x *GetStaleTogglesResponse) Reset() {
+	*x = GetStaleTogglesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +1665,13 @@ func (x *DeleteToggleResponse) Reset() {
 	}
 }
 
-func (x *DeleteToggleResponse) String() string {
+func (x *GetStaleTogglesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteToggleResponse) ProtoMessage() {}
+func (*GetStaleTogglesResponse) ProtoMessage() {}
 
-func (x *DeleteToggleResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStaleTogglesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,31 +1683,47 @@ func (x *DeleteToggleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteToggleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteToggleResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStaleTogglesResponse.ProtoReflect.Descriptor instead.
+func (*GetStaleTogglesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{11}
 }
 
-// Toggle represents a toggle data.
-type Toggle struct {
+func (x *GetStaleTogglesResponse) GetStaleToggles() []*StaleToggle {
+	if x != nil {
+		return x.StaleToggles
+	}
+	return nil
+}
+
+// ListToggleHistoryRequest represents request for list toggle history.
+type ListToggleHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// key represents a unique identifier of a toggle.
-	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	// is_enabled represents the toggle's usability status.
-	IsEnabled bool `protobuf:"varint,2,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
-	// description represents a concise description of a toggle.
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// created_at represents when the toggle was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// updated_at represents when the toggle was last updated.
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// key represents the toggle's key whose history is listed.
+	// Empty key means all toggles in the project.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// actor represents who made the changes.
+	// Empty actor means anyone.
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// start_time represents the earliest time of the changes, inclusive.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time represents the latest time of the changes, exclusive.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// page_size represents the maximum number of entries returned in a page.
+	// It defaults to 10 and it is capped at 100.
+	PageSize uint32 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token represents the next_page_token returned by the previous page.
+	// The rest of the request, except page_size, must be the same as the previous page's request.
+	// It is empty for the first page.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *Toggle) Reset() {
-	*x = Toggle{}
+func (x *ListToggleHistoryRequest) Reset() {
+	*x = ListToggleHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -731,13 +1731,13 @@ func (x *Toggle) Reset() {
 	}
 }
 
-func (x *Toggle) String() string {
+func (x *ListToggleHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Toggle) ProtoMessage() {}
+func (*ListToggleHistoryRequest) ProtoMessage() {}
 
-func (x *Toggle) ProtoReflect() protoreflect.Message {
+func (x *ListToggleHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -749,58 +1749,75 @@ func (x *Toggle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Toggle.ProtoReflect.Descriptor instead.
-func (*Toggle) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListToggleHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListToggleHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Toggle) GetKey() string {
+func (x *ListToggleHistoryRequest) GetProject() string {
 	if x != nil {
-		return x.Key
+		return x.Project
 	}
 	return ""
 }
 
-func (x *Toggle) GetIsEnabled() bool {
+func (x *ListToggleHistoryRequest) GetKey() string {
 	if x != nil {
-		return x.IsEnabled
+		return x.Key
 	}
-	return false
+	return ""
 }
 
-func (x *Toggle) GetDescription() string {
+func (x *ListToggleHistoryRequest) GetActor() string {
 	if x != nil {
-		return x.Description
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *Toggle) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ListToggleHistoryRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *Toggle) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *ListToggleHistoryRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.EndTime
 	}
 	return nil
 }
 
-// ToggleError represents message for any error happening in toggle.
-type ToggleError struct {
+func (x *ListToggleHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListToggleHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListToggleHistoryResponse represents response from list toggle history.
+type ListToggleHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// error_code represents specific and unique error code for toggle.
-	ErrorCode ToggleErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=proto.indrasaputra.toggle.v1.ToggleErrorCode" json:"error_code,omitempty"`
+	// entries represents the audit log's entries in the page, newest first.
+	Entries []*ToggleAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// next_page_token represents the token to retrieve the next page.
+	// It is empty if there isn't any next page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *ToggleError) Reset() {
-	*x = ToggleError{}
+func (x *ListToggleHistoryResponse) Reset() {
+	*x = ListToggleHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,13 +1825,13 @@ func (x *ToggleError) Reset() {
 	}
 }
 
-func (x *ToggleError) String() string {
+func (x *ListToggleHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleError) ProtoMessage() {}
+func (*ListToggleHistoryResponse) ProtoMessage() {}
 
-func (x *ToggleError) ProtoReflect() protoreflect.Message {
+func (x *ListToggleHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,34 +1843,45 @@ func (x *ToggleError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleError.ProtoReflect.Descriptor instead.
-func (*ToggleError) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListToggleHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListToggleHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ToggleError) GetErrorCode() ToggleErrorCode {
+func (x *ListToggleHistoryResponse) GetEntries() []*ToggleAuditEntry {
 	if x != nil {
-		return x.ErrorCode
+		return x.Entries
 	}
-	return ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED
+	return nil
 }
 
-// ToggleEvent represents an event of a toggle.
-type ToggleEvent struct {
+func (x *ListToggleHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetToggleUsageRequest represents request for get toggles' usage.
+type GetToggleUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// name represents event's name.
-	Name ToggleEventName `protobuf:"varint,1,opt,name=name,proto3,enum=proto.indrasaputra.toggle.v1.ToggleEventName" json:"name,omitempty"`
-	// toggle represents the toggle in the event.
-	Toggle *Toggle `protobuf:"bytes,2,opt,name=toggle,proto3" json:"toggle,omitempty"`
-	// created_at represents when the event was created.
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// environment represents the name of the environment the toggles are evaluated in.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// keys represents the keys of the toggles whose usage is retrieved.
+	// Empty keys means all toggles in the environment.
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	// start_time represents the earliest time of the evaluations, rounded down to the hour.
+	// Empty start_time means all evaluations ever reported.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
-func (x *ToggleEvent) Reset() {
-	*x = ToggleEvent{}
+func (x *GetToggleUsageRequest) Reset() {
+	*x = GetToggleUsageRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -861,13 +1889,13 @@ func (x *ToggleEvent) Reset() {
 	}
 }
 
-func (x *ToggleEvent) String() string {
+func (x *GetToggleUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleEvent) ProtoMessage() {}
+func (*GetToggleUsageRequest) ProtoMessage() {}
 
-func (x *ToggleEvent) ProtoReflect() protoreflect.Message {
+func (x *GetToggleUsageRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -879,375 +1907,5346 @@ func (x *ToggleEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleEvent.ProtoReflect.Descriptor instead.
-func (*ToggleEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetToggleUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetToggleUsageRequest) Descriptor() ([]byte, []int) {
 	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ToggleEvent) GetName() ToggleEventName {
+func (x *GetToggleUsageRequest) GetEnvironment() string {
 	if x != nil {
-		return x.Name
+		return x.Environment
 	}
-	return ToggleEventName_TOGGLE_EVENT_NAME_UNSPECIFIED
+	return ""
 }
 
-func (x *ToggleEvent) GetToggle() *Toggle {
+func (x *GetToggleUsageRequest) GetProject() string {
 	if x != nil {
-		return x.Toggle
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetToggleUsageRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
 	}
 	return nil
 }
 
-func (x *ToggleEvent) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetToggleUsageRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.StartTime
 	}
 	return nil
 }
 
-var File_proto_indrasaputra_toggle_v1_toggle_proto protoreflect.FileDescriptor
+// GetToggleUsageResponse represents response from get toggles' usage.
+type GetToggleUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_indrasaputra_toggle_v1_toggle_proto_rawDesc = []byte{
-	0x0a, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
-	0x75, 0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
-	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
-	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x13, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3c, 0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
-	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x22, 0x16,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41,
-	0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75,
-	0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
-	0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
-	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x22, 0x16, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
-	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x52, 0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x22, 0x6a, 0x0a,
-	0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e,
-	0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01,
-	0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x45, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x6b, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f,
-	0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f,
-	0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32,
-	0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x17,
-	0x0a, 0x15, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6a, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e,
-	0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a,
-	0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62,
-	0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb3, 0x03, 0x0a, 0x06,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61,
-	0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f,
-	0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01,
-	0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x51, 0x0a, 0x0a, 0x69,
-	0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x32, 0x92, 0x41, 0x2b, 0x32, 0x19, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x27, 0x73, 0x20, 0x75,
-	0x73, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a,
-	0x04, 0x74, 0x72, 0x75, 0x65, 0xa2, 0x02, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0xe2,
-	0x41, 0x01, 0x03, 0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x7f,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x5d, 0x92, 0x41, 0x5a, 0x32, 0x21, 0x41, 0x20, 0x63, 0x6f, 0x6e, 0x63,
-	0x69, 0x73, 0x65, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-	0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x2f, 0x22, 0x74, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x2f, 0x75, 0x6e, 0x73, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x20, 0x64, 0x72, 0x6f, 0x70, 0x64,
-	0x6f, 0x77, 0x6e, 0x20, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0xff, 0x01, 0x80,
-	0x01, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3f, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x3f, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0x5b, 0x0a, 0x0b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x4c, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64,
-	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8d,
-	0x02, 0x0a, 0x0b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x7f,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72,
-	0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x3c, 0x92, 0x41, 0x39,
-	0x32, 0x20, 0x41, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x73, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x4a, 0x0f, 0x22, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
-	0x45, 0x44, 0x22, 0x78, 0xff, 0x01, 0x80, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x3c, 0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
-	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x3f, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2,
-	0x41, 0x01, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xb3,
-	0x02, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59,
-	0x5f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4f, 0x47,
-	0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41,
-	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12,
-	0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
-	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4b, 0x45, 0x59,
-	0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
-	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x05, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x47, 0x47, 0x4c,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x06, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x4f, 0x47, 0x47,
-	0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52,
-	0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45,
-	0x54, 0x45, 0x10, 0x07, 0x2a, 0xb1, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x47, 0x47,
-	0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x54,
-	0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
-	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f,
-	0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f,
-	0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x47,
-	0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44,
-	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x47,
-	0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44,
-	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x32, 0xfb, 0x06, 0x0a, 0x14, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0xab, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61,
-	0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
-	0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x92, 0x41, 0x16, 0x0a, 0x06,
-	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x3a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12,
-	0xb0, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
-	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
-	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x92, 0x41, 0x16, 0x0a, 0x06, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x1a, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0xb5, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64,
-	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x92,
-	0x41, 0x17, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0d, 0x44, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x1a,
-	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65,
-	0x79, 0x7d, 0x2f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0xa9, 0x01, 0x0a, 0x0c, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
-	0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
-	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x32, 0x92, 0x41, 0x16, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a,
-	0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
-	0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x1a, 0x9d, 0x01, 0x92, 0x41, 0x99, 0x01, 0x12, 0x96, 0x01,
-	0x54, 0x68, 0x69, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x70, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x73, 0x20, 0x62, 0x61, 0x73, 0x69, 0x63, 0x20, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x20, 0x63, 0x61, 0x73, 0x65, 0x73, 0x20,
-	0x74, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x66, 0x65, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x2d, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x41, 0x20, 0x74, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e,
-	0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x61, 0x73, 0x20,
-	0x69, 0x74, 0x73, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x32, 0x91, 0x04, 0x0a, 0x12, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb1, 0x01,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79,
-	0x12, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
-	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
-	0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x79,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x92, 0x41, 0x18,
-	0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79,
-	0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
-	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x92, 0x41,
-	0x17, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x1a, 0x9c, 0x01, 0x92, 0x41,
-	0x98, 0x01, 0x12, 0x95, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x20, 0x62, 0x61, 0x73, 0x69, 0x63,
-	0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x20, 0x6f, 0x72, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x72,
-	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x20, 0x63, 0x61,
-	0x73, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68,
-	0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
-	0x41, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x6b, 0x65, 0x79,
-	0x20, 0x61, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x42, 0xa3, 0x02, 0x5a, 0x44, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
-	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
-	0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x76, 0x31, 0x92, 0x41, 0xd9, 0x01, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x2d, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x41, 0x50, 0x49, 0x22, 0x30,
-	0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x72, 0x61, 0x20, 0x53, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x12,
-	0x1f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
-	0x2a, 0x50, 0x0a, 0x14, 0x42, 0x53, 0x44, 0x20, 0x33, 0x2d, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65,
-	0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a,
-	0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64,
-	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e,
-	0x53, 0x45, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x31, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// usages represents the usage of the toggles, sorted by key.
+	Usages []*ToggleUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
 }
 
-var (
-	file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescOnce sync.Once
-	file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData = file_proto_indrasaputra_toggle_v1_toggle_proto_rawDesc
-)
+func (x *GetToggleUsageResponse) Reset() {
+	*x = GetToggleUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP() []byte {
-	file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescOnce.Do(func() {
-		file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData)
-	})
-	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData
+func (x *GetToggleUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_proto_indrasaputra_toggle_v1_toggle_proto_goTypes = []interface{}{
-	(ToggleErrorCode)(0),           // 0: proto.indrasaputra.toggle.v1.ToggleErrorCode
-	(ToggleEventName)(0),           // 1: proto.indrasaputra.toggle.v1.ToggleEventName
-	(*CreateToggleRequest)(nil),    // 2: proto.indrasaputra.toggle.v1.CreateToggleRequest
-	(*CreateToggleResponse)(nil),   // 3: proto.indrasaputra.toggle.v1.CreateToggleResponse
-	(*GetToggleByKeyRequest)(nil),  // 4: proto.indrasaputra.toggle.v1.GetToggleByKeyRequest
-	(*GetToggleByKeyResponse)(nil), // 5: proto.indrasaputra.toggle.v1.GetToggleByKeyResponse
-	(*GetAllTogglesRequest)(nil),   // 6: proto.indrasaputra.toggle.v1.GetAllTogglesRequest
-	(*GetAllTogglesResponse)(nil),  // 7: proto.indrasaputra.toggle.v1.GetAllTogglesResponse
-	(*EnableToggleRequest)(nil),    // 8: proto.indrasaputra.toggle.v1.EnableToggleRequest
-	(*EnableToggleResponse)(nil),   // 9: proto.indrasaputra.toggle.v1.EnableToggleResponse
-	(*DisableToggleRequest)(nil),   // 10: proto.indrasaputra.toggle.v1.DisableToggleRequest
-	(*DisableToggleResponse)(nil),  // 11: proto.indrasaputra.toggle.v1.DisableToggleResponse
-	(*DeleteToggleRequest)(nil),    // 12: proto.indrasaputra.toggle.v1.DeleteToggleRequest
-	(*DeleteToggleResponse)(nil),   // 13: proto.indrasaputra.toggle.v1.DeleteToggleResponse
-	(*Toggle)(nil),                 // 14: proto.indrasaputra.toggle.v1.Toggle
-	(*ToggleError)(nil),            // 15: proto.indrasaputra.toggle.v1.ToggleError
-	(*ToggleEvent)(nil),            // 16: proto.indrasaputra.toggle.v1.ToggleEvent
-	(*timestamppb.Timestamp)(nil),  // 17: google.protobuf.Timestamp
+func (*GetToggleUsageResponse) ProtoMessage() {}
+
+func (x *GetToggleUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_proto_indrasaputra_toggle_v1_toggle_proto_depIdxs = []int32{
-	14, // 0: proto.indrasaputra.toggle.v1.CreateToggleRequest.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
-	14, // 1: proto.indrasaputra.toggle.v1.GetToggleByKeyResponse.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
-	14, // 2: proto.indrasaputra.toggle.v1.GetAllTogglesResponse.toggles:type_name -> proto.indrasaputra.toggle.v1.Toggle
-	17, // 3: proto.indrasaputra.toggle.v1.Toggle.created_at:type_name -> google.protobuf.Timestamp
-	17, // 4: proto.indrasaputra.toggle.v1.Toggle.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: proto.indrasaputra.toggle.v1.ToggleError.error_code:type_name -> proto.indrasaputra.toggle.v1.ToggleErrorCode
-	1,  // 6: proto.indrasaputra.toggle.v1.ToggleEvent.name:type_name -> proto.indrasaputra.toggle.v1.ToggleEventName
-	14, // 7: proto.indrasaputra.toggle.v1.ToggleEvent.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
-	17, // 8: proto.indrasaputra.toggle.v1.ToggleEvent.created_at:type_name -> google.protobuf.Timestamp
-	2,  // 9: proto.indrasaputra.toggle.v1.ToggleCommandService.CreateToggle:input_type -> proto.indrasaputra.toggle.v1.CreateToggleRequest
-	8,  // 10: proto.indrasaputra.toggle.v1.ToggleCommandService.EnableToggle:input_type -> proto.indrasaputra.toggle.v1.EnableToggleRequest
-	10, // 11: proto.indrasaputra.toggle.v1.ToggleCommandService.DisableToggle:input_type -> proto.indrasaputra.toggle.v1.DisableToggleRequest
-	12, // 12: proto.indrasaputra.toggle.v1.ToggleCommandService.DeleteToggle:input_type -> proto.indrasaputra.toggle.v1.DeleteToggleRequest
-	4,  // 13: proto.indrasaputra.toggle.v1.ToggleQueryService.GetToggleByKey:input_type -> proto.indrasaputra.toggle.v1.GetToggleByKeyRequest
-	6,  // 14: proto.indrasaputra.toggle.v1.ToggleQueryService.GetAllToggles:input_type -> proto.indrasaputra.toggle.v1.GetAllTogglesRequest
-	3,  // 15: proto.indrasaputra.toggle.v1.ToggleCommandService.CreateToggle:output_type -> proto.indrasaputra.toggle.v1.CreateToggleResponse
-	9,  // 16: proto.indrasaputra.toggle.v1.ToggleCommandService.EnableToggle:output_type -> proto.indrasaputra.toggle.v1.EnableToggleResponse
-	11, // 17: proto.indrasaputra.toggle.v1.ToggleCommandService.DisableToggle:output_type -> proto.indrasaputra.toggle.v1.DisableToggleResponse
-	13, // 18: proto.indrasaputra.toggle.v1.ToggleCommandService.DeleteToggle:output_type -> proto.indrasaputra.toggle.v1.DeleteToggleResponse
-	5,  // 19: proto.indrasaputra.toggle.v1.ToggleQueryService.GetToggleByKey:output_type -> proto.indrasaputra.toggle.v1.GetToggleByKeyResponse
-	7,  // 20: proto.indrasaputra.toggle.v1.ToggleQueryService.GetAllToggles:output_type -> proto.indrasaputra.toggle.v1.GetAllTogglesResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+// Deprecated: Use GetToggleUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetToggleUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{15}
 }
 
-func init() { file_proto_indrasaputra_toggle_v1_toggle_proto_init() }
-func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
-	if File_proto_indrasaputra_toggle_v1_toggle_proto != nil {
-		return
+func (x *GetToggleUsageResponse) GetUsages() []*ToggleUsage {
+	if x != nil {
+		return x.Usages
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateToggleRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateToggleResponse); i {
-			case 0:
+	return nil
+}
+
+// WatchTogglesRequest represents request for watch toggles.
+type WatchTogglesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// keys represents the keys of the watched toggles.
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	// key_prefixes represents the key prefixes of the watched toggles.
+	// A toggle is watched if its key matches any of the keys or key prefixes.
+	KeyPrefixes []string `protobuf:"bytes,4,rep,name=key_prefixes,json=keyPrefixes,proto3" json:"key_prefixes,omitempty"`
+	// resume_token represents the resume token of the last message received before disconnection.
+	// If it is empty or can't be resumed anymore, the stream starts with a new snapshot.
+	ResumeToken string `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchTogglesRequest) Reset() {
+	*x = WatchTogglesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTogglesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTogglesRequest) ProtoMessage() {}
+
+func (x *WatchTogglesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTogglesRequest.ProtoReflect.Descriptor instead.
+func (*WatchTogglesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *WatchTogglesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *WatchTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *WatchTogglesRequest) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *WatchTogglesRequest) GetKeyPrefixes() []string {
+	if x != nil {
+		return x.KeyPrefixes
+	}
+	return nil
+}
+
+func (x *WatchTogglesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// WatchTogglesResponse represents a message in the watch toggles' stream.
+type WatchTogglesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// change represents either the snapshot or an event of the watched toggles.
+	//
+	// Types that are assignable to Change:
+	//	*WatchTogglesResponse_Snapshot
+	//	*WatchTogglesResponse_Event
+	Change isWatchTogglesResponse_Change `protobuf_oneof:"change"`
+	// resume_token represents the token to resume the stream right after this message.
+	ResumeToken string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchTogglesResponse) Reset() {
+	*x = WatchTogglesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTogglesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTogglesResponse) ProtoMessage() {}
+
+func (x *WatchTogglesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTogglesResponse.ProtoReflect.Descriptor instead.
+func (*WatchTogglesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *WatchTogglesResponse) GetChange() isWatchTogglesResponse_Change {
+	if m != nil {
+		return m.Change
+	}
+	return nil
+}
+
+func (x *WatchTogglesResponse) GetSnapshot() *ToggleSnapshot {
+	if x, ok := x.GetChange().(*WatchTogglesResponse_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *WatchTogglesResponse) GetEvent() *ToggleEvent {
+	if x, ok := x.GetChange().(*WatchTogglesResponse_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchTogglesResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type isWatchTogglesResponse_Change interface {
+	isWatchTogglesResponse_Change()
+}
+
+type WatchTogglesResponse_Snapshot struct {
+	// snapshot represents the current state of all watched toggles.
+	// It replaces whatever the client knew about the watched toggles.
+	Snapshot *ToggleSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type WatchTogglesResponse_Event struct {
+	// event represents a change of a watched toggle.
+	Event *ToggleEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof"`
+}
+
+func (*WatchTogglesResponse_Snapshot) isWatchTogglesResponse_Change() {}
+
+func (*WatchTogglesResponse_Event) isWatchTogglesResponse_Change() {}
+
+// ToggleSnapshot represents the state of many toggles at a point in time.
+type ToggleSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// toggles represents the toggles in the snapshot, sorted by key.
+	Toggles []*Toggle `protobuf:"bytes,1,rep,name=toggles,proto3" json:"toggles,omitempty"`
+	// format_version represents the version of the snapshot's format.
+	// It is set by ExportToggles. Zero means the latest version.
+	FormatVersion int32 `protobuf:"varint,2,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	// project represents the name of the project the snapshot was taken from.
+	// It is set by ExportToggles and ignored by ImportToggles.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// environment represents the name of the environment the snapshot was taken from.
+	// It is set by ExportToggles and ignored by ImportToggles.
+	Environment string `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	// created_at represents when the snapshot was taken.
+	// It is set by ExportToggles and ignored by ImportToggles.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ToggleSnapshot) Reset() {
+	*x = ToggleSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleSnapshot) ProtoMessage() {}
+
+func (x *ToggleSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleSnapshot.ProtoReflect.Descriptor instead.
+func (*ToggleSnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ToggleSnapshot) GetToggles() []*Toggle {
+	if x != nil {
+		return x.Toggles
+	}
+	return nil
+}
+
+func (x *ToggleSnapshot) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *ToggleSnapshot) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ToggleSnapshot) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ToggleSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// EnableToggleRequest represents request for enable a toggle.
+type EnableToggleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *EnableToggleRequest) Reset() {
+	*x = EnableToggleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableToggleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableToggleRequest) ProtoMessage() {}
+
+func (x *EnableToggleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableToggleRequest.ProtoReflect.Descriptor instead.
+func (*EnableToggleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EnableToggleRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EnableToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *EnableToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *EnableToggleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// EnableToggleResponse represents request from enable a toggle.
+type EnableToggleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version represents the toggle's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *EnableToggleResponse) Reset() {
+	*x = EnableToggleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableToggleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableToggleResponse) ProtoMessage() {}
+
+func (x *EnableToggleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableToggleResponse.ProtoReflect.Descriptor instead.
+func (*EnableToggleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EnableToggleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DisableToggleRequest represents request for disable a toggle.
+type DisableToggleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *DisableToggleRequest) Reset() {
+	*x = DisableToggleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableToggleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableToggleRequest) ProtoMessage() {}
+
+func (x *DisableToggleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableToggleRequest.ProtoReflect.Descriptor instead.
+func (*DisableToggleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DisableToggleRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DisableToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *DisableToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DisableToggleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// DisableToggleResponse represents request from disable a toggle.
+type DisableToggleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version represents the toggle's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DisableToggleResponse) Reset() {
+	*x = DisableToggleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisableToggleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableToggleResponse) ProtoMessage() {}
+
+func (x *DisableToggleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableToggleResponse.ProtoReflect.Descriptor instead.
+func (*DisableToggleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DisableToggleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// UpdateTogglePrerequisitesRequest represents request for update a toggle's prerequisites.
+type UpdateTogglePrerequisitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// prerequisites represents the new prerequisites of the toggle.
+	// Empty prerequisites removes all of the toggle's prerequisites.
+	Prerequisites []*Prerequisite `protobuf:"bytes,4,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+}
+
+func (x *UpdateTogglePrerequisitesRequest) Reset() {
+	*x = UpdateTogglePrerequisitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTogglePrerequisitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTogglePrerequisitesRequest) ProtoMessage() {}
+
+func (x *UpdateTogglePrerequisitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTogglePrerequisitesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTogglePrerequisitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateTogglePrerequisitesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateTogglePrerequisitesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *UpdateTogglePrerequisitesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateTogglePrerequisitesRequest) GetPrerequisites() []*Prerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+// UpdateTogglePrerequisitesResponse represents response from update a toggle's prerequisites.
+type UpdateTogglePrerequisitesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateTogglePrerequisitesResponse) Reset() {
+	*x = UpdateTogglePrerequisitesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTogglePrerequisitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTogglePrerequisitesResponse) ProtoMessage() {}
+
+func (x *UpdateTogglePrerequisitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTogglePrerequisitesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTogglePrerequisitesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{24}
+}
+
+// UpdateToggleRolloutRequest represents request for update a toggle's rollout.
+type UpdateToggleRolloutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// rollout represents the new percentage rollout of the toggle in the environment.
+	// Empty rollout removes the rollout.
+	Rollout *Rollout `protobuf:"bytes,4,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *UpdateToggleRolloutRequest) Reset() {
+	*x = UpdateToggleRolloutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateToggleRolloutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToggleRolloutRequest) ProtoMessage() {}
+
+func (x *UpdateToggleRolloutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToggleRolloutRequest.ProtoReflect.Descriptor instead.
+func (*UpdateToggleRolloutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateToggleRolloutRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateToggleRolloutRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *UpdateToggleRolloutRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateToggleRolloutRequest) GetRollout() *Rollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *UpdateToggleRolloutRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// UpdateToggleRolloutResponse represents response from update a toggle's rollout.
+type UpdateToggleRolloutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version represents the toggle's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateToggleRolloutResponse) Reset() {
+	*x = UpdateToggleRolloutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateToggleRolloutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToggleRolloutResponse) ProtoMessage() {}
+
+func (x *UpdateToggleRolloutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToggleRolloutResponse.ProtoReflect.Descriptor instead.
+func (*UpdateToggleRolloutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateToggleRolloutResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// UpdateToggleRulesRequest represents request for update a toggle's rules.
+type UpdateToggleRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// rules represents the new ordered list of targeting rules of the toggle in the environment.
+	// Empty rules remove all rules.
+	Rules []*Rule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	// default_value represents the value served when none of the rules matches.
+	// It defaults to true if it is not set.
+	DefaultValue *bool `protobuf:"varint,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *UpdateToggleRulesRequest) Reset() {
+	*x = UpdateToggleRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateToggleRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToggleRulesRequest) ProtoMessage() {}
+
+func (x *UpdateToggleRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToggleRulesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateToggleRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateToggleRulesRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateToggleRulesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *UpdateToggleRulesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateToggleRulesRequest) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *UpdateToggleRulesRequest) GetDefaultValue() bool {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return false
+}
+
+func (x *UpdateToggleRulesRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// UpdateToggleRulesResponse represents response from update a toggle's rules.
+type UpdateToggleRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version represents the toggle's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateToggleRulesResponse) Reset() {
+	*x = UpdateToggleRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateToggleRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateToggleRulesResponse) ProtoMessage() {}
+
+func (x *UpdateToggleRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateToggleRulesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateToggleRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateToggleRulesResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// DeleteToggleRequest represents request for delete a toggle.
+type DeleteToggleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// purge represents whether the toggle is gone forever right away instead of being soft-deleted.
+	// A soft-deleted toggle can be purged as well.
+	Purge bool `protobuf:"varint,5,opt,name=purge,proto3" json:"purge,omitempty"`
+}
+
+func (x *DeleteToggleRequest) Reset() {
+	*x = DeleteToggleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteToggleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteToggleRequest) ProtoMessage() {}
+
+func (x *DeleteToggleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteToggleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteToggleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteToggleRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DeleteToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *DeleteToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *DeleteToggleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DeleteToggleRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+// DeleteToggleResponse represents request from delete a toggle.
+type DeleteToggleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteToggleResponse) Reset() {
+	*x = DeleteToggleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteToggleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteToggleResponse) ProtoMessage() {}
+
+func (x *DeleteToggleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteToggleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteToggleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{30}
+}
+
+// RestoreToggleRequest represents request for restore a deleted toggle.
+type RestoreToggleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	Environment string `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the request is aborted.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *RestoreToggleRequest) Reset() {
+	*x = RestoreToggleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreToggleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreToggleRequest) ProtoMessage() {}
+
+func (x *RestoreToggleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreToggleRequest.ProtoReflect.Descriptor instead.
+func (*RestoreToggleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RestoreToggleRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RestoreToggleRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *RestoreToggleRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RestoreToggleRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// RestoreToggleResponse represents response from restore a deleted toggle.
+type RestoreToggleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// version represents the toggle's version after the change.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RestoreToggleResponse) Reset() {
+	*x = RestoreToggleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreToggleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreToggleResponse) ProtoMessage() {}
+
+func (x *RestoreToggleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreToggleResponse.ProtoReflect.Descriptor instead.
+func (*RestoreToggleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestoreToggleResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// BatchCreateTogglesRequest represents request for create many toggles.
+type BatchCreateTogglesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggles' state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// toggles represents the toggles to create.
+	// It holds at least 1 and at most 100 toggles with unique keys.
+	Toggles []*Toggle `protobuf:"bytes,3,rep,name=toggles,proto3" json:"toggles,omitempty"`
+	// all_or_nothing represents whether a single failure rolls back the whole batch.
+	AllOrNothing bool `protobuf:"varint,4,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+}
+
+func (x *BatchCreateTogglesRequest) Reset() {
+	*x = BatchCreateTogglesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTogglesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTogglesRequest) ProtoMessage() {}
+
+func (x *BatchCreateTogglesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTogglesRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateTogglesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *BatchCreateTogglesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *BatchCreateTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *BatchCreateTogglesRequest) GetToggles() []*Toggle {
+	if x != nil {
+		return x.Toggles
+	}
+	return nil
+}
+
+func (x *BatchCreateTogglesRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+// BatchCreateTogglesResponse represents response from create many toggles.
+type BatchCreateTogglesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results represents the result of each toggle, in the same order as the request.
+	Results []*BatchToggleResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchCreateTogglesResponse) Reset() {
+	*x = BatchCreateTogglesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateTogglesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateTogglesResponse) ProtoMessage() {}
+
+func (x *BatchCreateTogglesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateTogglesResponse.ProtoReflect.Descriptor instead.
+func (*BatchCreateTogglesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BatchCreateTogglesResponse) GetResults() []*BatchToggleResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchUpdateTogglesRequest represents request for update many toggles.
+type BatchUpdateTogglesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggles' state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// operations represents the operations to apply in order.
+	// It holds at least 1 and at most 100 operations.
+	Operations []*ToggleOperation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	// all_or_nothing represents whether a single failure rolls back the whole batch.
+	AllOrNothing bool `protobuf:"varint,4,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+}
+
+func (x *BatchUpdateTogglesRequest) Reset() {
+	*x = BatchUpdateTogglesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateTogglesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTogglesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateTogglesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTogglesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTogglesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BatchUpdateTogglesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *BatchUpdateTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *BatchUpdateTogglesRequest) GetOperations() []*ToggleOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *BatchUpdateTogglesRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+// BatchUpdateTogglesResponse represents response from update many toggles.
+type BatchUpdateTogglesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// results represents the result of each operation, in the same order as the request.
+	Results []*BatchToggleResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchUpdateTogglesResponse) Reset() {
+	*x = BatchUpdateTogglesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateTogglesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateTogglesResponse) ProtoMessage() {}
+
+func (x *BatchUpdateTogglesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateTogglesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateTogglesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BatchUpdateTogglesResponse) GetResults() []*BatchToggleResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ExportTogglesRequest represents request for export toggles.
+type ExportTogglesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggles' state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *ExportTogglesRequest) Reset() {
+	*x = ExportTogglesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTogglesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTogglesRequest) ProtoMessage() {}
+
+func (x *ExportTogglesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTogglesRequest.ProtoReflect.Descriptor instead.
+func (*ExportTogglesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExportTogglesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ExportTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+// ExportTogglesResponse represents response from export toggles.
+type ExportTogglesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// snapshot represents all toggles in the environment.
+	Snapshot *ToggleSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *ExportTogglesResponse) Reset() {
+	*x = ExportTogglesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportTogglesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTogglesResponse) ProtoMessage() {}
+
+func (x *ExportTogglesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTogglesResponse.ProtoReflect.Descriptor instead.
+func (*ExportTogglesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExportTogglesResponse) GetSnapshot() *ToggleSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+// ImportTogglesRequest represents request for import toggles.
+type ImportTogglesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggles' state belongs to.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// snapshot represents the toggles the environment should have.
+	// Unlike anywhere else, the toggles' is_enabled is read and applied to the environment.
+	Snapshot *ToggleSnapshot `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// conflict_policy represents what to do with the toggles which exist with a different content.
+	ConflictPolicy ImportConflictPolicy `protobuf:"varint,4,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=proto.indrasaputra.toggle.v1.ImportConflictPolicy" json:"conflict_policy,omitempty"`
+	// prune represents whether the toggles which aren't in the snapshot are deleted.
+	Prune bool `protobuf:"varint,5,opt,name=prune,proto3" json:"prune,omitempty"`
+	// dry_run represents whether the changes are only computed without being made.
+	DryRun bool `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportTogglesRequest) Reset() {
+	*x = ImportTogglesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTogglesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTogglesRequest) ProtoMessage() {}
+
+func (x *ImportTogglesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTogglesRequest.ProtoReflect.Descriptor instead.
+func (*ImportTogglesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ImportTogglesRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ImportTogglesRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ImportTogglesRequest) GetSnapshot() *ToggleSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *ImportTogglesRequest) GetConflictPolicy() ImportConflictPolicy {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ImportConflictPolicy_IMPORT_CONFLICT_POLICY_UNSPECIFIED
+}
+
+func (x *ImportTogglesRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *ImportTogglesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// ImportTogglesResponse represents response from import toggles.
+type ImportTogglesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes represents the change of each toggle, sorted by key.
+	Changes []*ToggleImportChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ImportTogglesResponse) Reset() {
+	*x = ImportTogglesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportTogglesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTogglesResponse) ProtoMessage() {}
+
+func (x *ImportTogglesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTogglesResponse.ProtoReflect.Descriptor instead.
+func (*ImportTogglesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportTogglesResponse) GetChanges() []*ToggleImportChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ReportEvaluationsRequest represents request for report evaluations.
+type ReportEvaluationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// environment represents the name of the environment the toggles are evaluated in.
+	Environment string `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggles belong to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// counts represents the evaluation counts since the previous report.
+	// It holds at most 1000 counts.
+	Counts []*EvaluationCount `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *ReportEvaluationsRequest) Reset() {
+	*x = ReportEvaluationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportEvaluationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEvaluationsRequest) ProtoMessage() {}
+
+func (x *ReportEvaluationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEvaluationsRequest.ProtoReflect.Descriptor instead.
+func (*ReportEvaluationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReportEvaluationsRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ReportEvaluationsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ReportEvaluationsRequest) GetCounts() []*EvaluationCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// ReportEvaluationsResponse represents response from report evaluations.
+type ReportEvaluationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rejected_count represents the number of counts which are skipped because they are invalid.
+	RejectedCount int64 `protobuf:"varint,1,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+}
+
+func (x *ReportEvaluationsResponse) Reset() {
+	*x = ReportEvaluationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportEvaluationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEvaluationsResponse) ProtoMessage() {}
+
+func (x *ReportEvaluationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEvaluationsResponse.ProtoReflect.Descriptor instead.
+func (*ReportEvaluationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReportEvaluationsResponse) GetRejectedCount() int64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+// Toggle represents a toggle data.
+type Toggle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents a unique identifier of a toggle.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// is_enabled represents the toggle's usability status.
+	IsEnabled bool `protobuf:"varint,2,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	// description represents a concise description of a toggle.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// created_at represents when the toggle was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// updated_at represents when the toggle was last updated.
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// rules represents an ordered list of targeting rules.
+	// The first rule that matches the evaluation context wins.
+	Rules []*Rule `protobuf:"bytes,6,rep,name=rules,proto3" json:"rules,omitempty"`
+	// default_value represents the value served when none of the rules matches.
+	// It defaults to true if it is not set.
+	DefaultValue *bool `protobuf:"varint,7,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	// rollout represents a percentage rollout applied when none of the rules matches.
+	// If it is set, it takes precedence over default_value.
+	Rollout *Rollout `protobuf:"bytes,8,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	// variants represents the list of named variants served by a multivariate toggle.
+	// A toggle without variants is an on/off toggle.
+	Variants []*Variant `protobuf:"bytes,9,rep,name=variants,proto3" json:"variants,omitempty"`
+	// default_variant represents the name of the variant served when the toggle resolves to true.
+	// It must be set if the toggle has variants.
+	DefaultVariant string `protobuf:"bytes,10,opt,name=default_variant,json=defaultVariant,proto3" json:"default_variant,omitempty"`
+	// off_variant represents the name of the variant served when the toggle resolves to false.
+	// It must be set if the toggle has variants.
+	OffVariant string `protobuf:"bytes,11,opt,name=off_variant,json=offVariant,proto3" json:"off_variant,omitempty"`
+	// environment represents the name of the environment the toggle's state belongs to.
+	// The toggle's state consists of is_enabled, rules, default_value, and rollout.
+	Environment string `protobuf:"bytes,12,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	// The toggle's key is unique within the project.
+	Project string `protobuf:"bytes,13,opt,name=project,proto3" json:"project,omitempty"`
+	// prerequisites represents the toggles which must be evaluated to the required values
+	// before the toggle is evaluated.
+	// If any of them isn't satisfied, the toggle is evaluated to false.
+	Prerequisites []*Prerequisite `protobuf:"bytes,14,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	// owner represents the person or team responsible for the toggle.
+	// The owner is notified to clean up the toggle once it expires.
+	Owner string `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	// expires_at represents when the toggle is expected to be removed.
+	// It is optional and the toggle keeps working after it expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// tags represents the labels attached to the toggle, such as team:payments or kind:kill-switch.
+	// Like the prerequisites, the tags are shared by all environments.
+	Tags []string `protobuf:"bytes,17,rep,name=tags,proto3" json:"tags,omitempty"`
+	// version represents the toggle's version which is increased every time the toggle changes.
+	// It can be sent back as the expected version to make sure the toggle isn't changed by someone else.
+	Version int64 `protobuf:"varint,18,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at represents when the toggle was soft-deleted.
+	// It is empty if the toggle isn't deleted.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *Toggle) Reset() {
+	*x = Toggle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Toggle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toggle) ProtoMessage() {}
+
+func (x *Toggle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toggle.ProtoReflect.Descriptor instead.
+func (*Toggle) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Toggle) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toggle) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *Toggle) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Toggle) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Toggle) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Toggle) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Toggle) GetDefaultValue() bool {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return false
+}
+
+func (x *Toggle) GetRollout() *Rollout {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *Toggle) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Toggle) GetDefaultVariant() string {
+	if x != nil {
+		return x.DefaultVariant
+	}
+	return ""
+}
+
+func (x *Toggle) GetOffVariant() string {
+	if x != nil {
+		return x.OffVariant
+	}
+	return ""
+}
+
+func (x *Toggle) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *Toggle) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Toggle) GetPrerequisites() []*Prerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+func (x *Toggle) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Toggle) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Toggle) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Toggle) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Toggle) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+// ToggleAuditEntry represents a change of a toggle recorded in the audit log.
+type ToggleAuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id represents the entry's unique identifier.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// key represents the changed toggle's key.
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// environment represents the name of the environment where the change happened.
+	Environment string `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	// action represents what was done to the toggle.
+	Action AuditAction `protobuf:"varint,5,opt,name=action,proto3,enum=proto.indrasaputra.toggle.v1.AuditAction" json:"action,omitempty"`
+	// actor represents who made the change.
+	// It is empty if the actor is unknown.
+	Actor string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	// reason represents why the change was made.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// request_id represents the ID of the request which made the change.
+	RequestId string `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// before represents the toggle before the change.
+	// It is empty if the toggle was created.
+	Before *Toggle `protobuf:"bytes,9,opt,name=before,proto3" json:"before,omitempty"`
+	// after represents the toggle after the change.
+	// It is empty if the toggle was purged.
+	After *Toggle `protobuf:"bytes,10,opt,name=after,proto3" json:"after,omitempty"`
+	// created_at represents when the change was made.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ToggleAuditEntry) Reset() {
+	*x = ToggleAuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleAuditEntry) ProtoMessage() {}
+
+func (x *ToggleAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleAuditEntry.ProtoReflect.Descriptor instead.
+func (*ToggleAuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ToggleAuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ToggleAuditEntry) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ToggleAuditEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ToggleAuditEntry) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ToggleAuditEntry) GetAction() AuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return AuditAction_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *ToggleAuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ToggleAuditEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ToggleAuditEntry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ToggleAuditEntry) GetBefore() *Toggle {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *ToggleAuditEntry) GetAfter() *Toggle {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *ToggleAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// StaleToggle represents a toggle which should be cleaned up.
+type StaleToggle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// toggle represents the stale toggle.
+	Toggle *Toggle `protobuf:"bytes,1,opt,name=toggle,proto3" json:"toggle,omitempty"`
+	// reasons represents why the toggle is stale.
+	Reasons []StaleReason `protobuf:"varint,2,rep,packed,name=reasons,proto3,enum=proto.indrasaputra.toggle.v1.StaleReason" json:"reasons,omitempty"`
+}
+
+func (x *StaleToggle) Reset() {
+	*x = StaleToggle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaleToggle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleToggle) ProtoMessage() {}
+
+func (x *StaleToggle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleToggle.ProtoReflect.Descriptor instead.
+func (*StaleToggle) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StaleToggle) GetToggle() *Toggle {
+	if x != nil {
+		return x.Toggle
+	}
+	return nil
+}
+
+func (x *StaleToggle) GetReasons() []StaleReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// EvaluationCount represents how many times a toggle serves a variant within a time window.
+type EvaluationCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents the evaluated toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// variant represents the name of the served variant.
+	// It is the served value, true or false, if the toggle doesn't serve any variant.
+	Variant string `protobuf:"bytes,2,opt,name=variant,proto3" json:"variant,omitempty"`
+	// window_start represents the start of the time window the evaluations are made in.
+	// It is rounded down to the hour.
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// count represents how many times the toggle serves the variant within the time window.
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// last_evaluated_at represents the time of the last evaluation within the time window.
+	// It defaults to window_start if it is not set.
+	LastEvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_evaluated_at,json=lastEvaluatedAt,proto3" json:"last_evaluated_at,omitempty"`
+}
+
+func (x *EvaluationCount) Reset() {
+	*x = EvaluationCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationCount) ProtoMessage() {}
+
+func (x *EvaluationCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationCount.ProtoReflect.Descriptor instead.
+func (*EvaluationCount) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EvaluationCount) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EvaluationCount) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *EvaluationCount) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *EvaluationCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *EvaluationCount) GetLastEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEvaluatedAt
+	}
+	return nil
+}
+
+// ToggleUsage represents how many times a toggle has been evaluated.
+type ToggleUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents the toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// evaluation_count represents how many times the toggle has been evaluated.
+	EvaluationCount int64 `protobuf:"varint,2,opt,name=evaluation_count,json=evaluationCount,proto3" json:"evaluation_count,omitempty"`
+	// last_evaluated_at represents when the toggle was last evaluated.
+	// It is empty if the toggle has never been evaluated.
+	LastEvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_evaluated_at,json=lastEvaluatedAt,proto3" json:"last_evaluated_at,omitempty"`
+	// variants represents the usage per served variant, sorted by variant.
+	Variants []*VariantUsage `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *ToggleUsage) Reset() {
+	*x = ToggleUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleUsage) ProtoMessage() {}
+
+func (x *ToggleUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleUsage.ProtoReflect.Descriptor instead.
+func (*ToggleUsage) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ToggleUsage) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ToggleUsage) GetEvaluationCount() int64 {
+	if x != nil {
+		return x.EvaluationCount
+	}
+	return 0
+}
+
+func (x *ToggleUsage) GetLastEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEvaluatedAt
+	}
+	return nil
+}
+
+func (x *ToggleUsage) GetVariants() []*VariantUsage {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// VariantUsage represents how many times a toggle has served a variant.
+type VariantUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// variant represents the name of the served variant, or true or false if the toggle doesn't serve any variant.
+	Variant string `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	// evaluation_count represents how many times the toggle has served the variant.
+	EvaluationCount int64 `protobuf:"varint,2,opt,name=evaluation_count,json=evaluationCount,proto3" json:"evaluation_count,omitempty"`
+	// last_evaluated_at represents when the toggle last served the variant.
+	LastEvaluatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_evaluated_at,json=lastEvaluatedAt,proto3" json:"last_evaluated_at,omitempty"`
+}
+
+func (x *VariantUsage) Reset() {
+	*x = VariantUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VariantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantUsage) ProtoMessage() {}
+
+func (x *VariantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantUsage.ProtoReflect.Descriptor instead.
+func (*VariantUsage) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VariantUsage) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *VariantUsage) GetEvaluationCount() int64 {
+	if x != nil {
+		return x.EvaluationCount
+	}
+	return 0
+}
+
+func (x *VariantUsage) GetLastEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEvaluatedAt
+	}
+	return nil
+}
+
+// ToggleOperation represents an operation on a toggle in a batch.
+type ToggleOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// type represents what the operation does to the toggle.
+	Type ToggleOperationType `protobuf:"varint,2,opt,name=type,proto3,enum=proto.indrasaputra.toggle.v1.ToggleOperationType" json:"type,omitempty"`
+	// expected_version represents the toggle's version the client expects to change.
+	// If it is set and doesn't match the toggle's current version, the operation fails.
+	// Zero means the toggle is changed regardless of its version.
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+}
+
+func (x *ToggleOperation) Reset() {
+	*x = ToggleOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleOperation) ProtoMessage() {}
+
+func (x *ToggleOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleOperation.ProtoReflect.Descriptor instead.
+func (*ToggleOperation) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ToggleOperation) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ToggleOperation) GetType() ToggleOperationType {
+	if x != nil {
+		return x.Type
+	}
+	return ToggleOperationType_TOGGLE_OPERATION_TYPE_UNSPECIFIED
+}
+
+func (x *ToggleOperation) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+// BatchToggleResult represents the result of a toggle in a batch.
+type BatchToggleResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// version represents the toggle's version after the change.
+	// It is zero if the change fails.
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// error_code represents why the change fails.
+	// It is TOGGLE_ERROR_CODE_UNSPECIFIED if the change succeeds.
+	ErrorCode ToggleErrorCode `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3,enum=proto.indrasaputra.toggle.v1.ToggleErrorCode" json:"error_code,omitempty"`
+	// error_message represents the detail of the failure.
+	ErrorMessage string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *BatchToggleResult) Reset() {
+	*x = BatchToggleResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchToggleResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchToggleResult) ProtoMessage() {}
+
+func (x *BatchToggleResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchToggleResult.ProtoReflect.Descriptor instead.
+func (*BatchToggleResult) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchToggleResult) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *BatchToggleResult) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BatchToggleResult) GetErrorCode() ToggleErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *BatchToggleResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// ToggleImportChange represents the change import makes to a toggle.
+type ToggleImportChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents unique toggle's key.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// action represents what import does to the toggle.
+	Action ToggleImportAction `protobuf:"varint,2,opt,name=action,proto3,enum=proto.indrasaputra.toggle.v1.ToggleImportAction" json:"action,omitempty"`
+	// fields represents the toggle's fields which differ from the snapshot, named after the toggle's fields.
+	// It is only set for updated and skipped toggles.
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// error_code represents why the change fails.
+	// It is TOGGLE_ERROR_CODE_UNSPECIFIED if the change succeeds.
+	ErrorCode ToggleErrorCode `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,enum=proto.indrasaputra.toggle.v1.ToggleErrorCode" json:"error_code,omitempty"`
+	// error_message represents the detail of the failure.
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ToggleImportChange) Reset() {
+	*x = ToggleImportChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleImportChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleImportChange) ProtoMessage() {}
+
+func (x *ToggleImportChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleImportChange.ProtoReflect.Descriptor instead.
+func (*ToggleImportChange) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ToggleImportChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ToggleImportChange) GetAction() ToggleImportAction {
+	if x != nil {
+		return x.Action
+	}
+	return ToggleImportAction_TOGGLE_IMPORT_ACTION_UNSPECIFIED
+}
+
+func (x *ToggleImportChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ToggleImportChange) GetErrorCode() ToggleErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *ToggleImportChange) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// Prerequisite represents a toggle which must be evaluated to a required value.
+type Prerequisite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key represents the key of the prerequisite toggle in the same project.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value represents the value the prerequisite toggle must be evaluated to.
+	Value bool `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Prerequisite) Reset() {
+	*x = Prerequisite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Prerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prerequisite) ProtoMessage() {}
+
+func (x *Prerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prerequisite.ProtoReflect.Descriptor instead.
+func (*Prerequisite) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Prerequisite) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Prerequisite) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+// Variant represents a named value served by a multivariate toggle.
+type Variant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name represents the variant's name.
+	// It must be unique in a toggle.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type represents the type of the variant's value.
+	Type VariantType `protobuf:"varint,2,opt,name=type,proto3,enum=proto.indrasaputra.toggle.v1.VariantType" json:"type,omitempty"`
+	// value represents the variant's value encoded as string.
+	// A number is written in decimal and a JSON is written as is.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Variant) Reset() {
+	*x = Variant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Variant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Variant) ProtoMessage() {}
+
+func (x *Variant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Variant.ProtoReflect.Descriptor instead.
+func (*Variant) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variant) GetType() VariantType {
+	if x != nil {
+		return x.Type
+	}
+	return VariantType_VARIANT_TYPE_UNSPECIFIED
+}
+
+func (x *Variant) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Rollout represents a percentage rollout of a toggle.
+type Rollout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// percentage represents the percentage of subjects that get true.
+	Percentage uint32 `protobuf:"varint,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// bucket_by represents the evaluation context's attribute used to bucket a subject.
+	// The same attribute's value always lands in the same bucket.
+	BucketBy string `protobuf:"bytes,2,opt,name=bucket_by,json=bucketBy,proto3" json:"bucket_by,omitempty"`
+}
+
+func (x *Rollout) Reset() {
+	*x = Rollout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rollout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollout) ProtoMessage() {}
+
+func (x *Rollout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollout.ProtoReflect.Descriptor instead.
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Rollout) GetPercentage() uint32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Rollout) GetBucketBy() string {
+	if x != nil {
+		return x.BucketBy
+	}
+	return ""
+}
+
+// Rule represents a targeting rule of a toggle.
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attribute represents the name of the evaluation context's attribute.
+	Attribute string `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	// operator represents how the attribute is compared with the values.
+	Operator RuleOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=proto.indrasaputra.toggle.v1.RuleOperator" json:"operator,omitempty"`
+	// values represents the values compared with the attribute.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	// value represents the value served when the rule matches.
+	Value bool `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	// variant represents the name of the variant served when the rule matches and its value is true.
+	// If it is empty, the toggle's default variant is served.
+	Variant string `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Rule) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *Rule) GetOperator() RuleOperator {
+	if x != nil {
+		return x.Operator
+	}
+	return RuleOperator_RULE_OPERATOR_UNSPECIFIED
+}
+
+func (x *Rule) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Rule) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *Rule) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+// ToggleError represents message for any error happening in toggle.
+type ToggleError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// error_code represents specific and unique error code for toggle.
+	ErrorCode ToggleErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=proto.indrasaputra.toggle.v1.ToggleErrorCode" json:"error_code,omitempty"`
+}
+
+func (x *ToggleError) Reset() {
+	*x = ToggleError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleError) ProtoMessage() {}
+
+func (x *ToggleError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleError.ProtoReflect.Descriptor instead.
+func (*ToggleError) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ToggleError) GetErrorCode() ToggleErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ToggleErrorCode_TOGGLE_ERROR_CODE_UNSPECIFIED
+}
+
+// ToggleEvent represents an event of a toggle.
+type ToggleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name represents event's name.
+	Name ToggleEventName `protobuf:"varint,1,opt,name=name,proto3,enum=proto.indrasaputra.toggle.v1.ToggleEventName" json:"name,omitempty"`
+	// toggle represents the toggle in the event.
+	Toggle *Toggle `protobuf:"bytes,2,opt,name=toggle,proto3" json:"toggle,omitempty"`
+	// created_at represents when the event was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// environment represents the name of the environment where the event happened.
+	Environment string `protobuf:"bytes,4,opt,name=environment,proto3" json:"environment,omitempty"`
+	// project represents the name of the project the toggle belongs to.
+	// Subscribers only receive the events of their project.
+	Project string `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *ToggleEvent) Reset() {
+	*x = ToggleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleEvent) ProtoMessage() {}
+
+func (x *ToggleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleEvent.ProtoReflect.Descriptor instead.
+func (*ToggleEvent) Descriptor() ([]byte, []int) {
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ToggleEvent) GetName() ToggleEventName {
+	if x != nil {
+		return x.Name
+	}
+	return ToggleEventName_TOGGLE_EVENT_NAME_UNSPECIFIED
+}
+
+func (x *ToggleEvent) GetToggle() *Toggle {
+	if x != nil {
+		return x.Toggle
+	}
+	return nil
+}
+
+func (x *ToggleEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ToggleEvent) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ToggleEvent) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+var File_proto_indrasaputra_toggle_v1_toggle_proto protoreflect.FileDescriptor
+
+var file_proto_indrasaputra_toggle_v1_toggle_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1c, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x02, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x04, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41,
+	0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65,
+	0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65,
+	0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a,
+	0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32,
+	0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41, 0x4d,
+	0x32, 0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x74,
+	0x6f, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x4a, 0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x22, 0x9c, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e,
+	0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a,
+	0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62,
+	0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a,
+	0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01,
+	0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x56, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x42, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74,
+	0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x22, 0x85, 0x08, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17,
+	0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x73, 0x0a, 0x08, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x58, 0x92, 0x41, 0x55, 0x32, 0x2f, 0x54, 0x61, 0x67,
+	0x73, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x68, 0x69, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20,
+	0x61, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65, 0x4a, 0x22, 0x5b, 0x22,
+	0x74, 0x65, 0x61, 0x6d, 0x3a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2c, 0x20,
+	0x22, 0x74, 0x65, 0x61, 0x6d, 0x3a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x5d,
+	0x52, 0x07, 0x61, 0x6e, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x60, 0x0a, 0x08, 0x61, 0x6c, 0x6c,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x45, 0x92, 0x41, 0x42,
+	0x32, 0x19, 0x54, 0x61, 0x67, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x4a, 0x25, 0x5b, 0x22, 0x74,
+	0x65, 0x61, 0x6d, 0x3a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2c, 0x20, 0x22,
+	0x6b, 0x69, 0x6e, 0x64, 0x3a, 0x6b, 0x69, 0x6c, 0x6c, 0x2d, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x22, 0x5d, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x12, 0x5b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x3e,
+	0x92, 0x41, 0x3b, 0x32, 0x2c, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x20, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x4a, 0x02, 0x35, 0x30, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41,
+	0x4f, 0x32, 0x4d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x2c, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x27, 0x73,
+	0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x81, 0x01, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x66,
+	0x92, 0x41, 0x63, 0x32, 0x4e, 0x53, 0x6f, 0x72, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x2c,
+	0x20, 0x65, 0x69, 0x74, 0x68, 0x65, 0x72, 0x20, 0x6b, 0x65, 0x79, 0x2c, 0x20, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x20, 0x6f, 0x72, 0x20, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x6c, 0x79, 0x20, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x64,
+	0x65, 0x73, 0x63, 0x4a, 0x11, 0x22, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x20, 0x64, 0x65, 0x73, 0x63, 0x22, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
+	0x4b, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x1a, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x27, 0x73,
+	0x20, 0x6b, 0x65, 0x79, 0x4a, 0x0b, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2d,
+	0x22, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x5c, 0x0a, 0x06,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x44, 0x92, 0x41,
+	0x41, 0x32, 0x2b, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x27, 0x73, 0x20, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x4a, 0x12,
+	0x22, 0x6e, 0x65, 0x77, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x61, 0x67,
+	0x65, 0x22, 0x52, 0x06, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x67, 0x0a, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x33, 0x57, 0x68, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6f, 0x66, 0x74, 0x2d, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x77, 0x65, 0x6c, 0x6c, 0x4a, 0x04, 0x74,
+	0x72, 0x75, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x22, 0x9e, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0xab, 0x04, 0x0a, 0x15, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e,
+	0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a,
+	0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62,
+	0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0xd0, 0x01, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
+	0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x74, 0x92, 0x41, 0x71, 0x32, 0x3f, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x2c, 0x20, 0x73, 0x75, 0x63, 0x68, 0x20, 0x61, 0x73, 0x20, 0x75, 0x73, 0x65, 0x72, 0x20,
+	0x69, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x4a, 0x2e, 0x7b,
+	0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x3a, 0x20, 0x22, 0x49, 0x44, 0x22, 0x2c,
+	0x20, 0x22, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x3a, 0x20, 0x22, 0x75, 0x73, 0x65, 0x72, 0x40,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x7d, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a,
+	0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32,
+	0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xc9, 0x02, 0x0a, 0x16, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x73, 0x69, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbc,
+	0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d,
+	0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2,
+	0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30,
+	0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x72, 0x0a, 0x10, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x48, 0x92, 0x41, 0x45, 0x32, 0x3f, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x64, 0x61, 0x79, 0x73, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x27, 0x73, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x73,
+	0x74, 0x61, 0x79, 0x20, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x20, 0x74, 0x6f,
+	0x20, 0x62, 0x65, 0x20, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x4a, 0x02, 0x33, 0x30, 0x52, 0x0e, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x44, 0x61, 0x79, 0x73, 0x22, 0x69, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x5f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x61, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x22, 0xf1, 0x05, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x5d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x32, 0x30, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c, 0x69, 0x73,
+	0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x4a, 0x12, 0x22, 0x6e, 0x65, 0x77, 0x2d, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x22, 0x78, 0x32, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x56, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x27, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c, 0x69,
+	0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6d,
+	0x61, 0x64, 0x65, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x4a, 0x12, 0x22, 0x6a, 0x61, 0x6e, 0x65, 0x40, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x63, 0x6f, 0x6d, 0x22, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x87, 0x01, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4c, 0x92, 0x41,
+	0x49, 0x32, 0x2f, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x64, 0x65, 0x20, 0x61, 0x74,
+	0x20, 0x6f, 0x72, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x69,
+	0x6d, 0x65, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x32, 0x2d, 0x30, 0x32, 0x2d, 0x30, 0x31, 0x54,
+	0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x7e, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x2a, 0x4f, 0x6e, 0x6c, 0x79, 0x20, 0x6c,
+	0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20,
+	0x6d, 0x61, 0x64, 0x65, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x74, 0x69, 0x6d, 0x65, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x32, 0x2d, 0x30, 0x33, 0x2d, 0x30,
+	0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x2c, 0x4d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x70, 0x61, 0x67, 0x65, 0x4a, 0x02, 0x31, 0x30, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x71, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92,
+	0x41, 0x4f, 0x32, 0x4d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x70, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x2c, 0x20, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x20, 0x70, 0x61, 0x67, 0x65, 0x27,
+	0x73, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8d, 0x01, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
+	0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd0, 0x03, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a,
+	0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32,
+	0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x5e, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x32, 0x2f, 0x4f, 0x6e, 0x6c, 0x79, 0x20,
+	0x67, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x61, 0x67, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x20, 0x77, 0x69, 0x74,
+	0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x4a, 0x14, 0x5b, 0x22, 0x6e, 0x65,
+	0x77, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x22, 0x5d,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0xa6, 0x01, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x32, 0x4e, 0x4f, 0x6e,
+	0x6c, 0x79, 0x20, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6d, 0x61, 0x64, 0x65, 0x20, 0x61, 0x74,
+	0x20, 0x6f, 0x72, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x69,
+	0x6d, 0x65, 0x2c, 0x20, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x20, 0x64, 0x6f, 0x77, 0x6e,
+	0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x4a, 0x16, 0x22, 0x32,
+	0x30, 0x32, 0x32, 0x2d, 0x30, 0x32, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a,
+	0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x5b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xbb, 0x03, 0x0a,
+	0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17,
+	0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x45, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x31, 0x92, 0x41, 0x2e, 0x32, 0x1b, 0x4b, 0x65, 0x79, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x4a, 0x0f, 0x5b, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x2d, 0x76, 0x32, 0x22, 0x5d, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x5a, 0x0a, 0x0c, 0x6b,
+	0x65, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x23, 0x4b, 0x65, 0x79, 0x20, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x4a, 0x0d, 0x5b, 0x22, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2d, 0x22, 0x5d, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0x92,
+	0x41, 0x2b, 0x32, 0x29, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd7, 0x01, 0x0a, 0x14, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
+	0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x41, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0b, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x0e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x07,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x3d, 0x92, 0x41, 0x3a, 0x32, 0x35, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x27, 0x73, 0x20,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x66, 0x6f, 0x72,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4a, 0x01, 0x31, 0x52, 0x0d,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x97, 0x03, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64,
+	0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22,
+	0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32,
+	0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x74, 0x6f,
+	0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4a,
+	0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a,
+	0x14, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x98, 0x03, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66,
+	0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70,
+	0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80,
+	0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32,
+	0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x7b, 0x0a,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x3e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+	0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x6b,
+	0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x03, 0x22, 0x33,
+	0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x02,
+	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x50, 0x72,
+	0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d,
+	0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b,
+	0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41,
+	0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13,
+	0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78,
+	0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x21, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdf,
+	0x03, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32,
+	0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12,
+	0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61,
+	0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61,
+	0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a,
+	0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2,
+	0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92,
+	0x41, 0x4d, 0x32, 0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f,
+	0x20, 0x74, 0x6f, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x4a, 0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x37, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd4, 0x04, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f,
+	0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01,
+	0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c,
+	0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92,
+	0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x40, 0x92, 0x41,
+	0x3d, 0x32, 0x2b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20,
+	0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x04,
+	0x74, 0x72, 0x75, 0x65, 0xa2, 0x02, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x00,
+	0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41, 0x4d,
+	0x32, 0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x74,
+	0x6f, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x4a, 0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x35, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x04, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41,
+	0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75,
+	0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17,
+	0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50,
+	0x92, 0x41, 0x4d, 0x32, 0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72,
+	0x6f, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x4a, 0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x67, 0x0a, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x51, 0x92, 0x41, 0x4e, 0x32, 0x45, 0x57, 0x68, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x70, 0x75, 0x72,
+	0x67, 0x65, 0x64, 0x20, 0x72, 0x69, 0x67, 0x68, 0x74, 0x20, 0x61, 0x77, 0x61, 0x79, 0x20, 0x69,
+	0x6e, 0x73, 0x74, 0x65, 0x61, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x65, 0x69, 0x6e, 0x67, 0x20,
+	0x73, 0x6f, 0x66, 0x74, 0x2d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4a, 0x05, 0x66, 0x61,
+	0x6c, 0x73, 0x65, 0x52, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x98, 0x03, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64,
+	0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22,
+	0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65,
+	0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32,
+	0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65, 0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x74, 0x6f,
+	0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4a,
+	0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xf1, 0x02, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f,
+	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78,
+	0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3e,
+	0x0a, 0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x07, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x64,
+	0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x33, 0x57, 0x68, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x20, 0x62, 0x61, 0x63, 0x6b,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74,
+	0x68, 0x69, 0x6e, 0x67, 0x22, 0x67, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x80, 0x03,
+	0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c,
+	0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92,
+	0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4d, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x5f, 0x6f, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x33, 0x57, 0x68, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x20, 0x72, 0x6f, 0x6c, 0x6c, 0x73, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x20, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x04, 0x74, 0x72,
+	0x75, 0x65, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x22, 0x67, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61,
+	0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a,
+	0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2,
+	0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x22, 0x61, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74,
+	0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xab, 0x04, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f,
+	0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f,
+	0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78,
+	0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x48,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x5b, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5e, 0x0a, 0x05, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x48, 0x92, 0x41, 0x45, 0x32, 0x3c, 0x57, 0x68, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x20, 0x77,
+	0x68, 0x69, 0x63, 0x68, 0x20, 0x61, 0x72, 0x65, 0x6e, 0x27, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x20, 0x61, 0x72, 0x65, 0x20,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4a, 0x05, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x05,
+	0x70, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x5c, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x43, 0x92, 0x41, 0x40, 0x32, 0x38, 0x57, 0x68, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x20, 0x61, 0x72, 0x65, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x62, 0x65, 0x69, 0x6e, 0x67,
+	0x20, 0x6d, 0x61, 0x64, 0x65, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x22, 0x63, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74,
+	0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x91, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x3a, 0x32,
+	0x17, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x0b, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x30, 0x32, 0x13, 0x4e, 0x61,
+	0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80,
+	0x01, 0x01, 0xd2, 0x01, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
+	0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x19,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x0e, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92,
+	0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e,
+	0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x31, 0x92, 0x41, 0x2b, 0x32, 0x19,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x27, 0x73, 0x20, 0x75, 0x73, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0xa2,
+	0x02, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x69, 0x73,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x7f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5d, 0x92, 0x41,
+	0x5a, 0x32, 0x21, 0x41, 0x20, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x73, 0x65, 0x20, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x4a, 0x2f, 0x22, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x73, 0x68, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x2f, 0x75, 0x6e, 0x73, 0x68, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x20, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x20, 0x6d, 0x65, 0x6e,
+	0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0xff, 0x01, 0x80, 0x01, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x6a, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x40, 0x92, 0x41, 0x3d, 0x32, 0x2b,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x04, 0x74, 0x72, 0x75,
+	0x65, 0xa2, 0x02, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f,
+	0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12,
+	0x41, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x73, 0x0a, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0x92, 0x41, 0x47,
+	0x32, 0x3b, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x72, 0x75, 0x65, 0x4a, 0x06, 0x22,
+	0x62, 0x6c, 0x75, 0x65, 0x22, 0x78, 0x32, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x6f, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4e, 0x92, 0x41,
+	0x4b, 0x32, 0x3c, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x4a,
+	0x09, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x22, 0x78, 0x32, 0x52, 0x0a, 0x6f, 0x66,
+	0x66, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x6d, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4b, 0x92,
+	0x41, 0x45, 0x32, 0x35, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x27, 0x73, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x20, 0x74, 0x6f, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe0, 0x41, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3d, 0x92, 0x41, 0x37, 0x32, 0x29, 0x4e,
+	0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x62, 0x65,
+	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x20, 0x74, 0x6f, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x22, 0xe0, 0x41, 0x03, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x50, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73,
+	0x69, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74,
+	0x65, 0x73, 0x12, 0x64, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4e, 0x92, 0x41, 0x4b, 0x32, 0x29, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x20, 0x6f,
+	0x72, 0x20, 0x74, 0x65, 0x61, 0x6d, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x4a, 0x1b, 0x22, 0x74, 0x65, 0x61, 0x6d, 0x2d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x40, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x22, 0x78, 0xff,
+	0x01, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4b, 0x92, 0x41, 0x48, 0x32, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x20, 0x74, 0x6f, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4a, 0x16,
+	0x22, 0x32, 0x30, 0x32, 0x32, 0x2d, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30,
+	0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x5d, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x49, 0x92, 0x41, 0x46, 0x32, 0x1d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x20, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x4a, 0x25, 0x5b, 0x22, 0x74, 0x65, 0x61, 0x6d, 0x3a, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x2c, 0x20, 0x22, 0x6b, 0x69, 0x6e, 0x64, 0x3a, 0x6b, 0x69, 0x6c,
+	0x6c, 0x2d, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x22, 0x5d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x68, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x4e, 0x92, 0x41, 0x48, 0x32, 0x39, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x27, 0x73,
+	0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x4a, 0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0xe0, 0x41,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x80, 0x01, 0x0a, 0x0a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x45, 0x92, 0x41, 0x3f,
+	0x32, 0x25, 0x54, 0x69, 0x6d, 0x65, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x77, 0x61, 0x73, 0x20, 0x73, 0x6f, 0x66, 0x74, 0x2d,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x32, 0x2d, 0x30,
+	0x36, 0x2d, 0x30, 0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0xe0,
+	0x41, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xec, 0x03, 0x0a, 0x10, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x15, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x25, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
+	0x41, 0x03, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x3f, 0x0a,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72,
+	0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x3e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x90,
+	0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x07,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x29, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74,
+	0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61,
+	0x6c, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x22, 0xf7, 0x04, 0x0a, 0x0f, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12, 0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77,
+	0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61, 0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2,
+	0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x8a, 0x01, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x70, 0x92, 0x41,
+	0x6d, 0x32, 0x54, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x2c, 0x20, 0x6f,
+	0x72, 0x20, 0x74, 0x72, 0x75, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x20,
+	0x69, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x64, 0x6f,
+	0x65, 0x73, 0x6e, 0x27, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x20, 0x61, 0x6e, 0x79, 0x20,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x4a, 0x06, 0x22, 0x74, 0x72, 0x75, 0x65, 0x22, 0x78,
+	0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x60, 0x92, 0x41, 0x5d, 0x32,
+	0x34, 0x53, 0x74, 0x61, 0x72, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x69,
+	0x6d, 0x65, 0x20, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6d, 0x61,
+	0x64, 0x65, 0x20, 0x69, 0x6e, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x32, 0x2d, 0x30, 0x32, 0x2d,
+	0x30, 0x31, 0x54, 0x30, 0x37, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0xd2, 0x01, 0x0c,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x0b, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x30, 0x92, 0x41, 0x2d, 0x32, 0x15, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x22, 0x34, 0x32, 0x22, 0xd2, 0x01, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0xa2, 0x02, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x97, 0x01, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x32, 0x32,
+	0x54, 0x69, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x61, 0x73, 0x74,
+	0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x32, 0x2d, 0x30, 0x32, 0x2d, 0x30, 0x31, 0x54,
+	0x30, 0x37, 0x3a, 0x35, 0x39, 0x3a, 0x33, 0x30, 0x5a, 0x22, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x0b,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2e, 0x0a, 0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41,
+	0x03, 0x52, 0x0f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x4b, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x42, 0x03, 0xe0,
+	0x41, 0x03, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0xaa, 0x01, 0x0a,
+	0x0c, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a,
+	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0xe0, 0x41, 0x03, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x10,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0f, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x11,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x0f, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32,
+	0x1d, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x12,
+	0x22, 0x64, 0x72, 0x6f, 0x70, 0x64, 0x6f, 0x77, 0x6e, 0x2d, 0x6d, 0x65, 0x6e, 0x75, 0x62, 0x61,
+	0x72, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x45, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x7b, 0x0a, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x3e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x68, 0x61, 0x76, 0x65,
+	0x2c, 0x20, 0x7a, 0x65, 0x72, 0x6f, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x6b, 0x69, 0x70, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4a, 0x03, 0x22, 0x33, 0x22, 0xa2, 0x02, 0x05,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72,
+	0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x12,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64,
+	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x50, 0x72,
+	0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x12, 0x50, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x1e, 0x4b, 0x65,
+	0x79, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4a, 0x0e, 0x22, 0x6e,
+	0x65, 0x77, 0x2d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x78, 0x32, 0x80, 0x01,
+	0x01, 0xd2, 0x01, 0x03, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x5d, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x47, 0x92, 0x41, 0x44,
+	0x32, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x65, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20,
+	0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x64, 0x20, 0x74, 0x6f, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0xa2, 0x02, 0x07, 0x62, 0x6f, 0x6f,
+	0x6c, 0x65, 0x61, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x07,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x26, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x20, 0x69, 0x6e, 0x20, 0x61, 0x20, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x4a, 0x06, 0x22, 0x62, 0x6c, 0x75, 0x65, 0x22, 0x78, 0x32, 0x80, 0x01, 0x01, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x32, 0x26, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x20, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x20, 0x61, 0x73, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4a, 0x09, 0x22, 0x23, 0x30, 0x30, 0x30, 0x30, 0x66, 0x66, 0x22, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x07, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0x65,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x45, 0x92, 0x41, 0x42, 0x32, 0x33, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x20,
+	0x74, 0x68, 0x61, 0x74, 0x20, 0x67, 0x65, 0x74, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x66,
+	0x72, 0x6f, 0x6d, 0x20, 0x30, 0x20, 0x74, 0x6f, 0x20, 0x31, 0x30, 0x30, 0x4a, 0x02, 0x31, 0x30,
+	0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x6a, 0x0a, 0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4d, 0x92, 0x41, 0x4a, 0x32, 0x37, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x27, 0x73, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x20, 0x75, 0x73,
+	0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x20, 0x61, 0x20, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x09, 0x22, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x22, 0x78, 0xff, 0x01, 0x80, 0x01, 0x01, 0x52, 0x08, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42,
+	0x79, 0x22, 0xa7, 0x03, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5e, 0x0a, 0x09, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x40, 0x92,
+	0x41, 0x3d, 0x32, 0x2a, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x27, 0x73, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4a, 0x09,
+	0x22, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x78, 0xff, 0x01, 0x80, 0x01, 0x01, 0x52,
+	0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72,
+	0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6c, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x22, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4a, 0x0c, 0x5b, 0x22, 0x49,
+	0x44, 0x22, 0x2c, 0x20, 0x22, 0x53, 0x47, 0x22, 0x5d, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x22, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c,
+	0x65, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x04, 0x74, 0x72, 0x75, 0x65, 0xa2,
+	0x02, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x59, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x32, 0x30, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x75, 0x6c, 0x65,
+	0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4a, 0x06, 0x22, 0x62, 0x6c, 0x75, 0x65, 0x22,
+	0x78, 0x32, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x0b, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xc9, 0x03, 0x0a, 0x0b, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x7f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x3c, 0x92, 0x41, 0x39, 0x32, 0x20, 0x41, 0x20, 0x63, 0x6f,
+	0x6e, 0x63, 0x69, 0x73, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4a, 0x0f, 0x22, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x22, 0x78, 0xff, 0x01,
+	0x80, 0x01, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52,
+	0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x65, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0x92, 0x41,
+	0x40, 0x32, 0x30, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x20, 0x68, 0x61, 0x70, 0x70, 0x65,
+	0x6e, 0x65, 0x64, 0x4a, 0x0c, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x54,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3a, 0x92, 0x41, 0x37, 0x32, 0x29, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x20, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x20, 0x74, 0x6f, 0x4a,
+	0x0a, 0x22, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x2a, 0xdb, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x4e, 0x41, 0x42,
+	0x4c, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x17,
+	0x0a, 0x13, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10,
+	0x05, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x55, 0x52, 0x47, 0x45, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x10, 0x07, 0x2a, 0x98, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54,
+	0x41, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41,
+	0x4e, 0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x4f, 0x4e, 0x10,
+	0x03, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x04, 0x2a, 0xa3, 0x01,
+	0x0a, 0x13, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x21,
+	0x0a, 0x1d, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x10, 0x03, 0x2a, 0xa6, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26, 0x0a, 0x22,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53,
+	0x4b, 0x49, 0x50, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f,
+	0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x2a, 0xe0, 0x01, 0x0a,
+	0x12, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x47,
+	0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f,
+	0x47, 0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54,
+	0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e,
+	0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x05, 0x2a,
+	0x74, 0x0a, 0x0b, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x18, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4a,
+	0x53, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0xa8, 0x02, 0x0a, 0x0c, 0x52, 0x75, 0x6c, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x51, 0x55, 0x41, 0x4c, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a,
+	0x10, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x49,
+	0x4e, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x17, 0x0a,
+	0x13, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x52,
+	0x45, 0x47, 0x45, 0x58, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f,
+	0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x45, 0x4d, 0x56, 0x45, 0x52, 0x5f, 0x47,
+	0x54, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x45, 0x4d, 0x56, 0x45, 0x52, 0x5f, 0x4c, 0x54, 0x10, 0x07,
+	0x12, 0x1c, 0x0a, 0x18, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f,
+	0x52, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x20,
+	0x0a, 0x1c, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x09,
+	0x2a, 0xe4, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x56, 0x41, 0x4c,
+	0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x49,
+	0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x56, 0x41, 0x4c,
+	0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x52, 0x55,
+	0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x56,
+	0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x4c, 0x4c, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x03, 0x12, 0x1d, 0x0a,
+	0x19, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25,
+	0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x50, 0x52, 0x45, 0x52, 0x45, 0x51, 0x55, 0x49, 0x53, 0x49, 0x54, 0x45, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x9f, 0x0a, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x54,
+	0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
+	0x0a, 0x1a, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x22,
+	0x0a, 0x1e, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x5f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x47, 0x47,
+	0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x54,
+	0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x05,
+	0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0x06, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x45,
+	0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x07, 0x12, 0x22, 0x0a,
+	0x1e, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x10,
+	0x08, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52,
+	0x4f, 0x4c, 0x4c, 0x4f, 0x55, 0x54, 0x10, 0x09, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4f, 0x47, 0x47,
+	0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x10, 0x0a, 0x12,
+	0x29, 0x0a, 0x25, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4e, 0x56,
+	0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x0b, 0x12, 0x2b, 0x0a, 0x27, 0x54, 0x4f,
+	0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x45, 0x4e, 0x56, 0x49, 0x52, 0x4f, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0c, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4f, 0x47, 0x47, 0x4c,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0d, 0x12, 0x27,
+	0x0a, 0x23, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0e, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4f, 0x47, 0x47, 0x4c,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x4f,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x0f,
+	0x12, 0x25, 0x0a, 0x21, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x45,
+	0x47, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x10, 0x12, 0x27, 0x0a, 0x23, 0x54, 0x4f, 0x47, 0x47, 0x4c,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x47,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x11,
+	0x12, 0x24, 0x0a, 0x20, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e,
+	0x5f, 0x55, 0x53, 0x45, 0x10, 0x12, 0x12, 0x2a, 0x0a, 0x26, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x52, 0x45, 0x51, 0x55, 0x49, 0x53, 0x49, 0x54, 0x45,
+	0x10, 0x13, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x52, 0x45, 0x51, 0x55, 0x49,
+	0x53, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x10, 0x14, 0x12, 0x24, 0x0a, 0x20,
+	0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x54, 0x53,
+	0x10, 0x15, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x16, 0x12, 0x28, 0x0a, 0x24, 0x54, 0x4f,
+	0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x10, 0x17, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x18, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4f, 0x47, 0x47, 0x4c,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x19, 0x12, 0x29, 0x0a, 0x25,
+	0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x1a, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4f, 0x47, 0x47, 0x4c,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x1b, 0x12,
+	0x23, 0x0a, 0x1f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4c, 0x41, 0x47, 0x47, 0x49,
+	0x4e, 0x47, 0x10, 0x1c, 0x12, 0x2f, 0x0a, 0x2b, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x45, 0x56, 0x41, 0x4c, 0x55, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x10, 0x1d, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x1e, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x4f,
+	0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f,
+	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54,
+	0x10, 0x1f, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x20, 0x2a, 0xef, 0x01, 0x0a, 0x0f, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x1d, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e,
+	0x0a, 0x1a, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d,
+	0x0a, 0x19, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a,
+	0x19, 0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19,
+	0x54, 0x4f, 0x47, 0x47, 0x4c, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x32, 0x99, 0x1a, 0x0a, 0x14,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0xd9, 0x01, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e,
+	0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x92, 0x41,
+	0x16, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x3a, 0x06, 0x74,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x22, 0x39, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
+	0x12, 0xde, 0x01, 0x0a, 0x0c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64,
+	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67, 0x92, 0x41, 0x16, 0x0a, 0x06, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x48, 0x1a, 0x46, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0xe3, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41,
+	0x17, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0d, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x1a, 0x47,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0xdf, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
+	0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x68, 0x92, 0x41, 0x16, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49,
+	0x3a, 0x06, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x32, 0x3f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d,
+	0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x9c, 0x02, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x92, 0x41, 0x23, 0x0a, 0x06, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x3a, 0x01, 0x2a, 0x1a, 0x4d, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x70, 0x72, 0x65, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0xfe, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74,
+	0x12, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x92, 0x41, 0x1d, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4c, 0x3a, 0x01, 0x2a,
+	0x1a, 0x47, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79,
+	0x7d, 0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x75, 0x74, 0x12, 0xf4, 0x01, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70,
+	0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x6e, 0x92, 0x41, 0x1b, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x11, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x3a, 0x01, 0x2a, 0x1a, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0xd7, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64,
+	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x92, 0x41, 0x16, 0x0a, 0x06, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x2a, 0x3f, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0xe3, 0x01, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x32, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72,
+	0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x69, 0x92, 0x41, 0x17, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x1a, 0x47, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0xf8, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x92, 0x41, 0x1c, 0x0a,
+	0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x4a, 0x3a, 0x01, 0x2a, 0x22, 0x45, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x3a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0xf8, 0x01, 0x0a, 0x12,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x12, 0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61,
+	0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
+	0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6f, 0x92, 0x41, 0x1c, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x3a, 0x01, 0x2a, 0x22,
+	0x45, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0xdf, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72,
+	0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x65, 0x92, 0x41, 0x17, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0d,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x45, 0x3a, 0x01, 0x2a, 0x22, 0x40, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76,
+	0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x73, 0x3a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xf3, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x6d, 0x92, 0x41, 0x1b, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x11, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x49, 0x3a, 0x01, 0x2a, 0x22, 0x44, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d,
+	0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65,
+	0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x65, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0xd6,
+	0x01, 0x92, 0x41, 0xd2, 0x01, 0x12, 0xcf, 0x01, 0x54, 0x68, 0x69, 0x73, 0x20, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x20, 0x62, 0x61,
+	0x73, 0x69, 0x63, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x6f, 0x72, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x2d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x75, 0x73,
+	0x65, 0x20, 0x63, 0x61, 0x73, 0x65, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x77, 0x6f, 0x72, 0x6b, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x41, 0x20, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x69, 0x73, 0x20,
+	0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61,
+	0x20, 0x6b, 0x65, 0x79, 0x20, 0x61, 0x73, 0x20, 0x69, 0x74, 0x73, 0x20, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x45, 0x76,
+	0x65, 0x72, 0x79, 0x20, 0x75, 0x73, 0x65, 0x20, 0x63, 0x61, 0x73, 0x65, 0x20, 0x69, 0x73, 0x20,
+	0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x32, 0xa3, 0x0f, 0x0a, 0x12, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xdf,
+	0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42,
+	0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x92, 0x41,
+	0x18, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x42, 0x79, 0x4b, 0x65, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12,
+	0x3f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d,
+	0x12, 0xd5, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61,
+	0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69,
+	0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x92, 0x41, 0x17,
+	0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d,
+	0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0xeb, 0x01, 0x0a, 0x0e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x12, 0x33, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61,
+	0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x92, 0x41, 0x18, 0x0a, 0x06, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2a, 0x0e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4d, 0x3a, 0x01, 0x2a, 0x22, 0x48, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x2f, 0x65, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0xe3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x35, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61,
+	0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x63, 0x92, 0x41, 0x19, 0x0a, 0x06, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x12, 0x3f, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x2d, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x12, 0xd1, 0x01, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61,
+	0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e,
+	0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4b, 0x92, 0x41, 0x1b, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x2a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0xde, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x61,
+	0x92, 0x41, 0x18, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0e, 0x47, 0x65, 0x74,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x40, 0x12, 0x3e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f,
+	0x7b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2d, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0xdc, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72,
+	0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x92, 0x41,
+	0x17, 0x0a, 0x06, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x42, 0x12, 0x40,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x7d, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x7d, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x92, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x73, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73,
+	0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x64,
+	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2e, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x92, 0x41, 0x16, 0x0a, 0x06, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x73, 0x30, 0x01, 0x1a, 0xd5, 0x01, 0x92, 0x41, 0xd1, 0x01, 0x12, 0xce, 0x01, 0x54,
+	0x68, 0x69, 0x73, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x73, 0x20, 0x62, 0x61, 0x73, 0x69, 0x63, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x20, 0x6f, 0x72, 0x20, 0x64, 0x61, 0x74, 0x61, 0x2d, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x69, 0x6e, 0x67, 0x20, 0x75, 0x73, 0x65, 0x20, 0x63, 0x61, 0x73, 0x65, 0x73, 0x20, 0x74, 0x6f,
+	0x20, 0x77, 0x6f, 0x72, 0x6b, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x2d, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2e, 0x41, 0x20, 0x74, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x65,
+	0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x6b, 0x65, 0x79, 0x20, 0x61, 0x73, 0x20, 0x69, 0x74,
+	0x73, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x45, 0x76, 0x65, 0x72, 0x79, 0x20, 0x75, 0x73, 0x65, 0x20, 0x63, 0x61,
+	0x73, 0x65, 0x20, 0x69, 0x73, 0x20, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20,
+	0x61, 0x20, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x6e,
+	0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0xa3, 0x02,
+	0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64,
+	0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x76, 0x31, 0x92, 0x41, 0xd9, 0x01, 0x12, 0x9f, 0x01, 0x0a, 0x12, 0x46,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x2d, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x20, 0x41, 0x50,
+	0x49, 0x22, 0x30, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x72, 0x61, 0x20, 0x53, 0x61, 0x70, 0x75, 0x74,
+	0x72, 0x61, 0x12, 0x1f, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75,
+	0x74, 0x72, 0x61, 0x2a, 0x50, 0x0a, 0x14, 0x42, 0x53, 0x44, 0x20, 0x33, 0x2d, 0x43, 0x6c, 0x61,
+	0x75, 0x73, 0x65, 0x20, 0x4c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x68, 0x74, 0x74,
+	0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x69, 0x6e, 0x64, 0x72, 0x61, 0x73, 0x61, 0x70, 0x75, 0x74, 0x72, 0x61, 0x2f, 0x74, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49,
+	0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x1a, 0x0e, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x3a, 0x38, 0x30, 0x38, 0x31, 0x2a, 0x01, 0x01, 0x32,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescOnce sync.Once
+	file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData = file_proto_indrasaputra_toggle_v1_toggle_proto_rawDesc
+)
+
+func file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescGZIP() []byte {
+	file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescOnce.Do(func() {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData)
+	})
+	return file_proto_indrasaputra_toggle_v1_toggle_proto_rawDescData
+}
+
+var file_proto_indrasaputra_toggle_v1_toggle_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_proto_indrasaputra_toggle_v1_toggle_proto_goTypes = []interface{}{
+	(AuditAction)(0),                          // 0: proto.indrasaputra.toggle.v1.AuditAction
+	(StaleReason)(0),                          // 1: proto.indrasaputra.toggle.v1.StaleReason
+	(ToggleOperationType)(0),                  // 2: proto.indrasaputra.toggle.v1.ToggleOperationType
+	(ImportConflictPolicy)(0),                 // 3: proto.indrasaputra.toggle.v1.ImportConflictPolicy
+	(ToggleImportAction)(0),                   // 4: proto.indrasaputra.toggle.v1.ToggleImportAction
+	(VariantType)(0),                          // 5: proto.indrasaputra.toggle.v1.VariantType
+	(RuleOperator)(0),                         // 6: proto.indrasaputra.toggle.v1.RuleOperator
+	(EvaluationReason)(0),                     // 7: proto.indrasaputra.toggle.v1.EvaluationReason
+	(ToggleErrorCode)(0),                      // 8: proto.indrasaputra.toggle.v1.ToggleErrorCode
+	(ToggleEventName)(0),                      // 9: proto.indrasaputra.toggle.v1.ToggleEventName
+	(*CreateToggleRequest)(nil),               // 10: proto.indrasaputra.toggle.v1.CreateToggleRequest
+	(*CreateToggleResponse)(nil),              // 11: proto.indrasaputra.toggle.v1.CreateToggleResponse
+	(*UpdateToggleRequest)(nil),               // 12: proto.indrasaputra.toggle.v1.UpdateToggleRequest
+	(*UpdateToggleResponse)(nil),              // 13: proto.indrasaputra.toggle.v1.UpdateToggleResponse
+	(*GetToggleByKeyRequest)(nil),             // 14: proto.indrasaputra.toggle.v1.GetToggleByKeyRequest
+	(*GetToggleByKeyResponse)(nil),            // 15: proto.indrasaputra.toggle.v1.GetToggleByKeyResponse
+	(*GetAllTogglesRequest)(nil),              // 16: proto.indrasaputra.toggle.v1.GetAllTogglesRequest
+	(*GetAllTogglesResponse)(nil),             // 17: proto.indrasaputra.toggle.v1.GetAllTogglesResponse
+	(*EvaluateToggleRequest)(nil),             // 18: proto.indrasaputra.toggle.v1.EvaluateToggleRequest
+	(*EvaluateToggleResponse)(nil),            // 19: proto.indrasaputra.toggle.v1.EvaluateToggleResponse
+	(*GetStaleTogglesRequest)(nil),            // 20: proto.indrasaputra.toggle.v1.GetStaleTogglesRequest
+	(*GetStaleTogglesResponse)(nil),           // 21: proto.indrasaputra.toggle.v1.GetStaleTogglesResponse
+	(*ListToggleHistoryRequest)(nil),          // 22: proto.indrasaputra.toggle.v1.ListToggleHistoryRequest
+	(*ListToggleHistoryResponse)(nil),         // 23: proto.indrasaputra.toggle.v1.ListToggleHistoryResponse
+	(*GetToggleUsageRequest)(nil),             // 24: proto.indrasaputra.toggle.v1.GetToggleUsageRequest
+	(*GetToggleUsageResponse)(nil),            // 25: proto.indrasaputra.toggle.v1.GetToggleUsageResponse
+	(*WatchTogglesRequest)(nil),               // 26: proto.indrasaputra.toggle.v1.WatchTogglesRequest
+	(*WatchTogglesResponse)(nil),              // 27: proto.indrasaputra.toggle.v1.WatchTogglesResponse
+	(*ToggleSnapshot)(nil),                    // 28: proto.indrasaputra.toggle.v1.ToggleSnapshot
+	(*EnableToggleRequest)(nil),               // 29: proto.indrasaputra.toggle.v1.EnableToggleRequest
+	(*EnableToggleResponse)(nil),              // 30: proto.indrasaputra.toggle.v1.EnableToggleResponse
+	(*DisableToggleRequest)(nil),              // 31: proto.indrasaputra.toggle.v1.DisableToggleRequest
+	(*DisableToggleResponse)(nil),             // 32: proto.indrasaputra.toggle.v1.DisableToggleResponse
+	(*UpdateTogglePrerequisitesRequest)(nil),  // 33: proto.indrasaputra.toggle.v1.UpdateTogglePrerequisitesRequest
+	(*UpdateTogglePrerequisitesResponse)(nil), // 34: proto.indrasaputra.toggle.v1.UpdateTogglePrerequisitesResponse
+	(*UpdateToggleRolloutRequest)(nil),        // 35: proto.indrasaputra.toggle.v1.UpdateToggleRolloutRequest
+	(*UpdateToggleRolloutResponse)(nil),       // 36: proto.indrasaputra.toggle.v1.UpdateToggleRolloutResponse
+	(*UpdateToggleRulesRequest)(nil),          // 37: proto.indrasaputra.toggle.v1.UpdateToggleRulesRequest
+	(*UpdateToggleRulesResponse)(nil),         // 38: proto.indrasaputra.toggle.v1.UpdateToggleRulesResponse
+	(*DeleteToggleRequest)(nil),               // 39: proto.indrasaputra.toggle.v1.DeleteToggleRequest
+	(*DeleteToggleResponse)(nil),              // 40: proto.indrasaputra.toggle.v1.DeleteToggleResponse
+	(*RestoreToggleRequest)(nil),              // 41: proto.indrasaputra.toggle.v1.RestoreToggleRequest
+	(*RestoreToggleResponse)(nil),             // 42: proto.indrasaputra.toggle.v1.RestoreToggleResponse
+	(*BatchCreateTogglesRequest)(nil),         // 43: proto.indrasaputra.toggle.v1.BatchCreateTogglesRequest
+	(*BatchCreateTogglesResponse)(nil),        // 44: proto.indrasaputra.toggle.v1.BatchCreateTogglesResponse
+	(*BatchUpdateTogglesRequest)(nil),         // 45: proto.indrasaputra.toggle.v1.BatchUpdateTogglesRequest
+	(*BatchUpdateTogglesResponse)(nil),        // 46: proto.indrasaputra.toggle.v1.BatchUpdateTogglesResponse
+	(*ExportTogglesRequest)(nil),              // 47: proto.indrasaputra.toggle.v1.ExportTogglesRequest
+	(*ExportTogglesResponse)(nil),             // 48: proto.indrasaputra.toggle.v1.ExportTogglesResponse
+	(*ImportTogglesRequest)(nil),              // 49: proto.indrasaputra.toggle.v1.ImportTogglesRequest
+	(*ImportTogglesResponse)(nil),             // 50: proto.indrasaputra.toggle.v1.ImportTogglesResponse
+	(*ReportEvaluationsRequest)(nil),          // 51: proto.indrasaputra.toggle.v1.ReportEvaluationsRequest
+	(*ReportEvaluationsResponse)(nil),         // 52: proto.indrasaputra.toggle.v1.ReportEvaluationsResponse
+	(*Toggle)(nil),                            // 53: proto.indrasaputra.toggle.v1.Toggle
+	(*ToggleAuditEntry)(nil),                  // 54: proto.indrasaputra.toggle.v1.ToggleAuditEntry
+	(*StaleToggle)(nil),                       // 55: proto.indrasaputra.toggle.v1.StaleToggle
+	(*EvaluationCount)(nil),                   // 56: proto.indrasaputra.toggle.v1.EvaluationCount
+	(*ToggleUsage)(nil),                       // 57: proto.indrasaputra.toggle.v1.ToggleUsage
+	(*VariantUsage)(nil),                      // 58: proto.indrasaputra.toggle.v1.VariantUsage
+	(*ToggleOperation)(nil),                   // 59: proto.indrasaputra.toggle.v1.ToggleOperation
+	(*BatchToggleResult)(nil),                 // 60: proto.indrasaputra.toggle.v1.BatchToggleResult
+	(*ToggleImportChange)(nil),                // 61: proto.indrasaputra.toggle.v1.ToggleImportChange
+	(*Prerequisite)(nil),                      // 62: proto.indrasaputra.toggle.v1.Prerequisite
+	(*Variant)(nil),                           // 63: proto.indrasaputra.toggle.v1.Variant
+	(*Rollout)(nil),                           // 64: proto.indrasaputra.toggle.v1.Rollout
+	(*Rule)(nil),                              // 65: proto.indrasaputra.toggle.v1.Rule
+	(*ToggleError)(nil),                       // 66: proto.indrasaputra.toggle.v1.ToggleError
+	(*ToggleEvent)(nil),                       // 67: proto.indrasaputra.toggle.v1.ToggleEvent
+	nil,                                       // 68: proto.indrasaputra.toggle.v1.EvaluateToggleRequest.ContextEntry
+	(*fieldmaskpb.FieldMask)(nil),             // 69: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),             // 70: google.protobuf.Timestamp
+}
+var file_proto_indrasaputra_toggle_v1_toggle_proto_depIdxs = []int32{
+	53, // 0: proto.indrasaputra.toggle.v1.CreateToggleRequest.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	53, // 1: proto.indrasaputra.toggle.v1.UpdateToggleRequest.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	69, // 2: proto.indrasaputra.toggle.v1.UpdateToggleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	53, // 3: proto.indrasaputra.toggle.v1.UpdateToggleResponse.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	53, // 4: proto.indrasaputra.toggle.v1.GetToggleByKeyResponse.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	53, // 5: proto.indrasaputra.toggle.v1.GetAllTogglesResponse.toggles:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	68, // 6: proto.indrasaputra.toggle.v1.EvaluateToggleRequest.context:type_name -> proto.indrasaputra.toggle.v1.EvaluateToggleRequest.ContextEntry
+	7,  // 7: proto.indrasaputra.toggle.v1.EvaluateToggleResponse.reason:type_name -> proto.indrasaputra.toggle.v1.EvaluationReason
+	65, // 8: proto.indrasaputra.toggle.v1.EvaluateToggleResponse.matched_rule:type_name -> proto.indrasaputra.toggle.v1.Rule
+	63, // 9: proto.indrasaputra.toggle.v1.EvaluateToggleResponse.variant:type_name -> proto.indrasaputra.toggle.v1.Variant
+	55, // 10: proto.indrasaputra.toggle.v1.GetStaleTogglesResponse.stale_toggles:type_name -> proto.indrasaputra.toggle.v1.StaleToggle
+	70, // 11: proto.indrasaputra.toggle.v1.ListToggleHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
+	70, // 12: proto.indrasaputra.toggle.v1.ListToggleHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
+	54, // 13: proto.indrasaputra.toggle.v1.ListToggleHistoryResponse.entries:type_name -> proto.indrasaputra.toggle.v1.ToggleAuditEntry
+	70, // 14: proto.indrasaputra.toggle.v1.GetToggleUsageRequest.start_time:type_name -> google.protobuf.Timestamp
+	57, // 15: proto.indrasaputra.toggle.v1.GetToggleUsageResponse.usages:type_name -> proto.indrasaputra.toggle.v1.ToggleUsage
+	28, // 16: proto.indrasaputra.toggle.v1.WatchTogglesResponse.snapshot:type_name -> proto.indrasaputra.toggle.v1.ToggleSnapshot
+	67, // 17: proto.indrasaputra.toggle.v1.WatchTogglesResponse.event:type_name -> proto.indrasaputra.toggle.v1.ToggleEvent
+	53, // 18: proto.indrasaputra.toggle.v1.ToggleSnapshot.toggles:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	70, // 19: proto.indrasaputra.toggle.v1.ToggleSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	62, // 20: proto.indrasaputra.toggle.v1.UpdateTogglePrerequisitesRequest.prerequisites:type_name -> proto.indrasaputra.toggle.v1.Prerequisite
+	64, // 21: proto.indrasaputra.toggle.v1.UpdateToggleRolloutRequest.rollout:type_name -> proto.indrasaputra.toggle.v1.Rollout
+	65, // 22: proto.indrasaputra.toggle.v1.UpdateToggleRulesRequest.rules:type_name -> proto.indrasaputra.toggle.v1.Rule
+	53, // 23: proto.indrasaputra.toggle.v1.BatchCreateTogglesRequest.toggles:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	60, // 24: proto.indrasaputra.toggle.v1.BatchCreateTogglesResponse.results:type_name -> proto.indrasaputra.toggle.v1.BatchToggleResult
+	59, // 25: proto.indrasaputra.toggle.v1.BatchUpdateTogglesRequest.operations:type_name -> proto.indrasaputra.toggle.v1.ToggleOperation
+	60, // 26: proto.indrasaputra.toggle.v1.BatchUpdateTogglesResponse.results:type_name -> proto.indrasaputra.toggle.v1.BatchToggleResult
+	28, // 27: proto.indrasaputra.toggle.v1.ExportTogglesResponse.snapshot:type_name -> proto.indrasaputra.toggle.v1.ToggleSnapshot
+	28, // 28: proto.indrasaputra.toggle.v1.ImportTogglesRequest.snapshot:type_name -> proto.indrasaputra.toggle.v1.ToggleSnapshot
+	3,  // 29: proto.indrasaputra.toggle.v1.ImportTogglesRequest.conflict_policy:type_name -> proto.indrasaputra.toggle.v1.ImportConflictPolicy
+	61, // 30: proto.indrasaputra.toggle.v1.ImportTogglesResponse.changes:type_name -> proto.indrasaputra.toggle.v1.ToggleImportChange
+	56, // 31: proto.indrasaputra.toggle.v1.ReportEvaluationsRequest.counts:type_name -> proto.indrasaputra.toggle.v1.EvaluationCount
+	70, // 32: proto.indrasaputra.toggle.v1.Toggle.created_at:type_name -> google.protobuf.Timestamp
+	70, // 33: proto.indrasaputra.toggle.v1.Toggle.updated_at:type_name -> google.protobuf.Timestamp
+	65, // 34: proto.indrasaputra.toggle.v1.Toggle.rules:type_name -> proto.indrasaputra.toggle.v1.Rule
+	64, // 35: proto.indrasaputra.toggle.v1.Toggle.rollout:type_name -> proto.indrasaputra.toggle.v1.Rollout
+	63, // 36: proto.indrasaputra.toggle.v1.Toggle.variants:type_name -> proto.indrasaputra.toggle.v1.Variant
+	62, // 37: proto.indrasaputra.toggle.v1.Toggle.prerequisites:type_name -> proto.indrasaputra.toggle.v1.Prerequisite
+	70, // 38: proto.indrasaputra.toggle.v1.Toggle.expires_at:type_name -> google.protobuf.Timestamp
+	70, // 39: proto.indrasaputra.toggle.v1.Toggle.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 40: proto.indrasaputra.toggle.v1.ToggleAuditEntry.action:type_name -> proto.indrasaputra.toggle.v1.AuditAction
+	53, // 41: proto.indrasaputra.toggle.v1.ToggleAuditEntry.before:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	53, // 42: proto.indrasaputra.toggle.v1.ToggleAuditEntry.after:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	70, // 43: proto.indrasaputra.toggle.v1.ToggleAuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	53, // 44: proto.indrasaputra.toggle.v1.StaleToggle.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	1,  // 45: proto.indrasaputra.toggle.v1.StaleToggle.reasons:type_name -> proto.indrasaputra.toggle.v1.StaleReason
+	70, // 46: proto.indrasaputra.toggle.v1.EvaluationCount.window_start:type_name -> google.protobuf.Timestamp
+	70, // 47: proto.indrasaputra.toggle.v1.EvaluationCount.last_evaluated_at:type_name -> google.protobuf.Timestamp
+	70, // 48: proto.indrasaputra.toggle.v1.ToggleUsage.last_evaluated_at:type_name -> google.protobuf.Timestamp
+	58, // 49: proto.indrasaputra.toggle.v1.ToggleUsage.variants:type_name -> proto.indrasaputra.toggle.v1.VariantUsage
+	70, // 50: proto.indrasaputra.toggle.v1.VariantUsage.last_evaluated_at:type_name -> google.protobuf.Timestamp
+	2,  // 51: proto.indrasaputra.toggle.v1.ToggleOperation.type:type_name -> proto.indrasaputra.toggle.v1.ToggleOperationType
+	8,  // 52: proto.indrasaputra.toggle.v1.BatchToggleResult.error_code:type_name -> proto.indrasaputra.toggle.v1.ToggleErrorCode
+	4,  // 53: proto.indrasaputra.toggle.v1.ToggleImportChange.action:type_name -> proto.indrasaputra.toggle.v1.ToggleImportAction
+	8,  // 54: proto.indrasaputra.toggle.v1.ToggleImportChange.error_code:type_name -> proto.indrasaputra.toggle.v1.ToggleErrorCode
+	5,  // 55: proto.indrasaputra.toggle.v1.Variant.type:type_name -> proto.indrasaputra.toggle.v1.VariantType
+	6,  // 56: proto.indrasaputra.toggle.v1.Rule.operator:type_name -> proto.indrasaputra.toggle.v1.RuleOperator
+	8,  // 57: proto.indrasaputra.toggle.v1.ToggleError.error_code:type_name -> proto.indrasaputra.toggle.v1.ToggleErrorCode
+	9,  // 58: proto.indrasaputra.toggle.v1.ToggleEvent.name:type_name -> proto.indrasaputra.toggle.v1.ToggleEventName
+	53, // 59: proto.indrasaputra.toggle.v1.ToggleEvent.toggle:type_name -> proto.indrasaputra.toggle.v1.Toggle
+	70, // 60: proto.indrasaputra.toggle.v1.ToggleEvent.created_at:type_name -> google.protobuf.Timestamp
+	10, // 61: proto.indrasaputra.toggle.v1.ToggleCommandService.CreateToggle:input_type -> proto.indrasaputra.toggle.v1.CreateToggleRequest
+	29, // 62: proto.indrasaputra.toggle.v1.ToggleCommandService.EnableToggle:input_type -> proto.indrasaputra.toggle.v1.EnableToggleRequest
+	31, // 63: proto.indrasaputra.toggle.v1.ToggleCommandService.DisableToggle:input_type -> proto.indrasaputra.toggle.v1.DisableToggleRequest
+	12, // 64: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateToggle:input_type -> proto.indrasaputra.toggle.v1.UpdateToggleRequest
+	33, // 65: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateTogglePrerequisites:input_type -> proto.indrasaputra.toggle.v1.UpdateTogglePrerequisitesRequest
+	35, // 66: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateToggleRollout:input_type -> proto.indrasaputra.toggle.v1.UpdateToggleRolloutRequest
+	37, // 67: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateToggleRules:input_type -> proto.indrasaputra.toggle.v1.UpdateToggleRulesRequest
+	39, // 68: proto.indrasaputra.toggle.v1.ToggleCommandService.DeleteToggle:input_type -> proto.indrasaputra.toggle.v1.DeleteToggleRequest
+	41, // 69: proto.indrasaputra.toggle.v1.ToggleCommandService.RestoreToggle:input_type -> proto.indrasaputra.toggle.v1.RestoreToggleRequest
+	43, // 70: proto.indrasaputra.toggle.v1.ToggleCommandService.BatchCreateToggles:input_type -> proto.indrasaputra.toggle.v1.BatchCreateTogglesRequest
+	45, // 71: proto.indrasaputra.toggle.v1.ToggleCommandService.BatchUpdateToggles:input_type -> proto.indrasaputra.toggle.v1.BatchUpdateTogglesRequest
+	49, // 72: proto.indrasaputra.toggle.v1.ToggleCommandService.ImportToggles:input_type -> proto.indrasaputra.toggle.v1.ImportTogglesRequest
+	51, // 73: proto.indrasaputra.toggle.v1.ToggleCommandService.ReportEvaluations:input_type -> proto.indrasaputra.toggle.v1.ReportEvaluationsRequest
+	14, // 74: proto.indrasaputra.toggle.v1.ToggleQueryService.GetToggleByKey:input_type -> proto.indrasaputra.toggle.v1.GetToggleByKeyRequest
+	16, // 75: proto.indrasaputra.toggle.v1.ToggleQueryService.GetAllToggles:input_type -> proto.indrasaputra.toggle.v1.GetAllTogglesRequest
+	18, // 76: proto.indrasaputra.toggle.v1.ToggleQueryService.EvaluateToggle:input_type -> proto.indrasaputra.toggle.v1.EvaluateToggleRequest
+	20, // 77: proto.indrasaputra.toggle.v1.ToggleQueryService.GetStaleToggles:input_type -> proto.indrasaputra.toggle.v1.GetStaleTogglesRequest
+	22, // 78: proto.indrasaputra.toggle.v1.ToggleQueryService.ListToggleHistory:input_type -> proto.indrasaputra.toggle.v1.ListToggleHistoryRequest
+	24, // 79: proto.indrasaputra.toggle.v1.ToggleQueryService.GetToggleUsage:input_type -> proto.indrasaputra.toggle.v1.GetToggleUsageRequest
+	47, // 80: proto.indrasaputra.toggle.v1.ToggleQueryService.ExportToggles:input_type -> proto.indrasaputra.toggle.v1.ExportTogglesRequest
+	26, // 81: proto.indrasaputra.toggle.v1.ToggleQueryService.WatchToggles:input_type -> proto.indrasaputra.toggle.v1.WatchTogglesRequest
+	11, // 82: proto.indrasaputra.toggle.v1.ToggleCommandService.CreateToggle:output_type -> proto.indrasaputra.toggle.v1.CreateToggleResponse
+	30, // 83: proto.indrasaputra.toggle.v1.ToggleCommandService.EnableToggle:output_type -> proto.indrasaputra.toggle.v1.EnableToggleResponse
+	32, // 84: proto.indrasaputra.toggle.v1.ToggleCommandService.DisableToggle:output_type -> proto.indrasaputra.toggle.v1.DisableToggleResponse
+	13, // 85: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateToggle:output_type -> proto.indrasaputra.toggle.v1.UpdateToggleResponse
+	34, // 86: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateTogglePrerequisites:output_type -> proto.indrasaputra.toggle.v1.UpdateTogglePrerequisitesResponse
+	36, // 87: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateToggleRollout:output_type -> proto.indrasaputra.toggle.v1.UpdateToggleRolloutResponse
+	38, // 88: proto.indrasaputra.toggle.v1.ToggleCommandService.UpdateToggleRules:output_type -> proto.indrasaputra.toggle.v1.UpdateToggleRulesResponse
+	40, // 89: proto.indrasaputra.toggle.v1.ToggleCommandService.DeleteToggle:output_type -> proto.indrasaputra.toggle.v1.DeleteToggleResponse
+	42, // 90: proto.indrasaputra.toggle.v1.ToggleCommandService.RestoreToggle:output_type -> proto.indrasaputra.toggle.v1.RestoreToggleResponse
+	44, // 91: proto.indrasaputra.toggle.v1.ToggleCommandService.BatchCreateToggles:output_type -> proto.indrasaputra.toggle.v1.BatchCreateTogglesResponse
+	46, // 92: proto.indrasaputra.toggle.v1.ToggleCommandService.BatchUpdateToggles:output_type -> proto.indrasaputra.toggle.v1.BatchUpdateTogglesResponse
+	50, // 93: proto.indrasaputra.toggle.v1.ToggleCommandService.ImportToggles:output_type -> proto.indrasaputra.toggle.v1.ImportTogglesResponse
+	52, // 94: proto.indrasaputra.toggle.v1.ToggleCommandService.ReportEvaluations:output_type -> proto.indrasaputra.toggle.v1.ReportEvaluationsResponse
+	15, // 95: proto.indrasaputra.toggle.v1.ToggleQueryService.GetToggleByKey:output_type -> proto.indrasaputra.toggle.v1.GetToggleByKeyResponse
+	17, // 96: proto.indrasaputra.toggle.v1.ToggleQueryService.GetAllToggles:output_type -> proto.indrasaputra.toggle.v1.GetAllTogglesResponse
+	19, // 97: proto.indrasaputra.toggle.v1.ToggleQueryService.EvaluateToggle:output_type -> proto.indrasaputra.toggle.v1.EvaluateToggleResponse
+	21, // 98: proto.indrasaputra.toggle.v1.ToggleQueryService.GetStaleToggles:output_type -> proto.indrasaputra.toggle.v1.GetStaleTogglesResponse
+	23, // 99: proto.indrasaputra.toggle.v1.ToggleQueryService.ListToggleHistory:output_type -> proto.indrasaputra.toggle.v1.ListToggleHistoryResponse
+	25, // 100: proto.indrasaputra.toggle.v1.ToggleQueryService.GetToggleUsage:output_type -> proto.indrasaputra.toggle.v1.GetToggleUsageResponse
+	48, // 101: proto.indrasaputra.toggle.v1.ToggleQueryService.ExportToggles:output_type -> proto.indrasaputra.toggle.v1.ExportTogglesResponse
+	27, // 102: proto.indrasaputra.toggle.v1.ToggleQueryService.WatchToggles:output_type -> proto.indrasaputra.toggle.v1.WatchTogglesResponse
+	82, // [82:103] is the sub-list for method output_type
+	61, // [61:82] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_proto_indrasaputra_toggle_v1_toggle_proto_init() }
+func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
+	if File_proto_indrasaputra_toggle_v1_toggle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateToggleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateToggleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateToggleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateToggleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToggleByKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToggleByKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateToggleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateToggleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStaleTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStaleTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListToggleHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListToggleHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToggleUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetToggleUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableToggleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableToggleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableToggleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableToggleResponse); i {
+			case 0:
 				return &v.state
 			case 1:
 				return &v.sizeCache
@@ -1257,8 +7256,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetToggleByKeyRequest); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTogglePrerequisitesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1269,8 +7268,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetToggleByKeyResponse); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateTogglePrerequisitesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1281,8 +7280,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTogglesRequest); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateToggleRolloutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1293,8 +7292,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTogglesResponse); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateToggleRolloutResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1305,8 +7304,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableToggleRequest); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateToggleRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1317,8 +7316,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableToggleResponse); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateToggleRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1329,8 +7328,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableToggleRequest); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteToggleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1341,8 +7340,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableToggleResponse); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteToggleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1353,8 +7352,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteToggleRequest); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreToggleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,8 +7364,8 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteToggleResponse); i {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreToggleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,7 +7376,127 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTogglesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportTogglesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportEvaluationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportEvaluationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Toggle); i {
 			case 0:
 				return &v.state
@@ -1389,7 +7508,151 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleAuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaleToggle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VariantUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchToggleResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleImportChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Prerequisite); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Variant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rollout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ToggleError); i {
 			case 0:
 				return &v.state
@@ -1401,7 +7664,7 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 				return nil
 			}
 		}
-		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ToggleEvent); i {
 			case 0:
 				return &v.state
@@ -1414,13 +7677,19 @@ func file_proto_indrasaputra_toggle_v1_toggle_proto_init() {
 			}
 		}
 	}
+	file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*WatchTogglesResponse_Snapshot)(nil),
+		(*WatchTogglesResponse_Event)(nil),
+	}
+	file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_proto_indrasaputra_toggle_v1_toggle_proto_msgTypes[43].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_indrasaputra_toggle_v1_toggle_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   15,
+			NumEnums:      10,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
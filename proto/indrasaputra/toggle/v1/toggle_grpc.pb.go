@@ -23,8 +23,10 @@ type ToggleCommandServiceClient interface {
 	//
 	// This endpoint creates a new toggle with provided key and description.
 	// The description can be left empty, but the key must exists.
-	// The key must be unique and it can only contain alphanumeric and dash.
+	// The key must be unique within the project and it can only contain alphanumeric and dash.
 	// The key will be converted to lower case.
+	// The toggle exists in all environments, but its rules, default value, and rollout
+	// are only applied to the given environment. The other environments get the default state.
 	CreateToggle(ctx context.Context, in *CreateToggleRequest, opts ...grpc.CallOption) (*CreateToggleResponse, error)
 	// Enable a toggle.
 	//
@@ -36,11 +38,82 @@ type ToggleCommandServiceClient interface {
 	// This endpoint set toggle's usability to inactive.
 	// Its *isEnabled* attribute will be set to false.
 	DisableToggle(ctx context.Context, in *DisableToggleRequest, opts ...grpc.CallOption) (*DisableToggleResponse, error)
+	// Update a toggle.
+	//
+	// This endpoint updates the toggle's description, owner, expiry time, and tags
+	// listed in the update mask. Empty update mask means all of those fields.
+	// Those fields are shared by all environments, hence the update applies to all environments.
+	// The key and the environment's state can't be changed using this endpoint.
+	UpdateToggle(ctx context.Context, in *UpdateToggleRequest, opts ...grpc.CallOption) (*UpdateToggleResponse, error)
+	// Update a toggle's prerequisites.
+	//
+	// This endpoint replaces the toggle's prerequisites in all environments.
+	// A prerequisite must refer to another existing toggle in the same project
+	// and it must not create a dependency cycle.
+	UpdateTogglePrerequisites(ctx context.Context, in *UpdateTogglePrerequisitesRequest, opts ...grpc.CallOption) (*UpdateTogglePrerequisitesResponse, error)
+	// Update a toggle's rollout.
+	//
+	// This endpoint replaces the toggle's percentage rollout in the given environment.
+	// Other environments are not affected. Empty rollout removes the rollout.
+	UpdateToggleRollout(ctx context.Context, in *UpdateToggleRolloutRequest, opts ...grpc.CallOption) (*UpdateToggleRolloutResponse, error)
+	// Update a toggle's rules.
+	//
+	// This endpoint replaces the toggle's targeting rules and default value in the given environment.
+	// Other environments are not affected. Empty rules remove all rules.
+	// The rules' variants must be the toggle's variants.
+	UpdateToggleRules(ctx context.Context, in *UpdateToggleRulesRequest, opts ...grpc.CallOption) (*UpdateToggleRulesResponse, error)
 	// Delete a toggle.
 	//
 	// This endpoint deletes a toggle by its key.
-	// The operation is hard-delete, thus the toggle will be gone forever.
+	// The operation is soft-delete, thus the toggle disappears from all environments
+	// but it can be restored until the retention period ends. Its key stays reserved meanwhile.
+	// Once the retention period ends, the toggle is purged and gone forever.
+	// Setting purge to true purges the toggle right away, even if it has been soft-deleted.
+	// The toggle must be disabled in all environments and no other toggle may depend on it.
 	DeleteToggle(ctx context.Context, in *DeleteToggleRequest, opts ...grpc.CallOption) (*DeleteToggleResponse, error)
+	// Restore a deleted toggle.
+	//
+	// This endpoint brings back a soft-deleted toggle in all environments before it is purged.
+	// The toggle comes back disabled in all environments, as it was when it was deleted.
+	// The toggle's prerequisites must still exist.
+	RestoreToggle(ctx context.Context, in *RestoreToggleRequest, opts ...grpc.CallOption) (*RestoreToggleResponse, error)
+	// Create many toggles.
+	//
+	// This endpoint creates at most 100 toggles in the environment within a single transaction.
+	// Every toggle is validated the same way as CreateToggle and a toggle may have a prerequisite
+	// which is created earlier in the same request.
+	// If all_or_nothing is true, the first failure rolls back all toggles and its error is returned.
+	// Otherwise, the toggles which succeed are kept and each toggle's result is returned.
+	BatchCreateToggles(ctx context.Context, in *BatchCreateTogglesRequest, opts ...grpc.CallOption) (*BatchCreateTogglesResponse, error)
+	// Update many toggles.
+	//
+	// This endpoint enables, disables, or deletes at most 100 toggles in the environment within a single transaction.
+	// The operations are applied in order, thus a toggle can be disabled and then deleted in the same request.
+	// Every operation is validated the same way as EnableToggle, DisableToggle, and DeleteToggle.
+	// Deletion is soft-delete.
+	// If all_or_nothing is true, the first failure rolls back all operations and its error is returned.
+	// Otherwise, the operations which succeed are kept and each operation's result is returned.
+	BatchUpdateToggles(ctx context.Context, in *BatchUpdateTogglesRequest, opts ...grpc.CallOption) (*BatchUpdateTogglesResponse, error)
+	// Import toggles.
+	//
+	// This endpoint makes the toggles in the environment match a snapshot taken by ExportToggles or written by hand.
+	// The snapshot's toggles are created, updated, enabled, and disabled through the same rules as the other endpoints,
+	// hence they are validated, recorded in the audit log, and published as events the same way.
+	// A toggle which exists with a different content is a conflict which is skipped, overwritten, or fails the whole import
+	// depending on conflict_policy. Variants can only be set when the toggle is created,
+	// hence a conflict on them can't be overwritten.
+	// If prune is true, the toggles which aren't in the snapshot are deleted from the project.
+	// If dry_run is true, nothing is changed and the changes which would be made are returned.
+	// Unlike BatchUpdateToggles, the changes aren't made within a single transaction,
+	// thus each change's failure is returned along with it and doesn't stop the rest.
+	ImportToggles(ctx context.Context, in *ImportTogglesRequest, opts ...grpc.CallOption) (*ImportTogglesResponse, error)
+	// Report evaluations.
+	//
+	// This endpoint records how many times the toggles in the environment have been evaluated by the SDK.
+	// The counts are aggregated per toggle, served variant, and hour, and they are added to the counts reported before.
+	// Toggles which don't exist are recorded as well, since the SDK may report after a toggle is deleted.
+	// Invalid counts are skipped rather than failing the whole report, and the number of skipped counts is returned.
+	ReportEvaluations(ctx context.Context, in *ReportEvaluationsRequest, opts ...grpc.CallOption) (*ReportEvaluationsResponse, error)
 }
 
 type toggleCommandServiceClient struct {
@@ -78,6 +151,42 @@ func (c *toggleCommandServiceClient) DisableToggle(ctx context.Context, in *Disa
 	return out, nil
 }
 
+func (c *toggleCommandServiceClient) UpdateToggle(ctx context.Context, in *UpdateToggleRequest, opts ...grpc.CallOption) (*UpdateToggleResponse, error) {
+	out := new(UpdateToggleResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateToggle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) UpdateTogglePrerequisites(ctx context.Context, in *UpdateTogglePrerequisitesRequest, opts ...grpc.CallOption) (*UpdateTogglePrerequisitesResponse, error) {
+	out := new(UpdateTogglePrerequisitesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateTogglePrerequisites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) UpdateToggleRollout(ctx context.Context, in *UpdateToggleRolloutRequest, opts ...grpc.CallOption) (*UpdateToggleRolloutResponse, error) {
+	out := new(UpdateToggleRolloutResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateToggleRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) UpdateToggleRules(ctx context.Context, in *UpdateToggleRulesRequest, opts ...grpc.CallOption) (*UpdateToggleRulesResponse, error) {
+	out := new(UpdateToggleRulesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateToggleRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *toggleCommandServiceClient) DeleteToggle(ctx context.Context, in *DeleteToggleRequest, opts ...grpc.CallOption) (*DeleteToggleResponse, error) {
 	out := new(DeleteToggleResponse)
 	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/DeleteToggle", in, out, opts...)
@@ -87,6 +196,51 @@ func (c *toggleCommandServiceClient) DeleteToggle(ctx context.Context, in *Delet
 	return out, nil
 }
 
+func (c *toggleCommandServiceClient) RestoreToggle(ctx context.Context, in *RestoreToggleRequest, opts ...grpc.CallOption) (*RestoreToggleResponse, error) {
+	out := new(RestoreToggleResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/RestoreToggle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) BatchCreateToggles(ctx context.Context, in *BatchCreateTogglesRequest, opts ...grpc.CallOption) (*BatchCreateTogglesResponse, error) {
+	out := new(BatchCreateTogglesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/BatchCreateToggles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) BatchUpdateToggles(ctx context.Context, in *BatchUpdateTogglesRequest, opts ...grpc.CallOption) (*BatchUpdateTogglesResponse, error) {
+	out := new(BatchUpdateTogglesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/BatchUpdateToggles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) ImportToggles(ctx context.Context, in *ImportTogglesRequest, opts ...grpc.CallOption) (*ImportTogglesResponse, error) {
+	out := new(ImportTogglesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/ImportToggles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleCommandServiceClient) ReportEvaluations(ctx context.Context, in *ReportEvaluationsRequest, opts ...grpc.CallOption) (*ReportEvaluationsResponse, error) {
+	out := new(ReportEvaluationsResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleCommandService/ReportEvaluations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ToggleCommandServiceServer is the server API for ToggleCommandService service.
 // All implementations must embed UnimplementedToggleCommandServiceServer
 // for forward compatibility
@@ -95,8 +249,10 @@ type ToggleCommandServiceServer interface {
 	//
 	// This endpoint creates a new toggle with provided key and description.
 	// The description can be left empty, but the key must exists.
-	// The key must be unique and it can only contain alphanumeric and dash.
+	// The key must be unique within the project and it can only contain alphanumeric and dash.
 	// The key will be converted to lower case.
+	// The toggle exists in all environments, but its rules, default value, and rollout
+	// are only applied to the given environment. The other environments get the default state.
 	CreateToggle(context.Context, *CreateToggleRequest) (*CreateToggleResponse, error)
 	// Enable a toggle.
 	//
@@ -108,11 +264,82 @@ type ToggleCommandServiceServer interface {
 	// This endpoint set toggle's usability to inactive.
 	// Its *isEnabled* attribute will be set to false.
 	DisableToggle(context.Context, *DisableToggleRequest) (*DisableToggleResponse, error)
+	// Update a toggle.
+	//
+	// This endpoint updates the toggle's description, owner, expiry time, and tags
+	// listed in the update mask. Empty update mask means all of those fields.
+	// Those fields are shared by all environments, hence the update applies to all environments.
+	// The key and the environment's state can't be changed using this endpoint.
+	UpdateToggle(context.Context, *UpdateToggleRequest) (*UpdateToggleResponse, error)
+	// Update a toggle's prerequisites.
+	//
+	// This endpoint replaces the toggle's prerequisites in all environments.
+	// A prerequisite must refer to another existing toggle in the same project
+	// and it must not create a dependency cycle.
+	UpdateTogglePrerequisites(context.Context, *UpdateTogglePrerequisitesRequest) (*UpdateTogglePrerequisitesResponse, error)
+	// Update a toggle's rollout.
+	//
+	// This endpoint replaces the toggle's percentage rollout in the given environment.
+	// Other environments are not affected. Empty rollout removes the rollout.
+	UpdateToggleRollout(context.Context, *UpdateToggleRolloutRequest) (*UpdateToggleRolloutResponse, error)
+	// Update a toggle's rules.
+	//
+	// This endpoint replaces the toggle's targeting rules and default value in the given environment.
+	// Other environments are not affected. Empty rules remove all rules.
+	// The rules' variants must be the toggle's variants.
+	UpdateToggleRules(context.Context, *UpdateToggleRulesRequest) (*UpdateToggleRulesResponse, error)
 	// Delete a toggle.
 	//
 	// This endpoint deletes a toggle by its key.
-	// The operation is hard-delete, thus the toggle will be gone forever.
+	// The operation is soft-delete, thus the toggle disappears from all environments
+	// but it can be restored until the retention period ends. Its key stays reserved meanwhile.
+	// Once the retention period ends, the toggle is purged and gone forever.
+	// Setting purge to true purges the toggle right away, even if it has been soft-deleted.
+	// The toggle must be disabled in all environments and no other toggle may depend on it.
 	DeleteToggle(context.Context, *DeleteToggleRequest) (*DeleteToggleResponse, error)
+	// Restore a deleted toggle.
+	//
+	// This endpoint brings back a soft-deleted toggle in all environments before it is purged.
+	// The toggle comes back disabled in all environments, as it was when it was deleted.
+	// The toggle's prerequisites must still exist.
+	RestoreToggle(context.Context, *RestoreToggleRequest) (*RestoreToggleResponse, error)
+	// Create many toggles.
+	//
+	// This endpoint creates at most 100 toggles in the environment within a single transaction.
+	// Every toggle is validated the same way as CreateToggle and a toggle may have a prerequisite
+	// which is created earlier in the same request.
+	// If all_or_nothing is true, the first failure rolls back all toggles and its error is returned.
+	// Otherwise, the toggles which succeed are kept and each toggle's result is returned.
+	BatchCreateToggles(context.Context, *BatchCreateTogglesRequest) (*BatchCreateTogglesResponse, error)
+	// Update many toggles.
+	//
+	// This endpoint enables, disables, or deletes at most 100 toggles in the environment within a single transaction.
+	// The operations are applied in order, thus a toggle can be disabled and then deleted in the same request.
+	// Every operation is validated the same way as EnableToggle, DisableToggle, and DeleteToggle.
+	// Deletion is soft-delete.
+	// If all_or_nothing is true, the first failure rolls back all operations and its error is returned.
+	// Otherwise, the operations which succeed are kept and each operation's result is returned.
+	BatchUpdateToggles(context.Context, *BatchUpdateTogglesRequest) (*BatchUpdateTogglesResponse, error)
+	// Import toggles.
+	//
+	// This endpoint makes the toggles in the environment match a snapshot taken by ExportToggles or written by hand.
+	// The snapshot's toggles are created, updated, enabled, and disabled through the same rules as the other endpoints,
+	// hence they are validated, recorded in the audit log, and published as events the same way.
+	// A toggle which exists with a different content is a conflict which is skipped, overwritten, or fails the whole import
+	// depending on conflict_policy. Variants can only be set when the toggle is created,
+	// hence a conflict on them can't be overwritten.
+	// If prune is true, the toggles which aren't in the snapshot are deleted from the project.
+	// If dry_run is true, nothing is changed and the changes which would be made are returned.
+	// Unlike BatchUpdateToggles, the changes aren't made within a single transaction,
+	// thus each change's failure is returned along with it and doesn't stop the rest.
+	ImportToggles(context.Context, *ImportTogglesRequest) (*ImportTogglesResponse, error)
+	// Report evaluations.
+	//
+	// This endpoint records how many times the toggles in the environment have been evaluated by the SDK.
+	// The counts are aggregated per toggle, served variant, and hour, and they are added to the counts reported before.
+	// Toggles which don't exist are recorded as well, since the SDK may report after a toggle is deleted.
+	// Invalid counts are skipped rather than failing the whole report, and the number of skipped counts is returned.
+	ReportEvaluations(context.Context, *ReportEvaluationsRequest) (*ReportEvaluationsResponse, error)
 	mustEmbedUnimplementedToggleCommandServiceServer()
 }
 
@@ -129,9 +356,36 @@ func (UnimplementedToggleCommandServiceServer) EnableToggle(context.Context, *En
 func (UnimplementedToggleCommandServiceServer) DisableToggle(context.Context, *DisableToggleRequest) (*DisableToggleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisableToggle not implemented")
 }
+func (UnimplementedToggleCommandServiceServer) UpdateToggle(context.Context, *UpdateToggleRequest) (*UpdateToggleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateToggle not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) UpdateTogglePrerequisites(context.Context, *UpdateTogglePrerequisitesRequest) (*UpdateTogglePrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTogglePrerequisites not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) UpdateToggleRollout(context.Context, *UpdateToggleRolloutRequest) (*UpdateToggleRolloutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateToggleRollout not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) UpdateToggleRules(context.Context, *UpdateToggleRulesRequest) (*UpdateToggleRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateToggleRules not implemented")
+}
 func (UnimplementedToggleCommandServiceServer) DeleteToggle(context.Context, *DeleteToggleRequest) (*DeleteToggleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToggle not implemented")
 }
+func (UnimplementedToggleCommandServiceServer) RestoreToggle(context.Context, *RestoreToggleRequest) (*RestoreToggleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreToggle not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) BatchCreateToggles(context.Context, *BatchCreateTogglesRequest) (*BatchCreateTogglesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateToggles not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) BatchUpdateToggles(context.Context, *BatchUpdateTogglesRequest) (*BatchUpdateTogglesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateToggles not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) ImportToggles(context.Context, *ImportTogglesRequest) (*ImportTogglesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportToggles not implemented")
+}
+func (UnimplementedToggleCommandServiceServer) ReportEvaluations(context.Context, *ReportEvaluationsRequest) (*ReportEvaluationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportEvaluations not implemented")
+}
 func (UnimplementedToggleCommandServiceServer) mustEmbedUnimplementedToggleCommandServiceServer() {}
 
 // UnsafeToggleCommandServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -199,6 +453,78 @@ func _ToggleCommandService_DisableToggle_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToggleCommandService_UpdateToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateToggleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).UpdateToggle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateToggle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).UpdateToggle(ctx, req.(*UpdateToggleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_UpdateTogglePrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTogglePrerequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).UpdateTogglePrerequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateTogglePrerequisites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).UpdateTogglePrerequisites(ctx, req.(*UpdateTogglePrerequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_UpdateToggleRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateToggleRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).UpdateToggleRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateToggleRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).UpdateToggleRollout(ctx, req.(*UpdateToggleRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_UpdateToggleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateToggleRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).UpdateToggleRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/UpdateToggleRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).UpdateToggleRules(ctx, req.(*UpdateToggleRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ToggleCommandService_DeleteToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteToggleRequest)
 	if err := dec(in); err != nil {
@@ -217,6 +543,96 @@ func _ToggleCommandService_DeleteToggle_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToggleCommandService_RestoreToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreToggleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).RestoreToggle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/RestoreToggle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).RestoreToggle(ctx, req.(*RestoreToggleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_BatchCreateToggles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTogglesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).BatchCreateToggles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/BatchCreateToggles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).BatchCreateToggles(ctx, req.(*BatchCreateTogglesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_BatchUpdateToggles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTogglesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).BatchUpdateToggles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/BatchUpdateToggles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).BatchUpdateToggles(ctx, req.(*BatchUpdateTogglesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_ImportToggles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTogglesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).ImportToggles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/ImportToggles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).ImportToggles(ctx, req.(*ImportTogglesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleCommandService_ReportEvaluations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportEvaluationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleCommandServiceServer).ReportEvaluations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleCommandService/ReportEvaluations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleCommandServiceServer).ReportEvaluations(ctx, req.(*ReportEvaluationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ToggleCommandService_ServiceDesc is the grpc.ServiceDesc for ToggleCommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -236,10 +652,46 @@ var ToggleCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DisableToggle",
 			Handler:    _ToggleCommandService_DisableToggle_Handler,
 		},
+		{
+			MethodName: "UpdateToggle",
+			Handler:    _ToggleCommandService_UpdateToggle_Handler,
+		},
+		{
+			MethodName: "UpdateTogglePrerequisites",
+			Handler:    _ToggleCommandService_UpdateTogglePrerequisites_Handler,
+		},
+		{
+			MethodName: "UpdateToggleRollout",
+			Handler:    _ToggleCommandService_UpdateToggleRollout_Handler,
+		},
+		{
+			MethodName: "UpdateToggleRules",
+			Handler:    _ToggleCommandService_UpdateToggleRules_Handler,
+		},
 		{
 			MethodName: "DeleteToggle",
 			Handler:    _ToggleCommandService_DeleteToggle_Handler,
 		},
+		{
+			MethodName: "RestoreToggle",
+			Handler:    _ToggleCommandService_RestoreToggle_Handler,
+		},
+		{
+			MethodName: "BatchCreateToggles",
+			Handler:    _ToggleCommandService_BatchCreateToggles_Handler,
+		},
+		{
+			MethodName: "BatchUpdateToggles",
+			Handler:    _ToggleCommandService_BatchUpdateToggles_Handler,
+		},
+		{
+			MethodName: "ImportToggles",
+			Handler:    _ToggleCommandService_ImportToggles_Handler,
+		},
+		{
+			MethodName: "ReportEvaluations",
+			Handler:    _ToggleCommandService_ReportEvaluations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/indrasaputra/toggle/v1/toggle.proto",
@@ -255,9 +707,55 @@ type ToggleQueryServiceClient interface {
 	GetToggleByKey(ctx context.Context, in *GetToggleByKeyRequest, opts ...grpc.CallOption) (*GetToggleByKeyResponse, error)
 	// Get many toggles.
 	//
-	// This endpoint gets all available toggles in the system.
-	// Currently, it only retrieves 10 toggles at most.
+	// This endpoint gets the available toggles in the environment page by page.
+	// The toggles can be filtered by tags, key prefix, and description, and sorted by key, created_at, or updated_at.
 	GetAllToggles(ctx context.Context, in *GetAllTogglesRequest, opts ...grpc.CallOption) (*GetAllTogglesResponse, error)
+	// Evaluate a toggle.
+	//
+	// This endpoint evaluates a toggle against the given evaluation context.
+	// A disabled toggle is always evaluated to false.
+	// An enabled toggle checks its rules in order and serves the value of the first matched rule.
+	// If none of the rules matches, the toggle's percentage rollout decides the value.
+	// If the toggle doesn't have any rollout, the toggle's default value is served.
+	// A multivariate toggle also serves its off variant for false and its default or matched rule's variant for true.
+	EvaluateToggle(ctx context.Context, in *EvaluateToggleRequest, opts ...grpc.CallOption) (*EvaluateToggleResponse, error)
+	// Get stale toggles.
+	//
+	// This endpoint gets the toggles in the environment which should be cleaned up.
+	// A toggle is stale if it has expired, or if its state in the environment
+	// hasn't changed for the given number of days.
+	// A stale toggle which serves the same value to everyone is reported as fully on or fully off.
+	GetStaleToggles(ctx context.Context, in *GetStaleTogglesRequest, opts ...grpc.CallOption) (*GetStaleTogglesResponse, error)
+	// List a toggle's history.
+	//
+	// This endpoint lists the audit log of the toggles in the project, newest first, page by page.
+	// Every creation, enablement, disablement, and deletion of a toggle is recorded along with
+	// the actor, the reason, and the request ID taken from the x-actor, x-reason, and x-request-id headers,
+	// and the toggle's value before and after the change.
+	// The history can be filtered by toggle's key, actor, and time range.
+	ListToggleHistory(ctx context.Context, in *ListToggleHistoryRequest, opts ...grpc.CallOption) (*ListToggleHistoryResponse, error)
+	// Get toggles' usage.
+	//
+	// This endpoint gets how many times the toggles in the environment have been evaluated by the SDK
+	// and when they were last evaluated, in total and per served variant.
+	// Every toggle in the environment is listed, including the ones which have never been evaluated.
+	// The usage can be limited to the given keys and to the evaluations since the given time, rounded down to the hour.
+	GetToggleUsage(ctx context.Context, in *GetToggleUsageRequest, opts ...grpc.CallOption) (*GetToggleUsageResponse, error)
+	// Export toggles.
+	//
+	// This endpoint takes a snapshot of all toggles in the environment, sorted by key.
+	// The snapshot can be imported to another environment or cluster using ImportToggles.
+	ExportToggles(ctx context.Context, in *ExportTogglesRequest, opts ...grpc.CallOption) (*ExportTogglesResponse, error)
+	// Watch toggles.
+	//
+	// This endpoint streams the changes of the toggles in the environment.
+	// It sends a snapshot of the watched toggles first, then every event of the watched toggles as it is published.
+	// Events which aren't scoped to any environment, such as expiry, are sent to the watchers of every environment.
+	// The toggles are watched by their keys or key prefixes, and all toggles are watched if both are empty.
+	// Every message carries a resume token. A client which reconnects with its last resume token
+	// receives the events it has missed instead of a new snapshot, as long as the server still remembers them.
+	// A client which falls too far behind is disconnected with codes.Unavailable and should reconnect with its last resume token.
+	WatchToggles(ctx context.Context, in *WatchTogglesRequest, opts ...grpc.CallOption) (ToggleQueryService_WatchTogglesClient, error)
 }
 
 type toggleQueryServiceClient struct {
@@ -286,6 +784,83 @@ func (c *toggleQueryServiceClient) GetAllToggles(ctx context.Context, in *GetAll
 	return out, nil
 }
 
+func (c *toggleQueryServiceClient) EvaluateToggle(ctx context.Context, in *EvaluateToggleRequest, opts ...grpc.CallOption) (*EvaluateToggleResponse, error) {
+	out := new(EvaluateToggleResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleQueryService/EvaluateToggle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleQueryServiceClient) GetStaleToggles(ctx context.Context, in *GetStaleTogglesRequest, opts ...grpc.CallOption) (*GetStaleTogglesResponse, error) {
+	out := new(GetStaleTogglesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleQueryService/GetStaleToggles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleQueryServiceClient) ListToggleHistory(ctx context.Context, in *ListToggleHistoryRequest, opts ...grpc.CallOption) (*ListToggleHistoryResponse, error) {
+	out := new(ListToggleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleQueryService/ListToggleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleQueryServiceClient) GetToggleUsage(ctx context.Context, in *GetToggleUsageRequest, opts ...grpc.CallOption) (*GetToggleUsageResponse, error) {
+	out := new(GetToggleUsageResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleQueryService/GetToggleUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleQueryServiceClient) ExportToggles(ctx context.Context, in *ExportTogglesRequest, opts ...grpc.CallOption) (*ExportTogglesResponse, error) {
+	out := new(ExportTogglesResponse)
+	err := c.cc.Invoke(ctx, "/proto.indrasaputra.toggle.v1.ToggleQueryService/ExportToggles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toggleQueryServiceClient) WatchToggles(ctx context.Context, in *WatchTogglesRequest, opts ...grpc.CallOption) (ToggleQueryService_WatchTogglesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ToggleQueryService_ServiceDesc.Streams[0], "/proto.indrasaputra.toggle.v1.ToggleQueryService/WatchToggles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &toggleQueryServiceWatchTogglesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ToggleQueryService_WatchTogglesClient interface {
+	Recv() (*WatchTogglesResponse, error)
+	grpc.ClientStream
+}
+
+type toggleQueryServiceWatchTogglesClient struct {
+	grpc.ClientStream
+}
+
+func (x *toggleQueryServiceWatchTogglesClient) Recv() (*WatchTogglesResponse, error) {
+	m := new(WatchTogglesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ToggleQueryServiceServer is the server API for ToggleQueryService service.
 // All implementations must embed UnimplementedToggleQueryServiceServer
 // for forward compatibility
@@ -296,9 +871,55 @@ type ToggleQueryServiceServer interface {
 	GetToggleByKey(context.Context, *GetToggleByKeyRequest) (*GetToggleByKeyResponse, error)
 	// Get many toggles.
 	//
-	// This endpoint gets all available toggles in the system.
-	// Currently, it only retrieves 10 toggles at most.
+	// This endpoint gets the available toggles in the environment page by page.
+	// The toggles can be filtered by tags, key prefix, and description, and sorted by key, created_at, or updated_at.
 	GetAllToggles(context.Context, *GetAllTogglesRequest) (*GetAllTogglesResponse, error)
+	// Evaluate a toggle.
+	//
+	// This endpoint evaluates a toggle against the given evaluation context.
+	// A disabled toggle is always evaluated to false.
+	// An enabled toggle checks its rules in order and serves the value of the first matched rule.
+	// If none of the rules matches, the toggle's percentage rollout decides the value.
+	// If the toggle doesn't have any rollout, the toggle's default value is served.
+	// A multivariate toggle also serves its off variant for false and its default or matched rule's variant for true.
+	EvaluateToggle(context.Context, *EvaluateToggleRequest) (*EvaluateToggleResponse, error)
+	// Get stale toggles.
+	//
+	// This endpoint gets the toggles in the environment which should be cleaned up.
+	// A toggle is stale if it has expired, or if its state in the environment
+	// hasn't changed for the given number of days.
+	// A stale toggle which serves the same value to everyone is reported as fully on or fully off.
+	GetStaleToggles(context.Context, *GetStaleTogglesRequest) (*GetStaleTogglesResponse, error)
+	// List a toggle's history.
+	//
+	// This endpoint lists the audit log of the toggles in the project, newest first, page by page.
+	// Every creation, enablement, disablement, and deletion of a toggle is recorded along with
+	// the actor, the reason, and the request ID taken from the x-actor, x-reason, and x-request-id headers,
+	// and the toggle's value before and after the change.
+	// The history can be filtered by toggle's key, actor, and time range.
+	ListToggleHistory(context.Context, *ListToggleHistoryRequest) (*ListToggleHistoryResponse, error)
+	// Get toggles' usage.
+	//
+	// This endpoint gets how many times the toggles in the environment have been evaluated by the SDK
+	// and when they were last evaluated, in total and per served variant.
+	// Every toggle in the environment is listed, including the ones which have never been evaluated.
+	// The usage can be limited to the given keys and to the evaluations since the given time, rounded down to the hour.
+	GetToggleUsage(context.Context, *GetToggleUsageRequest) (*GetToggleUsageResponse, error)
+	// Export toggles.
+	//
+	// This endpoint takes a snapshot of all toggles in the environment, sorted by key.
+	// The snapshot can be imported to another environment or cluster using ImportToggles.
+	ExportToggles(context.Context, *ExportTogglesRequest) (*ExportTogglesResponse, error)
+	// Watch toggles.
+	//
+	// This endpoint streams the changes of the toggles in the environment.
+	// It sends a snapshot of the watched toggles first, then every event of the watched toggles as it is published.
+	// Events which aren't scoped to any environment, such as expiry, are sent to the watchers of every environment.
+	// The toggles are watched by their keys or key prefixes, and all toggles are watched if both are empty.
+	// Every message carries a resume token. A client which reconnects with its last resume token
+	// receives the events it has missed instead of a new snapshot, as long as the server still remembers them.
+	// A client which falls too far behind is disconnected with codes.Unavailable and should reconnect with its last resume token.
+	WatchToggles(*WatchTogglesRequest, ToggleQueryService_WatchTogglesServer) error
 	mustEmbedUnimplementedToggleQueryServiceServer()
 }
 
@@ -312,6 +933,24 @@ func (UnimplementedToggleQueryServiceServer) GetToggleByKey(context.Context, *Ge
 func (UnimplementedToggleQueryServiceServer) GetAllToggles(context.Context, *GetAllTogglesRequest) (*GetAllTogglesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllToggles not implemented")
 }
+func (UnimplementedToggleQueryServiceServer) EvaluateToggle(context.Context, *EvaluateToggleRequest) (*EvaluateToggleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateToggle not implemented")
+}
+func (UnimplementedToggleQueryServiceServer) GetStaleToggles(context.Context, *GetStaleTogglesRequest) (*GetStaleTogglesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStaleToggles not implemented")
+}
+func (UnimplementedToggleQueryServiceServer) ListToggleHistory(context.Context, *ListToggleHistoryRequest) (*ListToggleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListToggleHistory not implemented")
+}
+func (UnimplementedToggleQueryServiceServer) GetToggleUsage(context.Context, *GetToggleUsageRequest) (*GetToggleUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToggleUsage not implemented")
+}
+func (UnimplementedToggleQueryServiceServer) ExportToggles(context.Context, *ExportTogglesRequest) (*ExportTogglesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportToggles not implemented")
+}
+func (UnimplementedToggleQueryServiceServer) WatchToggles(*WatchTogglesRequest, ToggleQueryService_WatchTogglesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchToggles not implemented")
+}
 func (UnimplementedToggleQueryServiceServer) mustEmbedUnimplementedToggleQueryServiceServer() {}
 
 // UnsafeToggleQueryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -361,6 +1000,117 @@ func _ToggleQueryService_GetAllToggles_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToggleQueryService_EvaluateToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateToggleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleQueryServiceServer).EvaluateToggle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleQueryService/EvaluateToggle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleQueryServiceServer).EvaluateToggle(ctx, req.(*EvaluateToggleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleQueryService_GetStaleToggles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStaleTogglesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleQueryServiceServer).GetStaleToggles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleQueryService/GetStaleToggles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleQueryServiceServer).GetStaleToggles(ctx, req.(*GetStaleTogglesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleQueryService_ListToggleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListToggleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleQueryServiceServer).ListToggleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleQueryService/ListToggleHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleQueryServiceServer).ListToggleHistory(ctx, req.(*ListToggleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleQueryService_GetToggleUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToggleUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleQueryServiceServer).GetToggleUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleQueryService/GetToggleUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleQueryServiceServer).GetToggleUsage(ctx, req.(*GetToggleUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleQueryService_ExportToggles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTogglesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToggleQueryServiceServer).ExportToggles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.indrasaputra.toggle.v1.ToggleQueryService/ExportToggles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToggleQueryServiceServer).ExportToggles(ctx, req.(*ExportTogglesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToggleQueryService_WatchToggles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTogglesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ToggleQueryServiceServer).WatchToggles(m, &toggleQueryServiceWatchTogglesServer{stream})
+}
+
+type ToggleQueryService_WatchTogglesServer interface {
+	Send(*WatchTogglesResponse) error
+	grpc.ServerStream
+}
+
+type toggleQueryServiceWatchTogglesServer struct {
+	grpc.ServerStream
+}
+
+func (x *toggleQueryServiceWatchTogglesServer) Send(m *WatchTogglesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ToggleQueryService_ServiceDesc is the grpc.ServiceDesc for ToggleQueryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -376,7 +1126,33 @@ var ToggleQueryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllToggles",
 			Handler:    _ToggleQueryService_GetAllToggles_Handler,
 		},
+		{
+			MethodName: "EvaluateToggle",
+			Handler:    _ToggleQueryService_EvaluateToggle_Handler,
+		},
+		{
+			MethodName: "GetStaleToggles",
+			Handler:    _ToggleQueryService_GetStaleToggles_Handler,
+		},
+		{
+			MethodName: "ListToggleHistory",
+			Handler:    _ToggleQueryService_ListToggleHistory_Handler,
+		},
+		{
+			MethodName: "GetToggleUsage",
+			Handler:    _ToggleQueryService_GetToggleUsage_Handler,
+		},
+		{
+			MethodName: "ExportToggles",
+			Handler:    _ToggleQueryService_ExportToggles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchToggles",
+			Handler:       _ToggleQueryService_WatchToggles_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/indrasaputra/toggle/v1/toggle.proto",
 }
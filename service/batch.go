@@ -0,0 +1,26 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// validateBatchSize ensures that the batch has at least one item and at most entity.MaxBatchSize items.
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return entity.ErrInvalidBatch("batch must not be empty")
+	}
+	if size > entity.MaxBatchSize {
+		return entity.ErrInvalidBatch(fmt.Sprintf("batch must not have more than %d items", entity.MaxBatchSize))
+	}
+	return nil
+}
+
+// mergeBatchResults puts the results of the items which are sent to the repository
+// back to the items' position in the batch. The indexes hold the position of each sent item.
+func mergeBatchResults(res, sent []*entity.BatchResult, indexes []int) {
+	for i, result := range sent {
+		res[indexes[i]] = result
+	}
+}
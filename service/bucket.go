@@ -0,0 +1,68 @@
+package service
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	bucketCount = 100
+	bucketSeed  = 0
+)
+
+// bucket puts a subject into one of 100 buckets, from 0 to 99.
+// The bucket only depends on the toggle's key and the subject,
+// hence the same subject always lands in the same bucket of the same toggle
+// and doesn't land in the same buckets across different toggles.
+//
+// Any client that evaluates toggles locally must use this exact algorithm
+// so it serves the same value as the server.
+func bucket(key, subject string) uint32 {
+	return murmur3([]byte(key+"."+subject), bucketSeed) % bucketCount
+}
+
+// murmur3 implements 32-bit MurmurHash3 x86 variant.
+func murmur3(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+
+	hash := seed
+	nblocks := len(data) / 4
+	for i := 0; i < nblocks; i++ {
+		k := binary.LittleEndian.Uint32(data[i*4:])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+
+		hash ^= k
+		hash = bits.RotateLeft32(hash, 13)
+		hash = hash*5 + 0xe6546b64
+	}
+
+	tail := data[nblocks*4:]
+	var k uint32
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		hash ^= k
+	}
+
+	hash ^= uint32(len(data))
+	hash ^= hash >> 16
+	hash *= 0x85ebca6b
+	hash ^= hash >> 13
+	hash *= 0xc2b2ae35
+	hash ^= hash >> 16
+	return hash
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// CreateEnvironment defines the interface to create an environment.
+type CreateEnvironment interface {
+	// Create creates a new environment and store it in the storage.
+	// It must check the uniqueness of the environment's name.
+	Create(ctx context.Context, env *entity.Environment) error
+}
+
+// CreateEnvironmentRepository defines the interface to save an environment into the repository.
+type CreateEnvironmentRepository interface {
+	// Insert inserts the environment into the repository.
+	// It also validates if the environment's name is unique.
+	// All existing toggles must get the default state in the new environment.
+	Insert(ctx context.Context, env *entity.Environment) error
+}
+
+// EnvironmentCreator is responsible for creating a new environment.
+type EnvironmentCreator struct {
+	repo CreateEnvironmentRepository
+}
+
+// NewEnvironmentCreator creates an instance of EnvironmentCreator.
+func NewEnvironmentCreator(repo CreateEnvironmentRepository) *EnvironmentCreator {
+	return &EnvironmentCreator{repo: repo}
+}
+
+// Create creates a new environment.
+// It will reject if the environment's name already exists in the system.
+// The environment's name is converted to lower case.
+func (ec *EnvironmentCreator) Create(ctx context.Context, env *entity.Environment) error {
+	if env == nil {
+		return entity.ErrInvalidEnvironment()
+	}
+	sanitizeEnvironment(env)
+	if !regexCompiler.MatchString(env.Name) {
+		return entity.ErrInvalidEnvironment()
+	}
+	return ec.repo.Insert(ctx, env)
+}
+
+func sanitizeEnvironment(env *entity.Environment) {
+	env.Name = strings.ToLower(strings.TrimSpace(env.Name))
+	env.Description = strings.TrimSpace(env.Description)
+}
@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type EnvironmentCreatorExecutor struct {
+	creator *service.EnvironmentCreator
+	repo    *mock_service.MockCreateEnvironmentRepository
+}
+
+func TestNewEnvironmentCreator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of EnvironmentCreator", func(t *testing.T) {
+		exec := createEnvironmentCreatorExecutor(ctrl)
+		assert.NotNil(t, exec.creator)
+	})
+}
+
+func TestEnvironmentCreator_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty environment is prohibited", func(t *testing.T) {
+		exec := createEnvironmentCreatorExecutor(ctrl)
+
+		err := exec.creator.Create(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+	})
+
+	t.Run("environment's name is empty or contains character outside of alphanumeric and dash", func(t *testing.T) {
+		exec := createEnvironmentCreatorExecutor(ctrl)
+
+		names := []string{"", "   ", "qa_01", "staging!"}
+		for _, name := range names {
+			err := exec.creator.Create(testCtx, &entity.Environment{Name: name})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+		}
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createEnvironmentCreatorExecutor(ctrl)
+		env := &entity.Environment{Name: "qa"}
+		exec.repo.EXPECT().Insert(testCtx, env).Return(entity.ErrAlreadyExists())
+
+		err := exec.creator.Create(testCtx, env)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("successfully create a new environment with sanitized name", func(t *testing.T) {
+		exec := createEnvironmentCreatorExecutor(ctrl)
+		env := &entity.Environment{Name: " QA ", Description: " quality assurance "}
+		exec.repo.EXPECT().Insert(testCtx, &entity.Environment{Name: "qa", Description: "quality assurance"}).Return(nil)
+
+		err := exec.creator.Create(testCtx, env)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createEnvironmentCreatorExecutor(ctrl *gomock.Controller) *EnvironmentCreatorExecutor {
+	r := mock_service.NewMockCreateEnvironmentRepository(ctrl)
+	c := service.NewEnvironmentCreator(r)
+	return &EnvironmentCreatorExecutor{
+		creator: c,
+		repo:    r,
+	}
+}
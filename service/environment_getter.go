@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// GetEnvironment defines the interface to get environment.
+type GetEnvironment interface {
+	// GetAll gets all environments available in system.
+	GetAll(ctx context.Context) ([]*entity.Environment, error)
+}
+
+// GetEnvironmentRepository defines the interface to get environment from the repository.
+type GetEnvironmentRepository interface {
+	// GetAll gets all environments available in repository.
+	// If there isn't any environment in repository, it returns empty list of environment and nil error.
+	GetAll(ctx context.Context) ([]*entity.Environment, error)
+}
+
+// EnvironmentGetter is responsible for getting environment.
+type EnvironmentGetter struct {
+	repo GetEnvironmentRepository
+}
+
+// NewEnvironmentGetter creates an instance of EnvironmentGetter.
+func NewEnvironmentGetter(repo GetEnvironmentRepository) *EnvironmentGetter {
+	return &EnvironmentGetter{repo: repo}
+}
+
+// GetAll gets all environments available in repository.
+// If there isn't any environment in repository, it returns empty list of environment and nil error.
+func (eg *EnvironmentGetter) GetAll(ctx context.Context) ([]*entity.Environment, error) {
+	return eg.repo.GetAll(ctx)
+}
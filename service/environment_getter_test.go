@@ -0,0 +1,63 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type EnvironmentGetterExecutor struct {
+	getter *service.EnvironmentGetter
+	repo   *mock_service.MockGetEnvironmentRepository
+}
+
+func TestNewEnvironmentGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of EnvironmentGetter", func(t *testing.T) {
+		exec := createEnvironmentGetterExecutor(ctrl)
+		assert.NotNil(t, exec.getter)
+	})
+}
+
+func TestEnvironmentGetter_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		exec := createEnvironmentGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx).Return([]*entity.Environment{}, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetAll(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get all environments", func(t *testing.T) {
+		exec := createEnvironmentGetterExecutor(ctrl)
+		envs := []*entity.Environment{{Name: "staging"}, {Name: "production"}}
+		exec.repo.EXPECT().GetAll(testCtx).Return(envs, nil)
+
+		res, err := exec.getter.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, envs, res)
+	})
+}
+
+func createEnvironmentGetterExecutor(ctrl *gomock.Controller) *EnvironmentGetterExecutor {
+	r := mock_service.NewMockGetEnvironmentRepository(ctrl)
+	g := service.NewEnvironmentGetter(r)
+	return &EnvironmentGetterExecutor{
+		getter: g,
+		repo:   r,
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	maxEvaluationCounts = 1000
+)
+
+// ReportEvaluation defines the interface to record the toggles' evaluations.
+type ReportEvaluation interface {
+	// Report records how many times the toggles in the project's environment have been evaluated.
+	// The counts are added to the counts reported before.
+	// Invalid counts are skipped and it returns the number of skipped counts.
+	Report(ctx context.Context, project, env string, counts []*entity.EvaluationCount) (int, error)
+}
+
+// ReportEvaluationRepository defines the interface to save the toggles' evaluation counts into the repository.
+type ReportEvaluationRepository interface {
+	// Increment adds the counts to the saved counts of the same toggle, variant, and time window in the project's environment.
+	// The last evaluation time becomes the latest of both.
+	Increment(ctx context.Context, project, env string, counts []*entity.EvaluationCount) error
+}
+
+// evaluationCountID identifies the counts which are merged into one.
+type evaluationCountID struct {
+	key     string
+	variant string
+	window  time.Time
+}
+
+// EvaluationReporter is responsible for recording the toggles' evaluations.
+type EvaluationReporter struct {
+	repo ReportEvaluationRepository
+}
+
+// NewEvaluationReporter creates an instance of EvaluationReporter.
+func NewEvaluationReporter(repo ReportEvaluationRepository) *EvaluationReporter {
+	return &EvaluationReporter{repo: repo}
+}
+
+// Report records how many times the toggles in the project's environment have been evaluated.
+// The keys are converted to lower case and the time windows are rounded down to the hour.
+// The counts of the same toggle, variant, and time window are merged.
+// The last evaluation time defaults to the start of its time window.
+// The toggles aren't required to exist, since the SDK may report after a toggle is deleted.
+// Invalid counts are skipped, so that one bad count doesn't lose the others, and it returns the number of skipped counts.
+// It returns InvalidArgument error if there are more than 1000 counts.
+func (er *EvaluationReporter) Report(ctx context.Context, project, env string, counts []*entity.EvaluationCount) (int, error) {
+	if len(counts) > maxEvaluationCounts {
+		return 0, entity.ErrInvalidEvaluationReport(fmt.Sprintf("counts must not be more than %d", maxEvaluationCounts))
+	}
+
+	rejected := 0
+	res := []*entity.EvaluationCount{}
+	merged := make(map[evaluationCountID]*entity.EvaluationCount)
+	for _, count := range counts {
+		if err := validateEvaluationCount(count); err != nil {
+			rejected++
+			continue
+		}
+
+		tmp := &entity.EvaluationCount{
+			Key:             strings.ToLower(count.Key),
+			Variant:         count.Variant,
+			WindowStart:     entity.EvaluationWindowStart(count.WindowStart),
+			Count:           count.Count,
+			LastEvaluatedAt: count.LastEvaluatedAt.UTC(),
+		}
+		if tmp.LastEvaluatedAt.Before(tmp.WindowStart) {
+			tmp.LastEvaluatedAt = tmp.WindowStart
+		}
+
+		id := evaluationCountID{key: tmp.Key, variant: tmp.Variant, window: tmp.WindowStart}
+		if prev, ok := merged[id]; ok {
+			prev.Count += tmp.Count
+			prev.LastEvaluatedAt = latestTime(prev.LastEvaluatedAt, tmp.LastEvaluatedAt)
+			continue
+		}
+		merged[id] = tmp
+		res = append(res, tmp)
+	}
+
+	if len(res) == 0 {
+		return rejected, nil
+	}
+	if err := er.repo.Increment(ctx, project, env, res); err != nil {
+		return 0, err
+	}
+	return rejected, nil
+}
+
+func validateEvaluationCount(count *entity.EvaluationCount) error {
+	if count == nil {
+		return entity.ErrInvalidEvaluationReport("count must not be empty")
+	}
+	if !regexCompiler.MatchString(count.Key) {
+		return entity.ErrInvalidEvaluationReport(fmt.Sprintf("key %q is invalid", count.Key))
+	}
+	if count.Variant == "" || len(count.Variant) > maxVariantLength {
+		return entity.ErrInvalidEvaluationReport(fmt.Sprintf("variant of %s must be between 1 and %d characters", count.Key, maxVariantLength))
+	}
+	if count.Count <= 0 {
+		return entity.ErrInvalidEvaluationReport(fmt.Sprintf("count of %s must be positive", count.Key))
+	}
+	if count.WindowStart.IsZero() {
+		return entity.ErrInvalidEvaluationReport(fmt.Sprintf("window start of %s must not be empty", count.Key))
+	}
+	return nil
+}
+
+func latestTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
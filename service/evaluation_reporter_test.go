@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type EvaluationReporterExecutor struct {
+	reporter *service.EvaluationReporter
+	repo     *mock_service.MockReportEvaluationRepository
+}
+
+func TestNewEvaluationReporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of EvaluationReporter", func(t *testing.T) {
+		exec := createEvaluationReporterExecutor(ctrl)
+		assert.NotNil(t, exec.reporter)
+	})
+}
+
+func TestEvaluationReporter_Report(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	window := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC)
+
+	t.Run("too many counts are rejected", func(t *testing.T) {
+		exec := createEvaluationReporterExecutor(ctrl)
+		counts := make([]*entity.EvaluationCount, 1001)
+
+		rejected, err := exec.reporter.Report(testCtx, testToggleProject, testToggleEnv, counts)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Zero(t, rejected)
+	})
+
+	t.Run("invalid counts are skipped without losing the valid ones", func(t *testing.T) {
+		counts := []*entity.EvaluationCount{
+			nil,
+			{Key: "toggle 1", Variant: "true", WindowStart: window, Count: 1},
+			{Key: testToggleKey, Variant: "", WindowStart: window, Count: 1},
+			{Key: testToggleKey, Variant: strings.Repeat("a", 51), WindowStart: window, Count: 1},
+			{Key: testToggleKey, Variant: "true", WindowStart: window, Count: 0},
+			{Key: testToggleKey, Variant: "true", Count: 1},
+			{Key: testToggleKey, Variant: "true", WindowStart: window, Count: 2},
+		}
+		expected := []*entity.EvaluationCount{{Key: testToggleKey, Variant: "true", WindowStart: window, Count: 2, LastEvaluatedAt: window}}
+		exec := createEvaluationReporterExecutor(ctrl)
+		exec.repo.EXPECT().Increment(testCtx, testToggleProject, testToggleEnv, expected).Return(nil)
+
+		rejected, err := exec.reporter.Report(testCtx, testToggleProject, testToggleEnv, counts)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 6, rejected)
+	})
+
+	t.Run("report of only invalid counts doesn't touch the repository", func(t *testing.T) {
+		exec := createEvaluationReporterExecutor(ctrl)
+
+		rejected, err := exec.reporter.Report(testCtx, testToggleProject, testToggleEnv, []*entity.EvaluationCount{nil})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, rejected)
+	})
+
+	t.Run("empty report doesn't touch the repository", func(t *testing.T) {
+		exec := createEvaluationReporterExecutor(ctrl)
+
+		rejected, err := exec.reporter.Report(testCtx, testToggleProject, testToggleEnv, nil)
+
+		assert.Nil(t, err)
+		assert.Zero(t, rejected)
+	})
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		exec := createEvaluationReporterExecutor(ctrl)
+		exec.repo.EXPECT().Increment(testCtx, testToggleProject, testToggleEnv, gomock.Any()).Return(entity.ErrInternal(""))
+
+		_, err := exec.reporter.Report(testCtx, testToggleProject, testToggleEnv, []*entity.EvaluationCount{{Key: testToggleKey, Variant: "true", WindowStart: window, Count: 1}})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("counts are sanitized and merged", func(t *testing.T) {
+		counts := []*entity.EvaluationCount{
+			{Key: "Toggle-1", Variant: "true", WindowStart: window.Add(10 * time.Minute), Count: 2, LastEvaluatedAt: window.Add(20 * time.Minute)},
+			{Key: "toggle-1", Variant: "false", WindowStart: window, Count: 1},
+			{Key: "toggle-1", Variant: "true", WindowStart: window.Add(30 * time.Minute), Count: 3, LastEvaluatedAt: window.Add(40 * time.Minute)},
+			{Key: "toggle-1", Variant: "true", WindowStart: window.Add(time.Hour), Count: 4, LastEvaluatedAt: window.Add(time.Hour)},
+		}
+		expected := []*entity.EvaluationCount{
+			{Key: "toggle-1", Variant: "true", WindowStart: window, Count: 5, LastEvaluatedAt: window.Add(40 * time.Minute)},
+			{Key: "toggle-1", Variant: "false", WindowStart: window, Count: 1, LastEvaluatedAt: window},
+			{Key: "toggle-1", Variant: "true", WindowStart: window.Add(time.Hour), Count: 4, LastEvaluatedAt: window.Add(time.Hour)},
+		}
+		exec := createEvaluationReporterExecutor(ctrl)
+		exec.repo.EXPECT().Increment(testCtx, testToggleProject, testToggleEnv, expected).Return(nil)
+
+		rejected, err := exec.reporter.Report(testCtx, testToggleProject, testToggleEnv, counts)
+
+		assert.Nil(t, err)
+		assert.Zero(t, rejected)
+	})
+}
+
+func createEvaluationReporterExecutor(ctrl *gomock.Controller) *EvaluationReporterExecutor {
+	r := mock_service.NewMockReportEvaluationRepository(ctrl)
+	s := service.NewEvaluationReporter(r)
+	return &EvaluationReporterExecutor{
+		reporter: s,
+		repo:     r,
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// minOutboxBackoff is the delay before an event which failed to be published for the first time is claimed again.
+	// The delay doubles for each of the next failures.
+	minOutboxBackoff = time.Second
+)
+
+// TogglePublisher defines the interface to publish toggle to message queue.
+type TogglePublisher interface {
+	// Publish publishes event toggle to message queue.
+	Publish(ctx context.Context, event *togglev1.ToggleEvent) error
+}
+
+// RelayOutbox defines the interface to relay the outbox's events to message queue.
+type RelayOutbox interface {
+	// Relay publishes the pending events in the outbox until there isn't any event which can be published.
+	// It returns the number of published events.
+	Relay(ctx context.Context) (int, error)
+}
+
+// RelayOutboxRepository defines the interface to claim and settle the outbox's events in the repository.
+type RelayOutboxRepository interface {
+	// ClaimPending claims at most limit events which are available at now and returns them sorted by their IDs.
+	// Only the oldest event of each toggle must be claimed, so that the toggle's events can be published in order.
+	// The claimed events must not be available until lease has passed.
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error)
+	// Delete removes the published event from the repository.
+	Delete(ctx context.Context, id int64) error
+	// Release makes the event which failed to be published available again at the given time.
+	Release(ctx context.Context, id int64, availableAt time.Time, reason string) error
+	// DeadLetter moves the event which can't be published out of the outbox along with the reason.
+	DeadLetter(ctx context.Context, id int64, reason string) error
+}
+
+// OutboxRelay is responsible for relaying the outbox's events to message queue.
+type OutboxRelay struct {
+	repo        RelayOutboxRepository
+	publisher   TogglePublisher
+	batchSize   int
+	maxAttempts int
+	lease       time.Duration
+	maxBackoff  time.Duration
+}
+
+// NewOutboxRelay creates an instance of OutboxRelay.
+// At most batchSize events are claimed at once and each of them must be published within lease.
+// A malformed event is dead-lettered once it has been claimed maxAttempts times.
+// The delay before a failed event is retried never exceeds maxBackoff.
+func NewOutboxRelay(repo RelayOutboxRepository, publisher TogglePublisher, batchSize, maxAttempts int, lease, maxBackoff time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		repo:        repo,
+		publisher:   publisher,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		lease:       lease,
+		maxBackoff:  maxBackoff,
+	}
+}
+
+// Relay claims the pending events and publishes them, oldest first.
+// A published event is removed from the outbox, while a failed one is retried later with exponential backoff.
+// Since a toggle's event is only claimed after its previous events are removed,
+// a failed event holds back the next events of its toggle until it is published.
+// A malformed event is retried the same way, since it may be written by a newer server version which can read it,
+// but it is moved to the dead letters once it has been claimed maxAttempts times, so that it stops holding back its toggle.
+// Events are published at least once: an event may be published again if it can't be removed.
+// It returns error if the events can't be claimed or settled.
+func (or *OutboxRelay) Relay(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		events, err := or.repo.ClaimPending(ctx, time.Now().UTC(), or.lease, or.batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		published, deadLettered := 0, 0
+		for _, event := range events {
+			if event.Event == nil {
+				log.Printf("malformed outbox event %d of toggle %s in project %s: %s", event.ID, event.Key, event.Project, event.Malformed)
+				if event.Attempts < or.maxAttempts {
+					if err := or.repo.Release(ctx, event.ID, time.Now().UTC().Add(or.backoff(event.Attempts)), event.Malformed); err != nil {
+						return total + published, err
+					}
+					continue
+				}
+				if err := or.repo.DeadLetter(ctx, event.ID, event.Malformed); err != nil {
+					return total + published, err
+				}
+				deadLettered++
+				continue
+			}
+			if err := or.publisher.Publish(ctx, event.Event); err != nil {
+				log.Printf("publish outbox event %d of toggle %s in project %s error: %v", event.ID, event.Key, event.Project, err)
+				if err := or.repo.Release(ctx, event.ID, time.Now().UTC().Add(or.backoff(event.Attempts)), err.Error()); err != nil {
+					return total + published, err
+				}
+				continue
+			}
+			if err := or.repo.Delete(ctx, event.ID); err != nil {
+				return total + published, err
+			}
+			published++
+		}
+
+		total += published
+		if published == 0 && deadLettered == 0 {
+			return total, nil
+		}
+	}
+}
+
+// backoff computes the delay before the event which has been claimed attempts times is claimed again.
+func (or *OutboxRelay) backoff(attempts int) time.Duration {
+	res := minOutboxBackoff
+	for i := 1; i < attempts && res < or.maxBackoff; i++ {
+		res *= 2
+	}
+	if res > or.maxBackoff {
+		return or.maxBackoff
+	}
+	return res
+}
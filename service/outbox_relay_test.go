@@ -0,0 +1,208 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testOutboxBatchSize   = 10
+	testOutboxMaxAttempts = 5
+	testOutboxLease      = 30 * time.Second
+	testOutboxMaxBackoff = 8 * time.Second
+)
+
+type OutboxRelayExecutor struct {
+	relay     *service.OutboxRelay
+	repo      *mock_service.MockRelayOutboxRepository
+	publisher *mock_service.MockTogglePublisher
+}
+
+func TestNewOutboxRelay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of OutboxRelay", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		assert.NotNil(t, exec.relay)
+	})
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository fails to claim pending events", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return(nil, entity.ErrInternal(""))
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("there isn't any pending event", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{}, nil)
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("failed event is released with exponential backoff", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		events := []*entity.OutboxEvent{createTestOutboxEvent(1, "toggle-1", 1), createTestOutboxEvent(2, "toggle-2", 3), createTestOutboxEvent(3, "toggle-3", 10)}
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return(events, nil)
+		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(errors.New("error")).Times(3)
+		for i, backoff := range []time.Duration{time.Second, 4 * time.Second, testOutboxMaxBackoff} {
+			backoff := backoff
+			exec.repo.EXPECT().Release(testCtx, events[i].ID, gomock.Any(), "error").DoAndReturn(func(_ context.Context, _ int64, availableAt time.Time, _ string) error {
+				assert.WithinDuration(t, time.Now().Add(backoff), availableAt, time.Second)
+				return nil
+			})
+		}
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("repository fails to release the failed event", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		event := createTestOutboxEvent(1, testToggleKey, 1)
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{event}, nil)
+		exec.publisher.EXPECT().Publish(testCtx, event.Event).Return(errors.New("error"))
+		exec.repo.EXPECT().Release(testCtx, event.ID, gomock.Any(), "error").Return(entity.ErrInternal(""))
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("malformed event is released until it reaches the max attempts", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		event := createTestMalformedOutboxEvent(1, testToggleKey, testOutboxMaxAttempts-1)
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{event}, nil)
+		exec.repo.EXPECT().Release(testCtx, event.ID, gomock.Any(), event.Malformed).Return(nil)
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("repository fails to release the malformed event", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		event := createTestMalformedOutboxEvent(1, testToggleKey, 1)
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{event}, nil)
+		exec.repo.EXPECT().Release(testCtx, event.ID, gomock.Any(), event.Malformed).Return(entity.ErrInternal(""))
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("repository fails to dead-letter the malformed event", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		event := createTestMalformedOutboxEvent(1, testToggleKey, testOutboxMaxAttempts)
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{event}, nil)
+		exec.repo.EXPECT().DeadLetter(testCtx, event.ID, event.Malformed).Return(entity.ErrInternal(""))
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("malformed event is dead-lettered and the toggle's next event is published", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		malformed := createTestMalformedOutboxEvent(1, testToggleKey, testOutboxMaxAttempts)
+		next := createTestOutboxEvent(2, testToggleKey, 1)
+		gomock.InOrder(
+			exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{malformed}, nil),
+			exec.repo.EXPECT().DeadLetter(testCtx, malformed.ID, malformed.Malformed).Return(nil),
+			exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{next}, nil),
+			exec.publisher.EXPECT().Publish(testCtx, next.Event).Return(nil),
+			exec.repo.EXPECT().Delete(testCtx, next.ID).Return(nil),
+			exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{}, nil),
+		)
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("repository fails to delete the published event", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		events := []*entity.OutboxEvent{createTestOutboxEvent(1, "toggle-1", 1), createTestOutboxEvent(2, "toggle-2", 1)}
+		exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return(events, nil)
+		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(nil).Times(2)
+		exec.repo.EXPECT().Delete(testCtx, int64(1)).Return(nil)
+		exec.repo.EXPECT().Delete(testCtx, int64(2)).Return(entity.ErrInternal(""))
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("successfully relay events until there isn't any event to publish", func(t *testing.T) {
+		exec := createOutboxRelayExecutor(ctrl)
+		first := []*entity.OutboxEvent{createTestOutboxEvent(1, "toggle-1", 1), createTestOutboxEvent(2, "toggle-2", 1)}
+		second := []*entity.OutboxEvent{createTestOutboxEvent(3, "toggle-1", 1)}
+		gomock.InOrder(
+			exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return(first, nil),
+			exec.publisher.EXPECT().Publish(testCtx, first[0].Event).Return(nil),
+			exec.repo.EXPECT().Delete(testCtx, int64(1)).Return(nil),
+			exec.publisher.EXPECT().Publish(testCtx, first[1].Event).Return(nil),
+			exec.repo.EXPECT().Delete(testCtx, int64(2)).Return(nil),
+			exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return(second, nil),
+			exec.publisher.EXPECT().Publish(testCtx, second[0].Event).Return(nil),
+			exec.repo.EXPECT().Delete(testCtx, int64(3)).Return(nil),
+			exec.repo.EXPECT().ClaimPending(testCtx, gomock.Any(), testOutboxLease, testOutboxBatchSize).Return([]*entity.OutboxEvent{}, nil),
+		)
+
+		n, err := exec.relay.Relay(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+	})
+}
+
+func createTestOutboxEvent(id int64, key string, attempts int) *entity.OutboxEvent {
+	res := entity.NewOutboxEvent(entity.EventToggleEnabled(&entity.Toggle{Key: key, Project: testToggleProject, Environment: testToggleEnv}))
+	res.ID = id
+	res.Attempts = attempts
+	return res
+}
+
+func createTestMalformedOutboxEvent(id int64, key string, attempts int) *entity.OutboxEvent {
+	return &entity.OutboxEvent{ID: id, Project: testToggleProject, Key: key, Attempts: attempts, Malformed: "unexpected EOF"}
+}
+
+func createOutboxRelayExecutor(ctrl *gomock.Controller) *OutboxRelayExecutor {
+	r := mock_service.NewMockRelayOutboxRepository(ctrl)
+	p := mock_service.NewMockTogglePublisher(ctrl)
+	or := service.NewOutboxRelay(r, p, testOutboxBatchSize, testOutboxMaxAttempts, testOutboxLease, testOutboxMaxBackoff)
+	return &OutboxRelayExecutor{
+		relay:     or,
+		repo:      r,
+		publisher: p,
+	}
+}
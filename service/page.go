@@ -0,0 +1,176 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+	descendingOrder = "desc"
+)
+
+// pageToken is the content of the opaque page token.
+// It holds the position of the last item in the previous page along with the listing it belongs to,
+// so that a token can't be used for a different listing.
+// Toggles are positioned by OrderBy, Key, and Time while audit log's entries are positioned by ID.
+type pageToken struct {
+	OrderBy    entity.ToggleOrderField `json:"o"`
+	Descending bool                    `json:"d,omitempty"`
+	Key        string                  `json:"k"`
+	Time       *time.Time              `json:"t,omitempty"`
+	ID         int64                   `json:"i,omitempty"`
+	Listing    string                  `json:"l"`
+}
+
+// createTogglePagination creates the keyset pagination from the requested page.
+// The page's size is clamped to the maximum size and one more toggle than the page's size is requested,
+// so that the existence of the next page can be known.
+func createTogglePagination(project, env string, filter *entity.ToggleFilter, page *entity.PageRequest) (*entity.TogglePagination, uint, error) {
+	if page == nil {
+		page = &entity.PageRequest{}
+	}
+	res, err := parseToggleOrder(page.OrderBy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	size := clampPageSize(page.Size)
+	res.Limit = size + 1
+
+	if page.Token == "" {
+		return res, size, nil
+	}
+	token, err := decodePageToken(page.Token)
+	if err != nil || token.OrderBy != res.OrderBy || token.Descending != res.Descending || token.Listing != fingerprintListing(project, env, filter) {
+		return nil, 0, entity.ErrInvalidQuery("page_token", "is malformed or doesn't belong to this listing")
+	}
+	res.After = &entity.ToggleCursor{Key: token.Key}
+	if token.Time != nil {
+		res.After.Time = *token.Time
+	}
+	return res, size, nil
+}
+
+// parseToggleOrder parses the order in form of "<field>" or "<field> desc".
+// Empty order means the toggles are sorted by key ascending.
+func parseToggleOrder(order string) (*entity.TogglePagination, error) {
+	fields := strings.Fields(strings.ToLower(order))
+	if len(fields) == 0 {
+		return &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey}, nil
+	}
+
+	res := &entity.TogglePagination{OrderBy: entity.ToggleOrderField(fields[0])}
+	if len(fields) > 2 || !entity.IsValidToggleOrderField(res.OrderBy) {
+		return nil, entity.ErrInvalidQuery("order_by", "must be one of key, created_at, and updated_at, optionally followed by desc")
+	}
+	if len(fields) == 2 {
+		if fields[1] != descendingOrder {
+			return nil, entity.ErrInvalidQuery("order_by", "must be one of key, created_at, and updated_at, optionally followed by desc")
+		}
+		res.Descending = true
+	}
+	return res, nil
+}
+
+// createNextPageToken creates the token which continues the listing after the toggle.
+func createNextPageToken(project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination, last *entity.Toggle) string {
+	token := &pageToken{
+		OrderBy:    page.OrderBy,
+		Descending: page.Descending,
+		Key:        last.Key,
+		Listing:    fingerprintListing(project, env, filter),
+	}
+	switch page.OrderBy {
+	case entity.ToggleOrderByCreatedAt:
+		token.Time = &last.CreatedAt
+	case entity.ToggleOrderByUpdatedAt:
+		token.Time = &last.UpdatedAt
+	}
+
+	return encodePageToken(token)
+}
+
+// createAuditPagination creates the keyset pagination of the audit log from the requested page.
+// Like toggles, one more entry than the page's size is requested.
+func createAuditPagination(project string, filter *entity.AuditFilter, page *entity.PageRequest) (*entity.AuditPagination, uint, error) {
+	if page == nil {
+		page = &entity.PageRequest{}
+	}
+	size := clampPageSize(page.Size)
+	res := &entity.AuditPagination{Limit: size + 1}
+
+	if page.Token == "" {
+		return res, size, nil
+	}
+	token, err := decodePageToken(page.Token)
+	if err != nil || token.ID <= 0 || token.Listing != fingerprintAuditListing(project, filter) {
+		return nil, 0, entity.ErrInvalidQuery("page_token", "is malformed or doesn't belong to this listing")
+	}
+	res.BeforeID = token.ID
+	return res, size, nil
+}
+
+// createNextAuditPageToken creates the token which continues the audit log after the entry.
+func createNextAuditPageToken(project string, filter *entity.AuditFilter, last *entity.Audit) string {
+	return encodePageToken(&pageToken{
+		ID:      last.ID,
+		Listing: fingerprintAuditListing(project, filter),
+	})
+}
+
+// clampPageSize applies the default and the maximum size to the requested page's size.
+func clampPageSize(value uint32) uint {
+	size := uint(value)
+	if size == 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+	return size
+}
+
+func encodePageToken(token *pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(value string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	token := &pageToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// fingerprintListing identifies the listing by its project, environment, and filter.
+func fingerprintListing(project, env string, filter *entity.ToggleFilter) string {
+	if filter == nil {
+		filter = &entity.ToggleFilter{}
+	}
+	data, _ := json.Marshal([]interface{}{project, env, filter.AnyTags, filter.AllTags, filter.KeyPrefix, filter.Search, filter.IncludeDeleted})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// fingerprintAuditListing identifies the audit log's listing by its project and filter.
+func fingerprintAuditListing(project string, filter *entity.AuditFilter) string {
+	if filter == nil {
+		filter = &entity.AuditFilter{}
+	}
+	data, _ := json.Marshal([]interface{}{"audit", project, filter.Key, filter.Actor, filter.From.UTC(), filter.To.UTC()})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// PrerequisiteRepository defines the interface to get the prerequisites of all toggles in a project from the repository.
+type PrerequisiteRepository interface {
+	// GetAllPrerequisites gets the prerequisites of all toggles in the project, keyed by the toggle's key.
+	// A toggle without any prerequisite is still included with empty prerequisites.
+	GetAllPrerequisites(ctx context.Context, project string) (map[string][]*entity.Prerequisite, error)
+}
+
+// failedPrerequisite returns the key of the first prerequisite whose evaluation doesn't match its required value.
+// A prerequisite which isn't evaluated is never satisfied.
+// It returns empty string if all prerequisites are satisfied.
+func failedPrerequisite(prerequisites []*entity.Prerequisite, evaluations map[string]*entity.Evaluation) string {
+	for _, prerequisite := range prerequisites {
+		eval, ok := evaluations[prerequisite.Key]
+		if !ok || eval == nil || eval.Value != prerequisite.Value {
+			return prerequisite.Key
+		}
+	}
+	return ""
+}
+
+// sanitizePrerequisites converts the prerequisite's keys to lower case.
+// Nil prerequisite is left as is to be rejected by validatePrerequisites.
+func sanitizePrerequisites(prerequisites []*entity.Prerequisite) {
+	for _, prerequisite := range prerequisites {
+		if prerequisite == nil {
+			continue
+		}
+		prerequisite.Key = strings.ToLower(strings.TrimSpace(prerequisite.Key))
+	}
+}
+
+// validatePrerequisites validates the prerequisites without looking at the other toggles.
+// The prerequisites must be sanitized first.
+func validatePrerequisites(key string, prerequisites []*entity.Prerequisite) error {
+	keys := make(map[string]bool)
+	for i, prerequisite := range prerequisites {
+		if prerequisite == nil {
+			return entity.ErrInvalidPrerequisite(fmt.Sprintf("prerequisite %d: empty or nil", i))
+		}
+		if !regexCompiler.MatchString(prerequisite.Key) {
+			return entity.ErrInvalidPrerequisite(fmt.Sprintf("prerequisite %d: key is empty or contain character outside of alphanumeric and dash", i))
+		}
+		if prerequisite.Key == key {
+			return entity.ErrInvalidPrerequisite(fmt.Sprintf("prerequisite %d: toggle can't be its own prerequisite", i))
+		}
+		if keys[prerequisite.Key] {
+			return entity.ErrInvalidPrerequisite(fmt.Sprintf("prerequisite %d: key %q is duplicated", i, prerequisite.Key))
+		}
+		keys[prerequisite.Key] = true
+	}
+	return nil
+}
+
+// findDependents returns the sorted keys of the toggles which have the key as their prerequisite.
+func findDependents(key string, graph map[string][]*entity.Prerequisite) []string {
+	var res []string
+	for dependent, prerequisites := range graph {
+		for _, prerequisite := range prerequisites {
+			if prerequisite.Key == key {
+				res = append(res, dependent)
+				break
+			}
+		}
+	}
+	sort.Strings(res)
+	return res
+}
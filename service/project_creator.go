@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// CreateProject defines the interface to create a project.
+type CreateProject interface {
+	// Create creates a new project and store it in the storage.
+	// It must check the uniqueness of the project's name.
+	Create(ctx context.Context, project *entity.Project) error
+}
+
+// CreateProjectRepository defines the interface to save a project into the repository.
+type CreateProjectRepository interface {
+	// Insert inserts the project into the repository.
+	// It also validates if the project's name is unique.
+	Insert(ctx context.Context, project *entity.Project) error
+}
+
+// ProjectCreator is responsible for creating a new project.
+type ProjectCreator struct {
+	repo CreateProjectRepository
+}
+
+// NewProjectCreator creates an instance of ProjectCreator.
+func NewProjectCreator(repo CreateProjectRepository) *ProjectCreator {
+	return &ProjectCreator{repo: repo}
+}
+
+// Create creates a new project.
+// It will reject if the project's name already exists in the system.
+// The project's name is converted to lower case.
+func (pc *ProjectCreator) Create(ctx context.Context, project *entity.Project) error {
+	if project == nil {
+		return entity.ErrInvalidProject()
+	}
+	sanitizeProject(project)
+	if !regexCompiler.MatchString(project.Name) {
+		return entity.ErrInvalidProject()
+	}
+	return pc.repo.Insert(ctx, project)
+}
+
+func sanitizeProject(project *entity.Project) {
+	project.Name = strings.ToLower(strings.TrimSpace(project.Name))
+	project.Description = strings.TrimSpace(project.Description)
+}
@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ProjectCreatorExecutor struct {
+	creator *service.ProjectCreator
+	repo    *mock_service.MockCreateProjectRepository
+}
+
+func TestNewProjectCreator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ProjectCreator", func(t *testing.T) {
+		exec := createProjectCreatorExecutor(ctrl)
+		assert.NotNil(t, exec.creator)
+	})
+}
+
+func TestProjectCreator_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty project is prohibited", func(t *testing.T) {
+		exec := createProjectCreatorExecutor(ctrl)
+
+		err := exec.creator.Create(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+	})
+
+	t.Run("project's name is empty or contains character outside of alphanumeric and dash", func(t *testing.T) {
+		exec := createProjectCreatorExecutor(ctrl)
+
+		names := []string{"", "   ", "team_01", "payment!"}
+		for _, name := range names {
+			err := exec.creator.Create(testCtx, &entity.Project{Name: name})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidProject(), err)
+		}
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createProjectCreatorExecutor(ctrl)
+		project := &entity.Project{Name: "payment"}
+		exec.repo.EXPECT().Insert(testCtx, project).Return(entity.ErrAlreadyExists())
+
+		err := exec.creator.Create(testCtx, project)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("successfully create a new project with sanitized name", func(t *testing.T) {
+		exec := createProjectCreatorExecutor(ctrl)
+		project := &entity.Project{Name: " Payment ", Description: " payment team "}
+		exec.repo.EXPECT().Insert(testCtx, &entity.Project{Name: "payment", Description: "payment team"}).Return(nil)
+
+		err := exec.creator.Create(testCtx, project)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createProjectCreatorExecutor(ctrl *gomock.Controller) *ProjectCreatorExecutor {
+	r := mock_service.NewMockCreateProjectRepository(ctrl)
+	c := service.NewProjectCreator(r)
+	return &ProjectCreatorExecutor{
+		creator: c,
+		repo:    r,
+	}
+}
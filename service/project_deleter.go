@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"strings"
+)
+
+// DeleteProject defines the interface to delete a project.
+type DeleteProject interface {
+	// DeleteByName deletes a single project by its name.
+	DeleteByName(ctx context.Context, name string) error
+}
+
+// DeleteProjectRepository defines the interface to delete project from the repository.
+type DeleteProjectRepository interface {
+	// DeleteByName deletes a single project from the repository.
+	// It returns ProjectNotFound error if the project doesn't exist
+	// and ProjectNotEmpty error if the project still owns toggles or segments.
+	DeleteByName(ctx context.Context, name string) error
+}
+
+// ProjectDeleter is responsible for deleting a project.
+type ProjectDeleter struct {
+	repo DeleteProjectRepository
+}
+
+// NewProjectDeleter creates an instance of ProjectDeleter.
+func NewProjectDeleter(repo DeleteProjectRepository) *ProjectDeleter {
+	return &ProjectDeleter{repo: repo}
+}
+
+// DeleteByName deletes a project by its name.
+// It only deletes project that doesn't own any toggle.
+func (pd *ProjectDeleter) DeleteByName(ctx context.Context, name string) error {
+	return pd.repo.DeleteByName(ctx, strings.ToLower(strings.TrimSpace(name)))
+}
@@ -0,0 +1,60 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ProjectDeleterExecutor struct {
+	deleter *service.ProjectDeleter
+	repo    *mock_service.MockDeleteProjectRepository
+}
+
+func TestNewProjectDeleter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ProjectDeleter", func(t *testing.T) {
+		exec := createProjectDeleterExecutor(ctrl)
+		assert.NotNil(t, exec.deleter)
+	})
+}
+
+func TestProjectDeleter_DeleteByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("project still owns toggles", func(t *testing.T) {
+		exec := createProjectDeleterExecutor(ctrl)
+		exec.repo.EXPECT().DeleteByName(testCtx, testProjectName).Return(entity.ErrProjectNotEmpty())
+
+		err := exec.deleter.DeleteByName(testCtx, testProjectName)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotEmpty(), err)
+	})
+
+	t.Run("successfully delete a project with sanitized name", func(t *testing.T) {
+		exec := createProjectDeleterExecutor(ctrl)
+		exec.repo.EXPECT().DeleteByName(testCtx, testProjectName).Return(nil)
+
+		err := exec.deleter.DeleteByName(testCtx, " Payment ")
+
+		assert.Nil(t, err)
+	})
+}
+
+func createProjectDeleterExecutor(ctrl *gomock.Controller) *ProjectDeleterExecutor {
+	r := mock_service.NewMockDeleteProjectRepository(ctrl)
+	d := service.NewProjectDeleter(r)
+	return &ProjectDeleterExecutor{
+		deleter: d,
+		repo:    r,
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// GetProject defines the interface to get project.
+type GetProject interface {
+	// GetByName gets a single project by its name.
+	GetByName(ctx context.Context, name string) (*entity.Project, error)
+	// GetAll gets all projects available in system.
+	GetAll(ctx context.Context) ([]*entity.Project, error)
+}
+
+// GetProjectRepository defines the interface to get project from the repository.
+type GetProjectRepository interface {
+	// GetByName gets a single project from the repository.
+	// If the project can't be found, it returns ProjectNotFound error.
+	GetByName(ctx context.Context, name string) (*entity.Project, error)
+	// GetAll gets all projects available in repository.
+	// If there isn't any project in repository, it returns empty list of project and nil error.
+	GetAll(ctx context.Context) ([]*entity.Project, error)
+}
+
+// ProjectGetter is responsible for getting project.
+type ProjectGetter struct {
+	repo GetProjectRepository
+}
+
+// NewProjectGetter creates an instance of ProjectGetter.
+func NewProjectGetter(repo GetProjectRepository) *ProjectGetter {
+	return &ProjectGetter{repo: repo}
+}
+
+// GetByName gets a single project by its name.
+// If the project can't be found, it returns ProjectNotFound error.
+func (pg *ProjectGetter) GetByName(ctx context.Context, name string) (*entity.Project, error) {
+	return pg.repo.GetByName(ctx, name)
+}
+
+// GetAll gets all projects available in repository.
+// If there isn't any project in repository, it returns empty list of project and nil error.
+func (pg *ProjectGetter) GetAll(ctx context.Context) ([]*entity.Project, error) {
+	return pg.repo.GetAll(ctx)
+}
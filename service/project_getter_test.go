@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testProjectName = "payment"
+	testProject     = &entity.Project{Name: testProjectName, Description: "payment team"}
+)
+
+type ProjectGetterExecutor struct {
+	getter *service.ProjectGetter
+	repo   *mock_service.MockGetProjectRepository
+}
+
+func TestNewProjectGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ProjectGetter", func(t *testing.T) {
+		exec := createProjectGetterExecutor(ctrl)
+		assert.NotNil(t, exec.getter)
+	})
+}
+
+func TestProjectGetter_GetByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createProjectGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetByName(testCtx, testProjectName).Return(nil, entity.ErrProjectNotFound())
+
+		res, err := exec.getter.GetByName(testCtx, testProjectName)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully get a single project", func(t *testing.T) {
+		exec := createProjectGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetByName(testCtx, testProjectName).Return(testProject, nil)
+
+		res, err := exec.getter.GetByName(testCtx, testProjectName)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testProject, res)
+	})
+}
+
+func TestProjectGetter_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		exec := createProjectGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx).Return([]*entity.Project{}, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetAll(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get all projects", func(t *testing.T) {
+		exec := createProjectGetterExecutor(ctrl)
+		projects := []*entity.Project{{Name: "default"}, testProject}
+		exec.repo.EXPECT().GetAll(testCtx).Return(projects, nil)
+
+		res, err := exec.getter.GetAll(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, projects, res)
+	})
+}
+
+func createProjectGetterExecutor(ctrl *gomock.Controller) *ProjectGetterExecutor {
+	r := mock_service.NewMockGetProjectRepository(ctrl)
+	g := service.NewProjectGetter(r)
+	return &ProjectGetterExecutor{
+		getter: g,
+		repo:   r,
+	}
+}
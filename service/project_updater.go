@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// UpdateProject defines the interface to update a project.
+type UpdateProject interface {
+	// Update updates the project's description.
+	// The project's name can't be changed.
+	Update(ctx context.Context, project *entity.Project) error
+}
+
+// UpdateProjectRepository defines the interface to update a project in the repository.
+type UpdateProjectRepository interface {
+	// Update updates the project's description in the repository.
+	// It returns ProjectNotFound error if the project doesn't exist.
+	Update(ctx context.Context, project *entity.Project) error
+}
+
+// ProjectUpdater is responsible for updating a project.
+type ProjectUpdater struct {
+	repo UpdateProjectRepository
+}
+
+// NewProjectUpdater creates an instance of ProjectUpdater.
+func NewProjectUpdater(repo UpdateProjectRepository) *ProjectUpdater {
+	return &ProjectUpdater{repo: repo}
+}
+
+// Update updates the project's description.
+// It returns ProjectNotFound error if the project doesn't exist.
+func (pu *ProjectUpdater) Update(ctx context.Context, project *entity.Project) error {
+	if project == nil {
+		return entity.ErrInvalidProject()
+	}
+	project.Name = strings.ToLower(strings.TrimSpace(project.Name))
+	project.Description = strings.TrimSpace(project.Description)
+	return pu.repo.Update(ctx, project)
+}
@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ProjectUpdaterExecutor struct {
+	updater *service.ProjectUpdater
+	repo    *mock_service.MockUpdateProjectRepository
+}
+
+func TestNewProjectUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ProjectUpdater", func(t *testing.T) {
+		exec := createProjectUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestProjectUpdater_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty project is prohibited", func(t *testing.T) {
+		exec := createProjectUpdaterExecutor(ctrl)
+
+		err := exec.updater.Update(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+	})
+
+	t.Run("project is not found", func(t *testing.T) {
+		exec := createProjectUpdaterExecutor(ctrl)
+		project := &entity.Project{Name: testProjectName}
+		exec.repo.EXPECT().Update(testCtx, project).Return(entity.ErrProjectNotFound())
+
+		err := exec.updater.Update(testCtx, project)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProjectNotFound(), err)
+	})
+
+	t.Run("successfully update project with sanitized description", func(t *testing.T) {
+		exec := createProjectUpdaterExecutor(ctrl)
+		project := &entity.Project{Name: " Payment ", Description: " payment squad "}
+		exec.repo.EXPECT().Update(testCtx, &entity.Project{Name: "payment", Description: "payment squad"}).Return(nil)
+
+		err := exec.updater.Update(testCtx, project)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createProjectUpdaterExecutor(ctrl *gomock.Controller) *ProjectUpdaterExecutor {
+	r := mock_service.NewMockUpdateProjectRepository(ctrl)
+	u := service.NewProjectUpdater(r)
+	return &ProjectUpdaterExecutor{
+		updater: u,
+		repo:    r,
+	}
+}
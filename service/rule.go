@@ -0,0 +1,383 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+var (
+	ruleRegexCache sync.Map
+)
+
+// EvaluationDependencies defines the data outside of the toggle which is needed to evaluate the toggle.
+type EvaluationDependencies struct {
+	// Segments defines the segments referenced by the toggle's rules, keyed by the segment's key.
+	Segments map[string]*entity.Segment
+	// Prerequisites defines the evaluations of the toggle's prerequisites, keyed by the prerequisite toggle's key.
+	Prerequisites map[string]*entity.Evaluation
+}
+
+// Evaluate evaluates the toggle against the evaluation context.
+// A disabled toggle is always evaluated to false.
+// An enabled toggle is evaluated to false if any of its prerequisites isn't evaluated to the required value.
+// Otherwise, it checks its rules in order and serves the value of the first matched rule.
+// If none of the rules matches, the toggle's percentage rollout decides the value.
+// If the toggle doesn't have any rollout, the toggle's default value is served.
+//
+// A multivariate toggle also serves a variant based on the resolved value.
+// See resolveVariant for the detail.
+//
+// It doesn't touch any storage, hence it can be used by anyone that already holds the toggle.
+// Since it doesn't resolve any segment nor prerequisite, rules referencing segments never match
+// and a toggle with prerequisites is never satisfied.
+// Use EvaluateWithDependencies to evaluate those.
+func Evaluate(toggle *entity.Toggle, evalCtx map[string]string) *entity.Evaluation {
+	return EvaluateWithDependencies(toggle, nil, evalCtx)
+}
+
+// EvaluateWithSegments evaluates the toggle against the evaluation context just like Evaluate.
+// The segments referenced by the toggle's rules are looked up in segments, keyed by the segment's key.
+// A rule referencing a segment which doesn't exist in segments never matches.
+func EvaluateWithSegments(toggle *entity.Toggle, segments map[string]*entity.Segment, evalCtx map[string]string) *entity.Evaluation {
+	return EvaluateWithDependencies(toggle, &EvaluationDependencies{Segments: segments}, evalCtx)
+}
+
+// EvaluateWithDependencies evaluates the toggle against the evaluation context just like Evaluate.
+// The segments and the prerequisites' evaluations are looked up in deps.
+// A rule referencing a segment which doesn't exist in deps never matches
+// and a prerequisite which isn't evaluated in deps is never satisfied.
+func EvaluateWithDependencies(toggle *entity.Toggle, deps *EvaluationDependencies, evalCtx map[string]string) *entity.Evaluation {
+	if deps == nil {
+		deps = &EvaluationDependencies{}
+	}
+	res := evaluate(toggle, deps, evalCtx)
+	res.Variant = resolveVariant(toggle, res)
+	res.Version = toggle.Version
+	return res
+}
+
+// ReferencedSegments returns the unique keys of segments referenced by the rules, in order of appearance.
+func ReferencedSegments(rules []*entity.Rule) []string {
+	var res []string
+	for _, rule := range rules {
+		if rule == nil || !isSegmentOperator(rule.Operator) {
+			continue
+		}
+		for _, key := range rule.Values {
+			if !containsString(res, key) {
+				res = append(res, key)
+			}
+		}
+	}
+	return res
+}
+
+func evaluate(toggle *entity.Toggle, deps *EvaluationDependencies, evalCtx map[string]string) *entity.Evaluation {
+	if !toggle.IsEnabled {
+		return &entity.Evaluation{Key: toggle.Key, Value: false, Reason: entity.EvaluationReasonDisabled}
+	}
+	if key := failedPrerequisite(toggle.Prerequisites, deps.Prerequisites); key != "" {
+		return &entity.Evaluation{Key: toggle.Key, Value: false, Reason: entity.EvaluationReasonPrerequisiteFailed, FailedPrerequisite: key}
+	}
+
+	for _, rule := range toggle.Rules {
+		if matchRule(rule, deps.Segments, evalCtx) {
+			return &entity.Evaluation{Key: toggle.Key, Value: rule.Value, Reason: entity.EvaluationReasonRuleMatch, MatchedRule: rule}
+		}
+	}
+	if toggle.Rollout != nil {
+		return &entity.Evaluation{Key: toggle.Key, Value: inRollout(toggle, evalCtx), Reason: entity.EvaluationReasonRollout}
+	}
+	return &entity.Evaluation{Key: toggle.Key, Value: toggle.DefaultValue, Reason: entity.EvaluationReasonFallthrough}
+}
+
+// inRollout never includes a subject whose bucketing attribute doesn't exist in the evaluation context.
+func inRollout(toggle *entity.Toggle, evalCtx map[string]string) bool {
+	subject, ok := evalCtx[toggle.Rollout.BucketBy]
+	if !ok {
+		return false
+	}
+	return bucket(toggle.Key, subject) < toggle.Rollout.Percentage
+}
+
+func validateRollout(rollout *entity.Rollout) error {
+	if rollout == nil {
+		return nil
+	}
+	if rollout.Percentage > bucketCount {
+		return entity.ErrInvalidRollout(fmt.Sprintf("percentage %d is more than %d", rollout.Percentage, bucketCount))
+	}
+	if strings.TrimSpace(rollout.BucketBy) == "" {
+		return entity.ErrInvalidRollout("bucket_by is empty")
+	}
+	return nil
+}
+
+// matchRule never matches if the attribute doesn't exist in the evaluation context,
+// even for negative operators such as not-equals, not-in, and not-in-segment.
+func matchRule(rule *entity.Rule, segments map[string]*entity.Segment, evalCtx map[string]string) bool {
+	attr, ok := evalCtx[rule.Attribute]
+	if !ok || len(rule.Values) == 0 {
+		return false
+	}
+
+	switch rule.Operator {
+	case entity.RuleOperatorEquals:
+		return attr == rule.Values[0]
+	case entity.RuleOperatorNotEquals:
+		return attr != rule.Values[0]
+	case entity.RuleOperatorIn:
+		return containsString(rule.Values, attr)
+	case entity.RuleOperatorNotIn:
+		return !containsString(rule.Values, attr)
+	case entity.RuleOperatorRegex:
+		regex, err := compileRuleRegex(rule.Values[0])
+		return err == nil && regex.MatchString(attr)
+	case entity.RuleOperatorSemverGreaterThan:
+		cmp, err := compareSemver(attr, rule.Values[0])
+		return err == nil && cmp > 0
+	case entity.RuleOperatorSemverLessThan:
+		cmp, err := compareSemver(attr, rule.Values[0])
+		return err == nil && cmp < 0
+	case entity.RuleOperatorInSegment:
+		member, ok := inAnySegment(rule.Values, segments, attr, evalCtx)
+		return ok && member
+	case entity.RuleOperatorNotInSegment:
+		member, ok := inAnySegment(rule.Values, segments, attr, evalCtx)
+		return ok && !member
+	default:
+		return false
+	}
+}
+
+// inAnySegment returns false as the second value if any of the segments can't be resolved.
+func inAnySegment(keys []string, segments map[string]*entity.Segment, subject string, evalCtx map[string]string) (bool, bool) {
+	member := false
+	for _, key := range keys {
+		segment, ok := segments[key]
+		if !ok || segment == nil {
+			return false, false
+		}
+		member = member || matchSegment(segment, subject, evalCtx)
+	}
+	return member, true
+}
+
+// matchSegment checks the excluded list first, then the included list, then the segment's rules.
+func matchSegment(segment *entity.Segment, subject string, evalCtx map[string]string) bool {
+	if containsString(segment.Excluded, subject) {
+		return false
+	}
+	if containsString(segment.Included, subject) {
+		return true
+	}
+	for _, rule := range segment.Rules {
+		if matchRule(rule, nil, evalCtx) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSegmentOperator(operator entity.RuleOperator) bool {
+	return operator == entity.RuleOperatorInSegment || operator == entity.RuleOperatorNotInSegment
+}
+
+// sanitizeSegmentReferences converts the segment's keys referenced by the rules to lower case.
+func sanitizeSegmentReferences(rules []*entity.Rule) {
+	for _, rule := range rules {
+		if !isSegmentOperator(rule.Operator) {
+			continue
+		}
+		for i, key := range rule.Values {
+			rule.Values[i] = strings.ToLower(strings.TrimSpace(key))
+		}
+	}
+}
+
+func validateRules(rules []*entity.Rule) error {
+	for i, rule := range rules {
+		if err := validateRule(rule); err != nil {
+			return entity.ErrInvalidRule(fmt.Sprintf("rule %d: %s", i, err.Error()))
+		}
+	}
+	return nil
+}
+
+func validateRule(rule *entity.Rule) error {
+	if rule == nil {
+		return fmt.Errorf("empty or nil")
+	}
+	if strings.TrimSpace(rule.Attribute) == "" {
+		return fmt.Errorf("attribute is empty")
+	}
+	if len(rule.Values) == 0 {
+		return fmt.Errorf("values are empty")
+	}
+
+	switch rule.Operator {
+	case entity.RuleOperatorIn, entity.RuleOperatorNotIn:
+		return nil
+	case entity.RuleOperatorEquals, entity.RuleOperatorNotEquals:
+		return validateSingleValue(rule)
+	case entity.RuleOperatorRegex:
+		if err := validateSingleValue(rule); err != nil {
+			return err
+		}
+		_, err := compileRuleRegex(rule.Values[0])
+		return err
+	case entity.RuleOperatorSemverGreaterThan, entity.RuleOperatorSemverLessThan:
+		if err := validateSingleValue(rule); err != nil {
+			return err
+		}
+		_, err := parseSemver(rule.Values[0])
+		return err
+	case entity.RuleOperatorInSegment, entity.RuleOperatorNotInSegment:
+		for _, key := range rule.Values {
+			if !regexCompiler.MatchString(strings.TrimSpace(key)) {
+				return fmt.Errorf("segment %q is invalid", key)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("operator %q is unknown", rule.Operator)
+	}
+}
+
+func validateSingleValue(rule *entity.Rule) error {
+	if len(rule.Values) != 1 {
+		return fmt.Errorf("operator %q needs exactly one value", rule.Operator)
+	}
+	return nil
+}
+
+func compileRuleRegex(pattern string) (*regexp.Regexp, error) {
+	if regex, ok := ruleRegexCache.Load(pattern); ok {
+		return regex.(*regexp.Regexp), nil
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	ruleRegexCache.Store(pattern, regex)
+	return regex, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, val := range values {
+		if val == value {
+			return true
+		}
+	}
+	return false
+}
+
+type semver struct {
+	numbers    [3]int
+	prerelease string
+}
+
+// compareSemver returns -1, 0, or 1 if a is less than, equal to, or greater than b.
+func compareSemver(a, b string) (int, error) {
+	va, err := parseSemver(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseSemver(b)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range va.numbers {
+		if va.numbers[i] != vb.numbers[i] {
+			return compareInt(va.numbers[i], vb.numbers[i]), nil
+		}
+	}
+	switch {
+	case va.prerelease == vb.prerelease:
+		return 0, nil
+	case va.prerelease == "":
+		return 1, nil
+	case vb.prerelease == "":
+		return -1, nil
+	default:
+		return comparePrerelease(va.prerelease, vb.prerelease), nil
+	}
+}
+
+// comparePrerelease returns -1, 0, or 1 if prerelease a has lower, equal, or higher precedence than b.
+// The dot-separated identifiers are compared from left to right as per semantic versioning:
+// numeric identifiers are compared numerically and have lower precedence than alphanumeric ones,
+// which are compared lexically, and the shorter list has lower precedence if all of its identifiers are equal.
+func comparePrerelease(a, b string) int {
+	pa := strings.Split(a, ".")
+	pb := strings.Split(b, ".")
+	for i := 0; i < len(pa) && i < len(pb); i++ {
+		na, errA := strconv.ParseUint(pa[i], 10, 64)
+		nb, errB := strconv.ParseUint(pb[i], 10, 64)
+		switch {
+		case errA == nil && errB == nil:
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		default:
+			if res := strings.Compare(pa[i], pb[i]); res != 0 {
+				return res
+			}
+		}
+	}
+	switch {
+	case len(pa) == len(pb):
+		return 0
+	case len(pa) < len(pb):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// parseSemver parses version in format of [v]MAJOR[.MINOR[.PATCH]][-PRERELEASE][+BUILD].
+func parseSemver(version string) (*semver, error) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if idx := strings.Index(version, "+"); idx >= 0 {
+		version = version[:idx]
+	}
+
+	res := &semver{}
+	if idx := strings.Index(version, "-"); idx >= 0 {
+		res.prerelease = version[idx+1:]
+		version = version[:idx]
+	}
+
+	parts := strings.Split(version, ".")
+	if len(parts) > len(res.numbers) {
+		return nil, fmt.Errorf("version %q is not a semantic version", version)
+	}
+	for i, part := range parts {
+		num, err := strconv.Atoi(part)
+		if err != nil || num < 0 {
+			return nil, fmt.Errorf("version %q is not a semantic version", version)
+		}
+		res.numbers[i] = num
+	}
+	return res, nil
+}
+
+func compareInt(a, b int) int {
+	if a < b {
+		return -1
+	}
+	return 1
+}
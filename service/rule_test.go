@@ -0,0 +1,372 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Run("disabled toggle is always evaluated to false", func(t *testing.T) {
+		toggle := &entity.Toggle{
+			Key:          testToggleKey,
+			IsEnabled:    false,
+			DefaultValue: true,
+			Rules:        []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}},
+		}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonDisabled, res.Reason)
+		assert.Nil(t, res.MatchedRule)
+	})
+
+	t.Run("enabled toggle without any matched rule serves default value", func(t *testing.T) {
+		for _, value := range []bool{true, false} {
+			toggle := &entity.Toggle{
+				Key:          testToggleKey,
+				IsEnabled:    true,
+				DefaultValue: value,
+				Rules:        []*entity.Rule{{Attribute: "email", Operator: entity.RuleOperatorEquals, Values: []string{"a@example.com"}, Value: !value}},
+			}
+
+			res := service.Evaluate(toggle, testEvaluationContext)
+
+			assert.Equal(t, value, res.Value)
+			assert.Equal(t, entity.EvaluationReasonFallthrough, res.Reason)
+			assert.Nil(t, res.MatchedRule)
+		}
+	})
+
+	t.Run("first matched rule wins", func(t *testing.T) {
+		first := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"SG", "ID"}, Value: false}
+		second := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Rules: []*entity.Rule{first, second}}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonRuleMatch, res.Reason)
+		assert.Equal(t, first, res.MatchedRule)
+	})
+
+	t.Run("each operator is evaluated accordingly", func(t *testing.T) {
+		tables := []struct {
+			rule    *entity.Rule
+			matched bool
+		}{
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}}, true},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"SG"}}, false},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorNotEquals, Values: []string{"SG"}}, true},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorNotEquals, Values: []string{"ID"}}, false},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"SG", "ID"}}, true},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"SG", "MY"}}, false},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorNotIn, Values: []string{"SG", "MY"}}, true},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorNotIn, Values: []string{"SG", "ID"}}, false},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorRegex, Values: []string{"^I[A-Z]$"}}, true},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorRegex, Values: []string{"^S"}}, false},
+			{&entity.Rule{Attribute: "version", Operator: entity.RuleOperatorSemverGreaterThan, Values: []string{"v1.3.9"}}, true},
+			{&entity.Rule{Attribute: "version", Operator: entity.RuleOperatorSemverGreaterThan, Values: []string{"1.4.0"}}, false},
+			{&entity.Rule{Attribute: "version", Operator: entity.RuleOperatorSemverGreaterThan, Values: []string{"1.4.0-beta.1"}}, true},
+			{&entity.Rule{Attribute: "version", Operator: entity.RuleOperatorSemverLessThan, Values: []string{"1.10"}}, true},
+			{&entity.Rule{Attribute: "version", Operator: entity.RuleOperatorSemverLessThan, Values: []string{"1.4"}}, false},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperatorSemverLessThan, Values: []string{"1.4"}}, false},
+			{&entity.Rule{Attribute: "email", Operator: entity.RuleOperatorNotIn, Values: []string{"a@example.com"}}, false},
+			{&entity.Rule{Attribute: "country", Operator: entity.RuleOperator("unknown"), Values: []string{"ID"}}, false},
+		}
+
+		for _, table := range tables {
+			table.rule.Value = true
+			toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{table.rule}}
+
+			res := service.Evaluate(toggle, testEvaluationContext)
+
+			assert.Equal(t, table.matched, res.Value, "operator %s with values %v", table.rule.Operator, table.rule.Values)
+		}
+	})
+
+	t.Run("prerelease versions are compared by their precedence", func(t *testing.T) {
+		tables := []struct {
+			version string
+			other   string
+			less    bool
+		}{
+			{"1.0.0-rc.9", "1.0.0-rc.10", true},
+			{"1.0.0-rc.10", "1.0.0-rc.9", false},
+			{"1.0.0-alpha.1", "1.0.0-alpha.beta", true},
+			{"1.0.0-alpha.beta", "1.0.0-alpha.1", false},
+			{"1.0.0-alpha", "1.0.0-alpha.1", true},
+			{"1.0.0-alpha.1", "1.0.0-alpha", false},
+			{"1.0.0-beta.2", "1.0.0-beta.11", true},
+			{"1.0.0-beta", "1.0.0-alpha", false},
+			{"1.0.0-rc.1", "1.0.0", true},
+			{"1.0.0-rc.1", "1.0.0-rc.1", false},
+		}
+
+		for _, table := range tables {
+			rule := &entity.Rule{Attribute: "version", Operator: entity.RuleOperatorSemverLessThan, Values: []string{table.other}, Value: true}
+			toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}}
+
+			res := service.Evaluate(toggle, map[string]string{"version": table.version})
+
+			assert.Equal(t, table.less, res.Value, "%s is less than %s", table.version, table.other)
+		}
+	})
+
+	t.Run("evaluation carries the toggle's version", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Version: 3}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.Equal(t, int64(3), res.Version)
+	})
+}
+
+func TestEvaluate_Rollout(t *testing.T) {
+	t.Run("matched rule takes precedence over rollout", func(t *testing.T) {
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}, Rollout: &entity.Rollout{Percentage: 0, BucketBy: "country"}}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.True(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonRuleMatch, res.Reason)
+	})
+
+	t.Run("rollout takes precedence over default value", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Rollout: &entity.Rollout{Percentage: 0, BucketBy: "country"}}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonRollout, res.Reason)
+	})
+
+	t.Run("subject without bucketing attribute is never in rollout", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 100, BucketBy: "user_id"}}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonRollout, res.Reason)
+	})
+
+	t.Run("0 and 100 percent rollout serve false and true to everyone", func(t *testing.T) {
+		none := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 0, BucketBy: "user_id"}}
+		all := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 100, BucketBy: "user_id"}}
+
+		for i := 0; i < 1000; i++ {
+			evalCtx := map[string]string{"user_id": fmt.Sprintf("user-%d", i)}
+
+			assert.False(t, service.Evaluate(none, evalCtx).Value)
+			assert.True(t, service.Evaluate(all, evalCtx).Value)
+		}
+	})
+
+	t.Run("subject is bucketed consistently", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 50, BucketBy: "user_id"}}
+
+		for i := 0; i < 100; i++ {
+			evalCtx := map[string]string{"user_id": fmt.Sprintf("user-%d", i)}
+			first := service.Evaluate(toggle, evalCtx).Value
+
+			for j := 0; j < 10; j++ {
+				assert.Equal(t, first, service.Evaluate(toggle, evalCtx).Value)
+			}
+		}
+	})
+
+	t.Run("subject in smaller rollout stays in bigger rollout", func(t *testing.T) {
+		small := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 10, BucketBy: "user_id"}}
+		big := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 50, BucketBy: "user_id"}}
+
+		for i := 0; i < 1000; i++ {
+			evalCtx := map[string]string{"user_id": fmt.Sprintf("user-%d", i)}
+			if service.Evaluate(small, evalCtx).Value {
+				assert.True(t, service.Evaluate(big, evalCtx).Value)
+			}
+		}
+	})
+
+	t.Run("rollout is distributed roughly by percentage", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rollout: &entity.Rollout{Percentage: 30, BucketBy: "user_id"}}
+
+		count := 0
+		for i := 0; i < 10000; i++ {
+			if service.Evaluate(toggle, map[string]string{"user_id": fmt.Sprintf("user-%d", i)}).Value {
+				count++
+			}
+		}
+
+		assert.InDelta(t, 3000, count, 300)
+	})
+
+	t.Run("bucketing algorithm is stable", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: "rollout-toggle", IsEnabled: true, Rollout: &entity.Rollout{Percentage: 50, BucketBy: "user_id"}}
+		tables := map[string]bool{
+			"alice": false,
+			"bob":   false,
+			"carol": true,
+			"dave":  true,
+			"erin":  false,
+			"frank": true,
+		}
+
+		for user, value := range tables {
+			assert.Equal(t, value, service.Evaluate(toggle, map[string]string{"user_id": user}).Value, "user %s", user)
+		}
+	})
+}
+
+func TestEvaluateWithSegments(t *testing.T) {
+	segments := map[string]*entity.Segment{
+		"beta-testers": {
+			Key:      "beta-testers",
+			Included: []string{"user-1", "user-3"},
+			Excluded: []string{"user-2"},
+			Rules:    []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}}},
+		},
+		"employees": {
+			Key:      "employees",
+			Included: []string{"user-9"},
+		},
+	}
+
+	t.Run("each subject is matched against the segment accordingly", func(t *testing.T) {
+		tables := []struct {
+			evalCtx map[string]string
+			matched bool
+		}{
+			{map[string]string{"user_id": "user-1"}, true},
+			{map[string]string{"user_id": "user-2", "country": "ID"}, false},
+			{map[string]string{"user_id": "user-4", "country": "SG"}, true},
+			{map[string]string{"user_id": "user-4", "country": "MY"}, false},
+			{map[string]string{"country": "ID"}, false},
+		}
+
+		for _, table := range tables {
+			rule := &entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta-testers"}, Value: true}
+			toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}}
+
+			res := service.EvaluateWithSegments(toggle, segments, table.evalCtx)
+
+			assert.Equal(t, table.matched, res.Value, "context %v", table.evalCtx)
+		}
+	})
+
+	t.Run("each segment operator is evaluated accordingly", func(t *testing.T) {
+		tables := []struct {
+			rule    *entity.Rule
+			matched bool
+		}{
+			{&entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"employees", "beta-testers"}}, true},
+			{&entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"employees"}}, false},
+			{&entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorNotInSegment, Values: []string{"employees"}}, true},
+			{&entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorNotInSegment, Values: []string{"employees", "beta-testers"}}, false},
+			{&entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta-testers", "unknown"}}, false},
+			{&entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorNotInSegment, Values: []string{"unknown"}}, false},
+		}
+
+		for _, table := range tables {
+			table.rule.Value = true
+			toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{table.rule}}
+
+			res := service.EvaluateWithSegments(toggle, segments, map[string]string{"user_id": "user-1"})
+
+			assert.Equal(t, table.matched, res.Value, "operator %s with values %v", table.rule.Operator, table.rule.Values)
+		}
+	})
+
+	t.Run("rule referencing segment never matches without segments", func(t *testing.T) {
+		rule := &entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorNotInSegment, Values: []string{"employees"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}}
+
+		res := service.Evaluate(toggle, map[string]string{"user_id": "user-1"})
+
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonFallthrough, res.Reason)
+	})
+}
+
+func TestEvaluateWithDependencies(t *testing.T) {
+	prerequisites := []*entity.Prerequisite{{Key: "toggle-0", Value: true}, {Key: "toggle-2", Value: false}}
+	variants := []*entity.Variant{{Name: "on", Type: entity.VariantTypeString, Value: "on"}, {Name: "off", Type: entity.VariantTypeString, Value: "off"}}
+
+	t.Run("unsatisfied prerequisite evaluates toggle to false", func(t *testing.T) {
+		tables := []struct {
+			evaluations map[string]*entity.Evaluation
+			failed      string
+		}{
+			{nil, "toggle-0"},
+			{map[string]*entity.Evaluation{"toggle-0": {Value: false}, "toggle-2": {Value: false}}, "toggle-0"},
+			{map[string]*entity.Evaluation{"toggle-0": {Value: true}}, "toggle-2"},
+			{map[string]*entity.Evaluation{"toggle-0": {Value: true}, "toggle-2": {Value: true}}, "toggle-2"},
+		}
+
+		for _, table := range tables {
+			toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Prerequisites: prerequisites, Variants: variants, DefaultVariant: "on", OffVariant: "off"}
+
+			res := service.EvaluateWithDependencies(toggle, &service.EvaluationDependencies{Prerequisites: table.evaluations}, testEvaluationContext)
+
+			assert.False(t, res.Value)
+			assert.Equal(t, entity.EvaluationReasonPrerequisiteFailed, res.Reason)
+			assert.Equal(t, table.failed, res.FailedPrerequisite)
+			assert.Equal(t, "off", res.Variant.Name)
+		}
+	})
+
+	t.Run("disabled toggle doesn't check its prerequisites", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: false, Prerequisites: prerequisites}
+
+		res := service.EvaluateWithDependencies(toggle, nil, testEvaluationContext)
+
+		assert.Equal(t, entity.EvaluationReasonDisabled, res.Reason)
+		assert.Empty(t, res.FailedPrerequisite)
+	})
+
+	t.Run("satisfied prerequisites continue to the rules", func(t *testing.T) {
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}, Prerequisites: prerequisites}
+		deps := &service.EvaluationDependencies{Prerequisites: map[string]*entity.Evaluation{"toggle-0": {Value: true}, "toggle-2": {Value: false}}}
+
+		res := service.EvaluateWithDependencies(toggle, deps, testEvaluationContext)
+
+		assert.True(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonRuleMatch, res.Reason)
+		assert.Empty(t, res.FailedPrerequisite)
+	})
+
+	t.Run("prerequisite is never satisfied without dependencies", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Prerequisites: prerequisites}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.False(t, res.Value)
+		assert.Equal(t, entity.EvaluationReasonPrerequisiteFailed, res.Reason)
+	})
+}
+
+func TestReferencedSegments(t *testing.T) {
+	t.Run("successfully collect unique segment keys", func(t *testing.T) {
+		rules := []*entity.Rule{
+			{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta-testers", "employees"}},
+			{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID"}},
+			{Attribute: "user_id", Operator: entity.RuleOperatorNotInSegment, Values: []string{"employees", "interns"}},
+		}
+
+		res := service.ReferencedSegments(rules)
+
+		assert.Equal(t, []string{"beta-testers", "employees", "interns"}, res)
+	})
+
+	t.Run("rules without segment reference", func(t *testing.T) {
+		res := service.ReferencedSegments([]*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID"}}})
+
+		assert.Empty(t, res)
+	})
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+)
+
+// CancelSchedule defines the interface to cancel a toggle's schedule.
+type CancelSchedule interface {
+	// Cancel cancels a pending schedule of the toggle in the project's environment.
+	Cancel(ctx context.Context, project, env, key string, id int64) error
+}
+
+// CancelScheduleRepository defines the interface to cancel a toggle's schedule in the repository.
+type CancelScheduleRepository interface {
+	// DeletePending deletes a pending schedule of the toggle in the project's environment from the repository.
+	// It returns ScheduleNotFound error if the schedule can't be found or it isn't pending anymore.
+	DeletePending(ctx context.Context, project, env, key string, id int64) error
+}
+
+// ScheduleCanceller is responsible for cancelling a toggle's schedule.
+type ScheduleCanceller struct {
+	repo CancelScheduleRepository
+}
+
+// NewScheduleCanceller creates an instance of ScheduleCanceller.
+func NewScheduleCanceller(repo CancelScheduleRepository) *ScheduleCanceller {
+	return &ScheduleCanceller{repo: repo}
+}
+
+// Cancel cancels a pending schedule.
+// It returns ScheduleNotFound error if the schedule can't be found or it has been executed.
+func (sc *ScheduleCanceller) Cancel(ctx context.Context, project, env, key string, id int64) error {
+	return sc.repo.DeletePending(ctx, project, env, key, id)
+}
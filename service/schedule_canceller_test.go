@@ -0,0 +1,60 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ScheduleCancellerExecutor struct {
+	canceller *service.ScheduleCanceller
+	repo      *mock_service.MockCancelScheduleRepository
+}
+
+func TestNewScheduleCanceller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ScheduleCanceller", func(t *testing.T) {
+		exec := createScheduleCancellerExecutor(ctrl)
+		assert.NotNil(t, exec.canceller)
+	})
+}
+
+func TestScheduleCanceller_Cancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("schedule is not found", func(t *testing.T) {
+		exec := createScheduleCancellerExecutor(ctrl)
+		exec.repo.EXPECT().DeletePending(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(entity.ErrScheduleNotFound())
+
+		err := exec.canceller.Cancel(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrScheduleNotFound(), err)
+	})
+
+	t.Run("successfully cancel a schedule", func(t *testing.T) {
+		exec := createScheduleCancellerExecutor(ctrl)
+		exec.repo.EXPECT().DeletePending(testCtx, testToggleProject, testToggleEnv, testToggleKey, int64(1)).Return(nil)
+
+		err := exec.canceller.Cancel(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createScheduleCancellerExecutor(ctrl *gomock.Controller) *ScheduleCancellerExecutor {
+	r := mock_service.NewMockCancelScheduleRepository(ctrl)
+	c := service.NewScheduleCanceller(r)
+	return &ScheduleCancellerExecutor{
+		canceller: c,
+		repo:      r,
+	}
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// CreateSchedule defines the interface to create a toggle's schedule.
+type CreateSchedule interface {
+	// Create creates a new pending schedule and store it in the storage.
+	// It must ensure that the toggle exists in the project's environment.
+	Create(ctx context.Context, schedule *entity.Schedule) error
+}
+
+// CreateScheduleRepository defines the interface to save a schedule into the repository.
+type CreateScheduleRepository interface {
+	// Insert inserts the schedule into the repository as a pending schedule.
+	// It sets the schedule's ID and CreatedAt.
+	// It returns NotFound error if the toggle doesn't exist in the project's environment.
+	Insert(ctx context.Context, schedule *entity.Schedule) error
+}
+
+// ScheduleCreator is responsible for creating a new toggle's schedule.
+type ScheduleCreator struct {
+	repo CreateScheduleRepository
+}
+
+// NewScheduleCreator creates an instance of ScheduleCreator.
+func NewScheduleCreator(repo CreateScheduleRepository) *ScheduleCreator {
+	return &ScheduleCreator{repo: repo}
+}
+
+// Create creates a new pending schedule.
+// It will reject if the action is unknown or the execution time isn't in the future.
+// The schedule's key, project, and environment are converted to lower case.
+func (sc *ScheduleCreator) Create(ctx context.Context, schedule *entity.Schedule) error {
+	if err := validateSchedule(schedule); err != nil {
+		return err
+	}
+	sanitizeSchedule(schedule)
+	return sc.repo.Insert(ctx, schedule)
+}
+
+func sanitizeSchedule(schedule *entity.Schedule) {
+	schedule.Key = strings.ToLower(strings.TrimSpace(schedule.Key))
+	schedule.Project = strings.ToLower(strings.TrimSpace(schedule.Project))
+	schedule.Environment = strings.ToLower(strings.TrimSpace(schedule.Environment))
+	schedule.ExecuteAt = schedule.ExecuteAt.UTC()
+}
+
+func validateSchedule(schedule *entity.Schedule) error {
+	if schedule == nil {
+		return entity.ErrInvalidSchedule("empty or nil")
+	}
+	if !regexCompiler.MatchString(strings.TrimSpace(schedule.Key)) {
+		return entity.ErrInvalidKey()
+	}
+	if !regexCompiler.MatchString(strings.TrimSpace(schedule.Project)) {
+		return entity.ErrInvalidProject()
+	}
+	if !regexCompiler.MatchString(strings.TrimSpace(schedule.Environment)) {
+		return entity.ErrInvalidEnvironment()
+	}
+	if !entity.IsValidScheduleAction(schedule.Action) {
+		return entity.ErrInvalidSchedule("action must be either enable or disable")
+	}
+	if !schedule.ExecuteAt.After(time.Now()) {
+		return entity.ErrInvalidSchedule("execution time must be in the future")
+	}
+	return nil
+}
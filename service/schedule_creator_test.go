@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ScheduleCreatorExecutor struct {
+	creator *service.ScheduleCreator
+	repo    *mock_service.MockCreateScheduleRepository
+}
+
+func TestNewScheduleCreator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ScheduleCreator", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+		assert.NotNil(t, exec.creator)
+	})
+}
+
+func TestScheduleCreator_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty schedule is prohibited", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+
+		err := exec.creator.Create(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("empty or nil"), err)
+	})
+
+	t.Run("schedule's key, project, or environment is invalid", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+
+		tables := []struct {
+			schedule *entity.Schedule
+			err      error
+		}{
+			{&entity.Schedule{Key: "toggle_1", Project: testToggleProject, Environment: testToggleEnv}, entity.ErrInvalidKey()},
+			{&entity.Schedule{Key: testToggleKey, Project: "team_01", Environment: testToggleEnv}, entity.ErrInvalidProject()},
+			{&entity.Schedule{Key: testToggleKey, Project: testToggleProject, Environment: ""}, entity.ErrInvalidEnvironment()},
+		}
+		for _, table := range tables {
+			err := exec.creator.Create(testCtx, table.schedule)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, table.err, err)
+		}
+	})
+
+	t.Run("schedule's action is unknown", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+
+		schedule := &entity.Schedule{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: "DELETE", ExecuteAt: time.Now().Add(time.Hour)}
+		err := exec.creator.Create(testCtx, schedule)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSchedule("action must be either enable or disable"), err)
+	})
+
+	t.Run("schedule's execution time isn't in the future", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+
+		times := []time.Time{{}, time.Now().Add(-time.Minute)}
+		for _, executeAt := range times {
+			schedule := &entity.Schedule{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: entity.ScheduleActionEnable, ExecuteAt: executeAt}
+			err := exec.creator.Create(testCtx, schedule)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidSchedule("execution time must be in the future"), err)
+		}
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+		schedule := &entity.Schedule{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: entity.ScheduleActionEnable, ExecuteAt: time.Now().Add(time.Hour)}
+		exec.repo.EXPECT().Insert(testCtx, schedule).Return(entity.ErrNotFound())
+
+		err := exec.creator.Create(testCtx, schedule)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("successfully create a schedule", func(t *testing.T) {
+		exec := createScheduleCreatorExecutor(ctrl)
+		schedule := &entity.Schedule{Key: " Toggle-1 ", Project: "Default", Environment: "PRODUCTION", Action: entity.ScheduleActionDisable, ExecuteAt: time.Now().Add(time.Hour)}
+		exec.repo.EXPECT().Insert(testCtx, schedule).Return(nil)
+
+		err := exec.creator.Create(testCtx, schedule)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testToggleKey, schedule.Key)
+		assert.Equal(t, testToggleProject, schedule.Project)
+		assert.Equal(t, testToggleEnv, schedule.Environment)
+	})
+}
+
+func createScheduleCreatorExecutor(ctrl *gomock.Controller) *ScheduleCreatorExecutor {
+	r := mock_service.NewMockCreateScheduleRepository(ctrl)
+	c := service.NewScheduleCreator(r)
+	return &ScheduleCreatorExecutor{
+		creator: c,
+		repo:    r,
+	}
+}
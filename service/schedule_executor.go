@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// ExecuteSchedule defines the interface to execute due toggle's schedules.
+type ExecuteSchedule interface {
+	// ExecuteDue executes the pending schedules whose execution time has passed.
+	// It returns the number of claimed schedules.
+	ExecuteDue(ctx context.Context) (int, error)
+}
+
+// ExecuteScheduleRepository defines the interface to claim due schedules from the repository.
+type ExecuteScheduleRepository interface {
+	// ClaimDue marks at most limit pending schedules whose execution time has passed as executed and returns them
+	// ordered by their execution time.
+	// A schedule must only be claimed once even if there are many claimers at the same time.
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.Schedule, error)
+	// MarkFailed marks the claimed schedule as failed.
+	MarkFailed(ctx context.Context, id int64) error
+}
+
+const (
+	// scheduleActor is the actor recorded in the audit log for the changes made by schedules.
+	scheduleActor = "scheduler"
+)
+
+// ScheduleExecutor is responsible for executing due toggle's schedules.
+type ScheduleExecutor struct {
+	repo      ExecuteScheduleRepository
+	enabler   EnableToggle
+	disabler  DisableToggle
+	batchSize int
+}
+
+// NewScheduleExecutor creates an instance of ScheduleExecutor.
+// The schedules are executed using enabler and disabler, hence they publish the same events as the manual ones.
+// At most batchSize schedules are claimed in each execution.
+func NewScheduleExecutor(repo ExecuteScheduleRepository, enabler EnableToggle, disabler DisableToggle, batchSize int) *ScheduleExecutor {
+	return &ScheduleExecutor{
+		repo:      repo,
+		enabler:   enabler,
+		disabler:  disabler,
+		batchSize: batchSize,
+	}
+}
+
+// ExecuteDue claims the due schedules and executes them in order of their execution time.
+// The changes are recorded in the audit log as made by the scheduler on behalf of the schedule.
+// A schedule which fails to be executed is marked as failed and isn't retried.
+// It only returns error if the schedules can't be claimed.
+func (se *ScheduleExecutor) ExecuteDue(ctx context.Context) (int, error) {
+	schedules, err := se.repo.ClaimDue(ctx, time.Now().UTC(), se.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, schedule := range schedules {
+		if err := se.execute(ctx, schedule); err != nil {
+			log.Printf("execute schedule %d error: %v", schedule.ID, err)
+			if err := se.repo.MarkFailed(ctx, schedule.ID); err != nil {
+				log.Printf("mark schedule %d as failed error: %v", schedule.ID, err)
+			}
+		}
+	}
+	return len(schedules), nil
+}
+
+func (se *ScheduleExecutor) execute(ctx context.Context, schedule *entity.Schedule) error {
+	ctx = entity.ContextWithAuditMetadata(ctx, &entity.AuditMetadata{
+		Actor:  scheduleActor,
+		Reason: fmt.Sprintf("schedule %d", schedule.ID),
+	})
+
+	switch schedule.Action {
+	case entity.ScheduleActionEnable:
+		_, err := se.enabler.Enable(ctx, schedule.Project, schedule.Environment, schedule.Key, 0)
+		return err
+	case entity.ScheduleActionDisable:
+		_, err := se.disabler.Disable(ctx, schedule.Project, schedule.Environment, schedule.Key, 0)
+		return err
+	default:
+		return entity.ErrInvalidSchedule("action must be either enable or disable")
+	}
+}
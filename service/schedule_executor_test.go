@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testScheduleBatchSize = 10
+)
+
+type ScheduleExecutorExecutor struct {
+	executor *service.ScheduleExecutor
+	repo     *mock_service.MockExecuteScheduleRepository
+	enabler  *mock_service.MockEnableToggle
+	disabler *mock_service.MockDisableToggle
+}
+
+func TestNewScheduleExecutor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ScheduleExecutor", func(t *testing.T) {
+		exec := createScheduleExecutorExecutor(ctrl)
+		assert.NotNil(t, exec.executor)
+	})
+}
+
+func TestScheduleExecutor_ExecuteDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository fails to claim due schedules", func(t *testing.T) {
+		exec := createScheduleExecutorExecutor(ctrl)
+		exec.repo.EXPECT().ClaimDue(testCtx, gomock.Any(), testScheduleBatchSize).Return(nil, entity.ErrInternal(""))
+
+		n, err := exec.executor.ExecuteDue(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("there isn't any due schedule", func(t *testing.T) {
+		exec := createScheduleExecutorExecutor(ctrl)
+		exec.repo.EXPECT().ClaimDue(testCtx, gomock.Any(), testScheduleBatchSize).Return([]*entity.Schedule{}, nil)
+
+		n, err := exec.executor.ExecuteDue(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("failed schedules are marked as failed and don't stop the others", func(t *testing.T) {
+		exec := createScheduleExecutorExecutor(ctrl)
+		schedules := []*entity.Schedule{
+			{ID: 1, Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: entity.ScheduleActionEnable},
+			{ID: 2, Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: "DELETE"},
+			{ID: 3, Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: entity.ScheduleActionDisable},
+		}
+		exec.repo.EXPECT().ClaimDue(testCtx, gomock.Any(), testScheduleBatchSize).Return(schedules, nil)
+		exec.enabler.EXPECT().Enable(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(0), entity.ErrNotFound())
+		exec.repo.EXPECT().MarkFailed(testCtx, int64(1)).Return(entity.ErrInternal(""))
+		exec.repo.EXPECT().MarkFailed(testCtx, int64(2)).Return(nil)
+		exec.disabler.EXPECT().Disable(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, int64(0)).Return(int64(2), nil)
+
+		n, err := exec.executor.ExecuteDue(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("successfully execute all due schedules", func(t *testing.T) {
+		exec := createScheduleExecutorExecutor(ctrl)
+		schedules := []*entity.Schedule{
+			{ID: 1, Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: entity.ScheduleActionEnable},
+			{ID: 2, Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Action: entity.ScheduleActionDisable},
+		}
+		exec.repo.EXPECT().ClaimDue(testCtx, gomock.Any(), testScheduleBatchSize).Return(schedules, nil)
+		gomock.InOrder(
+			exec.enabler.EXPECT().Enable(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, int64(0)).
+				DoAndReturn(func(ctx context.Context, _, _, _ string, _ int64) (int64, error) {
+					assert.Equal(t, &entity.AuditMetadata{Actor: "scheduler", Reason: "schedule 1"}, entity.AuditMetadataFromContext(ctx))
+					return int64(2), nil
+				}),
+			exec.disabler.EXPECT().Disable(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, int64(0)).
+				DoAndReturn(func(ctx context.Context, _, _, _ string, _ int64) (int64, error) {
+					assert.Equal(t, &entity.AuditMetadata{Actor: "scheduler", Reason: "schedule 2"}, entity.AuditMetadataFromContext(ctx))
+					return int64(2), nil
+				}),
+		)
+
+		n, err := exec.executor.ExecuteDue(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, n)
+	})
+}
+
+func createScheduleExecutorExecutor(ctrl *gomock.Controller) *ScheduleExecutorExecutor {
+	r := mock_service.NewMockExecuteScheduleRepository(ctrl)
+	en := mock_service.NewMockEnableToggle(ctrl)
+	dis := mock_service.NewMockDisableToggle(ctrl)
+	e := service.NewScheduleExecutor(r, en, dis, testScheduleBatchSize)
+	return &ScheduleExecutorExecutor{
+		executor: e,
+		repo:     r,
+		enabler:  en,
+		disabler: dis,
+	}
+}
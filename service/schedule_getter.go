@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// GetSchedule defines the interface to get toggle's schedule.
+type GetSchedule interface {
+	// GetAllPending gets all pending schedules of the toggle in the project's environment.
+	GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error)
+}
+
+// GetScheduleRepository defines the interface to get toggle's schedule from the repository.
+type GetScheduleRepository interface {
+	// GetAllPending gets all pending schedules of the toggle in the project's environment ordered by their execution time.
+	// If there isn't any pending schedule in repository, it returns empty list of schedule and nil error.
+	GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error)
+}
+
+// ScheduleGetter is responsible for getting toggle's schedule.
+type ScheduleGetter struct {
+	repo GetScheduleRepository
+}
+
+// NewScheduleGetter creates an instance of ScheduleGetter.
+func NewScheduleGetter(repo GetScheduleRepository) *ScheduleGetter {
+	return &ScheduleGetter{repo: repo}
+}
+
+// GetAllPending gets all pending schedules of the toggle in the project's environment ordered by their execution time.
+// If there isn't any pending schedule in repository, it returns empty list of schedule and nil error.
+func (sg *ScheduleGetter) GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error) {
+	return sg.repo.GetAllPending(ctx, project, env, key)
+}
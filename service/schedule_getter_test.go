@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ScheduleGetterExecutor struct {
+	getter *service.ScheduleGetter
+	repo   *mock_service.MockGetScheduleRepository
+}
+
+func TestNewScheduleGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ScheduleGetter", func(t *testing.T) {
+		exec := createScheduleGetterExecutor(ctrl)
+		assert.NotNil(t, exec.getter)
+	})
+}
+
+func TestScheduleGetter_GetAllPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createScheduleGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllPending(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return([]*entity.Schedule{}, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetAllPending(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.NotNil(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get all pending schedules", func(t *testing.T) {
+		exec := createScheduleGetterExecutor(ctrl)
+		schedules := []*entity.Schedule{{ID: 1, Key: testToggleKey, Action: entity.ScheduleActionEnable}}
+		exec.repo.EXPECT().GetAllPending(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(schedules, nil)
+
+		res, err := exec.getter.GetAllPending(testCtx, testToggleProject, testToggleEnv, testToggleKey)
+
+		assert.Nil(t, err)
+		assert.Equal(t, schedules, res)
+	})
+}
+
+func createScheduleGetterExecutor(ctrl *gomock.Controller) *ScheduleGetterExecutor {
+	r := mock_service.NewMockGetScheduleRepository(ctrl)
+	g := service.NewScheduleGetter(r)
+	return &ScheduleGetterExecutor{
+		getter: g,
+		repo:   r,
+	}
+}
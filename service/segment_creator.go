@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// CreateSegment defines the interface to create a segment.
+type CreateSegment interface {
+	// Create creates a new segment and store it in the storage.
+	// It must check the uniqueness of the segment's key in its project.
+	Create(ctx context.Context, segment *entity.Segment) error
+}
+
+// CreateSegmentRepository defines the interface to save a segment into the repository.
+type CreateSegmentRepository interface {
+	// Insert inserts the segment into the repository.
+	// It also validates if the segment's key is unique in the segment's project.
+	// It returns ProjectNotFound error if the segment's project doesn't exist.
+	Insert(ctx context.Context, segment *entity.Segment) error
+}
+
+// SegmentCreator is responsible for creating a new segment.
+type SegmentCreator struct {
+	repo CreateSegmentRepository
+}
+
+// NewSegmentCreator creates an instance of SegmentCreator.
+func NewSegmentCreator(repo CreateSegmentRepository) *SegmentCreator {
+	return &SegmentCreator{repo: repo}
+}
+
+// Create creates a new segment.
+// It will reject if the segment's key already exists in the project
+// or if any of the segment's rules is invalid.
+// The segment's key and project are converted to lower case.
+func (sc *SegmentCreator) Create(ctx context.Context, segment *entity.Segment) error {
+	if err := validateSegment(segment); err != nil {
+		return err
+	}
+	sanitizeSegment(segment)
+	return sc.repo.Insert(ctx, segment)
+}
+
+func sanitizeSegment(segment *entity.Segment) {
+	segment.Key = strings.ToLower(strings.TrimSpace(segment.Key))
+	segment.Project = strings.ToLower(strings.TrimSpace(segment.Project))
+	segment.Description = strings.TrimSpace(segment.Description)
+	segment.Included = sanitizeSubjects(segment.Included)
+	segment.Excluded = sanitizeSubjects(segment.Excluded)
+}
+
+// sanitizeSubjects trims the subjects and removes the empty and duplicated ones.
+func sanitizeSubjects(subjects []string) []string {
+	var res []string
+	for _, subject := range subjects {
+		subject = strings.TrimSpace(subject)
+		if subject != "" && !containsString(res, subject) {
+			res = append(res, subject)
+		}
+	}
+	return res
+}
+
+func validateSegment(segment *entity.Segment) error {
+	if segment == nil {
+		return entity.ErrInvalidSegment("empty or nil")
+	}
+	if !regexCompiler.MatchString(strings.TrimSpace(segment.Key)) {
+		return entity.ErrInvalidSegment("key is empty or contain character outside of alphanumeric and dash")
+	}
+	if !regexCompiler.MatchString(strings.TrimSpace(segment.Project)) {
+		return entity.ErrInvalidProject()
+	}
+	return validateSegmentRules(segment.Rules)
+}
+
+// validateSegmentRules rejects rules referencing another segment to prevent segments from nesting.
+func validateSegmentRules(rules []*entity.Rule) error {
+	for i, rule := range rules {
+		if rule != nil && isSegmentOperator(rule.Operator) {
+			return entity.ErrInvalidSegment(fmt.Sprintf("rule %d: operator %q can't be used in segment", i, rule.Operator))
+		}
+		if err := validateRule(rule); err != nil {
+			return entity.ErrInvalidSegment(fmt.Sprintf("rule %d: %s", i, err.Error()))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSegmentKey = "beta-testers"
+)
+
+type SegmentCreatorExecutor struct {
+	creator *service.SegmentCreator
+	repo    *mock_service.MockCreateSegmentRepository
+}
+
+func TestNewSegmentCreator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of SegmentCreator", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+		assert.NotNil(t, exec.creator)
+	})
+}
+
+func TestSegmentCreator_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty segment is prohibited", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+
+		err := exec.creator.Create(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+	})
+
+	t.Run("segment's key is empty or contains character outside of alphanumeric and dash", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+
+		keys := []string{"", "   ", "beta_testers", "beta!"}
+		for _, key := range keys {
+			err := exec.creator.Create(testCtx, &entity.Segment{Key: key, Project: testToggleProject})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("segment's project is invalid", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+
+		err := exec.creator.Create(testCtx, &entity.Segment{Key: testSegmentKey, Project: "team_01"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidProject(), err)
+	})
+
+	t.Run("segment's rules are invalid", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+
+		rules := []*entity.Rule{
+			nil,
+			{Attribute: "country", Operator: entity.RuleOperatorEquals},
+			{Attribute: "country", Operator: entity.RuleOperator("unknown"), Values: []string{"ID"}},
+			{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"employees"}},
+		}
+		for _, rule := range rules {
+			err := exec.creator.Create(testCtx, &entity.Segment{Key: testSegmentKey, Project: testToggleProject, Rules: []*entity.Rule{rule}})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+		segment := &entity.Segment{Key: testSegmentKey, Project: testToggleProject}
+		exec.repo.EXPECT().Insert(testCtx, segment).Return(entity.ErrAlreadyExists())
+
+		err := exec.creator.Create(testCtx, segment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrAlreadyExists(), err)
+	})
+
+	t.Run("successfully create a new segment with sanitized data", func(t *testing.T) {
+		exec := createSegmentCreatorExecutor(ctrl)
+		segment := &entity.Segment{
+			Key:         " Beta-Testers ",
+			Project:     " Default ",
+			Description: " beta program ",
+			Included:    []string{" user-1 ", "", "user-1", "user-2"},
+			Excluded:    []string{"  "},
+		}
+		exec.repo.EXPECT().Insert(testCtx, &entity.Segment{
+			Key:         testSegmentKey,
+			Project:     testToggleProject,
+			Description: "beta program",
+			Included:    []string{"user-1", "user-2"},
+		}).Return(nil)
+
+		err := exec.creator.Create(testCtx, segment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createSegmentCreatorExecutor(ctrl *gomock.Controller) *SegmentCreatorExecutor {
+	r := mock_service.NewMockCreateSegmentRepository(ctrl)
+	c := service.NewSegmentCreator(r)
+	return &SegmentCreatorExecutor{
+		creator: c,
+		repo:    r,
+	}
+}
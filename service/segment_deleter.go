@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// DeleteSegment defines the interface to delete a segment.
+type DeleteSegment interface {
+	// DeleteByKey deletes a single segment in the project by its key.
+	DeleteByKey(ctx context.Context, project, key string) error
+}
+
+// DeleteSegmentRepository defines the interface to delete segment from the repository.
+type DeleteSegmentRepository interface {
+	// IsReferenced checks whether any toggle's rule in any environment references the segment.
+	IsReferenced(ctx context.Context, project, key string) (bool, error)
+	// DeleteByKey deletes a single segment in the project from the repository.
+	// It returns SegmentNotFound error if the segment doesn't exist.
+	DeleteByKey(ctx context.Context, project, key string) error
+}
+
+// SegmentDeleter is responsible for deleting a segment.
+type SegmentDeleter struct {
+	repo DeleteSegmentRepository
+}
+
+// NewSegmentDeleter creates an instance of SegmentDeleter.
+func NewSegmentDeleter(repo DeleteSegmentRepository) *SegmentDeleter {
+	return &SegmentDeleter{repo: repo}
+}
+
+// DeleteByKey deletes a segment in the project by its key.
+// It only deletes segment that isn't referenced by any toggle's rule.
+func (sd *SegmentDeleter) DeleteByKey(ctx context.Context, project, key string) error {
+	project = strings.ToLower(strings.TrimSpace(project))
+	key = strings.ToLower(strings.TrimSpace(key))
+
+	used, err := sd.repo.IsReferenced(ctx, project, key)
+	if err != nil {
+		return err
+	}
+	if used {
+		return entity.ErrSegmentInUse()
+	}
+	return sd.repo.DeleteByKey(ctx, project, key)
+}
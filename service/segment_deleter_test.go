@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type SegmentDeleterExecutor struct {
+	deleter *service.SegmentDeleter
+	repo    *mock_service.MockDeleteSegmentRepository
+}
+
+func TestNewSegmentDeleter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of SegmentDeleter", func(t *testing.T) {
+		exec := createSegmentDeleterExecutor(ctrl)
+		assert.NotNil(t, exec.deleter)
+	})
+}
+
+func TestSegmentDeleter_DeleteByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository fails to check segment's references", func(t *testing.T) {
+		exec := createSegmentDeleterExecutor(ctrl)
+		exec.repo.EXPECT().IsReferenced(testCtx, testToggleProject, testSegmentKey).Return(false, entity.ErrInternal(""))
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testSegmentKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("segment is still used by toggles", func(t *testing.T) {
+		exec := createSegmentDeleterExecutor(ctrl)
+		exec.repo.EXPECT().IsReferenced(testCtx, testToggleProject, testSegmentKey).Return(true, nil)
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testSegmentKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentInUse(), err)
+	})
+
+	t.Run("repository fails to delete segment", func(t *testing.T) {
+		exec := createSegmentDeleterExecutor(ctrl)
+		exec.repo.EXPECT().IsReferenced(testCtx, testToggleProject, testSegmentKey).Return(false, nil)
+		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleProject, testSegmentKey).Return(entity.ErrSegmentNotFound())
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testSegmentKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+	})
+
+	t.Run("successfully delete a segment with sanitized key", func(t *testing.T) {
+		exec := createSegmentDeleterExecutor(ctrl)
+		exec.repo.EXPECT().IsReferenced(testCtx, testToggleProject, testSegmentKey).Return(false, nil)
+		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleProject, testSegmentKey).Return(nil)
+
+		err := exec.deleter.DeleteByKey(testCtx, " Default ", " Beta-Testers ")
+
+		assert.Nil(t, err)
+	})
+}
+
+func createSegmentDeleterExecutor(ctrl *gomock.Controller) *SegmentDeleterExecutor {
+	r := mock_service.NewMockDeleteSegmentRepository(ctrl)
+	d := service.NewSegmentDeleter(r)
+	return &SegmentDeleterExecutor{
+		deleter: d,
+		repo:    r,
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// GetSegment defines the interface to get segment.
+type GetSegment interface {
+	// GetByKey gets a single segment in the project by its key.
+	GetByKey(ctx context.Context, project, key string) (*entity.Segment, error)
+	// GetAll gets all segments available in the project.
+	GetAll(ctx context.Context, project string) ([]*entity.Segment, error)
+}
+
+// GetSegmentRepository defines the interface to get segment from the repository.
+type GetSegmentRepository interface {
+	// GetByKey gets a single segment in the project from the repository.
+	// If the segment can't be found, it returns SegmentNotFound error.
+	GetByKey(ctx context.Context, project, key string) (*entity.Segment, error)
+	// GetAll gets all segments in the project available in repository.
+	// If there isn't any segment in repository, it returns empty list of segment and nil error.
+	GetAll(ctx context.Context, project string) ([]*entity.Segment, error)
+}
+
+// SegmentGetter is responsible for getting segment.
+type SegmentGetter struct {
+	repo GetSegmentRepository
+}
+
+// NewSegmentGetter creates an instance of SegmentGetter.
+func NewSegmentGetter(repo GetSegmentRepository) *SegmentGetter {
+	return &SegmentGetter{repo: repo}
+}
+
+// GetByKey gets a single segment in the project by its key.
+// If the segment can't be found, it returns SegmentNotFound error.
+func (sg *SegmentGetter) GetByKey(ctx context.Context, project, key string) (*entity.Segment, error) {
+	return sg.repo.GetByKey(ctx, project, key)
+}
+
+// GetAll gets all segments in the project available in repository.
+// If there isn't any segment in repository, it returns empty list of segment and nil error.
+func (sg *SegmentGetter) GetAll(ctx context.Context, project string) ([]*entity.Segment, error) {
+	return sg.repo.GetAll(ctx, project)
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testSegment = &entity.Segment{Key: testSegmentKey, Project: testToggleProject, Included: []string{"user-1"}}
+)
+
+type SegmentGetterExecutor struct {
+	getter *service.SegmentGetter
+	repo   *mock_service.MockGetSegmentRepository
+}
+
+func TestNewSegmentGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of SegmentGetter", func(t *testing.T) {
+		exec := createSegmentGetterExecutor(ctrl)
+		assert.NotNil(t, exec.getter)
+	})
+}
+
+func TestSegmentGetter_GetByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("segment is not found", func(t *testing.T) {
+		exec := createSegmentGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testSegmentKey).Return(nil, entity.ErrSegmentNotFound())
+
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testSegmentKey)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully get a segment", func(t *testing.T) {
+		exec := createSegmentGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testSegmentKey).Return(testSegment, nil)
+
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testSegmentKey)
+
+		assert.Nil(t, err)
+		assert.Equal(t, testSegment, res)
+	})
+}
+
+func TestSegmentGetter_GetAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createSegmentGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject).Return([]*entity.Segment{}, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get all segments", func(t *testing.T) {
+		exec := createSegmentGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject).Return([]*entity.Segment{testSegment}, nil)
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Segment{testSegment}, res)
+	})
+}
+
+func createSegmentGetterExecutor(ctrl *gomock.Controller) *SegmentGetterExecutor {
+	r := mock_service.NewMockGetSegmentRepository(ctrl)
+	g := service.NewSegmentGetter(r)
+	return &SegmentGetterExecutor{
+		getter: g,
+		repo:   r,
+	}
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// UpdateSegment defines the interface to update a segment.
+type UpdateSegment interface {
+	// Update replaces the segment's description, included list, excluded list, and rules.
+	// The segment's key and project can't be changed.
+	Update(ctx context.Context, segment *entity.Segment) error
+}
+
+// UpdateSegmentRepository defines the interface to update a segment in the repository.
+type UpdateSegmentRepository interface {
+	// Update replaces the segment's description, included list, excluded list, and rules in the repository.
+	// It returns SegmentNotFound error if the segment doesn't exist in its project.
+	Update(ctx context.Context, segment *entity.Segment) error
+}
+
+// SegmentUpdater is responsible for updating a segment.
+type SegmentUpdater struct {
+	repo UpdateSegmentRepository
+}
+
+// NewSegmentUpdater creates an instance of SegmentUpdater.
+func NewSegmentUpdater(repo UpdateSegmentRepository) *SegmentUpdater {
+	return &SegmentUpdater{repo: repo}
+}
+
+// Update replaces the segment's description, included list, excluded list, and rules.
+// It validates the segment the same way as creating a segment.
+// It returns SegmentNotFound error if the segment doesn't exist in its project.
+func (su *SegmentUpdater) Update(ctx context.Context, segment *entity.Segment) error {
+	if err := validateSegment(segment); err != nil {
+		return err
+	}
+	sanitizeSegment(segment)
+	return su.repo.Update(ctx, segment)
+}
@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type SegmentUpdaterExecutor struct {
+	updater *service.SegmentUpdater
+	repo    *mock_service.MockUpdateSegmentRepository
+}
+
+func TestNewSegmentUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of SegmentUpdater", func(t *testing.T) {
+		exec := createSegmentUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestSegmentUpdater_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty segment is prohibited", func(t *testing.T) {
+		exec := createSegmentUpdaterExecutor(ctrl)
+
+		err := exec.updater.Update(testCtx, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment("empty or nil"), err)
+	})
+
+	t.Run("segment's rule references another segment", func(t *testing.T) {
+		exec := createSegmentUpdaterExecutor(ctrl)
+		rule := &entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorNotInSegment, Values: []string{"employees"}}
+
+		err := exec.updater.Update(testCtx, &entity.Segment{Key: testSegmentKey, Project: testToggleProject, Rules: []*entity.Rule{rule}})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSegment(`rule 0: operator "not-in-segment" can't be used in segment`), err)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createSegmentUpdaterExecutor(ctrl)
+		segment := &entity.Segment{Key: testSegmentKey, Project: testToggleProject}
+		exec.repo.EXPECT().Update(testCtx, segment).Return(entity.ErrSegmentNotFound())
+
+		err := exec.updater.Update(testCtx, segment)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrSegmentNotFound(), err)
+	})
+
+	t.Run("successfully update a segment", func(t *testing.T) {
+		exec := createSegmentUpdaterExecutor(ctrl)
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}}
+		segment := &entity.Segment{Key: " Beta-Testers ", Project: testToggleProject, Excluded: []string{" user-2 "}, Rules: []*entity.Rule{rule}}
+		exec.repo.EXPECT().Update(testCtx, &entity.Segment{Key: testSegmentKey, Project: testToggleProject, Excluded: []string{"user-2"}, Rules: []*entity.Rule{rule}}).Return(nil)
+
+		err := exec.updater.Update(testCtx, segment)
+
+		assert.Nil(t, err)
+	})
+}
+
+func createSegmentUpdaterExecutor(ctrl *gomock.Controller) *SegmentUpdaterExecutor {
+	r := mock_service.NewMockUpdateSegmentRepository(ctrl)
+	u := service.NewSegmentUpdater(r)
+	return &SegmentUpdaterExecutor{
+		updater: u,
+		repo:    r,
+	}
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+var (
+	// tagRegex allows an optional namespace separated by a single colon, such as team:payments.
+	tagRegex = regexp.MustCompile(`^[a-z0-9_.\-]+(:[a-z0-9_.\-]+)?$`)
+)
+
+// sanitizeTags converts the tags to lower case and removes the duplicated tags.
+// The order of the first occurrences is kept.
+func sanitizeTags(tags []string) []string {
+	seen := make(map[string]bool)
+	var res []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	return res
+}
+
+// validateTags validates the tags' format.
+// The tags must be sanitized first.
+func validateTags(tags []string) error {
+	for _, tag := range tags {
+		if !tagRegex.MatchString(tag) {
+			return entity.ErrInvalidTag()
+		}
+	}
+	return nil
+}
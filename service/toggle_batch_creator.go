@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// BatchCreateToggle defines the interface to create many toggles at once.
+type BatchCreateToggle interface {
+	// CreateAll creates the toggles in the project's environment
+	// and returns the result of each toggle in the same order.
+	// If atomic is true, either all toggles are created or none of them is.
+	CreateAll(ctx context.Context, project, env string, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error)
+}
+
+// BatchCreateToggleRepository defines the interface to save many toggles into the repository at once.
+type BatchCreateToggleRepository interface {
+	// InsertAll inserts the toggles into the repository within a single transaction in the same way
+	// CreateToggleRepository.Insert does, and returns the result of each toggle in the same order.
+	// If atomic is true, the first failure rolls back all toggles and it is returned along with the toggle's index.
+	// Otherwise, a failure only rolls back its toggle and it is returned in the toggle's result.
+	// The created event of each toggle must be published eventually if and only if the toggle is saved.
+	InsertAll(ctx context.Context, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error)
+}
+
+// ToggleBatchCreator is responsible for creating many toggles at once.
+type ToggleBatchCreator struct {
+	repo             BatchCreateToggleRepository
+	prerequisiteRepo PrerequisiteRepository
+}
+
+// NewToggleBatchCreator creates an instance of ToggleBatchCreator.
+func NewToggleBatchCreator(repo BatchCreateToggleRepository, prerequisiteRepo PrerequisiteRepository) *ToggleBatchCreator {
+	return &ToggleBatchCreator{
+		repo:             repo,
+		prerequisiteRepo: prerequisiteRepo,
+	}
+}
+
+// CreateAll creates the toggles in the project's environment, regardless of the toggles' own project and environment.
+// Every toggle is validated and sanitized the same way ToggleCreator.Create does.
+// A toggle may have a prerequisite which is created earlier in the same batch.
+// It returns InvalidBatch error if there are no toggles or more than 100 toggles.
+// A toggle whose key is used by an earlier toggle in the batch fails with InvalidBatch error.
+// If atomic is true, the first failure is returned along with the toggle's index and nothing is created.
+// Otherwise, the toggles which fail don't stop the rest and their failures are returned in their results.
+func (bc *ToggleBatchCreator) CreateAll(ctx context.Context, project, env string, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	if err := validateBatchSize(len(toggles)); err != nil {
+		return nil, err
+	}
+	graph, err := bc.getPrerequisiteGraph(ctx, project, toggles)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.BatchResult, len(toggles))
+	keys := make(map[string]bool)
+	var valid []*entity.Toggle
+	var indexes []int
+	for i, toggle := range toggles {
+		if toggle != nil {
+			toggle.Project = project
+			toggle.Environment = env
+		}
+		err := bc.check(toggle, keys, graph)
+		if err != nil && atomic {
+			return nil, entity.ErrBatchItemFailed(i, err)
+		}
+		if err != nil {
+			res[i] = &entity.BatchResult{Err: err}
+			if toggle != nil {
+				res[i].Key = toggle.Key
+			}
+			continue
+		}
+
+		keys[toggle.Key] = true
+		if graph != nil {
+			graph[toggle.Key] = toggle.Prerequisites
+		}
+		valid = append(valid, toggle)
+		indexes = append(indexes, i)
+	}
+
+	if len(valid) == 0 {
+		return res, nil
+	}
+	created, err := bc.repo.InsertAll(ctx, valid, atomic)
+	if err != nil {
+		return nil, err
+	}
+	mergeBatchResults(res, created, indexes)
+	return res, nil
+}
+
+// getPrerequisiteGraph gets the prerequisites of all toggles in the project
+// if any of the toggles has a prerequisite. Otherwise, it returns nil graph.
+func (bc *ToggleBatchCreator) getPrerequisiteGraph(ctx context.Context, project string, toggles []*entity.Toggle) (map[string][]*entity.Prerequisite, error) {
+	for _, toggle := range toggles {
+		if toggle != nil && len(toggle.Prerequisites) > 0 {
+			return bc.prerequisiteRepo.GetAllPrerequisites(ctx, project)
+		}
+	}
+	return nil, nil
+}
+
+func (bc *ToggleBatchCreator) check(toggle *entity.Toggle, keys map[string]bool, graph map[string][]*entity.Prerequisite) error {
+	if err := prepareToggle(toggle); err != nil {
+		return err
+	}
+	if keys[toggle.Key] {
+		return entity.ErrInvalidBatch(fmt.Sprintf("key %q is duplicated", toggle.Key))
+	}
+	if len(toggle.Prerequisites) > 0 {
+		return entity.CheckPrerequisiteGraph(toggle.Key, toggle.Prerequisites, graph)
+	}
+	return nil
+}
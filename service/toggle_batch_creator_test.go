@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleBatchCreatorExecutor struct {
+	creator          *service.ToggleBatchCreator
+	repo             *mock_service.MockBatchCreateToggleRepository
+	prerequisiteRepo *mock_service.MockPrerequisiteRepository
+}
+
+func TestNewToggleBatchCreator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleBatchCreator", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		assert.NotNil(t, exec.creator)
+	})
+}
+
+func TestToggleBatchCreator_CreateAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("empty batch is prohibited", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, nil, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidBatch("batch must not be empty"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("batch with more than 100 toggles is prohibited", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, make([]*entity.Toggle, 101), true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidBatch("batch must not have more than 100 items"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("atomic batch fails on the first invalid toggle without touching repository", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		toggles := []*entity.Toggle{{Key: "toggle-1"}, {Key: "toggle 2"}}
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "item 1")
+		assert.Nil(t, res)
+	})
+
+	t.Run("prerequisite repository returns error", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		toggles := []*entity.Toggle{{Key: "toggle-1", Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}}
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		toggles := []*entity.Toggle{{Key: "toggle-1"}}
+		exec.repo.EXPECT().InsertAll(testCtx, gomock.Any(), true).Return(nil, entity.ErrBatchItemFailed(0, entity.ErrAlreadyExists()))
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("all toggles in non-atomic batch are invalid", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, []*entity.Toggle{nil}, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{{Err: entity.ErrEmptyToggle()}}, res)
+	})
+
+	t.Run("non-atomic batch only sends valid toggles to repository", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		toggles := []*entity.Toggle{
+			{Key: "TOGGLE-1", Project: "other", Environment: "other"},
+			{Key: "toggle-1"},
+			{Key: "toggle-2", Prerequisites: []*entity.Prerequisite{{Key: "toggle-1", Value: true}}},
+			{Key: "toggle-3", Prerequisites: []*entity.Prerequisite{{Key: "toggle-4", Value: true}}},
+		}
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{}, nil)
+		exec.repo.EXPECT().InsertAll(testCtx, []*entity.Toggle{toggles[0], toggles[2]}, false).
+			Return([]*entity.BatchResult{{Key: "toggle-1", Version: 1}, {Key: "toggle-2", Err: entity.ErrAlreadyExists()}}, nil)
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(res))
+		assert.Equal(t, &entity.BatchResult{Key: "toggle-1", Version: 1}, res[0])
+		assert.Equal(t, &entity.BatchResult{Key: "toggle-1", Err: entity.ErrInvalidBatch(`key "toggle-1" is duplicated`)}, res[1])
+		assert.Equal(t, &entity.BatchResult{Key: "toggle-2", Err: entity.ErrAlreadyExists()}, res[2])
+		assert.Equal(t, "toggle-3", res[3].Key)
+		assert.Equal(t, codes.InvalidArgument, status.Code(res[3].Err))
+		assert.Equal(t, testToggleProject, toggles[0].Project)
+		assert.Equal(t, testToggleEnv, toggles[0].Environment)
+	})
+
+	t.Run("toggles which create a dependency cycle within the batch are rejected", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		toggles := []*entity.Toggle{
+			{Key: "toggle-1", Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}},
+		}
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{"toggle-0": {{Key: "toggle-1", Value: true}}}, nil)
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("success create all toggles atomically", func(t *testing.T) {
+		exec := createToggleBatchCreatorExecutor(ctrl)
+		toggles := []*entity.Toggle{{Key: "toggle-1", IsEnabled: true}, {Key: "toggle-2"}}
+		results := []*entity.BatchResult{{Key: "toggle-1", Version: 1}, {Key: "toggle-2", Version: 1}}
+		exec.repo.EXPECT().InsertAll(testCtx, toggles, true).Return(results, nil)
+
+		res, err := exec.creator.CreateAll(testCtx, testToggleProject, testToggleEnv, toggles, true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+		assert.False(t, toggles[0].IsEnabled)
+	})
+}
+
+func createToggleBatchCreatorExecutor(ctrl *gomock.Controller) *ToggleBatchCreatorExecutor {
+	r := mock_service.NewMockBatchCreateToggleRepository(ctrl)
+	pr := mock_service.NewMockPrerequisiteRepository(ctrl)
+	c := service.NewToggleBatchCreator(r, pr)
+	return &ToggleBatchCreatorExecutor{
+		creator:          c,
+		repo:             r,
+		prerequisiteRepo: pr,
+	}
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// BatchUpdateToggle defines the interface to enable, disable, or delete many toggles at once.
+type BatchUpdateToggle interface {
+	// UpdateAll applies the operations on the toggles in the project's environment in order
+	// and returns the result of each operation in the same order.
+	// If atomic is true, either all operations are applied or none of them is.
+	UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error)
+}
+
+// BatchUpdateToggleRepository defines the interface to save many changed toggles into the repository at once.
+type BatchUpdateToggleRepository interface {
+	// GetAllByKey gets a single toggle in all environments from the repository.
+	// If the toggle can't be found or has been soft-deleted, it returns NotFound error.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// UpdateAll applies the operations on the toggles in the project's environment in order within a single transaction
+	// and returns the result of each operation in the same order.
+	// Enabling and disabling are done the same way as EnableToggleRepository.Enable and DisableToggleRepository.Disable,
+	// while deletion is done the same way as DeleteToggleRepository.DeleteByKey.
+	// If atomic is true, the first failure rolls back all operations and it is returned along with the operation's index.
+	// Otherwise, a failure only rolls back its operation and it is returned in the operation's result.
+	// The enabled and disabled events must be published eventually if and only if the toggles are updated.
+	UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error)
+}
+
+// ToggleBatchUpdater is responsible for enabling, disabling, and deleting many toggles at once.
+type ToggleBatchUpdater struct {
+	repo             BatchUpdateToggleRepository
+	prerequisiteRepo PrerequisiteRepository
+}
+
+// NewToggleBatchUpdater creates an instance of ToggleBatchUpdater.
+func NewToggleBatchUpdater(repo BatchUpdateToggleRepository, prerequisiteRepo PrerequisiteRepository) *ToggleBatchUpdater {
+	return &ToggleBatchUpdater{
+		repo:             repo,
+		prerequisiteRepo: prerequisiteRepo,
+	}
+}
+
+// batchState holds the toggles as they are once the earlier operations in the batch are applied,
+// so that each operation is checked against the result of the earlier ones.
+type batchState struct {
+	// toggles holds the toggles in all environments keyed by the toggle's key. Nil means the toggle doesn't exist.
+	toggles map[string][]*entity.Toggle
+	// graph holds the prerequisites of all toggles in the project. It is only retrieved once a toggle is deleted.
+	graph map[string][]*entity.Prerequisite
+}
+
+// UpdateAll applies the operations on the toggles in the project's environment in order.
+// Each operation is checked the same way ToggleEnabler.Enable, ToggleDisabler.Disable, and ToggleDeleter.DeleteByKey do,
+// against the toggle as it is once the earlier operations are applied.
+// Thus, a toggle can be disabled and deleted in the same batch, and its expected version grows by one on every operation.
+// It returns InvalidBatch error if there are no operations or more than 100 operations.
+// An operation with unknown type fails with InvalidBatch error.
+// If atomic is true, the first failure is returned along with the operation's index and nothing is changed.
+// Otherwise, the operations which fail don't stop the rest and their failures are returned in their results.
+func (bu *ToggleBatchUpdater) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	if err := validateBatchSize(len(operations)); err != nil {
+		return nil, err
+	}
+
+	res := make([]*entity.BatchResult, len(operations))
+	state := &batchState{toggles: make(map[string][]*entity.Toggle)}
+	var valid []*entity.ToggleOperation
+	var indexes []int
+	for i, op := range operations {
+		err := bu.apply(ctx, project, env, op, state)
+		if err != nil && atomic {
+			return nil, entity.ErrBatchItemFailed(i, err)
+		}
+		if err != nil {
+			res[i] = &entity.BatchResult{Err: err}
+			if op != nil {
+				res[i].Key = op.Key
+			}
+			continue
+		}
+		valid = append(valid, op)
+		indexes = append(indexes, i)
+	}
+
+	if len(valid) == 0 {
+		return res, nil
+	}
+	updated, err := bu.repo.UpdateAll(ctx, project, env, valid, atomic)
+	if err != nil {
+		return nil, err
+	}
+	mergeBatchResults(res, updated, indexes)
+	return res, nil
+}
+
+// apply checks the operation and applies it to the state.
+func (bu *ToggleBatchUpdater) apply(ctx context.Context, project, env string, op *entity.ToggleOperation, state *batchState) error {
+	if op == nil {
+		return entity.ErrInvalidBatch("operation must not be empty")
+	}
+	if !entity.IsValidToggleOperationType(op.Type) {
+		return entity.ErrInvalidBatch(fmt.Sprintf("operation type of %s is unknown", op.Key))
+	}
+
+	toggles, ok := state.toggles[op.Key]
+	if !ok {
+		var err error
+		toggles, err = bu.repo.GetAllByKey(ctx, project, op.Key)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		state.toggles[op.Key] = toggles
+	}
+	if !existsInEnvironment(toggles, env) {
+		return entity.ErrNotFound()
+	}
+	if op.Version != 0 && toggles[0].Version != op.Version {
+		return entity.ErrVersionConflict()
+	}
+
+	if op.Type == entity.ToggleOperationDelete {
+		if err := bu.checkDeletable(ctx, project, op.Key, toggles, state); err != nil {
+			return err
+		}
+		state.toggles[op.Key] = nil
+		delete(state.graph, op.Key)
+		return nil
+	}
+
+	next := make([]*entity.Toggle, 0, len(toggles))
+	for _, toggle := range toggles {
+		tmp := *toggle
+		tmp.Version++
+		if tmp.Environment == env {
+			tmp.IsEnabled = op.Type == entity.ToggleOperationEnable
+		}
+		next = append(next, &tmp)
+	}
+	state.toggles[op.Key] = next
+	return nil
+}
+
+func (bu *ToggleBatchUpdater) checkDeletable(ctx context.Context, project, key string, toggles []*entity.Toggle, state *batchState) error {
+	for _, toggle := range toggles {
+		if toggle.IsEnabled {
+			return entity.ErrProhibitedToDelete()
+		}
+	}
+	if state.graph == nil {
+		graph, err := bu.prerequisiteRepo.GetAllPrerequisites(ctx, project)
+		if err != nil {
+			return err
+		}
+		state.graph = graph
+	}
+	if dependents := findDependents(key, state.graph); len(dependents) > 0 {
+		return entity.ErrToggleHasDependents(dependents)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleBatchUpdaterExecutor struct {
+	updater          *service.ToggleBatchUpdater
+	repo             *mock_service.MockBatchUpdateToggleRepository
+	prerequisiteRepo *mock_service.MockPrerequisiteRepository
+}
+
+func TestNewToggleBatchUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleBatchUpdater", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestToggleBatchUpdater_UpdateAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	enabled := func() []*entity.Toggle {
+		return []*entity.Toggle{
+			{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, IsEnabled: true, Version: 3},
+			{Key: testToggleKey, Project: testToggleProject, Environment: "staging", Version: 3},
+		}
+	}
+
+	t.Run("empty batch is prohibited", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, nil, false)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidBatch("batch must not be empty"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("operation with unknown type is invalid", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: "PURGE"}, nil}
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{
+			{Key: testToggleKey, Err: entity.ErrInvalidBatch("operation type of toggle-1 is unknown")},
+			{Err: entity.ErrInvalidBatch("operation must not be empty")},
+		}, res)
+	})
+
+	t.Run("repository returns error for find toggle", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationEnable}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle not found is only retrieved once", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationDisable}, {Key: testToggleKey, Type: entity.ToggleOperationDelete}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{{Key: testToggleKey, Err: entity.ErrNotFound()}, {Key: testToggleKey, Err: entity.ErrNotFound()}}, res)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationDisable, Version: 2}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(enabled(), nil)
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("enabled toggle can't be deleted", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationDelete}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(enabled(), nil)
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{{Key: testToggleKey, Err: entity.ErrProhibitedToDelete()}}, res)
+	})
+
+	t.Run("prerequisite repository returns error", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationDisable}, {Key: testToggleKey, Type: entity.ToggleOperationDelete}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(enabled(), nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle which has dependents can't be deleted until its dependents are deleted", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{
+			{Key: "toggle-0", Type: entity.ToggleOperationDelete},
+			{Key: testToggleKey, Type: entity.ToggleOperationDelete},
+			{Key: "toggle-0", Type: entity.ToggleOperationDelete},
+		}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, "toggle-0").Return([]*entity.Toggle{{Key: "toggle-0", Environment: testToggleEnv}}, nil)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleDisabled}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{
+			"toggle-0":    nil,
+			testToggleKey: {{Key: "toggle-0", Value: true}},
+		}, nil)
+		exec.repo.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops[1:], false).
+			Return([]*entity.BatchResult{{Key: testToggleKey, Version: 2}, {Key: "toggle-0", Version: 2}}, nil)
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, false)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.BatchResult{
+			{Key: "toggle-0", Err: entity.ErrToggleHasDependents([]string{testToggleKey})},
+			{Key: testToggleKey, Version: 2},
+			{Key: "toggle-0", Version: 2},
+		}, res)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		ops := []*entity.ToggleOperation{{Key: testToggleKey, Type: entity.ToggleOperationEnable}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(enabled(), nil)
+		exec.repo.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("success disable and delete toggle with growing versions", func(t *testing.T) {
+		exec := createToggleBatchUpdaterExecutor(ctrl)
+		toggles := enabled()
+		ops := []*entity.ToggleOperation{
+			{Key: testToggleKey, Type: entity.ToggleOperationDisable, Version: 3},
+			{Key: testToggleKey, Type: entity.ToggleOperationDelete, Version: 4},
+		}
+		results := []*entity.BatchResult{{Key: testToggleKey, Version: 4}, {Key: testToggleKey, Version: 5}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(toggles, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{testToggleKey: nil}, nil)
+		exec.repo.EXPECT().UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true).Return(results, nil)
+
+		res, err := exec.updater.UpdateAll(testCtx, testToggleProject, testToggleEnv, ops, true)
+
+		assert.Nil(t, err)
+		assert.Equal(t, results, res)
+		assert.True(t, toggles[0].IsEnabled)
+		assert.Equal(t, int64(3), toggles[0].Version)
+	})
+}
+
+func createToggleBatchUpdaterExecutor(ctrl *gomock.Controller) *ToggleBatchUpdaterExecutor {
+	r := mock_service.NewMockBatchUpdateToggleRepository(ctrl)
+	pr := mock_service.NewMockPrerequisiteRepository(ctrl)
+	u := service.NewToggleBatchUpdater(r, pr)
+	return &ToggleBatchUpdaterExecutor{
+		updater:          u,
+		repo:             r,
+		prerequisiteRepo: pr,
+	}
+}
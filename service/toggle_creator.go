@@ -2,12 +2,10 @@ package service
 
 import (
 	"context"
-	"log"
 	"regexp"
 	"strings"
 
 	"github.com/indrasaputra/toggle/entity"
-	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
 )
 
 var (
@@ -21,60 +19,88 @@ func init() {
 // CreateToggle defines the interface to create a toggle.
 type CreateToggle interface {
 	// Create creates a new toggle and store it in the storage.
-	// It must check the uniqueness of the toggle's key.
+	// It must check the uniqueness of the toggle's key in the toggle's project.
+	// The toggle's state is applied to the toggle's environment.
 	Create(ctx context.Context, toggle *entity.Toggle) error
 }
 
 // CreateToggleRepository defines the interface to save a toggle into the repository.
 type CreateToggleRepository interface {
 	// Insert inserts the toggle into the repository.
-	// It also validates if the toggle's key is unique.
+	// It also validates if the toggle's key is unique in the toggle's project.
+	// The toggle's state is saved for the toggle's environment,
+	// while the other environments get the default state.
+	// It returns ProjectNotFound error if the toggle's project doesn't exist
+	// and EnvironmentNotFound error if the toggle's environment doesn't exist.
+	// The toggle's prerequisites must be checked against the project's prerequisite graph once more
+	// atomically with the insertion, so that concurrent changes can't create a dependency cycle.
+	// The created event must be published eventually if and only if the toggle is saved.
 	Insert(ctx context.Context, toggle *entity.Toggle) error
 }
 
-// TogglePublisher defines the interface to publish toggle to message queue.
-type TogglePublisher interface {
-	// Publish publishes event toggle to message queue.
-	Publish(ctx context.Context, event *togglev1.ToggleEvent) error
-}
-
 // ToggleCreator is responsible for creating a new toggle.
 type ToggleCreator struct {
-	repo      CreateToggleRepository
-	publisher TogglePublisher
+	repo             CreateToggleRepository
+	prerequisiteRepo PrerequisiteRepository
 }
 
 // NewToggleCreator creates an instance of ToggleCreator.
-func NewToggleCreator(repo CreateToggleRepository, publisher TogglePublisher) *ToggleCreator {
+func NewToggleCreator(repo CreateToggleRepository, prerequisiteRepo PrerequisiteRepository) *ToggleCreator {
 	return &ToggleCreator{
-		repo:      repo,
-		publisher: publisher,
+		repo:             repo,
+		prerequisiteRepo: prerequisiteRepo,
 	}
 }
 
 // Create creates a new toggle.
-// It will reject if the toggle's key already exists in the system.
-// The toggle's key is converted to lower case.
+// It will reject if the toggle's key already exists in the system
+// or if any of the toggle's rules is invalid.
+// It also rejects prerequisites which refer to unknown toggles or create a dependency cycle.
+// The toggle's key, project, environment, tags, and prerequisites' keys are converted to lower case.
 func (tc *ToggleCreator) Create(ctx context.Context, toggle *entity.Toggle) error {
-	if err := validateToggle(toggle); err != nil {
+	if err := prepareToggle(toggle); err != nil {
 		return err
 	}
-	sanitizeToggle(toggle)
+	if len(toggle.Prerequisites) > 0 {
+		graph, err := tc.prerequisiteRepo.GetAllPrerequisites(ctx, toggle.Project)
+		if err != nil {
+			return err
+		}
+		if err := entity.CheckPrerequisiteGraph(toggle.Key, toggle.Prerequisites, graph); err != nil {
+			return err
+		}
+	}
 
-	if err := tc.repo.Insert(ctx, toggle); err != nil {
+	return tc.repo.Insert(ctx, toggle)
+}
+
+// prepareToggle validates and sanitizes the toggle without looking at the other toggles.
+func prepareToggle(toggle *entity.Toggle) error {
+	if err := validateToggle(toggle); err != nil {
 		return err
 	}
-	if err := tc.publisher.Publish(ctx, entity.EventToggleCreated(toggle)); err != nil {
-		log.Printf("publish on toggle creator error: %v", err)
+	sanitizeToggle(toggle)
+	if err := validateTags(toggle.Tags); err != nil {
+		return err
 	}
-	return nil
+	return validatePrerequisites(toggle.Key, toggle.Prerequisites)
 }
 
 func sanitizeToggle(toggle *entity.Toggle) {
 	toggle.Key = strings.TrimSpace(toggle.Key)
 	toggle.Key = strings.ToLower(toggle.Key)
+	toggle.Project = strings.ToLower(strings.TrimSpace(toggle.Project))
+	toggle.Environment = strings.ToLower(strings.TrimSpace(toggle.Environment))
 	toggle.Description = strings.TrimSpace(toggle.Description)
+	toggle.Owner = strings.TrimSpace(toggle.Owner)
+	toggle.Tags = sanitizeTags(toggle.Tags)
+	if toggle.ExpiresAt != nil {
+		expiresAt := toggle.ExpiresAt.UTC()
+		toggle.ExpiresAt = &expiresAt
+	}
 	toggle.IsEnabled = false
+	sanitizeSegmentReferences(toggle.Rules)
+	sanitizePrerequisites(toggle.Prerequisites)
 }
 
 func validateToggle(toggle *entity.Toggle) error {
@@ -85,5 +111,17 @@ func validateToggle(toggle *entity.Toggle) error {
 	if !regexCompiler.MatchString(toggle.Key) {
 		return entity.ErrInvalidKey()
 	}
-	return nil
+	if !regexCompiler.MatchString(strings.TrimSpace(toggle.Project)) {
+		return entity.ErrInvalidProject()
+	}
+	if !regexCompiler.MatchString(strings.TrimSpace(toggle.Environment)) {
+		return entity.ErrInvalidEnvironment()
+	}
+	if err := validateRules(toggle.Rules); err != nil {
+		return err
+	}
+	if err := validateRollout(toggle.Rollout); err != nil {
+		return err
+	}
+	return validateVariants(toggle)
 }
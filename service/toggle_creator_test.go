@@ -2,11 +2,14 @@ package service_test
 
 import (
 	"context"
-	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/indrasaputra/toggle/entity"
 	"github.com/indrasaputra/toggle/service"
@@ -19,9 +22,9 @@ var (
 )
 
 type ToggleCreatorExecutor struct {
-	creator   *service.ToggleCreator
-	repo      *mock_service.MockCreateToggleRepository
-	publisher *mock_service.MockTogglePublisher
+	creator          *service.ToggleCreator
+	repo             *mock_service.MockCreateToggleRepository
+	prerequisiteRepo *mock_service.MockPrerequisiteRepository
 }
 
 func TestNewToggleCreator(t *testing.T) {
@@ -52,7 +55,7 @@ func TestToggleCreator_Create(t *testing.T) {
 
 		keys := []string{"____", "!@#_", "~)(*&@##!{}:", "key123!!!"}
 		for _, key := range keys {
-			toggle := &entity.Toggle{Key: key}
+			toggle := &entity.Toggle{Key: key, Project: testToggleProject, Environment: testToggleEnv}
 			err := exec.creator.Create(testCtx, toggle)
 
 			assert.NotNil(t, err)
@@ -60,11 +63,110 @@ func TestToggleCreator_Create(t *testing.T) {
 		}
 	})
 
+	t.Run("toggle's project is empty or contains character outside of alphanumeric and dash", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+
+		projects := []string{"", "  ", "team_01", "payment!"}
+		for _, project := range projects {
+			toggle := &entity.Toggle{Key: testToggleKeys[0], Project: project, Environment: testToggleEnv}
+			err := exec.creator.Create(testCtx, toggle)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidProject(), err)
+		}
+	})
+
+	t.Run("toggle's environment is empty or contains character outside of alphanumeric and dash", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+
+		envs := []string{"", "  ", "prod_01", "staging!"}
+		for _, env := range envs {
+			toggle := &entity.Toggle{Key: testToggleKeys[0], Project: testToggleProject, Environment: env}
+			err := exec.creator.Create(testCtx, toggle)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidEnvironment(), err)
+		}
+	})
+
+	t.Run("toggle's rules are invalid", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+
+		rules := []*entity.Rule{
+			nil,
+			{Attribute: "", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}},
+			{Attribute: "country", Operator: entity.RuleOperatorEquals},
+			{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID", "SG"}},
+			{Attribute: "country", Operator: entity.RuleOperatorRegex, Values: []string{"[a-z"}},
+			{Attribute: "version", Operator: entity.RuleOperatorSemverGreaterThan, Values: []string{"one.two"}},
+			{Attribute: "country", Operator: entity.RuleOperator("unknown"), Values: []string{"ID"}},
+			{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta testers"}},
+		}
+		for _, rule := range rules {
+			toggle := &entity.Toggle{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Rules: []*entity.Rule{rule}}
+			err := exec.creator.Create(testCtx, toggle)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("toggle's rollout is invalid", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+
+		rollouts := []*entity.Rollout{
+			{Percentage: 101, BucketBy: "user_id"},
+			{Percentage: 10, BucketBy: "  "},
+		}
+		for _, rollout := range rollouts {
+			toggle := &entity.Toggle{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Rollout: rollout}
+			err := exec.creator.Create(testCtx, toggle)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("toggle's variants are invalid", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+
+		on := &entity.Variant{Name: "on", Type: entity.VariantTypeString, Value: "on"}
+		off := &entity.Variant{Name: "off", Type: entity.VariantTypeString, Value: "off"}
+		toggles := []*entity.Toggle{
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, DefaultVariant: "on"},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Rules: []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID"}, Variant: "on"}}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{nil}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{{Name: " ", Type: entity.VariantTypeString}}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{{Name: strings.Repeat("a", 51), Type: entity.VariantTypeString}}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{{Name: "limit", Type: entity.VariantTypeNumber, Value: "ten"}}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{{Name: "config", Type: entity.VariantTypeJSON, Value: "{"}}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{{Name: "on", Type: entity.VariantType("unknown")}}},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{on, on}, DefaultVariant: "on", OffVariant: "on"},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{on, off}, DefaultVariant: "unknown", OffVariant: "off"},
+			{Key: testToggleKeys[0], Project: testToggleProject, Environment: testToggleEnv, Variants: []*entity.Variant{on, off}, DefaultVariant: "on", OffVariant: ""},
+			{
+				Key:            testToggleKeys[0],
+				Project:        testToggleProject,
+				Environment:    testToggleEnv,
+				Rules:          []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID"}, Variant: "unknown"}},
+				Variants:       []*entity.Variant{on, off},
+				DefaultVariant: "on",
+				OffVariant:     "off",
+			},
+		}
+		for _, toggle := range toggles {
+			err := exec.creator.Create(testCtx, toggle)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
 	t.Run("repository returns error", func(t *testing.T) {
 		exec := createToggleCreatorExecutor(ctrl)
 
 		for _, key := range testToggleKeys {
-			toggle := &entity.Toggle{Key: key}
+			toggle := &entity.Toggle{Key: key, Project: testToggleProject, Environment: testToggleEnv}
 			exec.repo.EXPECT().Insert(testCtx, toggle).Return(entity.ErrInternal(""))
 
 			err := exec.creator.Create(testCtx, toggle)
@@ -78,7 +180,7 @@ func TestToggleCreator_Create(t *testing.T) {
 		exec := createToggleCreatorExecutor(ctrl)
 
 		for _, key := range testToggleKeys {
-			toggle := &entity.Toggle{Key: key}
+			toggle := &entity.Toggle{Key: key, Project: testToggleProject, Environment: testToggleEnv}
 			exec.repo.EXPECT().Insert(testCtx, toggle).Return(entity.ErrAlreadyExists())
 
 			err := exec.creator.Create(testCtx, toggle)
@@ -88,27 +190,119 @@ func TestToggleCreator_Create(t *testing.T) {
 		}
 	})
 
-	t.Run("successfully save a new toggle, but fail to publish event", func(t *testing.T) {
+	t.Run("toggle's prerequisite is invalid", func(t *testing.T) {
 		exec := createToggleCreatorExecutor(ctrl)
 
-		for _, key := range testToggleKeys {
-			toggle := &entity.Toggle{Key: key}
-			exec.repo.EXPECT().Insert(testCtx, toggle).Return(nil)
-			exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(errors.New("error"))
+		prerequisites := [][]*entity.Prerequisite{
+			{nil},
+			{{Key: "toggle 0", Value: true}},
+			{{Key: testToggleKey, Value: true}},
+			{{Key: "toggle-0", Value: true}, {Key: "TOGGLE-0", Value: false}},
+		}
+		for _, prerequisite := range prerequisites {
+			toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Prerequisites: prerequisite}
+			err := exec.creator.Create(testCtx, toggle)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+
+	t.Run("repository returns error for get prerequisites", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(nil, entity.ErrInternal(""))
+
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		err := exec.creator.Create(testCtx, toggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("toggle's prerequisite doesn't exist", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{}, nil)
+
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		err := exec.creator.Create(testCtx, toggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidPrerequisite(`prerequisite 0: toggle "toggle-0" doesn't exist`), err)
+	})
+
+	t.Run("toggle's prerequisites create a dependency cycle", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{
+			"toggle-0": {{Key: "toggle-2", Value: true}},
+			"toggle-2": {{Key: testToggleKey, Value: true}},
+		}, nil)
+
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		err := exec.creator.Create(testCtx, toggle)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrPrerequisiteCycle([]string{testToggleKey, "toggle-0", "toggle-2", testToggleKey}), err)
+	})
+
+	t.Run("successfully save a new toggle with prerequisites", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{
+			"toggle-0": nil,
+			"toggle-2": {{Key: "toggle-0", Value: true}},
+		}, nil)
+
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Prerequisites: []*entity.Prerequisite{{Key: " Toggle-0 ", Value: true}, {Key: "toggle-2", Value: false}}}
+		exec.repo.EXPECT().Insert(testCtx, toggle).Return(nil)
+
+		err := exec.creator.Create(testCtx, toggle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "toggle-0", toggle.Prerequisites[0].Key)
+	})
+
+	t.Run("toggle's tag is invalid", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		for _, tag := range []string{"", "team:payments:core", "team payments", ":payments", "team:"} {
+			toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Tags: []string{"kind:kill-switch", tag}}
 
 			err := exec.creator.Create(testCtx, toggle)
 
-			assert.Nil(t, err)
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidTag(), err)
 		}
 	})
 
-	t.Run("successfully save and publish a new toggle", func(t *testing.T) {
+	t.Run("successfully save a new toggle with sanitized tags", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Tags: []string{" Team:Payments", "kind:kill-switch", "team:payments", "v1.2_beta"}}
+		exec.repo.EXPECT().Insert(testCtx, toggle).Return(nil)
+
+		err := exec.creator.Create(testCtx, toggle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"team:payments", "kind:kill-switch", "v1.2_beta"}, toggle.Tags)
+	})
+
+	t.Run("successfully save a new toggle with owner and expiry time", func(t *testing.T) {
+		exec := createToggleCreatorExecutor(ctrl)
+		expiresAt := time.Date(2030, 1, 2, 10, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+		toggle := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Owner: "  team-checkout ", ExpiresAt: &expiresAt}
+		exec.repo.EXPECT().Insert(testCtx, toggle).Return(nil)
+
+		err := exec.creator.Create(testCtx, toggle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "team-checkout", toggle.Owner)
+		assert.Equal(t, time.UTC, toggle.ExpiresAt.Location())
+		assert.True(t, expiresAt.Equal(*toggle.ExpiresAt))
+	})
+
+	t.Run("successfully save a new toggle", func(t *testing.T) {
 		exec := createToggleCreatorExecutor(ctrl)
 
 		for _, key := range testToggleKeys {
-			toggle := &entity.Toggle{Key: key}
+			toggle := &entity.Toggle{Key: key, Project: testToggleProject, Environment: testToggleEnv}
 			exec.repo.EXPECT().Insert(testCtx, toggle).Return(nil)
-			exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(nil)
 
 			err := exec.creator.Create(testCtx, toggle)
 
@@ -119,11 +313,11 @@ func TestToggleCreator_Create(t *testing.T) {
 
 func createToggleCreatorExecutor(ctrl *gomock.Controller) *ToggleCreatorExecutor {
 	r := mock_service.NewMockCreateToggleRepository(ctrl)
-	p := mock_service.NewMockTogglePublisher(ctrl)
-	c := service.NewToggleCreator(r, p)
+	pr := mock_service.NewMockPrerequisiteRepository(ctrl)
+	c := service.NewToggleCreator(r, pr)
 	return &ToggleCreatorExecutor{
-		creator:   c,
-		repo:      r,
-		publisher: p,
+		creator:          c,
+		repo:             r,
+		prerequisiteRepo: pr,
 	}
 }
@@ -2,56 +2,137 @@ package service
 
 import (
 	"context"
-	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/indrasaputra/toggle/entity"
 )
 
 // DeleteToggle defines the interface to delete a toggle.
 type DeleteToggle interface {
-	// DeleteByKey deletes a single toggle by its key.
-	DeleteByKey(ctx context.Context, key string) error
+	// DeleteByKey soft-deletes a single toggle by its key.
+	// The toggle is deleted from all environments but it can be restored until it is purged.
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	DeleteByKey(ctx context.Context, project, env, key string, version int64) error
+	// PurgeByKey deletes a single toggle by its key forever, regardless of whether it has been soft-deleted.
+	// The toggle is deleted from all environments.
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	PurgeByKey(ctx context.Context, project, env, key string, version int64) error
 }
 
 // DeleteToggleRepository defines the interface to delete toggle from the repository.
 type DeleteToggleRepository interface {
-	// GetByKey gets a single toggle from the repository.
-	// If the toggle can't be found, it returns NotFound error.
-	GetByKey(ctx context.Context, key string) (*entity.Toggle, error)
-	// DeleteByKey deletes a single toggle from the repository.
+	// GetAllByKey gets a single toggle in all environments from the repository.
+	// If the toggle can't be found or has been soft-deleted, it returns NotFound error.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// GetAllDeletedByKey gets a single soft-deleted toggle in all environments from the repository.
+	// If the toggle can't be found or hasn't been soft-deleted, it returns NotFound error.
+	GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// DeleteByKey soft-deletes a single toggle from all environments in the repository.
+	// If the toggle can't be found, it doesn't return error.
+	// If version is not zero and doesn't match the toggle's current version, it returns Aborted error.
+	DeleteByKey(ctx context.Context, project, key string, version int64) error
+	// PurgeByKey deletes a single toggle from all environments in the repository forever.
 	// If the toggle can't be found, it doesn't return error.
-	DeleteByKey(ctx context.Context, key string) error
+	// If version is not zero and doesn't match the toggle's current version, it returns Aborted error.
+	// The deleted event of every environment must be published eventually if and only if the toggle is purged.
+	PurgeByKey(ctx context.Context, project, key string, version int64) error
 }
 
 // ToggleDeleter is responsible for deleting a toggle.
 type ToggleDeleter struct {
-	repo      DeleteToggleRepository
-	publisher TogglePublisher
+	repo             DeleteToggleRepository
+	prerequisiteRepo PrerequisiteRepository
 }
 
 // NewToggleDeleter creates an instance of ToggleDeleter.
-func NewToggleDeleter(repo DeleteToggleRepository, publisher TogglePublisher) *ToggleDeleter {
+func NewToggleDeleter(repo DeleteToggleRepository, prerequisiteRepo PrerequisiteRepository) *ToggleDeleter {
 	return &ToggleDeleter{
-		repo:      repo,
-		publisher: publisher,
+		repo:             repo,
+		prerequisiteRepo: prerequisiteRepo,
+	}
+}
+
+// DeleteByKey soft-deletes a toggle by its key.
+// The toggle must exist in the project's environment.
+// It only deletes toggle that is disabled in all environments and isn't a prerequisite of any other toggle.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+// The toggle's key stays reserved until the toggle is purged.
+// It doesn't publish any event since the toggle can still be restored.
+func (td *ToggleDeleter) DeleteByKey(ctx context.Context, project, env, key string, version int64) error {
+	toggles, err := td.repo.GetAllByKey(ctx, project, key)
+	if err != nil {
+		return err
+	}
+	if err := td.checkDeletable(ctx, project, env, key, version, toggles); err != nil {
+		return err
+	}
+	return td.repo.DeleteByKey(ctx, project, key, version)
+}
+
+// PurgeByKey deletes a toggle by its key forever.
+// The toggle must exist in the project's environment, either soft-deleted or not.
+// A toggle which hasn't been soft-deleted must satisfy the same conditions as DeleteByKey.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+// The deleted event is published for every environment.
+func (td *ToggleDeleter) PurgeByKey(ctx context.Context, project, env, key string, version int64) error {
+	toggles, err := td.repo.GetAllByKey(ctx, project, key)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return err
+	}
+	if err == nil {
+		err = td.checkDeletable(ctx, project, env, key, version, toggles)
+	} else {
+		err = td.checkDeleted(ctx, project, env, key, version)
 	}
+	if err != nil {
+		return err
+	}
+	return td.repo.PurgeByKey(ctx, project, key, version)
 }
 
-// DeleteByKey deletes a toggle by its key.
-// It only deletes disabled toggle.
-func (td *ToggleDeleter) DeleteByKey(ctx context.Context, key string) error {
-	toggle, err := td.repo.GetByKey(ctx, key)
+func (td *ToggleDeleter) checkDeletable(ctx context.Context, project, env, key string, version int64, toggles []*entity.Toggle) error {
+	if !existsInEnvironment(toggles, env) {
+		return entity.ErrNotFound()
+	}
+	if version != 0 && toggles[0].Version != version {
+		return entity.ErrVersionConflict()
+	}
+	for _, toggle := range toggles {
+		if toggle.IsEnabled {
+			return entity.ErrProhibitedToDelete()
+		}
+	}
+	graph, err := td.prerequisiteRepo.GetAllPrerequisites(ctx, project)
 	if err != nil {
 		return err
 	}
-	if toggle.IsEnabled {
-		return entity.ErrProhibitedToDelete()
+	if dependents := findDependents(key, graph); len(dependents) > 0 {
+		return entity.ErrToggleHasDependents(dependents)
 	}
-	if err := td.repo.DeleteByKey(ctx, key); err != nil {
+	return nil
+}
+
+func (td *ToggleDeleter) checkDeleted(ctx context.Context, project, env, key string, version int64) error {
+	toggles, err := td.repo.GetAllDeletedByKey(ctx, project, key)
+	if err != nil {
 		return err
 	}
-	if err := td.publisher.Publish(ctx, entity.EventToggleDeleted(toggle)); err != nil {
-		log.Printf("publish on toggle deleter error: %v", err)
+	if !existsInEnvironment(toggles, env) {
+		return entity.ErrNotFound()
+	}
+	if version != 0 && toggles[0].Version != version {
+		return entity.ErrVersionConflict()
 	}
 	return nil
 }
+
+func existsInEnvironment(toggles []*entity.Toggle, env string) bool {
+	for _, toggle := range toggles {
+		if toggle.Environment == env {
+			return true
+		}
+	}
+	return false
+}
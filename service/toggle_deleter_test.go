@@ -1,8 +1,8 @@
 package service_test
 
 import (
-	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -14,14 +14,22 @@ import (
 
 var (
 	testToggleKey      = "toggle-1"
-	testToggle         = &entity.Toggle{Key: testToggleKey, IsEnabled: testToggleIsEnabledTrue}
-	testToggleDisabled = &entity.Toggle{Key: testToggleKey, IsEnabled: testToggleIsEnabledFalse}
+	testToggleProject  = "default"
+	testToggleEnv      = "production"
+	testToggle         = &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, IsEnabled: testToggleIsEnabledTrue}
+	testToggleDisabled = &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, IsEnabled: testToggleIsEnabledFalse}
+	testToggleStaging  = &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging", IsEnabled: testToggleIsEnabledFalse}
+
+	testPrerequisiteGraph = map[string][]*entity.Prerequisite{
+		"toggle-0":    nil,
+		testToggleKey: {{Key: "toggle-0", Value: true}},
+	}
 )
 
 type ToggleDeleterExecutor struct {
-	deleter   *service.ToggleDeleter
-	repo      *mock_service.MockDeleteToggleRepository
-	publisher *mock_service.MockTogglePublisher
+	deleter          *service.ToggleDeleter
+	repo             *mock_service.MockDeleteToggleRepository
+	prerequisiteRepo *mock_service.MockPrerequisiteRepository
 }
 
 func TestNewToggleDeleter(t *testing.T) {
@@ -40,53 +48,176 @@ func TestToggleDeleter_DeleteByKey(t *testing.T) {
 
 	t.Run("repository returns error for find toggle", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(nil, entity.ErrInternal(""))
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrInternal(""))
 
-		err := exec.deleter.DeleteByKey(testCtx, testToggleKey)
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
 	})
 
+	t.Run("toggle doesn't exist in the environment", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleStaging}, nil)
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleDisabled}, nil)
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+	})
+
 	t.Run("enabled toggle can't be deleted", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(testToggle, nil)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
 
-		err := exec.deleter.DeleteByKey(testCtx, testToggleKey)
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, "staging", testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrProhibitedToDelete(), err)
 	})
 
+	t.Run("repository returns error for get prerequisites", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleDisabled}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(nil, entity.ErrInternal(""))
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("toggle which is a prerequisite of other toggles can't be deleted", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleDisabled}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{
+			testToggleKey: nil,
+			"toggle-3":    {{Key: testToggleKey, Value: true}},
+			"toggle-2":    {{Key: "toggle-0", Value: true}, {Key: testToggleKey, Value: false}},
+		}, nil)
+
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrToggleHasDependents([]string{"toggle-2", "toggle-3"}), err)
+	})
+
 	t.Run("repository returns error for delete toggle", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(testToggleDisabled, nil)
-		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleKey).Return(entity.ErrInternal(""))
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleDisabled}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(testPrerequisiteGraph, nil)
+		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleProject, testToggleKey, int64(0)).Return(entity.ErrInternal(""))
 
-		err := exec.deleter.DeleteByKey(testCtx, testToggleKey)
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
 	})
 
-	t.Run("successfully delete a single toggle, but fail to publish event", func(t *testing.T) {
+	t.Run("successfully soft-delete a single toggle without publishing any event", func(t *testing.T) {
+		production := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Version: 3}
+		staging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging", Version: 3}
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(testToggleDisabled, nil)
-		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleKey).Return(nil)
-		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(errors.New("error"))
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{production, staging}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(testPrerequisiteGraph, nil)
+		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleProject, testToggleKey, int64(3)).Return(nil)
 
-		err := exec.deleter.DeleteByKey(testCtx, testToggleKey)
+		err := exec.deleter.DeleteByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 3)
 
 		assert.Nil(t, err)
 	})
+}
+
+func TestToggleDeleter_PurgeByKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	deleted := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Version: 4, DeletedAt: &now}
+	deletedStaging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging", Version: 4, DeletedAt: &now}
+
+	t.Run("repository returns error for find toggle", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrInternal(""))
+
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("enabled toggle can't be purged", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle, testToggleStaging}, nil)
+
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrProhibitedToDelete(), err)
+	})
+
+	t.Run("toggle doesn't exist nor has been soft-deleted", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("soft-deleted toggle doesn't exist in the environment", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deletedStaging}, nil)
+
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("soft-deleted toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deleted, deletedStaging}, nil)
+
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+	})
+
+	t.Run("repository returns error for purge toggle", func(t *testing.T) {
+		exec := createToggleDeleterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deleted, deletedStaging}, nil)
+		exec.repo.EXPECT().PurgeByKey(testCtx, testToggleProject, testToggleKey, int64(0)).Return(entity.ErrInternal(""))
+
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
 
-	t.Run("successfully delete and publish a single toggle", func(t *testing.T) {
+	t.Run("successfully purge a soft-deleted toggle for every environment", func(t *testing.T) {
 		exec := createToggleDeleterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(testToggleDisabled, nil)
-		exec.repo.EXPECT().DeleteByKey(testCtx, testToggleKey).Return(nil)
-		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(nil)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deleted, deletedStaging}, nil)
+		exec.repo.EXPECT().PurgeByKey(testCtx, testToggleProject, testToggleKey, int64(4)).Return(nil)
 
-		err := exec.deleter.DeleteByKey(testCtx, testToggleKey)
+		err := exec.deleter.PurgeByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey, 4)
 
 		assert.Nil(t, err)
 	})
@@ -94,11 +225,11 @@ func TestToggleDeleter_DeleteByKey(t *testing.T) {
 
 func createToggleDeleterExecutor(ctrl *gomock.Controller) *ToggleDeleterExecutor {
 	r := mock_service.NewMockDeleteToggleRepository(ctrl)
-	p := mock_service.NewMockTogglePublisher(ctrl)
-	d := service.NewToggleDeleter(r, p)
+	pr := mock_service.NewMockPrerequisiteRepository(ctrl)
+	d := service.NewToggleDeleter(r, pr)
 	return &ToggleDeleterExecutor{
-		deleter:   d,
-		repo:      r,
-		publisher: p,
+		deleter:          d,
+		repo:             r,
+		prerequisiteRepo: pr,
 	}
 }
@@ -2,51 +2,45 @@ package service
 
 import (
 	"context"
-	"log"
-
-	"github.com/indrasaputra/toggle/entity"
 )
 
 // DisableToggle defines the interface to disable a toggle.
 type DisableToggle interface {
-	// Disable disables the toggle in the system.
+	// Disable disables the toggle in the project's environment.
 	// It sets the is_enabled to be false.
 	// It must ensure that the toggle exists.
 	// Otherwise, it returns error.
-	Disable(ctx context.Context, key string) error
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	// It returns the toggle's new version.
+	Disable(ctx context.Context, project, env, key string, version int64) (int64, error)
 }
 
 // DisableToggleRepository defines the interface to save a disabled toggle into the repository.
 type DisableToggleRepository interface {
-	// Disable updates the toggle's is_enabled value in the repository.
-	// It returns NotFound error if the toggle doesn't exist.
-	Disable(ctx context.Context, key string, value bool) error
+	// Disable updates the toggle's is_enabled value in the project's environment in the repository.
+	// It returns NotFound error if the toggle doesn't exist in the project's environment
+	// and Aborted error if version is not zero and doesn't match the toggle's current version.
+	// It returns the toggle's new version.
+	// The disabled event must be published eventually if and only if the toggle is updated.
+	Disable(ctx context.Context, project, env, key string, value bool, version int64) (int64, error)
 }
 
 // ToggleDisabler is responsible for disabling a toggle.
 type ToggleDisabler struct {
-	repo      DisableToggleRepository
-	publisher TogglePublisher
+	repo DisableToggleRepository
 }
 
 // NewToggleDisabler creates an instance of ToggleDisabler.
-func NewToggleDisabler(repo DisableToggleRepository, publisher TogglePublisher) *ToggleDisabler {
-	return &ToggleDisabler{
-		repo:      repo,
-		publisher: publisher,
-	}
+func NewToggleDisabler(repo DisableToggleRepository) *ToggleDisabler {
+	return &ToggleDisabler{repo: repo}
 }
 
 // Disable disables a toggle.
 // It just sets the toogle's is_enabled to be false.
 // It doesn't validate toggle's key like ToggleCreator.Create does.
-// But, it returns NotFound error if the toggle doesn't exist.
-func (td *ToggleDisabler) Disable(ctx context.Context, key string) error {
-	if err := td.repo.Disable(ctx, key, false); err != nil {
-		return err
-	}
-	if err := td.publisher.Publish(ctx, entity.EventToggleDisabled(&entity.Toggle{Key: key})); err != nil {
-		log.Printf("publish on toggle deleter error: %v", err)
-	}
-	return nil
+// But, it returns NotFound error if the toggle doesn't exist in the project's environment.
+// Other environments are not affected, except that the toggle's version is shared by all environments.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+func (td *ToggleDisabler) Disable(ctx context.Context, project, env, key string, version int64) (int64, error) {
+	return td.repo.Disable(ctx, project, env, key, false, version)
 }
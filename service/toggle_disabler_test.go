@@ -1,7 +1,6 @@
 package service_test
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -17,9 +16,8 @@ var (
 )
 
 type ToggleDisablerExecutor struct {
-	updater   *service.ToggleDisabler
-	repo      *mock_service.MockDisableToggleRepository
-	publisher *mock_service.MockTogglePublisher
+	updater *service.ToggleDisabler
+	repo    *mock_service.MockDisableToggleRepository
 }
 
 func TestNewToggleDisabler(t *testing.T) {
@@ -38,52 +36,53 @@ func TestToggleDisabler_Disable(t *testing.T) {
 
 	t.Run("repository returns internal error", func(t *testing.T) {
 		exec := createToggleDisablerExecutor(ctrl)
-		exec.repo.EXPECT().Disable(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(entity.ErrInternal(""))
+		exec.repo.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(0)).Return(int64(0), entity.ErrInternal(""))
 
-		err := exec.updater.Disable(testCtx, testToggleKey)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Equal(t, int64(0), res)
 	})
 
 	t.Run("repository returns not found error", func(t *testing.T) {
 		exec := createToggleDisablerExecutor(ctrl)
-		exec.repo.EXPECT().Disable(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(entity.ErrNotFound())
+		exec.repo.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(0)).Return(int64(0), entity.ErrNotFound())
 
-		err := exec.updater.Disable(testCtx, testToggleKey)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("successfully disable a toggle, but fail to publish", func(t *testing.T) {
+	t.Run("repository returns version conflict error", func(t *testing.T) {
 		exec := createToggleDisablerExecutor(ctrl)
-		exec.repo.EXPECT().Disable(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(nil)
-		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(errors.New("error"))
+		exec.repo.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(1)).Return(int64(0), entity.ErrVersionConflict())
 
-		err := exec.updater.Disable(testCtx, testToggleKey)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
-		assert.Nil(t, err)
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("successfully disable and publish a toggle", func(t *testing.T) {
+	t.Run("successfully disable a toggle", func(t *testing.T) {
 		exec := createToggleDisablerExecutor(ctrl)
-		exec.repo.EXPECT().Disable(testCtx, testToggleKey, testToggleIsEnabledFalse).Return(nil)
-		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(nil)
+		exec.repo.EXPECT().Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledFalse, int64(1)).Return(int64(2), nil)
 
-		err := exec.updater.Disable(testCtx, testToggleKey)
+		res, err := exec.updater.Disable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 }
 
 func createToggleDisablerExecutor(ctrl *gomock.Controller) *ToggleDisablerExecutor {
 	r := mock_service.NewMockDisableToggleRepository(ctrl)
-	p := mock_service.NewMockTogglePublisher(ctrl)
-	u := service.NewToggleDisabler(r, p)
+	u := service.NewToggleDisabler(r)
 	return &ToggleDisablerExecutor{
-		updater:   u,
-		repo:      r,
-		publisher: p,
+		updater: u,
+		repo:    r,
 	}
 }
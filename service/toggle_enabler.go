@@ -2,51 +2,45 @@ package service
 
 import (
 	"context"
-	"log"
-
-	"github.com/indrasaputra/toggle/entity"
 )
 
 // EnableToggle defines the interface to enable a toggle.
 type EnableToggle interface {
-	// Enable enables the toggle in the system.
+	// Enable enables the toggle in the project's environment.
 	// It sets the is_enabled to be true.
 	// It must ensure that the toggle exists.
 	// Otherwise, it returns error.
-	Enable(ctx context.Context, key string) error
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	// It returns the toggle's new version.
+	Enable(ctx context.Context, project, env, key string, version int64) (int64, error)
 }
 
 // EnableToggleRepository defines the interface to save an enabled toggle into the repository.
 type EnableToggleRepository interface {
-	// Enable updates the toggle's is_enabled value in the repository.
-	// It returns NotFound error if the toggle doesn't exist.
-	Enable(ctx context.Context, key string, value bool) error
+	// Enable updates the toggle's is_enabled value in the project's environment in the repository.
+	// It returns NotFound error if the toggle doesn't exist in the project's environment
+	// and Aborted error if version is not zero and doesn't match the toggle's current version.
+	// It returns the toggle's new version.
+	// The enabled event must be published eventually if and only if the toggle is updated.
+	Enable(ctx context.Context, project, env, key string, value bool, version int64) (int64, error)
 }
 
 // ToggleEnabler is responsible for enabling a toggle.
 type ToggleEnabler struct {
-	repo      EnableToggleRepository
-	publisher TogglePublisher
+	repo EnableToggleRepository
 }
 
 // NewToggleEnabler creates an instance of ToggleEnabler.
-func NewToggleEnabler(repo EnableToggleRepository, publisher TogglePublisher) *ToggleEnabler {
-	return &ToggleEnabler{
-		repo:      repo,
-		publisher: publisher,
-	}
+func NewToggleEnabler(repo EnableToggleRepository) *ToggleEnabler {
+	return &ToggleEnabler{repo: repo}
 }
 
 // Enable enables a toggle.
 // It just sets the toogle's is_enabled to be true.
 // It doesn't validate toggle's key like ToggleCreator.Create does.
-// But, it returns NotFound error if the toggle doesn't exist.
-func (te *ToggleEnabler) Enable(ctx context.Context, key string) error {
-	if err := te.repo.Enable(ctx, key, true); err != nil {
-		return err
-	}
-	if err := te.publisher.Publish(ctx, entity.EventToggleEnabled(&entity.Toggle{Key: key})); err != nil {
-		log.Printf("publish on toggle deleter error: %v", err)
-	}
-	return nil
+// But, it returns NotFound error if the toggle doesn't exist in the project's environment.
+// Other environments are not affected, except that the toggle's version is shared by all environments.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+func (te *ToggleEnabler) Enable(ctx context.Context, project, env, key string, version int64) (int64, error) {
+	return te.repo.Enable(ctx, project, env, key, true, version)
 }
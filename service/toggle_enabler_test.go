@@ -1,7 +1,6 @@
 package service_test
 
 import (
-	"errors"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -17,9 +16,8 @@ var (
 )
 
 type ToggleEnablerExecutor struct {
-	enabler   *service.ToggleEnabler
-	repo      *mock_service.MockEnableToggleRepository
-	publisher *mock_service.MockTogglePublisher
+	enabler *service.ToggleEnabler
+	repo    *mock_service.MockEnableToggleRepository
 }
 
 func TestNewToggleEnabler(t *testing.T) {
@@ -38,52 +36,53 @@ func TestToggleEnabler_Enable(t *testing.T) {
 
 	t.Run("repository returns internal error", func(t *testing.T) {
 		exec := createToggleEnablerExecutor(ctrl)
-		exec.repo.EXPECT().Enable(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(entity.ErrInternal(""))
+		exec.repo.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(0)).Return(int64(0), entity.ErrInternal(""))
 
-		err := exec.enabler.Enable(testCtx, testToggleKey)
+		res, err := exec.enabler.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Equal(t, int64(0), res)
 	})
 
 	t.Run("repository returns not found error", func(t *testing.T) {
 		exec := createToggleEnablerExecutor(ctrl)
-		exec.repo.EXPECT().Enable(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(entity.ErrNotFound())
+		exec.repo.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(0)).Return(int64(0), entity.ErrNotFound())
 
-		err := exec.enabler.Enable(testCtx, testToggleKey)
+		res, err := exec.enabler.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("successfully enable a toggle, but fail to publish", func(t *testing.T) {
+	t.Run("repository returns version conflict error", func(t *testing.T) {
 		exec := createToggleEnablerExecutor(ctrl)
-		exec.repo.EXPECT().Enable(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(nil)
-		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(errors.New("error"))
+		exec.repo.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(1)).Return(int64(0), entity.ErrVersionConflict())
 
-		err := exec.enabler.Enable(testCtx, testToggleKey)
+		res, err := exec.enabler.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
-		assert.Nil(t, err)
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Equal(t, int64(0), res)
 	})
 
-	t.Run("successfully enable and publish a toggle", func(t *testing.T) {
+	t.Run("successfully enable a toggle", func(t *testing.T) {
 		exec := createToggleEnablerExecutor(ctrl)
-		exec.repo.EXPECT().Enable(testCtx, testToggleKey, testToggleIsEnabledTrue).Return(nil)
-		exec.publisher.EXPECT().Publish(testCtx, gomock.Any()).Return(nil)
+		exec.repo.EXPECT().Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, testToggleIsEnabledTrue, int64(1)).Return(int64(2), nil)
 
-		err := exec.enabler.Enable(testCtx, testToggleKey)
+		res, err := exec.enabler.Enable(testCtx, testToggleProject, testToggleEnv, testToggleKey, 1)
 
 		assert.Nil(t, err)
+		assert.Equal(t, int64(2), res)
 	})
 }
 
 func createToggleEnablerExecutor(ctrl *gomock.Controller) *ToggleEnablerExecutor {
 	r := mock_service.NewMockEnableToggleRepository(ctrl)
-	p := mock_service.NewMockTogglePublisher(ctrl)
-	u := service.NewToggleEnabler(r, p)
+	u := service.NewToggleEnabler(r)
 	return &ToggleEnablerExecutor{
-		enabler:   u,
-		repo:      r,
-		publisher: p,
+		enabler: u,
+		repo:    r,
 	}
 }
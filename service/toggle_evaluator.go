@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// EvaluateToggle defines the interface to evaluate a toggle.
+type EvaluateToggle interface {
+	// Evaluate evaluates a single toggle in the project's environment by its key against the evaluation context.
+	// It must ensure that the toggle exists.
+	// Otherwise, it returns error.
+	Evaluate(ctx context.Context, project, env, key string, evalCtx map[string]string) (*entity.Evaluation, error)
+}
+
+// EvaluateToggleRepository defines the interface to get the evaluated toggle from the repository.
+type EvaluateToggleRepository interface {
+	// GetByKey gets a single toggle in the project's environment from the repository.
+	// If the toggle can't be found in the project's environment, it returns NotFound error.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+}
+
+// EvaluateSegmentRepository defines the interface to get the segments referenced by the evaluated toggle from the repository.
+type EvaluateSegmentRepository interface {
+	// GetByKeys gets the segments in the project by their keys from the repository.
+	// The segments which can't be found are omitted from the result.
+	GetByKeys(ctx context.Context, project string, keys []string) ([]*entity.Segment, error)
+}
+
+// ToggleEvaluator is responsible for evaluating a toggle.
+type ToggleEvaluator struct {
+	repo        EvaluateToggleRepository
+	segmentRepo EvaluateSegmentRepository
+}
+
+// NewToggleEvaluator creates an instance of ToggleEvaluator.
+func NewToggleEvaluator(repo EvaluateToggleRepository, segmentRepo EvaluateSegmentRepository) *ToggleEvaluator {
+	return &ToggleEvaluator{repo: repo, segmentRepo: segmentRepo}
+}
+
+// Evaluate evaluates a toggle's state in the project's environment against the evaluation context.
+// The evaluation rules are explained in Evaluate function.
+// The segments referenced by the toggle's rules are resolved from the toggle's project.
+// The prerequisites of an enabled toggle are evaluated in the same project's environment against the same evaluation context.
+// It returns NotFound error if the toggle doesn't exist in the project's environment.
+func (te *ToggleEvaluator) Evaluate(ctx context.Context, project, env, key string, evalCtx map[string]string) (*entity.Evaluation, error) {
+	return te.evaluate(ctx, project, env, key, evalCtx, map[string]bool{})
+}
+
+// evaluate marks the toggle as visited while its prerequisites are being evaluated.
+// Hence, a dependency cycle which somehow exists in the storage leaves the prerequisite unsatisfied instead of recursing forever.
+func (te *ToggleEvaluator) evaluate(ctx context.Context, project, env, key string, evalCtx map[string]string, visited map[string]bool) (*entity.Evaluation, error) {
+	toggle, err := te.repo.GetByKey(ctx, project, env, key)
+	if err != nil {
+		return nil, err
+	}
+	visited[key] = true
+	defer delete(visited, key)
+
+	deps := &EvaluationDependencies{}
+	if toggle.IsEnabled && len(toggle.Prerequisites) > 0 {
+		deps.Prerequisites, err = te.evaluatePrerequisites(ctx, project, env, toggle.Prerequisites, evalCtx, visited)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keys := ReferencedSegments(toggle.Rules)
+	if len(keys) > 0 {
+		segments, err := te.segmentRepo.GetByKeys(ctx, project, keys)
+		if err != nil {
+			return nil, err
+		}
+		deps.Segments = mapSegments(segments)
+	}
+	return EvaluateWithDependencies(toggle, deps, evalCtx), nil
+}
+
+// evaluatePrerequisites stops at the first unsatisfied prerequisite since the rest don't change the result.
+// A prerequisite which doesn't exist in the project's environment is left unevaluated.
+func (te *ToggleEvaluator) evaluatePrerequisites(ctx context.Context, project, env string, prerequisites []*entity.Prerequisite, evalCtx map[string]string, visited map[string]bool) (map[string]*entity.Evaluation, error) {
+	res := make(map[string]*entity.Evaluation, len(prerequisites))
+	for _, prerequisite := range prerequisites {
+		if visited[prerequisite.Key] {
+			break
+		}
+		eval, err := te.evaluate(ctx, project, env, prerequisite.Key, evalCtx, visited)
+		if status.Code(err) == codes.NotFound {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		res[prerequisite.Key] = eval
+		if eval.Value != prerequisite.Value {
+			break
+		}
+	}
+	return res, nil
+}
+
+func mapSegments(segments []*entity.Segment) map[string]*entity.Segment {
+	res := make(map[string]*entity.Segment, len(segments))
+	for _, segment := range segments {
+		res[segment.Key] = segment
+	}
+	return res
+}
@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testEvaluationContext = map[string]string{"country": "ID", "version": "1.4.0"}
+)
+
+type ToggleEvaluatorExecutor struct {
+	evaluator   *service.ToggleEvaluator
+	repo        *mock_service.MockEvaluateToggleRepository
+	segmentRepo *mock_service.MockEvaluateSegmentRepository
+}
+
+func TestNewToggleEvaluator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleEvaluator", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		assert.NotNil(t, exec.evaluator)
+	})
+}
+
+func TestToggleEvaluator_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully evaluate a toggle", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID", "SG"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Evaluation{Key: testToggleKey, Value: true, Reason: entity.EvaluationReasonRuleMatch, MatchedRule: rule}, res)
+	})
+
+	t.Run("segment repository returns error", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		rule := &entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta-testers"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.segmentRepo.EXPECT().GetByKeys(testCtx, testToggleProject, []string{"beta-testers"}).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, map[string]string{"user_id": "user-1"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully evaluate a toggle targeting a segment", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		rule := &entity.Rule{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta-testers"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}}
+		segment := &entity.Segment{Key: "beta-testers", Project: testToggleProject, Included: []string{"user-1"}}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.segmentRepo.EXPECT().GetByKeys(testCtx, testToggleProject, []string{"beta-testers"}).Return([]*entity.Segment{segment}, nil)
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, map[string]string{"user_id": "user-1"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Evaluation{Key: testToggleKey, Value: true, Reason: entity.EvaluationReasonRuleMatch, MatchedRule: rule}, res)
+	})
+
+	t.Run("repository returns error for prerequisite", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-0").Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("prerequisite which doesn't exist is never satisfied", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-0").Return(nil, entity.ErrNotFound())
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Evaluation{Key: testToggleKey, Value: false, Reason: entity.EvaluationReasonPrerequisiteFailed, FailedPrerequisite: "toggle-0"}, res)
+	})
+
+	t.Run("successfully evaluate prerequisites recursively", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}, {Key: "toggle-2", Value: false}}}
+		parent := &entity.Toggle{Key: "toggle-0", IsEnabled: true, DefaultValue: true, Prerequisites: []*entity.Prerequisite{{Key: "toggle-3", Value: true}}}
+		grandparent := &entity.Toggle{Key: "toggle-3", IsEnabled: true, DefaultValue: true}
+		disabled := &entity.Toggle{Key: "toggle-2", IsEnabled: false}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-0").Return(parent, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-3").Return(grandparent, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-2").Return(disabled, nil)
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Evaluation{Key: testToggleKey, Value: true, Reason: entity.EvaluationReasonFallthrough}, res)
+	})
+
+	t.Run("prerequisite which fails stops the evaluation of the rest", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}, {Key: "toggle-2", Value: false}}}
+		parent := &entity.Toggle{Key: "toggle-0", IsEnabled: false}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-0").Return(parent, nil)
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Evaluation{Key: testToggleKey, Value: false, Reason: entity.EvaluationReasonPrerequisiteFailed, FailedPrerequisite: "toggle-0"}, res)
+	})
+
+	t.Run("dependency cycle in storage doesn't recurse forever", func(t *testing.T) {
+		exec := createToggleEvaluatorExecutor(ctrl)
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+		parent := &entity.Toggle{Key: "toggle-0", IsEnabled: true, DefaultValue: true, Prerequisites: []*entity.Prerequisite{{Key: testToggleKey, Value: true}}}
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, "toggle-0").Return(parent, nil)
+
+		res, err := exec.evaluator.Evaluate(testCtx, testToggleProject, testToggleEnv, testToggleKey, testEvaluationContext)
+
+		assert.Nil(t, err)
+		assert.Equal(t, entity.EvaluationReasonPrerequisiteFailed, res.Reason)
+		assert.Equal(t, "toggle-0", res.FailedPrerequisite)
+	})
+}
+
+func createToggleEvaluatorExecutor(ctrl *gomock.Controller) *ToggleEvaluatorExecutor {
+	r := mock_service.NewMockEvaluateToggleRepository(ctrl)
+	s := mock_service.NewMockEvaluateSegmentRepository(ctrl)
+	e := service.NewToggleEvaluator(r, s)
+	return &ToggleEvaluatorExecutor{
+		evaluator:   e,
+		repo:        r,
+		segmentRepo: s,
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// NotifyExpiredToggle defines the interface to notify expired toggles.
+type NotifyExpiredToggle interface {
+	// NotifyExpired publishes an expired event for each toggle whose expiry time has passed.
+	// It returns the number of claimed toggles.
+	NotifyExpired(ctx context.Context) (int, error)
+}
+
+// NotifyExpiredToggleRepository defines the interface to claim expired toggles from the repository.
+type NotifyExpiredToggleRepository interface {
+	// ClaimExpired marks at most limit toggles whose expiry time has passed as notified and returns them.
+	// A toggle must only be claimed once even if there are many claimers at the same time.
+	// The expired event must be published eventually if and only if the toggle is claimed.
+	ClaimExpired(ctx context.Context, now time.Time, limit int) ([]*entity.Toggle, error)
+}
+
+// ToggleExpiryNotifier is responsible for notifying expired toggles.
+type ToggleExpiryNotifier struct {
+	repo      NotifyExpiredToggleRepository
+	batchSize int
+}
+
+// NewToggleExpiryNotifier creates an instance of ToggleExpiryNotifier.
+// At most batchSize toggles are claimed in each notification.
+func NewToggleExpiryNotifier(repo NotifyExpiredToggleRepository, batchSize int) *ToggleExpiryNotifier {
+	return &ToggleExpiryNotifier{
+		repo:      repo,
+		batchSize: batchSize,
+	}
+}
+
+// NotifyExpired claims the expired toggles along with their expired events, which are published by the outbox's relay.
+// Each toggle is only notified once.
+// It returns error if the toggles can't be claimed.
+func (en *ToggleExpiryNotifier) NotifyExpired(ctx context.Context) (int, error) {
+	toggles, err := en.repo.ClaimExpired(ctx, time.Now().UTC(), en.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	return len(toggles), nil
+}
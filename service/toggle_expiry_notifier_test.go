@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testExpiryBatchSize = 10
+)
+
+type ToggleExpiryNotifierExecutor struct {
+	notifier *service.ToggleExpiryNotifier
+	repo     *mock_service.MockNotifyExpiredToggleRepository
+}
+
+func TestNewToggleExpiryNotifier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleExpiryNotifier", func(t *testing.T) {
+		exec := createToggleExpiryNotifierExecutor(ctrl)
+		assert.NotNil(t, exec.notifier)
+	})
+}
+
+func TestToggleExpiryNotifier_NotifyExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository fails to claim expired toggles", func(t *testing.T) {
+		exec := createToggleExpiryNotifierExecutor(ctrl)
+		exec.repo.EXPECT().ClaimExpired(testCtx, gomock.Any(), testExpiryBatchSize).Return(nil, entity.ErrInternal(""))
+
+		n, err := exec.notifier.NotifyExpired(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("there isn't any expired toggle", func(t *testing.T) {
+		exec := createToggleExpiryNotifierExecutor(ctrl)
+		exec.repo.EXPECT().ClaimExpired(testCtx, gomock.Any(), testExpiryBatchSize).Return([]*entity.Toggle{}, nil)
+
+		n, err := exec.notifier.NotifyExpired(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("successfully notify all expired toggles", func(t *testing.T) {
+		exec := createToggleExpiryNotifierExecutor(ctrl)
+		expiresAt := time.Now().Add(-time.Hour)
+		toggles := []*entity.Toggle{
+			{Key: "toggle-1", Project: testToggleProject, Owner: "team-a", ExpiresAt: &expiresAt},
+		}
+		exec.repo.EXPECT().ClaimExpired(testCtx, gomock.Any(), testExpiryBatchSize).Return(toggles, nil)
+
+		n, err := exec.notifier.NotifyExpired(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+	})
+}
+
+func createToggleExpiryNotifierExecutor(ctrl *gomock.Controller) *ToggleExpiryNotifierExecutor {
+	r := mock_service.NewMockNotifyExpiredToggleRepository(ctrl)
+	n := service.NewToggleExpiryNotifier(r, testExpiryBatchSize)
+	return &ToggleExpiryNotifierExecutor{
+		notifier: n,
+		repo:     r,
+	}
+}
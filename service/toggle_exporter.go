@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// ExportToggle defines the interface to export toggles.
+type ExportToggle interface {
+	// Export takes a snapshot of all toggles in the project's environment.
+	Export(ctx context.Context, project, env string) (*entity.Snapshot, error)
+}
+
+// ExportToggleRepository defines the interface to get toggles from the repository.
+type ExportToggleRepository interface {
+	// GetAll gets the toggles available in the project's environment which satisfy the filter in repository.
+	// Nil pagination means all toggles.
+	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+}
+
+// ToggleExporter is responsible for exporting toggles.
+type ToggleExporter struct {
+	repo ExportToggleRepository
+}
+
+// NewToggleExporter creates an instance of ToggleExporter.
+func NewToggleExporter(repo ExportToggleRepository) *ToggleExporter {
+	return &ToggleExporter{repo: repo}
+}
+
+// Export takes a snapshot of all toggles in the project's environment in the latest format.
+// The toggles are sorted by key. Soft-deleted toggles aren't exported.
+func (te *ToggleExporter) Export(ctx context.Context, project, env string) (*entity.Snapshot, error) {
+	toggles, err := te.repo.GetAll(ctx, project, env, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(toggles, func(i, j int) bool {
+		return toggles[i].Key < toggles[j].Key
+	})
+
+	return &entity.Snapshot{
+		FormatVersion: entity.SnapshotFormatVersion,
+		Project:       project,
+		Environment:   env,
+		CreatedAt:     time.Now().UTC(),
+		Toggles:       toggles,
+	}, nil
+}
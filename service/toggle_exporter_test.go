@@ -0,0 +1,66 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleExporterExecutor struct {
+	exporter *service.ToggleExporter
+	repo     *mock_service.MockExportToggleRepository
+}
+
+func TestNewToggleExporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleExporter", func(t *testing.T) {
+		exec := createToggleExporterExecutor(ctrl)
+		assert.NotNil(t, exec.exporter)
+	})
+}
+
+func TestToggleExporter_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createToggleExporterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.exporter.Export(testCtx, testToggleProject, testToggleEnv)
+
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("successfully export toggles sorted by key", func(t *testing.T) {
+		exec := createToggleExporterExecutor(ctrl)
+		toggles := []*entity.Toggle{{Key: "toggle-2"}, {Key: "toggle-1", IsEnabled: true}}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(toggles, nil)
+
+		res, err := exec.exporter.Export(testCtx, testToggleProject, testToggleEnv)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(entity.SnapshotFormatVersion), res.FormatVersion)
+		assert.Equal(t, testToggleProject, res.Project)
+		assert.Equal(t, testToggleEnv, res.Environment)
+		assert.False(t, res.CreatedAt.IsZero())
+		assert.Equal(t, []*entity.Toggle{{Key: "toggle-1", IsEnabled: true}, {Key: "toggle-2"}}, res.Toggles)
+	})
+}
+
+func createToggleExporterExecutor(ctrl *gomock.Controller) *ToggleExporterExecutor {
+	r := mock_service.NewMockExportToggleRepository(ctrl)
+	e := service.NewToggleExporter(r)
+	return &ToggleExporterExecutor{
+		exporter: e,
+		repo:     r,
+	}
+}
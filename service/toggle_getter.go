@@ -2,26 +2,31 @@ package service
 
 import (
 	"context"
+	"strings"
 
 	"github.com/indrasaputra/toggle/entity"
 )
 
 // GetToggle defines the interface to get toggle.
 type GetToggle interface {
-	// GetByKey gets a single toggle by its key.
-	GetByKey(ctx context.Context, key string) (*entity.Toggle, error)
-	// GetAll gets all toggles available in system.
-	GetAll(ctx context.Context) ([]*entity.Toggle, error)
+	// GetByKey gets a single toggle in the project's environment by its key.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+	// GetAll gets a page of toggles available in the project's environment which satisfy the filter.
+	// Nil filter means all toggles. Nil page means the first page with the default size and order.
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.PageRequest) (*entity.TogglePage, error)
 }
 
 // GetToggleRepository defines the interface to get toggle from the repository.
 type GetToggleRepository interface {
-	// GetByKey gets a single toggle from the repository.
-	// If the toggle can't be found, it returns NotFound error.
-	GetByKey(ctx context.Context, key string) (*entity.Toggle, error)
-	// GetAll gets all toggles available in repository.
+	// GetByKey gets a single toggle in the project's environment from the repository.
+	// If the toggle can't be found in the project's environment, it returns NotFound error.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+	// GetAll gets the toggles available in the project's environment which satisfy the filter in repository.
+	// The toggles are sorted and limited as defined by the pagination.
 	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
-	GetAll(ctx context.Context) ([]*entity.Toggle, error)
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+	// Count counts the toggles available in the project's environment which satisfy the filter in repository.
+	Count(ctx context.Context, project, env string, filter *entity.ToggleFilter) (int, error)
 }
 
 // ToggleGetter is responsible for getting toggle.
@@ -34,14 +39,50 @@ func NewToggleGetter(repo GetToggleRepository) *ToggleGetter {
 	return &ToggleGetter{repo: repo}
 }
 
-// GetByKey gets a single toggle.
-// If the toggle can't be found, it returns NotFound error.
-func (tg *ToggleGetter) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
-	return tg.repo.GetByKey(ctx, key)
+// GetByKey gets a single toggle in the project's environment.
+// If the toggle can't be found in the project's environment, it returns NotFound error.
+func (tg *ToggleGetter) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	return tg.repo.GetByKey(ctx, project, env, key)
 }
 
-// GetAll gets all toggles available in repository.
-// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
-func (tg *ToggleGetter) GetAll(ctx context.Context) ([]*entity.Toggle, error) {
-	return tg.repo.GetAll(ctx)
+// GetAll gets a page of toggles available in repository which satisfy the filter.
+// The filter's tags and key prefix are converted to lower case.
+// The page's size defaults to 10 and is capped to 100.
+// If there is a next page, the returned page contains the token to retrieve it.
+// If the page token is malformed or was issued for a different listing, it returns InvalidArgument error.
+func (tg *ToggleGetter) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.PageRequest) (*entity.TogglePage, error) {
+	filter = sanitizeToggleFilter(filter)
+	pagination, size, err := createTogglePagination(project, env, filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	toggles, err := tg.repo.GetAll(ctx, project, env, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+	total, err := tg.repo.Count(ctx, project, env, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.TogglePage{Toggles: toggles, TotalSize: total}
+	if uint(len(toggles)) > size {
+		res.Toggles = toggles[:size]
+		res.NextPageToken = createNextPageToken(project, env, filter, pagination, toggles[size-1])
+	}
+	return res, nil
+}
+
+func sanitizeToggleFilter(filter *entity.ToggleFilter) *entity.ToggleFilter {
+	if filter == nil {
+		return nil
+	}
+	return &entity.ToggleFilter{
+		AnyTags:        sanitizeTags(filter.AnyTags),
+		AllTags:        sanitizeTags(filter.AllTags),
+		KeyPrefix:      strings.ToLower(strings.TrimSpace(filter.KeyPrefix)),
+		Search:         strings.TrimSpace(filter.Search),
+		IncludeDeleted: filter.IncludeDeleted,
+	}
 }
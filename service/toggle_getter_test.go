@@ -2,9 +2,12 @@ package service_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/indrasaputra/toggle/entity"
 	"github.com/indrasaputra/toggle/service"
@@ -32,9 +35,9 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("repository returns internal error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(nil, entity.ErrInternal(""))
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrInternal(""))
 
-		res, err := exec.getter.GetByKey(testCtx, testToggleKey)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
@@ -43,9 +46,9 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("repository returns not found error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(nil, entity.ErrNotFound())
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrNotFound())
 
-		res, err := exec.getter.GetByKey(testCtx, testToggleKey)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrNotFound(), err)
@@ -54,9 +57,9 @@ func TestToggleGetter_GetByKey(t *testing.T) {
 
 	t.Run("successfully get a single toggle", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.repo.EXPECT().GetByKey(testCtx, testToggleKey).Return(testToggle, nil)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(testToggle, nil)
 
-		res, err := exec.getter.GetByKey(testCtx, testToggleKey)
+		res, err := exec.getter.GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey)
 
 		assert.Nil(t, err)
 		assert.Equal(t, testToggle, res)
@@ -67,35 +70,163 @@ func TestToggleGetter_GetAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
+	firstPage := &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 11}
+
+	t.Run("order by is invalid", func(t *testing.T) {
+		orders := []string{"name", "key asc", "key desc nulls", "updated_at DESC key"}
+		for _, order := range orders {
+			exec := createToggleGetterExecutor(ctrl)
+
+			res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{OrderBy: order})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidQuery("order_by", "must be one of key, created_at, and updated_at, optionally followed by desc"), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("page token is malformed", func(t *testing.T) {
+		tokens := []string{"not a token", "bm90LWpzb24"}
+		for _, token := range tokens {
+			exec := createToggleGetterExecutor(ctrl)
+
+			res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{Token: token})
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidQuery("page_token", "is malformed or doesn't belong to this listing"), err)
+			assert.Nil(t, res)
+		}
+	})
+
 	t.Run("repository returns internal error", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.repo.EXPECT().GetAll(testCtx).Return([]*entity.Toggle{}, entity.ErrInternal(""))
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, firstPage).Return([]*entity.Toggle{}, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository fails to count toggles", func(t *testing.T) {
+		exec := createToggleGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, firstPage).Return([]*entity.Toggle{testToggle}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, nil).Return(0, entity.ErrInternal(""))
 
-		res, err := exec.getter.GetAll(testCtx)
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
 
 		assert.NotNil(t, err)
 		assert.Equal(t, entity.ErrInternal(""), err)
-		assert.Empty(t, res)
+		assert.Nil(t, res)
 	})
 
 	t.Run("repository returns empty list", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.repo.EXPECT().GetAll(testCtx).Return([]*entity.Toggle{}, nil)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, firstPage).Return([]*entity.Toggle{}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, nil).Return(0, nil)
 
-		res, err := exec.getter.GetAll(testCtx)
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil)
 
 		assert.Nil(t, err)
-		assert.Empty(t, res)
+		assert.Empty(t, res.Toggles)
+		assert.Empty(t, res.NextPageToken)
+		assert.Zero(t, res.TotalSize)
 	})
 
-	t.Run("successfully get a single toggle", func(t *testing.T) {
+	t.Run("successfully get the last page", func(t *testing.T) {
+		exec := createToggleGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, firstPage).Return([]*entity.Toggle{testToggle}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, nil).Return(1, nil)
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Toggle{testToggle}, res.Toggles)
+		assert.Empty(t, res.NextPageToken)
+		assert.Equal(t, 1, res.TotalSize)
+	})
+
+	t.Run("page size is capped", func(t *testing.T) {
+		exec := createToggleGetterExecutor(ctrl)
+		page := &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 101}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, page).Return([]*entity.Toggle{testToggle}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, nil).Return(1, nil)
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{Size: 1000})
+
+		assert.Nil(t, err)
+		assert.NotEmpty(t, res.Toggles)
+	})
+
+	t.Run("successfully walk through pages using the page token", func(t *testing.T) {
+		first := &entity.Toggle{Key: "a", UpdatedAt: time.Date(2022, time.February, 3, 0, 0, 0, 0, time.UTC)}
+		second := &entity.Toggle{Key: "b", UpdatedAt: time.Date(2022, time.February, 2, 0, 0, 0, 0, time.UTC)}
+		third := &entity.Toggle{Key: "c", UpdatedAt: time.Date(2022, time.February, 1, 0, 0, 0, 0, time.UTC)}
+		filter := &entity.ToggleFilter{KeyPrefix: "feature"}
+
+		exec := createToggleGetterExecutor(ctrl)
+		page := &entity.TogglePagination{OrderBy: entity.ToggleOrderByUpdatedAt, Descending: true, Limit: 3}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, filter, page).Return([]*entity.Toggle{first, second, third}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, filter).Return(3, nil)
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, filter, &entity.PageRequest{Size: 2, OrderBy: "updated_at desc"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Toggle{first, second}, res.Toggles)
+		assert.NotEmpty(t, res.NextPageToken)
+		assert.Equal(t, 3, res.TotalSize)
+
+		next := &entity.TogglePagination{OrderBy: entity.ToggleOrderByUpdatedAt, Descending: true, After: &entity.ToggleCursor{Key: "b", Time: second.UpdatedAt}, Limit: 3}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, filter, next).Return([]*entity.Toggle{third}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, filter).Return(3, nil)
+
+		res, err = exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, filter, &entity.PageRequest{Size: 2, OrderBy: "updated_at desc", Token: res.NextPageToken})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.Toggle{third}, res.Toggles)
+		assert.Empty(t, res.NextPageToken)
+	})
+
+	t.Run("page token can't be used for a different listing", func(t *testing.T) {
+		exec := createToggleGetterExecutor(ctrl)
+		page := &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 2}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, page).Return([]*entity.Toggle{{Key: "a"}, {Key: "b"}}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, nil).Return(2, nil)
+
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, &entity.PageRequest{Size: 1})
+		assert.Nil(t, err)
+
+		requests := []*entity.PageRequest{
+			{Size: 1, Token: res.NextPageToken, OrderBy: "created_at"},
+			{Size: 1, Token: res.NextPageToken, OrderBy: "key desc"},
+		}
+		for _, req := range requests {
+			out, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, nil, req)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Nil(t, out)
+		}
+
+		out, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, &entity.ToggleFilter{Search: "checkout"}, &entity.PageRequest{Size: 1, Token: res.NextPageToken})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, out)
+	})
+
+	t.Run("successfully get toggles filtered by sanitized filter", func(t *testing.T) {
 		exec := createToggleGetterExecutor(ctrl)
-		exec.repo.EXPECT().GetAll(testCtx).Return([]*entity.Toggle{testToggle}, nil)
+		filter := &entity.ToggleFilter{AnyTags: []string{" Team:Payments ", "team:checkout"}, AllTags: []string{"KIND:kill-switch", "kind:kill-switch"}, KeyPrefix: " Checkout ", Search: " new flow ", IncludeDeleted: true}
+		expected := &entity.ToggleFilter{AnyTags: []string{"team:payments", "team:checkout"}, AllTags: []string{"kind:kill-switch"}, KeyPrefix: "checkout", Search: "new flow", IncludeDeleted: true}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, expected, firstPage).Return([]*entity.Toggle{testToggle}, nil)
+		exec.repo.EXPECT().Count(testCtx, testToggleProject, testToggleEnv, expected).Return(1, nil)
 
-		res, err := exec.getter.GetAll(testCtx)
+		res, err := exec.getter.GetAll(testCtx, testToggleProject, testToggleEnv, filter, nil)
 
 		assert.Nil(t, err)
-		assert.NotEmpty(t, res)
+		assert.NotEmpty(t, res.Toggles)
 	})
 }
 
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// GetToggleHistory defines the interface to get the toggles' audit log.
+type GetToggleHistory interface {
+	// GetHistory gets a page of the project's audit log which satisfies the filter, newest first.
+	// Nil filter means all entries. Nil page means the first page with the default size.
+	GetHistory(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.PageRequest) (*entity.AuditPage, error)
+}
+
+// GetToggleHistoryRepository defines the interface to get the toggles' audit log from the repository.
+type GetToggleHistoryRepository interface {
+	// GetAll gets the project's audit log's entries which satisfy the filter in repository, newest first.
+	// The entries are limited as defined by the pagination.
+	// If there isn't any entry in repository, it returns empty list of audit and nil error.
+	GetAll(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.AuditPagination) ([]*entity.Audit, error)
+}
+
+// ToggleHistoryGetter is responsible for getting the toggles' audit log.
+type ToggleHistoryGetter struct {
+	repo GetToggleHistoryRepository
+}
+
+// NewToggleHistoryGetter creates an instance of ToggleHistoryGetter.
+func NewToggleHistoryGetter(repo GetToggleHistoryRepository) *ToggleHistoryGetter {
+	return &ToggleHistoryGetter{repo: repo}
+}
+
+// GetHistory gets a page of the project's audit log which satisfies the filter, newest first.
+// The filter's key is converted to lower case, the same way as the toggle's key.
+// The page's size defaults to 10 and is capped to 100. The page's order is ignored.
+// If there is a next page, the returned page contains the token to retrieve it.
+// If the time range is empty, or if the page token is malformed or was issued for a different listing,
+// it returns InvalidArgument error.
+func (hg *ToggleHistoryGetter) GetHistory(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.PageRequest) (*entity.AuditPage, error) {
+	filter = sanitizeAuditFilter(filter)
+	if filter != nil && !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, entity.ErrInvalidQuery("end_time", "must be after start_time")
+	}
+	pagination, size, err := createAuditPagination(project, filter, page)
+	if err != nil {
+		return nil, err
+	}
+
+	audits, err := hg.repo.GetAll(ctx, project, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.AuditPage{Audits: audits}
+	if uint(len(audits)) > size {
+		res.Audits = audits[:size]
+		res.NextPageToken = createNextAuditPageToken(project, filter, audits[size-1])
+	}
+	return res, nil
+}
+
+func sanitizeAuditFilter(filter *entity.AuditFilter) *entity.AuditFilter {
+	if filter == nil {
+		return nil
+	}
+	return &entity.AuditFilter{
+		Key:   strings.ToLower(strings.TrimSpace(filter.Key)),
+		Actor: strings.TrimSpace(filter.Actor),
+		From:  filter.From.UTC(),
+		To:    filter.To.UTC(),
+	}
+}
@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleHistoryGetterExecutor struct {
+	getter *service.ToggleHistoryGetter
+	repo   *mock_service.MockGetToggleHistoryRepository
+}
+
+func TestNewToggleHistoryGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleHistoryGetter", func(t *testing.T) {
+		exec := createToggleHistoryGetterExecutor(ctrl)
+		assert.NotNil(t, exec.getter)
+	})
+}
+
+func TestToggleHistoryGetter_GetHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	to := from.Add(24 * time.Hour)
+	audits := []*entity.Audit{
+		{ID: 3, Key: testToggleKey, Action: entity.AuditActionDisable},
+		{ID: 2, Key: testToggleKey, Action: entity.AuditActionEnable},
+		{ID: 1, Key: testToggleKey, Action: entity.AuditActionCreate},
+	}
+
+	t.Run("empty time range is invalid", func(t *testing.T) {
+		exec := createToggleHistoryGetterExecutor(ctrl)
+
+		res, err := exec.getter.GetHistory(testCtx, testToggleProject, &entity.AuditFilter{From: to, To: from}, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidQuery("end_time", "must be after start_time"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("malformed page token is invalid", func(t *testing.T) {
+		exec := createToggleHistoryGetterExecutor(ctrl)
+
+		res, err := exec.getter.GetHistory(testCtx, testToggleProject, nil, &entity.PageRequest{Token: "malformed"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		exec := createToggleHistoryGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, nil, &entity.AuditPagination{Limit: 11}).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetHistory(testCtx, testToggleProject, nil, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("filter is sanitized and the last page doesn't have next page token", func(t *testing.T) {
+		expected := &entity.AuditFilter{Key: testToggleKey, Actor: "jane", From: from.UTC(), To: to.UTC()}
+		exec := createToggleHistoryGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, expected, &entity.AuditPagination{Limit: 11}).Return(audits, nil)
+
+		filter := &entity.AuditFilter{Key: " Toggle-1 ", Actor: " jane ", From: from, To: to}
+		res, err := exec.getter.GetHistory(testCtx, testToggleProject, filter, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, audits, res.Audits)
+		assert.Empty(t, res.NextPageToken)
+	})
+
+	t.Run("next page continues before the last entry", func(t *testing.T) {
+		exec := createToggleHistoryGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, nil, &entity.AuditPagination{Limit: 3}).Return(audits, nil)
+
+		first, err := exec.getter.GetHistory(testCtx, testToggleProject, nil, &entity.PageRequest{Size: 2})
+
+		assert.Nil(t, err)
+		assert.Equal(t, audits[:2], first.Audits)
+		assert.NotEmpty(t, first.NextPageToken)
+
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, nil, &entity.AuditPagination{BeforeID: 2, Limit: 3}).Return(audits[2:], nil)
+
+		second, err := exec.getter.GetHistory(testCtx, testToggleProject, nil, &entity.PageRequest{Size: 2, Token: first.NextPageToken})
+
+		assert.Nil(t, err)
+		assert.Equal(t, audits[2:], second.Audits)
+		assert.Empty(t, second.NextPageToken)
+	})
+
+	t.Run("page token can't be used for a different filter", func(t *testing.T) {
+		exec := createToggleHistoryGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, nil, &entity.AuditPagination{Limit: 2}).Return(audits, nil)
+
+		first, err := exec.getter.GetHistory(testCtx, testToggleProject, nil, &entity.PageRequest{Size: 1})
+		assert.Nil(t, err)
+
+		res, err := exec.getter.GetHistory(testCtx, testToggleProject, &entity.AuditFilter{Actor: "jane"}, &entity.PageRequest{Size: 1, Token: first.NextPageToken})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, res)
+	})
+}
+
+func createToggleHistoryGetterExecutor(ctrl *gomock.Controller) *ToggleHistoryGetterExecutor {
+	r := mock_service.NewMockGetToggleHistoryRepository(ctrl)
+	g := service.NewToggleHistoryGetter(r)
+	return &ToggleHistoryGetterExecutor{
+		getter: g,
+		repo:   r,
+	}
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// importReason is the reason recorded in the audit log for the changes made by import
+	// unless the request gives its own reason.
+	importReason = "import"
+)
+
+var (
+	// importedToggleFields lists the toggle's fields compared by import, in the order they are reported.
+	// The fields are named after the API's field names.
+	importedToggleFields = []string{
+		"is_enabled", "description", "rules", "default_value", "rollout", "variants",
+		"default_variant", "off_variant", "prerequisites", "owner", "expires_at", "tags",
+	}
+	// creationOnlyToggleFields lists the toggle's fields which can only be set when the toggle is created.
+	creationOnlyToggleFields = []string{"variants", "default_variant", "off_variant"}
+)
+
+// ImportToggle defines the interface to import toggles.
+type ImportToggle interface {
+	// Import makes the toggles in the project's environment match the snapshot
+	// and returns the change made to each toggle, sorted by key.
+	// If the options ask for a dry run, nothing is changed.
+	Import(ctx context.Context, project, env string, snapshot *entity.Snapshot, options *entity.ImportOptions) ([]*entity.ImportChange, error)
+}
+
+// ImportToggleRepository defines the interface to get the existing toggles from the repository.
+type ImportToggleRepository interface {
+	// GetAll gets the toggles available in the project's environment which satisfy the filter in repository.
+	// Nil pagination means all toggles.
+	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+}
+
+// ToggleImporter is responsible for importing toggles.
+type ToggleImporter struct {
+	repo                ImportToggleRepository
+	creator             CreateToggle
+	updater             UpdateToggle
+	enabler             EnableToggle
+	disabler            DisableToggle
+	prerequisiteUpdater UpdateTogglePrerequisites
+	rulesUpdater        UpdateToggleRules
+	rolloutUpdater      UpdateToggleRollout
+	deleter             DeleteToggle
+}
+
+// NewToggleImporter creates an instance of ToggleImporter.
+// The toggles are changed using the given services, hence they are validated, recorded in the audit log,
+// and published the same way as the changes made one by one.
+func NewToggleImporter(repo ImportToggleRepository, creator CreateToggle, updater UpdateToggle, enabler EnableToggle, disabler DisableToggle, prerequisiteUpdater UpdateTogglePrerequisites, rulesUpdater UpdateToggleRules, rolloutUpdater UpdateToggleRollout, deleter DeleteToggle) *ToggleImporter {
+	return &ToggleImporter{
+		repo:                repo,
+		creator:             creator,
+		updater:             updater,
+		enabler:             enabler,
+		disabler:            disabler,
+		prerequisiteUpdater: prerequisiteUpdater,
+		rulesUpdater:        rulesUpdater,
+		rolloutUpdater:      rolloutUpdater,
+		deleter:             deleter,
+	}
+}
+
+// importItem holds the change of a toggle along with the toggle's existing and desired state.
+type importItem struct {
+	change  *entity.ImportChange
+	current *entity.Toggle
+	desired *entity.Toggle
+}
+
+// Import makes the toggles in the project's environment match the snapshot, regardless of the snapshot's own project and environment.
+// Every toggle is validated and sanitized the same way ToggleCreator.Create does, and its IsEnabled is kept.
+// It returns InvalidSnapshot error if the snapshot is empty, has unsupported format version, or has duplicate keys,
+// and the error of the first invalid toggle along with its index.
+//
+// A toggle which exists with a different content is skipped, overwritten, or fails the import depending on the conflict policy.
+// If the policy is to fail, it returns ImportConflict error before anything is changed,
+// unless it is a dry run in which case the conflicts are returned in their changes.
+// The fields which can only be set when the toggle is created can't be overwritten, hence such change fails with ImportConflict error.
+// If the options ask to prune, the toggles which aren't in the snapshot are disabled and deleted the same way ToggleDeleter.DeleteByKey does.
+//
+// The toggles are created and updated after their prerequisites, and deleted before the toggles they depend on.
+// Unless it is a dry run, the changes are recorded in the audit log with "import" as the reason if the request doesn't give any.
+// A change which fails doesn't stop the rest and its failure is returned in the change.
+func (ti *ToggleImporter) Import(ctx context.Context, project, env string, snapshot *entity.Snapshot, options *entity.ImportOptions) ([]*entity.ImportChange, error) {
+	if options == nil {
+		options = &entity.ImportOptions{}
+	}
+	policy := options.ConflictPolicy
+	if policy == "" {
+		policy = entity.ImportConflictFail
+	}
+	if !entity.IsValidImportConflictPolicy(policy) {
+		return nil, entity.ErrInvalidSnapshot(fmt.Sprintf("conflict policy %s is unknown", policy))
+	}
+	desired, err := prepareSnapshot(project, env, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := ti.repo.GetAll(ctx, project, env, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items, conflicts := diffSnapshot(existing, desired, policy, options.Prune)
+	if len(conflicts) > 0 && !options.DryRun {
+		return nil, entity.ErrImportConflict(fmt.Sprintf("toggles %s differ from the snapshot", strings.Join(conflicts, ", ")))
+	}
+	if !options.DryRun {
+		ti.apply(withImportReason(ctx), project, env, items)
+	}
+
+	res := make([]*entity.ImportChange, 0, len(items))
+	for _, item := range items {
+		res = append(res, item.change)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res, nil
+}
+
+// apply makes the changes which haven't failed yet.
+func (ti *ToggleImporter) apply(ctx context.Context, project, env string, items []*importItem) {
+	var upserts, deletes []*importItem
+	for _, item := range items {
+		if item.change.Err != nil {
+			continue
+		}
+		switch item.change.Action {
+		case entity.ImportActionCreate, entity.ImportActionUpdate:
+			upserts = append(upserts, item)
+		case entity.ImportActionDelete:
+			deletes = append(deletes, item)
+		}
+	}
+
+	for _, item := range sortByPrerequisites(upserts, func(item *importItem) *entity.Toggle { return item.desired }) {
+		if item.change.Action == entity.ImportActionCreate {
+			item.change.Err = ti.create(ctx, project, env, item.desired)
+		} else {
+			item.change.Err = ti.update(ctx, project, env, item)
+		}
+	}
+	deletes = sortByPrerequisites(deletes, func(item *importItem) *entity.Toggle { return item.current })
+	for i := len(deletes) - 1; i >= 0; i-- {
+		deletes[i].change.Err = ti.delete(ctx, project, env, deletes[i].current)
+	}
+}
+
+func (ti *ToggleImporter) create(ctx context.Context, project, env string, toggle *entity.Toggle) error {
+	// the creator always creates a disabled toggle, thus the toggle is copied to keep its state.
+	tmp := *toggle
+	if err := ti.creator.Create(ctx, &tmp); err != nil {
+		return err
+	}
+	if !toggle.IsEnabled {
+		return nil
+	}
+	_, err := ti.enabler.Enable(ctx, project, env, toggle.Key, tmp.Version)
+	return err
+}
+
+func (ti *ToggleImporter) update(ctx context.Context, project, env string, item *importItem) error {
+	key := item.change.Key
+	version := item.current.Version
+
+	var mask []string
+	for _, field := range item.change.Fields {
+		if isUpdatableToggleField(field) {
+			mask = append(mask, field)
+		}
+	}
+	if len(mask) > 0 {
+		res, err := ti.updater.Update(ctx, project, env, key, item.desired, mask, version)
+		if err != nil {
+			return err
+		}
+		version = res.Version
+	}
+	if containsString(item.change.Fields, "rules") || containsString(item.change.Fields, "default_value") {
+		var err error
+		if version, err = ti.rulesUpdater.UpdateRules(ctx, project, env, key, item.desired.Rules, item.desired.DefaultValue, version); err != nil {
+			return err
+		}
+	}
+	if containsString(item.change.Fields, "rollout") {
+		var err error
+		if version, err = ti.rolloutUpdater.UpdateRollout(ctx, project, env, key, item.desired.Rollout, version); err != nil {
+			return err
+		}
+	}
+
+	if containsString(item.change.Fields, "is_enabled") {
+		var err error
+		if item.desired.IsEnabled {
+			_, err = ti.enabler.Enable(ctx, project, env, key, version)
+		} else {
+			_, err = ti.disabler.Disable(ctx, project, env, key, version)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if containsString(item.change.Fields, "prerequisites") {
+		return ti.prerequisiteUpdater.UpdatePrerequisites(ctx, project, env, key, item.desired.Prerequisites)
+	}
+	return nil
+}
+
+func (ti *ToggleImporter) delete(ctx context.Context, project, env string, toggle *entity.Toggle) error {
+	version := toggle.Version
+	if toggle.IsEnabled {
+		var err error
+		if version, err = ti.disabler.Disable(ctx, project, env, toggle.Key, version); err != nil {
+			return err
+		}
+	}
+	return ti.deleter.DeleteByKey(ctx, project, env, toggle.Key, version)
+}
+
+// prepareSnapshot validates and sanitizes the copies of the snapshot's toggles for the project's environment.
+func prepareSnapshot(project, env string, snapshot *entity.Snapshot) ([]*entity.Toggle, error) {
+	if snapshot == nil {
+		return nil, entity.ErrInvalidSnapshot("snapshot must not be empty")
+	}
+	if snapshot.FormatVersion < 0 || snapshot.FormatVersion > entity.SnapshotFormatVersion {
+		return nil, entity.ErrInvalidSnapshot(fmt.Sprintf("format version %d isn't supported", snapshot.FormatVersion))
+	}
+
+	keys := make(map[string]bool)
+	res := make([]*entity.Toggle, 0, len(snapshot.Toggles))
+	for i, toggle := range snapshot.Toggles {
+		if toggle == nil {
+			return nil, entity.ErrBatchItemFailed(i, entity.ErrEmptyToggle())
+		}
+		tmp := *toggle
+		tmp.Project = project
+		tmp.Environment = env
+		if err := prepareToggle(&tmp); err != nil {
+			return nil, entity.ErrBatchItemFailed(i, err)
+		}
+		tmp.IsEnabled = toggle.IsEnabled
+		if keys[tmp.Key] {
+			return nil, entity.ErrBatchItemFailed(i, entity.ErrInvalidSnapshot(fmt.Sprintf("key %q is duplicated", tmp.Key)))
+		}
+		keys[tmp.Key] = true
+		res = append(res, &tmp)
+	}
+	return res, nil
+}
+
+// diffSnapshot finds the change of every toggle which is either in the snapshot or, if prune is true, exists.
+// It also returns the keys of the toggles which fail the import because of the conflict policy.
+func diffSnapshot(existing, desired []*entity.Toggle, policy entity.ImportConflictPolicy, prune bool) ([]*importItem, []string) {
+	current := make(map[string]*entity.Toggle, len(existing))
+	for _, toggle := range existing {
+		current[toggle.Key] = toggle
+	}
+
+	var items []*importItem
+	var conflicts []string
+	for _, toggle := range desired {
+		item := &importItem{change: &entity.ImportChange{Key: toggle.Key}, current: current[toggle.Key], desired: toggle}
+		items = append(items, item)
+		delete(current, toggle.Key)
+		if item.current == nil {
+			item.change.Action = entity.ImportActionCreate
+			continue
+		}
+
+		item.change.Fields = diffToggleFields(item.current, toggle)
+		switch {
+		case len(item.change.Fields) == 0:
+			item.change.Action = entity.ImportActionUnchanged
+		case policy == entity.ImportConflictSkip:
+			item.change.Action = entity.ImportActionSkip
+		case policy == entity.ImportConflictOverwrite:
+			item.change.Action = entity.ImportActionUpdate
+			if fields := filterCreationOnlyFields(item.change.Fields); len(fields) > 0 {
+				item.change.Err = entity.ErrImportConflict(fmt.Sprintf("%s can only be set when the toggle is created", strings.Join(fields, ", ")))
+			}
+		default:
+			item.change.Action = entity.ImportActionUpdate
+			item.change.Err = entity.ErrImportConflict("toggle differs from the snapshot")
+			conflicts = append(conflicts, toggle.Key)
+		}
+	}
+
+	if prune {
+		for _, toggle := range existing {
+			if _, ok := current[toggle.Key]; ok {
+				items = append(items, &importItem{change: &entity.ImportChange{Key: toggle.Key, Action: entity.ImportActionDelete}, current: toggle})
+			}
+		}
+	}
+	sort.Strings(conflicts)
+	return items, conflicts
+}
+
+// diffToggleFields returns the fields whose values differ between the toggles.
+func diffToggleFields(a, b *entity.Toggle) []string {
+	var res []string
+	for _, field := range importedToggleFields {
+		if !isToggleFieldEqual(a, b, field) {
+			res = append(res, field)
+		}
+	}
+	return res
+}
+
+// isToggleFieldEqual compares the field of the toggles.
+// The fields which hold messages are compared in their proto form, so that nil and empty values are equal.
+// The tags are compared regardless of their order.
+func isToggleFieldEqual(a, b *entity.Toggle, field string) bool {
+	switch field {
+	case "is_enabled":
+		return a.IsEnabled == b.IsEnabled
+	case "description":
+		return a.Description == b.Description
+	case "rules":
+		return proto.Equal(&togglev1.Toggle{Rules: entity.RulesToProto(a.Rules)}, &togglev1.Toggle{Rules: entity.RulesToProto(b.Rules)})
+	case "default_value":
+		return a.DefaultValue == b.DefaultValue
+	case "rollout":
+		return proto.Equal(&togglev1.Toggle{Rollout: entity.RolloutToProto(a.Rollout)}, &togglev1.Toggle{Rollout: entity.RolloutToProto(b.Rollout)})
+	case "variants":
+		return proto.Equal(&togglev1.Toggle{Variants: entity.VariantsToProto(a.Variants)}, &togglev1.Toggle{Variants: entity.VariantsToProto(b.Variants)})
+	case "default_variant":
+		return a.DefaultVariant == b.DefaultVariant
+	case "off_variant":
+		return a.OffVariant == b.OffVariant
+	case "prerequisites":
+		return proto.Equal(&togglev1.Toggle{Prerequisites: entity.PrerequisitesToProto(a.Prerequisites)}, &togglev1.Toggle{Prerequisites: entity.PrerequisitesToProto(b.Prerequisites)})
+	case "owner":
+		return a.Owner == b.Owner
+	case "expires_at":
+		return proto.Equal(&togglev1.Toggle{ExpiresAt: entity.TimeToProto(a.ExpiresAt)}, &togglev1.Toggle{ExpiresAt: entity.TimeToProto(b.ExpiresAt)})
+	case "tags":
+		return proto.Equal(&togglev1.Toggle{Tags: sortedStrings(a.Tags)}, &togglev1.Toggle{Tags: sortedStrings(b.Tags)})
+	}
+	return true
+}
+
+func filterCreationOnlyFields(fields []string) []string {
+	var res []string
+	for _, field := range fields {
+		if containsString(creationOnlyToggleFields, field) {
+			res = append(res, field)
+		}
+	}
+	return res
+}
+
+func sortedStrings(values []string) []string {
+	res := append([]string(nil), values...)
+	sort.Strings(res)
+	return res
+}
+
+// sortByPrerequisites sorts the items so that every item comes after the items its toggle depends on.
+// The items which don't depend on each other keep their order. A dependency cycle is broken arbitrarily.
+func sortByPrerequisites(items []*importItem, toggleOf func(*importItem) *entity.Toggle) []*importItem {
+	byKey := make(map[string]*importItem, len(items))
+	for _, item := range items {
+		byKey[item.change.Key] = item
+	}
+
+	visited := make(map[string]bool, len(items))
+	res := make([]*importItem, 0, len(items))
+	var visit func(item *importItem)
+	visit = func(item *importItem) {
+		if visited[item.change.Key] {
+			return
+		}
+		visited[item.change.Key] = true
+		for _, prerequisite := range toggleOf(item).Prerequisites {
+			if dependency, ok := byKey[prerequisite.Key]; ok {
+				visit(dependency)
+			}
+		}
+		res = append(res, item)
+	}
+	for _, item := range items {
+		visit(item)
+	}
+	return res
+}
+
+// withImportReason sets importReason as the reason recorded in the audit log if the context doesn't carry any.
+func withImportReason(ctx context.Context) context.Context {
+	md := *entity.AuditMetadataFromContext(ctx)
+	if md.Reason != "" {
+		return ctx
+	}
+	md.Reason = importReason
+	return entity.ContextWithAuditMetadata(ctx, &md)
+}
@@ -0,0 +1,293 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleImporterExecutor struct {
+	importer            *service.ToggleImporter
+	repo                *mock_service.MockImportToggleRepository
+	creator             *mock_service.MockCreateToggle
+	updater             *mock_service.MockUpdateToggle
+	enabler             *mock_service.MockEnableToggle
+	disabler            *mock_service.MockDisableToggle
+	prerequisiteUpdater *mock_service.MockUpdateTogglePrerequisites
+	rulesUpdater        *mock_service.MockUpdateToggleRules
+	rolloutUpdater      *mock_service.MockUpdateToggleRollout
+	deleter             *mock_service.MockDeleteToggle
+}
+
+func TestNewToggleImporter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleImporter", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		assert.NotNil(t, exec.importer)
+	})
+}
+
+func TestToggleImporter_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existing := func() []*entity.Toggle {
+		return []*entity.Toggle{
+			{Key: "toggle-0", Project: testToggleProject, Environment: testToggleEnv, IsEnabled: true, Version: 2},
+			{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Description: "old", Tags: []string{"a", "b"}, Version: 3},
+			{Key: "toggle-2", Project: testToggleProject, Environment: testToggleEnv, Tags: []string{"a", "b"}, Version: 1},
+		}
+	}
+	snapshot := func() *entity.Snapshot {
+		return &entity.Snapshot{
+			FormatVersion: entity.SnapshotFormatVersion,
+			Project:       "other",
+			Environment:   "staging",
+			Toggles: []*entity.Toggle{
+				{Key: testToggleKey, Description: "new", IsEnabled: true, Tags: []string{"b", "a"}, Prerequisites: []*entity.Prerequisite{{Key: "toggle-3", Value: true}}},
+				{Key: "toggle-2", Tags: []string{"b", "a"}},
+				{Key: "toggle-3", IsEnabled: true},
+			},
+		}
+	}
+
+	t.Run("unknown conflict policy is invalid", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, snapshot(), &entity.ImportOptions{ConflictPolicy: "MERGE"})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSnapshot("conflict policy MERGE is unknown"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("empty snapshot is invalid", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, nil, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSnapshot("snapshot must not be empty"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("unsupported format version is invalid", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, &entity.Snapshot{FormatVersion: entity.SnapshotFormatVersion + 1}, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidSnapshot("format version 2 isn't supported"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("invalid toggle fails the import", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		tmp := snapshot()
+		tmp.Toggles = append(tmp.Toggles, &entity.Toggle{Key: "toggle 4"})
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, tmp, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrBatchItemFailed(3, entity.ErrInvalidKey()), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("duplicate key fails the import", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		tmp := snapshot()
+		tmp.Toggles = append(tmp.Toggles, &entity.Toggle{Key: "TOGGLE-2"})
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, tmp, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrBatchItemFailed(3, entity.ErrInvalidSnapshot(`key "toggle-2" is duplicated`)), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository returns error", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, snapshot(), nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Nil(t, res)
+	})
+
+	t.Run("conflict fails the import before anything is changed", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(existing(), nil)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, snapshot(), nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrImportConflict("toggles toggle-1 differ from the snapshot"), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("dry run only shows the changes", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(existing(), nil)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, snapshot(), &entity.ImportOptions{Prune: true, DryRun: true})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ImportChange{
+			{Key: "toggle-0", Action: entity.ImportActionDelete},
+			{
+				Key:    testToggleKey,
+				Action: entity.ImportActionUpdate,
+				Fields: []string{"is_enabled", "description", "prerequisites"},
+				Err:    entity.ErrImportConflict("toggle differs from the snapshot"),
+			},
+			{Key: "toggle-2", Action: entity.ImportActionUnchanged},
+			{Key: "toggle-3", Action: entity.ImportActionCreate},
+		}, res)
+	})
+
+	t.Run("skip policy keeps the existing toggles", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		tmp := snapshot()
+		tmp.Toggles = tmp.Toggles[:2]
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(existing(), nil)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, tmp, &entity.ImportOptions{ConflictPolicy: entity.ImportConflictSkip})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ImportChange{
+			{Key: testToggleKey, Action: entity.ImportActionSkip, Fields: []string{"is_enabled", "description", "prerequisites"}},
+			{Key: "toggle-2", Action: entity.ImportActionUnchanged},
+		}, res)
+	})
+
+	t.Run("fields which can only be set when the toggle is created can't be overwritten", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		tmp := &entity.Snapshot{Toggles: []*entity.Toggle{{Key: "toggle-2", Variants: testVariants, DefaultVariant: "blue", OffVariant: "control", Tags: []string{"a", "b"}}}}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(existing(), nil)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, tmp, &entity.ImportOptions{ConflictPolicy: entity.ImportConflictOverwrite})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ImportChange{
+			{
+				Key:    "toggle-2",
+				Action: entity.ImportActionUpdate,
+				Fields: []string{"variants", "default_variant", "off_variant"},
+				Err:    entity.ErrImportConflict("variants, default_variant, off_variant can only be set when the toggle is created"),
+			},
+		}, res)
+	})
+
+	t.Run("rules, default value, and rollout are overwritten in the environment", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		rules := []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}}
+		rollout := &entity.Rollout{Percentage: 30, BucketBy: "user_id"}
+		tmp := &entity.Snapshot{Toggles: []*entity.Toggle{{Key: "toggle-2", Rules: rules, DefaultValue: true, Rollout: rollout, Tags: []string{"a", "b"}}}}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(existing(), nil)
+		gomock.InOrder(
+			exec.rulesUpdater.EXPECT().UpdateRules(gomock.Any(), testToggleProject, testToggleEnv, "toggle-2", rules, true, int64(1)).Return(int64(2), nil),
+			exec.rolloutUpdater.EXPECT().UpdateRollout(gomock.Any(), testToggleProject, testToggleEnv, "toggle-2", rollout, int64(2)).Return(int64(3), nil),
+		)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, tmp, &entity.ImportOptions{ConflictPolicy: entity.ImportConflictOverwrite})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ImportChange{
+			{Key: "toggle-2", Action: entity.ImportActionUpdate, Fields: []string{"rules", "default_value", "rollout"}},
+		}, res)
+	})
+
+	t.Run("successfully import with overwrite and prune", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(existing(), nil)
+		auditReason := func(ctx context.Context) {
+			assert.Equal(t, "import", entity.AuditMetadataFromContext(ctx).Reason)
+		}
+		gomock.InOrder(
+			exec.creator.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, toggle *entity.Toggle) error {
+				auditReason(ctx)
+				assert.Equal(t, "toggle-3", toggle.Key)
+				assert.Equal(t, testToggleProject, toggle.Project)
+				assert.Equal(t, testToggleEnv, toggle.Environment)
+				toggle.Version = 1
+				return nil
+			}),
+			exec.enabler.EXPECT().Enable(gomock.Any(), testToggleProject, testToggleEnv, "toggle-3", int64(1)).Return(int64(2), nil),
+			exec.updater.EXPECT().Update(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, gomock.Any(), []string{"description"}, int64(3)).
+				DoAndReturn(func(ctx context.Context, project, env, key string, toggle *entity.Toggle, mask []string, version int64) (*entity.Toggle, error) {
+					auditReason(ctx)
+					assert.Equal(t, "new", toggle.Description)
+					return &entity.Toggle{Key: key, Version: 4}, nil
+				}),
+			exec.enabler.EXPECT().Enable(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, int64(4)).Return(int64(5), nil),
+			exec.prerequisiteUpdater.EXPECT().UpdatePrerequisites(gomock.Any(), testToggleProject, testToggleEnv, testToggleKey, []*entity.Prerequisite{{Key: "toggle-3", Value: true}}).Return(nil),
+			exec.disabler.EXPECT().Disable(gomock.Any(), testToggleProject, testToggleEnv, "toggle-0", int64(2)).Return(int64(3), nil),
+			exec.deleter.EXPECT().DeleteByKey(gomock.Any(), testToggleProject, testToggleEnv, "toggle-0", int64(3)).Return(entity.ErrToggleHasDependents([]string{"toggle-5"})),
+		)
+
+		res, err := exec.importer.Import(testCtx, testToggleProject, testToggleEnv, snapshot(), &entity.ImportOptions{ConflictPolicy: entity.ImportConflictOverwrite, Prune: true})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ImportChange{
+			{Key: "toggle-0", Action: entity.ImportActionDelete, Err: entity.ErrToggleHasDependents([]string{"toggle-5"})},
+			{Key: testToggleKey, Action: entity.ImportActionUpdate, Fields: []string{"is_enabled", "description", "prerequisites"}},
+			{Key: "toggle-2", Action: entity.ImportActionUnchanged},
+			{Key: "toggle-3", Action: entity.ImportActionCreate},
+		}, res)
+	})
+
+	t.Run("service's error doesn't stop the rest and the request's reason is kept", func(t *testing.T) {
+		exec := createToggleImporterExecutor(ctrl)
+		ctx := entity.ContextWithAuditMetadata(testCtx, &entity.AuditMetadata{Reason: "seed"})
+		tmp := &entity.Snapshot{Toggles: []*entity.Toggle{{Key: "toggle-3"}, {Key: "toggle-4", IsEnabled: true}}}
+		exec.repo.EXPECT().GetAll(ctx, testToggleProject, testToggleEnv, nil, nil).Return(nil, nil)
+		exec.creator.EXPECT().Create(ctx, gomock.Any()).Return(entity.ErrAlreadyExists())
+		exec.creator.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		exec.enabler.EXPECT().Enable(ctx, testToggleProject, testToggleEnv, "toggle-4", int64(0)).Return(int64(0), entity.ErrInternal(""))
+
+		res, err := exec.importer.Import(ctx, testToggleProject, testToggleEnv, tmp, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ImportChange{
+			{Key: "toggle-3", Action: entity.ImportActionCreate, Err: entity.ErrAlreadyExists()},
+			{Key: "toggle-4", Action: entity.ImportActionCreate, Err: entity.ErrInternal("")},
+		}, res)
+	})
+}
+
+func createToggleImporterExecutor(ctrl *gomock.Controller) *ToggleImporterExecutor {
+	r := mock_service.NewMockImportToggleRepository(ctrl)
+	c := mock_service.NewMockCreateToggle(ctrl)
+	u := mock_service.NewMockUpdateToggle(ctrl)
+	e := mock_service.NewMockEnableToggle(ctrl)
+	d := mock_service.NewMockDisableToggle(ctrl)
+	p := mock_service.NewMockUpdateTogglePrerequisites(ctrl)
+	ru := mock_service.NewMockUpdateToggleRules(ctrl)
+	ro := mock_service.NewMockUpdateToggleRollout(ctrl)
+	dl := mock_service.NewMockDeleteToggle(ctrl)
+	i := service.NewToggleImporter(r, c, u, e, d, p, ru, ro, dl)
+	return &ToggleImporterExecutor{
+		importer:            i,
+		repo:                r,
+		creator:             c,
+		updater:             u,
+		enabler:             e,
+		disabler:            d,
+		prerequisiteUpdater: p,
+		rulesUpdater:        ru,
+		rolloutUpdater:      ro,
+		deleter:             dl,
+	}
+}
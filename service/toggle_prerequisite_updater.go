@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// UpdateTogglePrerequisites defines the interface to update a toggle's prerequisites.
+type UpdateTogglePrerequisites interface {
+	// UpdatePrerequisites replaces the toggle's prerequisites.
+	// The prerequisites are shared by all environments.
+	// It must ensure that the toggle exists in the project's environment.
+	// Otherwise, it returns error.
+	UpdatePrerequisites(ctx context.Context, project, env, key string, prerequisites []*entity.Prerequisite) error
+}
+
+// UpdatePrerequisitesRepository defines the interface to update a toggle's prerequisites in the repository.
+type UpdatePrerequisitesRepository interface {
+	// GetAllByKey gets a single toggle in all environments from the repository.
+	// If the toggle can't be found, it returns NotFound error.
+	GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// UpdatePrerequisites replaces the toggle's prerequisites in the repository.
+	// If the toggle can't be found, it returns NotFound error.
+	// The prerequisites must be checked against the project's prerequisite graph once more
+	// atomically with the update, so that concurrent changes can't create a dependency cycle.
+	// The updated event must be published eventually if and only if the prerequisites are updated.
+	UpdatePrerequisites(ctx context.Context, project, key string, prerequisites []*entity.Prerequisite) error
+}
+
+// TogglePrerequisiteUpdater is responsible for updating a toggle's prerequisites.
+type TogglePrerequisiteUpdater struct {
+	repo             UpdatePrerequisitesRepository
+	prerequisiteRepo PrerequisiteRepository
+}
+
+// NewTogglePrerequisiteUpdater creates an instance of TogglePrerequisiteUpdater.
+func NewTogglePrerequisiteUpdater(repo UpdatePrerequisitesRepository, prerequisiteRepo PrerequisiteRepository) *TogglePrerequisiteUpdater {
+	return &TogglePrerequisiteUpdater{
+		repo:             repo,
+		prerequisiteRepo: prerequisiteRepo,
+	}
+}
+
+// UpdatePrerequisites replaces a toggle's prerequisites.
+// It rejects prerequisites which refer to the toggle itself, refer to unknown toggles, or create a dependency cycle.
+// The prerequisites' keys are converted to lower case.
+// It returns NotFound error if the toggle doesn't exist in the project's environment.
+func (tu *TogglePrerequisiteUpdater) UpdatePrerequisites(ctx context.Context, project, env, key string, prerequisites []*entity.Prerequisite) error {
+	sanitizePrerequisites(prerequisites)
+	if err := validatePrerequisites(key, prerequisites); err != nil {
+		return err
+	}
+
+	toggles, err := tu.repo.GetAllByKey(ctx, project, key)
+	if err != nil {
+		return err
+	}
+	if !existsInEnvironment(toggles, env) {
+		return entity.ErrNotFound()
+	}
+	if len(prerequisites) > 0 {
+		graph, err := tu.prerequisiteRepo.GetAllPrerequisites(ctx, project)
+		if err != nil {
+			return err
+		}
+		if err := entity.CheckPrerequisiteGraph(key, prerequisites, graph); err != nil {
+			return err
+		}
+	}
+	return tu.repo.UpdatePrerequisites(ctx, project, key, prerequisites)
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type TogglePrerequisiteUpdaterExecutor struct {
+	updater          *service.TogglePrerequisiteUpdater
+	repo             *mock_service.MockUpdatePrerequisitesRepository
+	prerequisiteRepo *mock_service.MockPrerequisiteRepository
+}
+
+func TestNewTogglePrerequisiteUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of TogglePrerequisiteUpdater", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestTogglePrerequisiteUpdater_UpdatePrerequisites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("prerequisite is invalid", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+
+		prerequisites := []*entity.Prerequisite{{Key: testToggleKey, Value: true}}
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, prerequisites)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("repository returns error for find toggle", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("toggle doesn't exist in the environment", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggleStaging}, nil)
+
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+	})
+
+	t.Run("repository returns error for get prerequisites", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(nil, entity.ErrInternal(""))
+
+		prerequisites := []*entity.Prerequisite{{Key: "toggle-0", Value: true}}
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, prerequisites)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("prerequisites create a dependency cycle", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{
+			testToggleKey: nil,
+			"toggle-0":    {{Key: testToggleKey, Value: true}},
+		}, nil)
+
+		prerequisites := []*entity.Prerequisite{{Key: "toggle-0", Value: true}}
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, prerequisites)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrPrerequisiteCycle([]string{testToggleKey, "toggle-0", testToggleKey}), err)
+	})
+
+	t.Run("repository returns error for update prerequisites", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+		exec.repo.EXPECT().UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, nil).Return(entity.ErrInternal(""))
+
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("successfully update prerequisites", func(t *testing.T) {
+		exec := createTogglePrerequisiteUpdaterExecutor(ctrl)
+		prerequisites := []*entity.Prerequisite{{Key: "TOGGLE-0", Value: true}}
+		exec.repo.EXPECT().GetAllByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{testToggle}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(testPrerequisiteGraph, nil)
+		exec.repo.EXPECT().UpdatePrerequisites(testCtx, testToggleProject, testToggleKey, prerequisites).Return(nil)
+
+		err := exec.updater.UpdatePrerequisites(testCtx, testToggleProject, testToggleEnv, testToggleKey, prerequisites)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "toggle-0", prerequisites[0].Key)
+	})
+}
+
+func createTogglePrerequisiteUpdaterExecutor(ctrl *gomock.Controller) *TogglePrerequisiteUpdaterExecutor {
+	r := mock_service.NewMockUpdatePrerequisitesRepository(ctrl)
+	pr := mock_service.NewMockPrerequisiteRepository(ctrl)
+	u := service.NewTogglePrerequisiteUpdater(r, pr)
+	return &TogglePrerequisiteUpdaterExecutor{
+		updater:          u,
+		repo:             r,
+		prerequisiteRepo: pr,
+	}
+}
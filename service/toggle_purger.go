@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// PurgeDeletedToggle defines the interface to purge the soft-deleted toggles.
+type PurgeDeletedToggle interface {
+	// PurgeDeleted deletes the toggles whose retention period has ended forever
+	// and publishes a deleted event for each of them.
+	// It returns the number of purged toggles.
+	PurgeDeleted(ctx context.Context) (int, error)
+}
+
+// PurgeDeletedToggleRepository defines the interface to claim the soft-deleted toggles from the repository.
+type PurgeDeletedToggleRepository interface {
+	// ClaimDeleted purges at most limit toggles which were soft-deleted at or before deletedBefore
+	// and returns them in all of their environments.
+	// A toggle must only be claimed once even if there are many claimers at the same time.
+	// The deleted event of every environment must be published eventually if and only if the toggle is purged.
+	ClaimDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]*entity.Toggle, error)
+}
+
+// TogglePurger is responsible for purging the soft-deleted toggles.
+type TogglePurger struct {
+	repo      PurgeDeletedToggleRepository
+	retention time.Duration
+	batchSize int
+}
+
+// NewTogglePurger creates an instance of TogglePurger.
+// A soft-deleted toggle is purged once it has been deleted for longer than retention.
+// At most batchSize toggles are claimed in each purge.
+func NewTogglePurger(repo PurgeDeletedToggleRepository, retention time.Duration, batchSize int) *TogglePurger {
+	return &TogglePurger{
+		repo:      repo,
+		retention: retention,
+		batchSize: batchSize,
+	}
+}
+
+// PurgeDeleted claims the soft-deleted toggles whose retention period has ended.
+// The claimed toggles are purged along with their deleted events, which are published by the outbox's relay.
+// It returns error if the toggles can't be claimed.
+func (tp *TogglePurger) PurgeDeleted(ctx context.Context) (int, error) {
+	toggles, err := tp.repo.ClaimDeleted(ctx, time.Now().UTC().Add(-tp.retention), tp.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	purged := make(map[[2]string]bool)
+	for _, toggle := range toggles {
+		purged[[2]string{toggle.Project, toggle.Key}] = true
+	}
+	return len(purged), nil
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testPurgeRetention = 24 * time.Hour
+	testPurgeBatchSize = 10
+)
+
+type TogglePurgerExecutor struct {
+	purger *service.TogglePurger
+	repo   *mock_service.MockPurgeDeletedToggleRepository
+}
+
+func TestNewTogglePurger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of TogglePurger", func(t *testing.T) {
+		exec := createTogglePurgerExecutor(ctrl)
+		assert.NotNil(t, exec.purger)
+	})
+}
+
+func TestTogglePurger_PurgeDeleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository fails to claim deleted toggles", func(t *testing.T) {
+		exec := createTogglePurgerExecutor(ctrl)
+		exec.repo.EXPECT().ClaimDeleted(testCtx, gomock.Any(), testPurgeBatchSize).Return(nil, entity.ErrInternal(""))
+
+		n, err := exec.purger.PurgeDeleted(testCtx)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("only toggles deleted before the retention period are claimed", func(t *testing.T) {
+		exec := createTogglePurgerExecutor(ctrl)
+		exec.repo.EXPECT().ClaimDeleted(testCtx, gomock.Any(), testPurgeBatchSize).DoAndReturn(func(_ interface{}, deletedBefore time.Time, _ int) ([]*entity.Toggle, error) {
+			assert.WithinDuration(t, time.Now().Add(-testPurgeRetention), deletedBefore, time.Minute)
+			return []*entity.Toggle{}, nil
+		})
+
+		n, err := exec.purger.PurgeDeleted(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("purged toggles are counted once regardless of their environments", func(t *testing.T) {
+		exec := createTogglePurgerExecutor(ctrl)
+		toggles := []*entity.Toggle{
+			{Key: "toggle-1", Project: testToggleProject, Environment: testToggleEnv},
+			{Key: "toggle-1", Project: testToggleProject, Environment: "staging"},
+			{Key: "toggle-2", Project: testToggleProject, Environment: testToggleEnv},
+		}
+		exec.repo.EXPECT().ClaimDeleted(testCtx, gomock.Any(), testPurgeBatchSize).Return(toggles, nil)
+
+		n, err := exec.purger.PurgeDeleted(testCtx)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, n)
+	})
+}
+
+func createTogglePurgerExecutor(ctrl *gomock.Controller) *TogglePurgerExecutor {
+	r := mock_service.NewMockPurgeDeletedToggleRepository(ctrl)
+	tp := service.NewTogglePurger(r, testPurgeRetention, testPurgeBatchSize)
+	return &TogglePurgerExecutor{
+		purger: tp,
+		repo:   r,
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// RestoreToggle defines the interface to restore a soft-deleted toggle.
+type RestoreToggle interface {
+	// Restore brings back a soft-deleted toggle in all environments.
+	// It must ensure that the toggle has been soft-deleted and hasn't been purged.
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	// It returns the toggle's new version.
+	Restore(ctx context.Context, project, env, key string, version int64) (int64, error)
+}
+
+// RestoreToggleRepository defines the interface to restore a soft-deleted toggle in the repository.
+type RestoreToggleRepository interface {
+	// GetAllDeletedByKey gets a single soft-deleted toggle in all environments from the repository.
+	// If the toggle can't be found or hasn't been soft-deleted, it returns NotFound error.
+	GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error)
+	// Restore brings back a soft-deleted toggle in all environments in the repository.
+	// It returns NotFound error if the toggle can't be found or hasn't been soft-deleted
+	// and Aborted error if version is not zero and doesn't match the toggle's current version.
+	// It returns the toggle's new version.
+	Restore(ctx context.Context, project, key string, version int64) (int64, error)
+}
+
+// ToggleRestorer is responsible for restoring a soft-deleted toggle.
+type ToggleRestorer struct {
+	repo             RestoreToggleRepository
+	prerequisiteRepo PrerequisiteRepository
+}
+
+// NewToggleRestorer creates an instance of ToggleRestorer.
+func NewToggleRestorer(repo RestoreToggleRepository, prerequisiteRepo PrerequisiteRepository) *ToggleRestorer {
+	return &ToggleRestorer{
+		repo:             repo,
+		prerequisiteRepo: prerequisiteRepo,
+	}
+}
+
+// Restore brings back a soft-deleted toggle by its key.
+// The toggle must have been soft-deleted in the project's environment.
+// Its prerequisites must still exist since they may have been deleted while the toggle was deleted.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+// The toggle comes back disabled in all environments, as it was when it was deleted.
+// It doesn't publish any event since the toggle's deletion has never been published.
+func (tr *ToggleRestorer) Restore(ctx context.Context, project, env, key string, version int64) (int64, error) {
+	toggles, err := tr.repo.GetAllDeletedByKey(ctx, project, key)
+	if err != nil {
+		return 0, err
+	}
+	if !existsInEnvironment(toggles, env) {
+		return 0, entity.ErrNotFound()
+	}
+	if version != 0 && toggles[0].Version != version {
+		return 0, entity.ErrVersionConflict()
+	}
+	if len(toggles[0].Prerequisites) > 0 {
+		graph, err := tr.prerequisiteRepo.GetAllPrerequisites(ctx, project)
+		if err != nil {
+			return 0, err
+		}
+		if err := entity.CheckPrerequisiteGraph(key, toggles[0].Prerequisites, graph); err != nil {
+			return 0, err
+		}
+	}
+	return tr.repo.Restore(ctx, project, key, version)
+}
@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleRestorerExecutor struct {
+	restorer         *service.ToggleRestorer
+	repo             *mock_service.MockRestoreToggleRepository
+	prerequisiteRepo *mock_service.MockPrerequisiteRepository
+}
+
+func TestNewToggleRestorer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleRestorer", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		assert.NotNil(t, exec.restorer)
+	})
+}
+
+func TestToggleRestorer_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	deleted := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Version: 4, DeletedAt: &now}
+	deletedStaging := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: "staging", Version: 4, DeletedAt: &now}
+	dependent := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Version: 4, DeletedAt: &now, Prerequisites: []*entity.Prerequisite{{Key: "toggle-0", Value: true}}}
+
+	t.Run("toggle hasn't been soft-deleted", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("toggle doesn't exist in the environment", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deletedStaging}, nil)
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deleted, deletedStaging}, nil)
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("repository returns error for get prerequisites", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{dependent}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(nil, entity.ErrInternal(""))
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("toggle's prerequisite has been deleted", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{dependent}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{}, nil)
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidPrerequisite(`prerequisite 0: toggle "toggle-0" doesn't exist`), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("repository returns error for restore toggle", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{deleted, deletedStaging}, nil)
+		exec.repo.EXPECT().Restore(testCtx, testToggleProject, testToggleKey, int64(0)).Return(int64(0), entity.ErrVersionConflict())
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, version)
+	})
+
+	t.Run("successfully restore a toggle", func(t *testing.T) {
+		exec := createToggleRestorerExecutor(ctrl)
+		exec.repo.EXPECT().GetAllDeletedByKey(testCtx, testToggleProject, testToggleKey).Return([]*entity.Toggle{dependent}, nil)
+		exec.prerequisiteRepo.EXPECT().GetAllPrerequisites(testCtx, testToggleProject).Return(map[string][]*entity.Prerequisite{"toggle-0": nil}, nil)
+		exec.repo.EXPECT().Restore(testCtx, testToggleProject, testToggleKey, int64(4)).Return(int64(5), nil)
+
+		version, err := exec.restorer.Restore(testCtx, testToggleProject, testToggleEnv, testToggleKey, 4)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), version)
+	})
+}
+
+func createToggleRestorerExecutor(ctrl *gomock.Controller) *ToggleRestorerExecutor {
+	r := mock_service.NewMockRestoreToggleRepository(ctrl)
+	pr := mock_service.NewMockPrerequisiteRepository(ctrl)
+	tr := service.NewToggleRestorer(r, pr)
+	return &ToggleRestorerExecutor{
+		restorer:         tr,
+		repo:             r,
+		prerequisiteRepo: pr,
+	}
+}
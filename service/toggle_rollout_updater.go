@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// UpdateToggleRollout defines the interface to update a toggle's rollout.
+type UpdateToggleRollout interface {
+	// UpdateRollout replaces the toggle's percentage rollout in the project's environment.
+	// Nil rollout removes the rollout. Other environments are not affected.
+	// It must ensure that the toggle exists in the project's environment.
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	// It returns the toggle's new version.
+	UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error)
+}
+
+// UpdateRolloutRepository defines the interface to update a toggle's rollout in the repository.
+type UpdateRolloutRepository interface {
+	// UpdateRollout replaces the toggle's rollout in the project's environment in the repository.
+	// It returns NotFound error if the toggle doesn't exist in the project's environment
+	// and Aborted error if version is not zero and doesn't match the toggle's current version.
+	// It returns the toggle's new version.
+	// The updated event must be published eventually if and only if the toggle is updated.
+	UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error)
+}
+
+// ToggleRolloutUpdater is responsible for updating a toggle's rollout.
+type ToggleRolloutUpdater struct {
+	repo UpdateRolloutRepository
+}
+
+// NewToggleRolloutUpdater creates an instance of ToggleRolloutUpdater.
+func NewToggleRolloutUpdater(repo UpdateRolloutRepository) *ToggleRolloutUpdater {
+	return &ToggleRolloutUpdater{repo: repo}
+}
+
+// UpdateRollout replaces a toggle's rollout in the project's environment.
+// The rollout is validated the same way as creating a toggle.
+// It returns NotFound error if the toggle doesn't exist in the project's environment.
+// Other environments are not affected, except that the toggle's version is shared by all environments.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+func (tu *ToggleRolloutUpdater) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	if err := validateRollout(rollout); err != nil {
+		return 0, err
+	}
+	return tu.repo.UpdateRollout(ctx, project, env, key, rollout, version)
+}
@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleRolloutUpdaterExecutor struct {
+	updater *service.ToggleRolloutUpdater
+	repo    *mock_service.MockUpdateRolloutRepository
+}
+
+func TestNewToggleRolloutUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleRolloutUpdater", func(t *testing.T) {
+		exec := createToggleRolloutUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestToggleRolloutUpdater_UpdateRollout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("rollout is invalid", func(t *testing.T) {
+		exec := createToggleRolloutUpdaterExecutor(ctrl)
+
+		rollouts := []*entity.Rollout{
+			{Percentage: 101, BucketBy: "user_id"},
+			{Percentage: 50, BucketBy: " "},
+		}
+		for _, rollout := range rollouts {
+			res, err := exec.updater.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 0)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Zero(t, res)
+		}
+	})
+
+	t.Run("repository returns version conflict error", func(t *testing.T) {
+		rollout := &entity.Rollout{Percentage: 50, BucketBy: "user_id"}
+		exec := createToggleRolloutUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(3)).Return(int64(0), entity.ErrVersionConflict())
+
+		res, err := exec.updater.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("nil rollout removes the rollout", func(t *testing.T) {
+		exec := createToggleRolloutUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, int64(0)).Return(int64(4), nil)
+
+		res, err := exec.updater.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), res)
+	})
+
+	t.Run("successfully update rollout", func(t *testing.T) {
+		rollout := &entity.Rollout{Percentage: 50, BucketBy: "user_id"}
+		exec := createToggleRolloutUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, int64(3)).Return(int64(4), nil)
+
+		res, err := exec.updater.UpdateRollout(testCtx, testToggleProject, testToggleEnv, testToggleKey, rollout, 3)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), res)
+	})
+}
+
+func createToggleRolloutUpdaterExecutor(ctrl *gomock.Controller) *ToggleRolloutUpdaterExecutor {
+	r := mock_service.NewMockUpdateRolloutRepository(ctrl)
+	u := service.NewToggleRolloutUpdater(r)
+	return &ToggleRolloutUpdaterExecutor{
+		updater: u,
+		repo:    r,
+	}
+}
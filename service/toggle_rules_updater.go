@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// UpdateToggleRules defines the interface to update a toggle's rules and default value.
+type UpdateToggleRules interface {
+	// UpdateRules replaces the toggle's rules and default value in the project's environment.
+	// Other environments are not affected.
+	// It must ensure that the toggle exists in the project's environment.
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	// It returns the toggle's new version.
+	UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error)
+}
+
+// UpdateRulesRepository defines the interface to update a toggle's rules and default value in the repository.
+type UpdateRulesRepository interface {
+	// GetByKey gets a single toggle in the project's environment from the repository.
+	// If the toggle can't be found in the project's environment, it returns NotFound error.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+	// UpdateRules replaces the toggle's rules and default value in the project's environment in the repository.
+	// It returns NotFound error if the toggle doesn't exist in the project's environment
+	// and Aborted error if version is not zero and doesn't match the toggle's current version.
+	// It returns the toggle's new version.
+	// The updated event must be published eventually if and only if the toggle is updated.
+	UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error)
+}
+
+// ToggleRulesUpdater is responsible for updating a toggle's rules and default value.
+type ToggleRulesUpdater struct {
+	repo UpdateRulesRepository
+}
+
+// NewToggleRulesUpdater creates an instance of ToggleRulesUpdater.
+func NewToggleRulesUpdater(repo UpdateRulesRepository) *ToggleRulesUpdater {
+	return &ToggleRulesUpdater{repo: repo}
+}
+
+// UpdateRules replaces a toggle's rules and default value in the project's environment.
+// The rules are sanitized and validated the same way as creating a toggle,
+// including that the rules' variants must be the toggle's variants.
+// It returns NotFound error if the toggle doesn't exist in the project's environment.
+// Other environments are not affected, except that the toggle's version is shared by all environments.
+// If version is not zero, it returns VersionConflict error if the toggle's current version doesn't equal version.
+func (tu *ToggleRulesUpdater) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	if err := validateRules(rules); err != nil {
+		return 0, err
+	}
+	sanitizeSegmentReferences(rules)
+
+	toggle, err := tu.repo.GetByKey(ctx, project, env, key)
+	if err != nil {
+		return 0, err
+	}
+	if version != 0 && toggle.Version != version {
+		return 0, entity.ErrVersionConflict()
+	}
+	toggle.Rules = rules
+	if err := validateVariants(toggle); err != nil {
+		return 0, err
+	}
+	return tu.repo.UpdateRules(ctx, project, env, key, rules, defaultValue, version)
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleRulesUpdaterExecutor struct {
+	updater *service.ToggleRulesUpdater
+	repo    *mock_service.MockUpdateRulesRepository
+}
+
+func TestNewToggleRulesUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleRulesUpdater", func(t *testing.T) {
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestToggleRulesUpdater_UpdateRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("rules are invalid", func(t *testing.T) {
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		rules := []*entity.Rule{{Attribute: " ", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}}
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, true, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Zero(t, res)
+	})
+
+	t.Run("toggle is not found", func(t *testing.T) {
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, true, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(&entity.Toggle{Key: testToggleKey, Version: 2}, nil)
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, true, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("rule refers to variant the toggle doesn't have", func(t *testing.T) {
+		rules := []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true, Variant: "green"}}
+		toggle := &entity.Toggle{Key: testToggleKey, Version: 3, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, true, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Zero(t, res)
+	})
+
+	t.Run("repository returns version conflict error", func(t *testing.T) {
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(&entity.Toggle{Key: testToggleKey, Version: 3}, nil)
+		exec.repo.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, false, int64(3)).Return(int64(0), entity.ErrVersionConflict())
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, false, 3)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Zero(t, res)
+	})
+
+	t.Run("successfully update rules", func(t *testing.T) {
+		rules := []*entity.Rule{{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{" Beta-Testers "}, Value: true, Variant: "red"}}
+		sanitized := []*entity.Rule{{Attribute: "user_id", Operator: entity.RuleOperatorInSegment, Values: []string{"beta-testers"}, Value: true, Variant: "red"}}
+		toggle := &entity.Toggle{Key: testToggleKey, Version: 3, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+		exec := createToggleRulesUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(toggle, nil)
+		exec.repo.EXPECT().UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, sanitized, false, int64(0)).Return(int64(4), nil)
+
+		res, err := exec.updater.UpdateRules(testCtx, testToggleProject, testToggleEnv, testToggleKey, rules, false, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(4), res)
+	})
+}
+
+func createToggleRulesUpdaterExecutor(ctrl *gomock.Controller) *ToggleRulesUpdaterExecutor {
+	r := mock_service.NewMockUpdateRulesRepository(ctrl)
+	u := service.NewToggleRulesUpdater(r)
+	return &ToggleRulesUpdaterExecutor{
+		updater: u,
+		repo:    r,
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// FindStaleToggle defines the interface to find stale toggles.
+type FindStaleToggle interface {
+	// GetStale gets all toggles in the project's environment which should be cleaned up.
+	// A toggle whose state hasn't changed for staleAfter is considered stale.
+	GetStale(ctx context.Context, project, env string, staleAfter time.Duration) ([]*entity.StaleToggle, error)
+}
+
+// FindStaleToggleRepository defines the interface to get toggles from the repository.
+type FindStaleToggleRepository interface {
+	// GetAll gets the toggles available in the project's environment which satisfy the filter in repository.
+	// Nil pagination means all toggles.
+	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+}
+
+// StaleToggleFinder is responsible for finding stale toggles.
+type StaleToggleFinder struct {
+	repo FindStaleToggleRepository
+}
+
+// NewStaleToggleFinder creates an instance of StaleToggleFinder.
+func NewStaleToggleFinder(repo FindStaleToggleRepository) *StaleToggleFinder {
+	return &StaleToggleFinder{repo: repo}
+}
+
+// GetStale gets all stale toggles in the project's environment.
+// A toggle is stale if it has expired or if its state hasn't changed for staleAfter.
+// The latter is reported as fully on or fully off if the toggle serves the same value to everyone.
+// If there isn't any stale toggle, it returns empty list and nil error.
+func (sf *StaleToggleFinder) GetStale(ctx context.Context, project, env string, staleAfter time.Duration) ([]*entity.StaleToggle, error) {
+	toggles, err := sf.repo.GetAll(ctx, project, env, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	res := []*entity.StaleToggle{}
+	for _, toggle := range toggles {
+		reasons := findStaleReasons(toggle, now, staleAfter)
+		if len(reasons) > 0 {
+			res = append(res, &entity.StaleToggle{Toggle: toggle, Reasons: reasons})
+		}
+	}
+	return res, nil
+}
+
+func findStaleReasons(toggle *entity.Toggle, now time.Time, staleAfter time.Duration) []entity.StaleReason {
+	var reasons []entity.StaleReason
+	if toggle.IsExpired(now) {
+		reasons = append(reasons, entity.StaleReasonExpired)
+	}
+	if now.Sub(toggle.UpdatedAt) < staleAfter {
+		return reasons
+	}
+
+	switch value, constant := servedValue(toggle); {
+	case constant && value:
+		reasons = append(reasons, entity.StaleReasonFullyOn)
+	case constant && !value:
+		reasons = append(reasons, entity.StaleReasonFullyOff)
+	default:
+		reasons = append(reasons, entity.StaleReasonUnchanged)
+	}
+	return reasons
+}
+
+// servedValue returns the value served by the toggle to everyone.
+// The second return value is false if the toggle may serve different values.
+func servedValue(toggle *entity.Toggle) (bool, bool) {
+	if !toggle.IsEnabled {
+		return false, true
+	}
+	if len(toggle.Rules) > 0 {
+		return false, false
+	}
+	if toggle.Rollout == nil {
+		return toggle.DefaultValue, true
+	}
+	switch toggle.Rollout.Percentage {
+	case 0:
+		return false, true
+	case 100:
+		return true, true
+	default:
+		return false, false
+	}
+}
@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testStaleAfter = 30 * 24 * time.Hour
+)
+
+type StaleToggleFinderExecutor struct {
+	finder *service.StaleToggleFinder
+	repo   *mock_service.MockFindStaleToggleRepository
+}
+
+func TestNewStaleToggleFinder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of StaleToggleFinder", func(t *testing.T) {
+		exec := createStaleToggleFinderExecutor(ctrl)
+		assert.NotNil(t, exec.finder)
+	})
+}
+
+func TestStaleToggleFinder_GetStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository returns internal error", func(t *testing.T) {
+		exec := createStaleToggleFinderExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.finder.GetStale(testCtx, testToggleProject, testToggleEnv, testStaleAfter)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("there isn't any stale toggle", func(t *testing.T) {
+		exec := createStaleToggleFinderExecutor(ctrl)
+		future := time.Now().Add(time.Hour)
+		toggles := []*entity.Toggle{
+			{Key: "toggle-1", IsEnabled: true, UpdatedAt: time.Now()},
+			{Key: "toggle-2", UpdatedAt: time.Now().Add(-24 * time.Hour), ExpiresAt: &future},
+		}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(toggles, nil)
+
+		res, err := exec.finder.GetStale(testCtx, testToggleProject, testToggleEnv, testStaleAfter)
+
+		assert.Nil(t, err)
+		assert.Empty(t, res)
+	})
+
+	t.Run("successfully get stale toggles with their reasons", func(t *testing.T) {
+		exec := createStaleToggleFinderExecutor(ctrl)
+		past := time.Now().Add(-time.Hour)
+		old := time.Now().Add(-testStaleAfter - time.Hour)
+		rules := []*entity.Rule{{Attribute: "country", Operator: entity.RuleOperatorIn, Values: []string{"ID"}, Value: true}}
+		toggles := []*entity.Toggle{
+			{Key: "expired", IsEnabled: true, UpdatedAt: time.Now(), ExpiresAt: &past},
+			{Key: "expired-and-off", UpdatedAt: old, ExpiresAt: &past},
+			{Key: "enabled-default-true", IsEnabled: true, DefaultValue: true, UpdatedAt: old},
+			{Key: "enabled-default-false", IsEnabled: true, UpdatedAt: old},
+			{Key: "full-rollout", IsEnabled: true, Rollout: &entity.Rollout{Percentage: 100}, UpdatedAt: old},
+			{Key: "zero-rollout", IsEnabled: true, DefaultValue: true, Rollout: &entity.Rollout{Percentage: 0}, UpdatedAt: old},
+			{Key: "partial-rollout", IsEnabled: true, Rollout: &entity.Rollout{Percentage: 50}, UpdatedAt: old},
+			{Key: "targeted", IsEnabled: true, DefaultValue: true, Rules: rules, UpdatedAt: old},
+			{Key: "fresh", IsEnabled: true, DefaultValue: true, UpdatedAt: time.Now()},
+		}
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(toggles, nil)
+
+		res, err := exec.finder.GetStale(testCtx, testToggleProject, testToggleEnv, testStaleAfter)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 8, len(res))
+		expected := map[string][]entity.StaleReason{
+			"expired":               {entity.StaleReasonExpired},
+			"expired-and-off":       {entity.StaleReasonExpired, entity.StaleReasonFullyOff},
+			"enabled-default-true":  {entity.StaleReasonFullyOn},
+			"enabled-default-false": {entity.StaleReasonFullyOff},
+			"full-rollout":          {entity.StaleReasonFullyOn},
+			"zero-rollout":          {entity.StaleReasonFullyOff},
+			"partial-rollout":       {entity.StaleReasonUnchanged},
+			"targeted":              {entity.StaleReasonUnchanged},
+		}
+		for _, stale := range res {
+			assert.Equal(t, expected[stale.Toggle.Key], stale.Reasons, stale.Toggle.Key)
+		}
+	})
+}
+
+func createStaleToggleFinderExecutor(ctrl *gomock.Controller) *StaleToggleFinderExecutor {
+	r := mock_service.NewMockFindStaleToggleRepository(ctrl)
+	f := service.NewStaleToggleFinder(r)
+	return &StaleToggleFinderExecutor{
+		finder: f,
+		repo:   r,
+	}
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+var (
+	// updatableToggleFields lists the toggle's fields which can be updated, in the order they are applied.
+	// The fields are named after the API's field names.
+	updatableToggleFields = []string{"description", "owner", "expires_at", "tags"}
+)
+
+// UpdateToggle defines the interface to update a toggle.
+type UpdateToggle interface {
+	// Update updates the toggle's fields listed in the mask using the values from the given toggle.
+	// Only description, owner, expires_at, and tags can be updated. Empty mask means all of them.
+	// Those fields are shared by all environments.
+	// If version is not zero, it must ensure that the toggle's current version equals version.
+	// It returns the updated toggle in the project's environment.
+	Update(ctx context.Context, project, env, key string, toggle *entity.Toggle, mask []string, version int64) (*entity.Toggle, error)
+}
+
+// UpdateToggleRepository defines the interface to update a toggle in the repository.
+type UpdateToggleRepository interface {
+	// GetByKey gets a single toggle in the project's environment from the repository.
+	// If the toggle can't be found in the project's environment, it returns NotFound error.
+	GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error)
+	// Update replaces the toggle's description, owner, expiry time, and tags in the repository.
+	// If the toggle's version doesn't match the toggle's current version, it returns Aborted error.
+	// The toggle's version is then set to the new version.
+	// The updated event must be published eventually if and only if the toggle is updated.
+	Update(ctx context.Context, toggle *entity.Toggle) error
+}
+
+// ToggleUpdater is responsible for updating a toggle.
+type ToggleUpdater struct {
+	repo UpdateToggleRepository
+}
+
+// NewToggleUpdater creates an instance of ToggleUpdater.
+func NewToggleUpdater(repo UpdateToggleRepository) *ToggleUpdater {
+	return &ToggleUpdater{repo: repo}
+}
+
+// Update updates the toggle's fields listed in the mask.
+// It rejects the mask if it contains any field which is unknown or can't be updated.
+// The values are sanitized and validated the same way as creating a toggle.
+// It returns NotFound error if the toggle doesn't exist in the project's environment.
+// The toggle is only updated if it isn't changed since it is retrieved, otherwise it returns VersionConflict error.
+// If version is not zero, the toggle's current version must equal version as well.
+func (tu *ToggleUpdater) Update(ctx context.Context, project, env, key string, toggle *entity.Toggle, mask []string, version int64) (*entity.Toggle, error) {
+	if toggle == nil {
+		return nil, entity.ErrEmptyToggle()
+	}
+	paths, err := sanitizeUpdateMask(mask)
+	if err != nil {
+		return nil, err
+	}
+	update := &entity.Toggle{
+		Description: toggle.Description,
+		Owner:       toggle.Owner,
+		ExpiresAt:   toggle.ExpiresAt,
+		Tags:        toggle.Tags,
+	}
+	sanitizeToggle(update)
+
+	res, err := tu.repo.GetByKey(ctx, project, env, key)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && res.Version != version {
+		return nil, entity.ErrVersionConflict()
+	}
+	for _, path := range paths {
+		applyToggleField(res, update, path)
+	}
+	if err := validateTags(res.Tags); err != nil {
+		return nil, err
+	}
+	if err := tu.repo.Update(ctx, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// sanitizeUpdateMask trims and deduplicates the mask's paths.
+// Empty mask means all of the updatable fields.
+func sanitizeUpdateMask(mask []string) ([]string, error) {
+	if len(mask) == 0 {
+		return updatableToggleFields, nil
+	}
+
+	requested := make(map[string]bool)
+	for _, path := range mask {
+		path = strings.TrimSpace(path)
+		if !isUpdatableToggleField(path) {
+			return nil, entity.ErrInvalidUpdateMask(path)
+		}
+		requested[path] = true
+	}
+
+	var res []string
+	for _, field := range updatableToggleFields {
+		if requested[field] {
+			res = append(res, field)
+		}
+	}
+	return res, nil
+}
+
+func isUpdatableToggleField(path string) bool {
+	for _, field := range updatableToggleFields {
+		if field == path {
+			return true
+		}
+	}
+	return false
+}
+
+func applyToggleField(dst, src *entity.Toggle, field string) {
+	switch field {
+	case "description":
+		dst.Description = src.Description
+	case "owner":
+		dst.Owner = src.Owner
+	case "expires_at":
+		dst.ExpiresAt = src.ExpiresAt
+	case "tags":
+		dst.Tags = src.Tags
+	}
+}
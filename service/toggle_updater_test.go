@@ -0,0 +1,140 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleUpdaterExecutor struct {
+	updater *service.ToggleUpdater
+	repo    *mock_service.MockUpdateToggleRepository
+}
+
+func TestNewToggleUpdater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleUpdater", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		assert.NotNil(t, exec.updater)
+	})
+}
+
+func TestToggleUpdater_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiresAt := time.Date(2030, time.January, 1, 7, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+
+	t.Run("nil toggle is prohibited", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, nil, nil, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrEmptyToggle(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("update mask contains immutable or unknown field", func(t *testing.T) {
+		masks := [][]string{{"key"}, {"description", "is_enabled"}, {"unknown"}}
+		for _, mask := range masks {
+			exec := createToggleUpdaterExecutor(ctrl)
+
+			res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, &entity.Toggle{}, mask, 0)
+
+			assert.NotNil(t, err)
+			assert.Equal(t, entity.ErrInvalidUpdateMask(mask[len(mask)-1]), err)
+			assert.Nil(t, res)
+		}
+	})
+
+	t.Run("repository fails to get the toggle", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(nil, entity.ErrNotFound())
+
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, &entity.Toggle{}, []string{"description"}, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrNotFound(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("toggle's version doesn't match", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(&entity.Toggle{Key: testToggleKey, Version: 2}, nil)
+
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, &entity.Toggle{}, []string{"description"}, 1)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrVersionConflict(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("tags are invalid", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(&entity.Toggle{Key: testToggleKey}, nil)
+
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, &entity.Toggle{Tags: []string{"team:payments:core"}}, []string{"tags"}, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInvalidTag(), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("repository fails to update the toggle", func(t *testing.T) {
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(&entity.Toggle{Key: testToggleKey}, nil)
+		exec.repo.EXPECT().Update(testCtx, gomock.Any()).Return(entity.ErrInternal(""))
+
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, &entity.Toggle{}, nil, 0)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("only the fields in the update mask are updated", func(t *testing.T) {
+		current := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Description: "old", Owner: "team-checkout", Tags: []string{"team:checkout"}, Version: 1}
+		expected := &entity.Toggle{Key: testToggleKey, Project: testToggleProject, Environment: testToggleEnv, Description: "new", Owner: "team-checkout", Tags: []string{"team:payments"}, Version: 1}
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(current, nil)
+		exec.repo.EXPECT().Update(testCtx, expected).Return(nil)
+
+		update := &entity.Toggle{Key: "ignored", Description: " new ", Owner: "team-payments", Tags: []string{" Team:Payments "}}
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, update, []string{"description", " tags", "description"}, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("empty update mask updates all updatable fields", func(t *testing.T) {
+		utc := expiresAt.UTC()
+		current := &entity.Toggle{Key: testToggleKey, Description: "old", Owner: "team-checkout", Tags: []string{"team:checkout"}}
+		expected := &entity.Toggle{Key: testToggleKey, Description: "new", Owner: "team-payments", ExpiresAt: &utc}
+		exec := createToggleUpdaterExecutor(ctrl)
+		exec.repo.EXPECT().GetByKey(testCtx, testToggleProject, testToggleEnv, testToggleKey).Return(current, nil)
+		exec.repo.EXPECT().Update(testCtx, expected).Return(nil)
+
+		res, err := exec.updater.Update(testCtx, testToggleProject, testToggleEnv, testToggleKey, &entity.Toggle{Description: "new", Owner: "team-payments", ExpiresAt: &expiresAt}, nil, 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+}
+
+func createToggleUpdaterExecutor(ctrl *gomock.Controller) *ToggleUpdaterExecutor {
+	r := mock_service.NewMockUpdateToggleRepository(ctrl)
+	u := service.NewToggleUpdater(r)
+	return &ToggleUpdaterExecutor{
+		updater: u,
+		repo:    r,
+	}
+}
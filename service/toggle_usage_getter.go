@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+// GetToggleUsage defines the interface to get the toggles' usage.
+type GetToggleUsage interface {
+	// GetUsage gets how many times the toggles in the project's environment have been evaluated since the time.
+	// Empty keys means all toggles. Zero time means all evaluations ever reported.
+	GetUsage(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.ToggleUsage, error)
+}
+
+// GetToggleUsageRepository defines the interface to get the toggles whose usage is retrieved from the repository.
+type GetToggleUsageRepository interface {
+	// GetAll gets the toggles available in the project's environment which satisfy the filter in repository.
+	// Nil pagination means all toggles.
+	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+}
+
+// GetEvaluationCountRepository defines the interface to get the toggles' evaluation counts from the repository.
+type GetEvaluationCountRepository interface {
+	// Sum sums the evaluation counts in the project's environment per toggle and variant,
+	// starting from the time window which starts at since.
+	// Empty keys means all toggles. Zero time means all time windows.
+	// The returned counts don't have any window start.
+	// If there isn't any count in repository, it returns empty list of count and nil error.
+	Sum(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.EvaluationCount, error)
+}
+
+// ToggleUsageGetter is responsible for getting the toggles' usage.
+type ToggleUsageGetter struct {
+	repo      GetToggleUsageRepository
+	countRepo GetEvaluationCountRepository
+}
+
+// NewToggleUsageGetter creates an instance of ToggleUsageGetter.
+func NewToggleUsageGetter(repo GetToggleUsageRepository, countRepo GetEvaluationCountRepository) *ToggleUsageGetter {
+	return &ToggleUsageGetter{
+		repo:      repo,
+		countRepo: countRepo,
+	}
+}
+
+// GetUsage gets how many times the toggles in the project's environment have been evaluated since the time,
+// in total and per served variant, sorted by key and variant.
+// Every toggle is listed, including the ones which have never been evaluated,
+// while the counts of toggles which don't exist anymore are omitted.
+// The keys are converted to lower case and the time is rounded down to the hour.
+func (ug *ToggleUsageGetter) GetUsage(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.ToggleUsage, error) {
+	keys = sanitizeUsageKeys(keys)
+	if !since.IsZero() {
+		since = entity.EvaluationWindowStart(since)
+	}
+
+	toggles, err := ug.repo.GetAll(ctx, project, env, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := ug.countRepo.Sum(ctx, project, env, keys, since)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make(map[string]*entity.ToggleUsage)
+	for _, toggle := range toggles {
+		if len(keys) == 0 || containsString(keys, toggle.Key) {
+			usages[toggle.Key] = &entity.ToggleUsage{Key: toggle.Key}
+		}
+	}
+	for _, count := range counts {
+		usage, ok := usages[count.Key]
+		if !ok {
+			continue
+		}
+		usage.Count += count.Count
+		usage.Variants = append(usage.Variants, &entity.VariantUsage{Variant: count.Variant, Count: count.Count, LastEvaluatedAt: count.LastEvaluatedAt})
+		if usage.LastEvaluatedAt == nil || count.LastEvaluatedAt.After(*usage.LastEvaluatedAt) {
+			last := count.LastEvaluatedAt
+			usage.LastEvaluatedAt = &last
+		}
+	}
+
+	res := make([]*entity.ToggleUsage, 0, len(usages))
+	for _, usage := range usages {
+		sort.Slice(usage.Variants, func(i, j int) bool {
+			return usage.Variants[i].Variant < usage.Variants[j].Variant
+		})
+		res = append(res, usage)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Key < res[j].Key
+	})
+	return res, nil
+}
+
+func sanitizeUsageKeys(keys []string) []string {
+	var res []string
+	for _, key := range keys {
+		if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+			res = append(res, key)
+		}
+	}
+	return res
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+type ToggleUsageGetterExecutor struct {
+	getter    *service.ToggleUsageGetter
+	repo      *mock_service.MockGetToggleUsageRepository
+	countRepo *mock_service.MockGetEvaluationCountRepository
+}
+
+func TestNewToggleUsageGetter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleUsageGetter", func(t *testing.T) {
+		exec := createToggleUsageGetterExecutor(ctrl)
+		assert.NotNil(t, exec.getter)
+	})
+}
+
+func TestToggleUsageGetter_GetUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	window := time.Date(2022, time.February, 1, 7, 0, 0, 0, time.UTC)
+	toggles := []*entity.Toggle{{Key: "toggle-2"}, {Key: "toggle-1"}, {Key: "toggle-3"}}
+
+	t.Run("toggle repository returns internal error", func(t *testing.T) {
+		exec := createToggleUsageGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetUsage(testCtx, testToggleProject, testToggleEnv, nil, time.Time{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("count repository returns internal error", func(t *testing.T) {
+		exec := createToggleUsageGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(toggles, nil)
+		exec.countRepo.EXPECT().Sum(testCtx, testToggleProject, testToggleEnv, nil, time.Time{}).Return(nil, entity.ErrInternal(""))
+
+		res, err := exec.getter.GetUsage(testCtx, testToggleProject, testToggleEnv, nil, time.Time{})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("every toggle is listed with its counts", func(t *testing.T) {
+		counts := []*entity.EvaluationCount{
+			{Key: "toggle-1", Variant: "true", Count: 3, LastEvaluatedAt: window.Add(time.Hour)},
+			{Key: "toggle-1", Variant: "false", Count: 2, LastEvaluatedAt: window.Add(2 * time.Hour)},
+			{Key: "toggle-3", Variant: "blue", Count: 1, LastEvaluatedAt: window},
+			{Key: "toggle-deleted", Variant: "true", Count: 7, LastEvaluatedAt: window},
+		}
+		exec := createToggleUsageGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(toggles, nil)
+		exec.countRepo.EXPECT().Sum(testCtx, testToggleProject, testToggleEnv, nil, time.Time{}).Return(counts, nil)
+
+		res, err := exec.getter.GetUsage(testCtx, testToggleProject, testToggleEnv, nil, time.Time{})
+
+		last1 := window.Add(2 * time.Hour)
+		last3 := window
+		expected := []*entity.ToggleUsage{
+			{
+				Key:             "toggle-1",
+				Count:           5,
+				LastEvaluatedAt: &last1,
+				Variants: []*entity.VariantUsage{
+					{Variant: "false", Count: 2, LastEvaluatedAt: window.Add(2 * time.Hour)},
+					{Variant: "true", Count: 3, LastEvaluatedAt: window.Add(time.Hour)},
+				},
+			},
+			{Key: "toggle-2"},
+			{Key: "toggle-3", Count: 1, LastEvaluatedAt: &last3, Variants: []*entity.VariantUsage{{Variant: "blue", Count: 1, LastEvaluatedAt: window}}},
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("keys are sanitized and time is rounded down to the hour", func(t *testing.T) {
+		since := time.Date(2022, time.February, 1, 14, 30, 0, 0, time.FixedZone("WIB", 7*60*60))
+		exec := createToggleUsageGetterExecutor(ctrl)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, nil).Return(toggles, nil)
+		exec.countRepo.EXPECT().Sum(testCtx, testToggleProject, testToggleEnv, []string{"toggle-2"}, window).Return([]*entity.EvaluationCount{}, nil)
+
+		res, err := exec.getter.GetUsage(testCtx, testToggleProject, testToggleEnv, []string{" Toggle-2 ", ""}, since)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*entity.ToggleUsage{{Key: "toggle-2"}}, res)
+	})
+}
+
+func createToggleUsageGetterExecutor(ctrl *gomock.Controller) *ToggleUsageGetterExecutor {
+	r := mock_service.NewMockGetToggleUsageRepository(ctrl)
+	c := mock_service.NewMockGetEvaluationCountRepository(ctrl)
+	g := service.NewToggleUsageGetter(r, c)
+	return &ToggleUsageGetterExecutor{
+		getter:    g,
+		repo:      r,
+		countRepo: c,
+	}
+}
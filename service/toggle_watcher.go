@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+const (
+	// watchSnapshotPageSize is the number of toggles read at once to build the watcher's snapshot.
+	watchSnapshotPageSize = 100
+)
+
+// WatchToggle defines the interface to watch toggles.
+type WatchToggle interface {
+	// Watch sends the snapshot of the watched toggles in the project's environment and then every event of the watched toggles.
+	// If the watch can be resumed from the token, the missed events are sent instead of the snapshot.
+	// It blocks until the context is done, the update can't be sent, or the watcher falls behind.
+	Watch(ctx context.Context, project, env string, filter *entity.WatchFilter, token string, send func(*entity.WatchUpdate) error) error
+}
+
+// ToggleSubscription defines the interface of a subscription to the toggle's events.
+type ToggleSubscription interface {
+	// Events returns the events published after the subscription's token.
+	// The channel is closed if the subscriber falls too far behind.
+	Events() <-chan *entity.BroadcastEvent
+	// Token returns the token of the position where the subscription starts.
+	Token() string
+	// Close ends the subscription.
+	Close()
+}
+
+// ToggleBroadcaster defines the interface to subscribe to the toggle's events.
+type ToggleBroadcaster interface {
+	// Subscribe subscribes to the events published after the token.
+	// If the token is empty, unknown, or the events after it have been forgotten,
+	// the subscription starts from the latest event and it returns false.
+	Subscribe(token string) (ToggleSubscription, bool)
+}
+
+// WatchToggleRepository defines the interface to get the watched toggles from the repository.
+type WatchToggleRepository interface {
+	// GetAll gets the toggles available in the project's environment which satisfy the filter in repository.
+	// The toggles are sorted and limited as defined by the pagination.
+	// If there isn't any toggle in repository, it returns empty list of toggle and nil error.
+	GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error)
+}
+
+// ToggleWatcher is responsible for watching toggles.
+type ToggleWatcher struct {
+	repo        WatchToggleRepository
+	broadcaster ToggleBroadcaster
+}
+
+// NewToggleWatcher creates an instance of ToggleWatcher.
+func NewToggleWatcher(repo WatchToggleRepository, broadcaster ToggleBroadcaster) *ToggleWatcher {
+	return &ToggleWatcher{
+		repo:        repo,
+		broadcaster: broadcaster,
+	}
+}
+
+// Watch sends the snapshot of the watched toggles in the project's environment and then every event of the watched toggles.
+// The watched keys and key prefixes are converted to lower case.
+// The subscription starts before the snapshot is read, hence no event is missed between them,
+// though an event which is already reflected in the snapshot may be sent afterwards.
+// Events which aren't scoped to any environment are sent to the watchers of every environment.
+// If the watcher falls too far behind, it returns Unavailable error and the watcher should resume from its last token.
+func (tw *ToggleWatcher) Watch(ctx context.Context, project, env string, filter *entity.WatchFilter, token string, send func(*entity.WatchUpdate) error) error {
+	filter = sanitizeWatchFilter(filter)
+	sub, resumed := tw.broadcaster.Subscribe(token)
+	defer sub.Close()
+
+	if !resumed {
+		toggles, err := tw.snapshot(ctx, project, env, filter)
+		if err != nil {
+			return err
+		}
+		if err := send(&entity.WatchUpdate{Snapshot: toggles, Token: sub.Token()}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return entity.ErrWatchLagging()
+			}
+			if !isWatchedEvent(event.Event, project, env, filter) {
+				continue
+			}
+			if err := send(&entity.WatchUpdate{Event: event.Event, Token: event.Token}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// snapshot reads all watched toggles in the project's environment page by page, sorted by key.
+func (tw *ToggleWatcher) snapshot(ctx context.Context, project, env string, filter *entity.WatchFilter) ([]*entity.Toggle, error) {
+	var toggleFilter *entity.ToggleFilter
+	if len(filter.Keys) == 0 && len(filter.KeyPrefixes) == 1 {
+		toggleFilter = &entity.ToggleFilter{KeyPrefix: filter.KeyPrefixes[0]}
+	}
+
+	res := []*entity.Toggle{}
+	page := &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: watchSnapshotPageSize}
+	for {
+		toggles, err := tw.repo.GetAll(ctx, project, env, toggleFilter, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, toggle := range toggles {
+			if filter.Matches(toggle.Key) {
+				res = append(res, toggle)
+			}
+		}
+		if uint(len(toggles)) < page.Limit {
+			return res, nil
+		}
+		page.After = &entity.ToggleCursor{Key: toggles[len(toggles)-1].Key}
+	}
+}
+
+func isWatchedEvent(event *togglev1.ToggleEvent, project, env string, filter *entity.WatchFilter) bool {
+	if event.GetProject() != project {
+		return false
+	}
+	if event.GetEnvironment() != "" && event.GetEnvironment() != env {
+		return false
+	}
+	return filter.Matches(event.GetToggle().GetKey())
+}
+
+func sanitizeWatchFilter(filter *entity.WatchFilter) *entity.WatchFilter {
+	res := &entity.WatchFilter{}
+	if filter == nil {
+		return res
+	}
+	for _, key := range filter.Keys {
+		res.Keys = append(res.Keys, strings.ToLower(strings.TrimSpace(key)))
+	}
+	for _, prefix := range filter.KeyPrefixes {
+		res.KeyPrefixes = append(res.KeyPrefixes, strings.ToLower(strings.TrimSpace(prefix)))
+	}
+	return res
+}
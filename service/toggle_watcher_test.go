@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+	"github.com/indrasaputra/toggle/service"
+	mock_service "github.com/indrasaputra/toggle/test/mock/service"
+)
+
+var (
+	testWatchToken = "epoch.1"
+)
+
+type ToggleWatcherExecutor struct {
+	watcher      *service.ToggleWatcher
+	repo         *mock_service.MockWatchToggleRepository
+	broadcaster  *mock_service.MockToggleBroadcaster
+	subscription *mock_service.MockToggleSubscription
+	events       chan *entity.BroadcastEvent
+}
+
+func TestNewToggleWatcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("successfully create an instance of ToggleWatcher", func(t *testing.T) {
+		exec := createToggleWatcherExecutor(ctrl)
+		assert.NotNil(t, exec.watcher)
+	})
+}
+
+func TestToggleWatcher_Watch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("repository fails to get the snapshot", func(t *testing.T) {
+		exec := createToggleWatcherExecutor(ctrl)
+		exec.broadcaster.EXPECT().Subscribe("").Return(exec.subscription, false)
+		exec.repo.EXPECT().GetAll(testCtx, testToggleProject, testToggleEnv, nil, gomock.Any()).Return(nil, entity.ErrInternal(""))
+
+		err := exec.watcher.Watch(testCtx, testToggleProject, testToggleEnv, nil, "", collectWatchUpdates(nil))
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrInternal(""), err)
+	})
+
+	t.Run("snapshot is read page by page and filtered by keys and key prefixes", func(t *testing.T) {
+		exec := createToggleWatcherExecutor(ctrl)
+		firstPage := make([]*entity.Toggle, 100)
+		for i := range firstPage {
+			firstPage[i] = &entity.Toggle{Key: "other"}
+		}
+		firstPage[0] = &entity.Toggle{Key: "checkout-v2"}
+		firstPage[99] = &entity.Toggle{Key: "other-99"}
+		secondPage := []*entity.Toggle{{Key: "search-v2"}, {Key: "search-v3"}}
+		exec.broadcaster.EXPECT().Subscribe("").Return(exec.subscription, false)
+		gomock.InOrder(
+			exec.repo.EXPECT().GetAll(gomock.Any(), testToggleProject, testToggleEnv, nil, &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 100}).Return(firstPage, nil),
+			exec.repo.EXPECT().GetAll(gomock.Any(), testToggleProject, testToggleEnv, nil, &entity.TogglePagination{OrderBy: entity.ToggleOrderByKey, Limit: 100, After: &entity.ToggleCursor{Key: "other-99"}}).Return(secondPage, nil),
+		)
+		updates := []*entity.WatchUpdate{}
+		filter := &entity.WatchFilter{Keys: []string{" Search-V2 "}, KeyPrefixes: []string{"checkout-"}}
+
+		err := exec.watcher.Watch(testCtx, testToggleProject, testToggleEnv, filter, "", collectWatchUpdates(&updates))
+
+		assert.Equal(t, errStopWatching, err)
+		assert.Equal(t, 1, len(updates))
+		assert.True(t, updates[0].IsSnapshot())
+		assert.Equal(t, testWatchToken, updates[0].Token)
+		assert.Equal(t, 2, len(updates[0].Snapshot))
+		assert.Equal(t, "checkout-v2", updates[0].Snapshot[0].Key)
+		assert.Equal(t, "search-v2", updates[0].Snapshot[1].Key)
+	})
+
+	t.Run("single key prefix is applied to the snapshot's query", func(t *testing.T) {
+		exec := createToggleWatcherExecutor(ctrl)
+		exec.broadcaster.EXPECT().Subscribe("").Return(exec.subscription, false)
+		exec.repo.EXPECT().GetAll(gomock.Any(), testToggleProject, testToggleEnv, &entity.ToggleFilter{KeyPrefix: "checkout-"}, gomock.Any()).Return([]*entity.Toggle{}, nil)
+		updates := []*entity.WatchUpdate{}
+
+		err := exec.watcher.Watch(testCtx, testToggleProject, testToggleEnv, &entity.WatchFilter{KeyPrefixes: []string{"checkout-"}}, "", collectWatchUpdates(&updates))
+
+		assert.Equal(t, errStopWatching, err)
+		assert.Equal(t, 1, len(updates))
+		assert.Empty(t, updates[0].Snapshot)
+	})
+
+	t.Run("resumed watch only sends the watched events", func(t *testing.T) {
+		exec := createToggleWatcherExecutor(ctrl)
+		exec.broadcaster.EXPECT().Subscribe(testWatchToken).Return(exec.subscription, true)
+		exec.events <- createWatchTestEvent("default", "staging", "toggle-1", "epoch.2")
+		exec.events <- createWatchTestEvent("other", testToggleEnv, "toggle-1", "epoch.3")
+		exec.events <- createWatchTestEvent(testToggleProject, testToggleEnv, "toggle-2", "epoch.4")
+		exec.events <- createWatchTestEvent(testToggleProject, testToggleEnv, "toggle-1", "epoch.5")
+		exec.events <- createWatchTestEvent(testToggleProject, "", "toggle-1", "epoch.6")
+		updates := []*entity.WatchUpdate{}
+		ctx, cancel := context.WithCancel(testCtx)
+		send := func(update *entity.WatchUpdate) error {
+			updates = append(updates, update)
+			if len(updates) == 2 {
+				cancel()
+			}
+			return nil
+		}
+
+		err := exec.watcher.Watch(ctx, testToggleProject, testToggleEnv, &entity.WatchFilter{Keys: []string{"toggle-1"}}, testWatchToken, send)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(updates))
+		assert.Equal(t, "epoch.5", updates[0].Token)
+		assert.Equal(t, "epoch.6", updates[1].Token)
+		assert.False(t, updates[1].IsSnapshot())
+	})
+
+	t.Run("watcher which falls behind gets unavailable error", func(t *testing.T) {
+		exec := createToggleWatcherExecutor(ctrl)
+		exec.broadcaster.EXPECT().Subscribe(testWatchToken).Return(exec.subscription, true)
+		close(exec.events)
+
+		err := exec.watcher.Watch(testCtx, testToggleProject, testToggleEnv, nil, testWatchToken, collectWatchUpdates(nil))
+
+		assert.NotNil(t, err)
+		assert.Equal(t, entity.ErrWatchLagging(), err)
+	})
+}
+
+var errStopWatching = errors.New("stop watching")
+
+// collectWatchUpdates collects the sent update and stops watching right away.
+func collectWatchUpdates(updates *[]*entity.WatchUpdate) func(*entity.WatchUpdate) error {
+	return func(update *entity.WatchUpdate) error {
+		if updates != nil {
+			*updates = append(*updates, update)
+		}
+		return errStopWatching
+	}
+}
+
+func createWatchTestEvent(project, env, key, token string) *entity.BroadcastEvent {
+	return &entity.BroadcastEvent{
+		Event: &togglev1.ToggleEvent{
+			Name:        togglev1.ToggleEventName_TOGGLE_EVENT_NAME_ENABLED,
+			Toggle:      &togglev1.Toggle{Key: key},
+			Environment: env,
+			Project:     project,
+		},
+		Token: token,
+	}
+}
+
+func createToggleWatcherExecutor(ctrl *gomock.Controller) *ToggleWatcherExecutor {
+	r := mock_service.NewMockWatchToggleRepository(ctrl)
+	b := mock_service.NewMockToggleBroadcaster(ctrl)
+	s := mock_service.NewMockToggleSubscription(ctrl)
+	e := make(chan *entity.BroadcastEvent, 10)
+	s.EXPECT().Events().Return(e).AnyTimes()
+	s.EXPECT().Token().Return(testWatchToken).AnyTimes()
+	s.EXPECT().Close().AnyTimes()
+
+	w := service.NewToggleWatcher(r, b)
+	return &ToggleWatcherExecutor{
+		watcher:      w,
+		repo:         r,
+		broadcaster:  b,
+		subscription: s,
+		events:       e,
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/indrasaputra/toggle/entity"
+)
+
+const (
+	// maxVariantLength is the maximum length of a variant's name, including the variant reported in the evaluation counts.
+	maxVariantLength = 50
+)
+
+// resolveVariant returns nil if the toggle doesn't have any variant.
+// A false value is served the off variant.
+// A true value is served the matched rule's variant or the default variant if the rule doesn't have any.
+func resolveVariant(toggle *entity.Toggle, evaluation *entity.Evaluation) *entity.Variant {
+	if len(toggle.Variants) == 0 {
+		return nil
+	}
+	if !evaluation.Value {
+		return findVariant(toggle.Variants, toggle.OffVariant)
+	}
+	if evaluation.MatchedRule != nil && evaluation.MatchedRule.Variant != "" {
+		return findVariant(toggle.Variants, evaluation.MatchedRule.Variant)
+	}
+	return findVariant(toggle.Variants, toggle.DefaultVariant)
+}
+
+func findVariant(variants []*entity.Variant, name string) *entity.Variant {
+	for _, variant := range variants {
+		if variant != nil && variant.Name == name {
+			return variant
+		}
+	}
+	return nil
+}
+
+func validateVariants(toggle *entity.Toggle) error {
+	if len(toggle.Variants) == 0 {
+		return validateVariantsAreNotReferred(toggle)
+	}
+
+	names := make(map[string]bool)
+	for i, variant := range toggle.Variants {
+		if err := validateVariant(variant); err != nil {
+			return entity.ErrInvalidVariant(fmt.Sprintf("variant %d: %s", i, err.Error()))
+		}
+		if names[variant.Name] {
+			return entity.ErrInvalidVariant(fmt.Sprintf("variant %d: name %q is duplicated", i, variant.Name))
+		}
+		names[variant.Name] = true
+	}
+
+	if !names[toggle.DefaultVariant] {
+		return entity.ErrInvalidVariant(fmt.Sprintf("default variant %q doesn't exist", toggle.DefaultVariant))
+	}
+	if !names[toggle.OffVariant] {
+		return entity.ErrInvalidVariant(fmt.Sprintf("off variant %q doesn't exist", toggle.OffVariant))
+	}
+	for i, rule := range toggle.Rules {
+		if rule.Variant != "" && !names[rule.Variant] {
+			return entity.ErrInvalidVariant(fmt.Sprintf("rule %d: variant %q doesn't exist", i, rule.Variant))
+		}
+	}
+	return nil
+}
+
+func validateVariantsAreNotReferred(toggle *entity.Toggle) error {
+	if toggle.DefaultVariant != "" || toggle.OffVariant != "" {
+		return entity.ErrInvalidVariant("default and off variant must be empty if there isn't any variant")
+	}
+	for i, rule := range toggle.Rules {
+		if rule.Variant != "" {
+			return entity.ErrInvalidVariant(fmt.Sprintf("rule %d: variant %q doesn't exist", i, rule.Variant))
+		}
+	}
+	return nil
+}
+
+func validateVariant(variant *entity.Variant) error {
+	if variant == nil {
+		return fmt.Errorf("empty or nil")
+	}
+	if strings.TrimSpace(variant.Name) == "" {
+		return fmt.Errorf("name is empty")
+	}
+	if len(variant.Name) > maxVariantLength {
+		return fmt.Errorf("name must not be more than %d characters", maxVariantLength)
+	}
+
+	switch variant.Type {
+	case entity.VariantTypeString:
+		return nil
+	case entity.VariantTypeNumber:
+		if _, err := strconv.ParseFloat(variant.Value, 64); err != nil {
+			return fmt.Errorf("value %q is not a number", variant.Value)
+		}
+		return nil
+	case entity.VariantTypeJSON:
+		if !json.Valid([]byte(variant.Value)) {
+			return fmt.Errorf("value is not a valid JSON")
+		}
+		return nil
+	default:
+		return fmt.Errorf("type %q is unknown", variant.Type)
+	}
+}
@@ -0,0 +1,71 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/indrasaputra/toggle/entity"
+	"github.com/indrasaputra/toggle/service"
+)
+
+var (
+	testVariantControl = &entity.Variant{Name: "control", Type: entity.VariantTypeString, Value: "grey"}
+	testVariantBlue    = &entity.Variant{Name: "blue", Type: entity.VariantTypeString, Value: "#0000ff"}
+	testVariantRed     = &entity.Variant{Name: "red", Type: entity.VariantTypeString, Value: "#ff0000"}
+	testVariants       = []*entity.Variant{testVariantControl, testVariantBlue, testVariantRed}
+)
+
+func TestEvaluate_Variant(t *testing.T) {
+	t.Run("on/off toggle doesn't serve any variant", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.True(t, res.Value)
+		assert.Nil(t, res.Variant)
+	})
+
+	t.Run("disabled toggle serves off variant", func(t *testing.T) {
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: false, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.Equal(t, testVariantControl, res.Variant)
+	})
+
+	t.Run("matched rule with false value serves off variant", func(t *testing.T) {
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: false, Variant: "red"}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.Equal(t, testVariantControl, res.Variant)
+	})
+
+	t.Run("matched rule serves its own variant", func(t *testing.T) {
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true, Variant: "red"}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.Equal(t, testVariantRed, res.Variant)
+	})
+
+	t.Run("matched rule without variant serves default variant", func(t *testing.T) {
+		rule := &entity.Rule{Attribute: "country", Operator: entity.RuleOperatorEquals, Values: []string{"ID"}, Value: true}
+		toggle := &entity.Toggle{Key: testToggleKey, IsEnabled: true, Rules: []*entity.Rule{rule}, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+
+		res := service.Evaluate(toggle, testEvaluationContext)
+
+		assert.Equal(t, testVariantBlue, res.Variant)
+	})
+
+	t.Run("fallthrough serves variant based on default value", func(t *testing.T) {
+		on := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: true, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+		off := &entity.Toggle{Key: testToggleKey, IsEnabled: true, DefaultValue: false, Variants: testVariants, DefaultVariant: "blue", OffVariant: "control"}
+
+		assert.Equal(t, testVariantBlue, service.Evaluate(on, testEvaluationContext).Variant)
+		assert.Equal(t, testVariantControl, service.Evaluate(off, testEvaluationContext).Variant)
+	})
+}
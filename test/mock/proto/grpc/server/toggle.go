@@ -6,6 +6,7 @@ package mock_server
 
 import (
 	"context"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -17,6 +18,7 @@ import (
 var (
 	keyErr      = "has-error"
 	keyReturn   = "complete-return"
+	keyVariant  = "variant"
 	errInternal = status.New(codes.Internal, "").Err()
 )
 
@@ -34,13 +36,20 @@ func (MockToggleServiceServer) CreateToggle(ctx context.Context, _ *togglev1.Cre
 	return &togglev1.CreateToggleResponse{}, nil
 }
 
-func (MockToggleServiceServer) GetToggleByKey(ctx context.Context, _ *togglev1.GetToggleByKeyRequest) (*togglev1.GetToggleByKeyResponse, error) {
+func (MockToggleServiceServer) GetToggleByKey(ctx context.Context, req *togglev1.GetToggleByKeyRequest) (*togglev1.GetToggleByKeyResponse, error) {
 	md, _ := metadata.FromIncomingContext(ctx)
 	if len(md[keyErr]) > 0 && md[keyErr][0] != "" {
 		return nil, errInternal
 	}
 	if len(md[keyReturn]) > 0 && md[keyReturn][0] != "" {
-		return &togglev1.GetToggleByKeyResponse{Toggle: &togglev1.Toggle{Key: md[keyReturn][0], IsEnabled: true}}, nil
+		toggle := &togglev1.Toggle{
+			Key:         md[keyReturn][0],
+			IsEnabled:   true,
+			Rollout:     &togglev1.Rollout{Percentage: 100, BucketBy: "user_id"},
+			Environment: req.GetEnvironment(),
+			Project:     req.GetProject(),
+		}
+		return &togglev1.GetToggleByKeyResponse{Toggle: toggle}, nil
 	}
 	return &togglev1.GetToggleByKeyResponse{}, nil
 }
@@ -76,3 +85,34 @@ func (MockToggleServiceServer) DeleteToggle(ctx context.Context, _ *togglev1.Del
 	}
 	return &togglev1.DeleteToggleResponse{}, nil
 }
+
+func (MockToggleServiceServer) EvaluateToggle(ctx context.Context, _ *togglev1.EvaluateToggleRequest) (*togglev1.EvaluateToggleResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md[keyErr]) > 0 && md[keyErr][0] != "" {
+		return nil, errInternal
+	}
+	resp := &togglev1.EvaluateToggleResponse{Value: true, Reason: togglev1.EvaluationReason_EVALUATION_REASON_FALLTHROUGH}
+	if len(md[keyVariant]) > 0 && md[keyVariant][0] != "" {
+		resp.Variant = createVariantFromMetadata(md[keyVariant][0])
+	}
+	return resp, nil
+}
+
+// createVariantFromMetadata creates variant from metadata in format of type:value, e.g: number:10.
+func createVariantFromMetadata(val string) *togglev1.Variant {
+	parts := strings.SplitN(val, ":", 2)
+	types := map[string]togglev1.VariantType{
+		"string": togglev1.VariantType_VARIANT_TYPE_STRING,
+		"number": togglev1.VariantType_VARIANT_TYPE_NUMBER,
+		"json":   togglev1.VariantType_VARIANT_TYPE_JSON,
+	}
+	return &togglev1.Variant{Name: parts[0], Type: types[parts[0]], Value: parts[1]}
+}
+
+func (MockToggleServiceServer) ReportEvaluations(ctx context.Context, _ *togglev1.ReportEvaluationsRequest) (*togglev1.ReportEvaluationsResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md[keyErr]) > 0 && md[keyErr][0] != "" {
+		return nil, errInternal
+	}
+	return &togglev1.ReportEvaluationsResponse{}, nil
+}
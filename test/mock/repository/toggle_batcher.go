@@ -0,0 +1,147 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/toggle_batcher.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockBatchToggleDatabase is a mock of BatchToggleDatabase interface.
+type MockBatchToggleDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchToggleDatabaseMockRecorder
+}
+
+// MockBatchToggleDatabaseMockRecorder is the mock recorder for MockBatchToggleDatabase.
+type MockBatchToggleDatabaseMockRecorder struct {
+	mock *MockBatchToggleDatabase
+}
+
+// NewMockBatchToggleDatabase creates a new mock instance.
+func NewMockBatchToggleDatabase(ctrl *gomock.Controller) *MockBatchToggleDatabase {
+	mock := &MockBatchToggleDatabase{ctrl: ctrl}
+	mock.recorder = &MockBatchToggleDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchToggleDatabase) EXPECT() *MockBatchToggleDatabaseMockRecorder {
+	return m.recorder
+}
+
+// GetAllByKey mocks base method.
+func (m *MockBatchToggleDatabase) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockBatchToggleDatabaseMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockBatchToggleDatabase)(nil).GetAllByKey), ctx, project, key)
+}
+
+// GetAllByKeys mocks base method.
+func (m *MockBatchToggleDatabase) GetAllByKeys(ctx context.Context, project string, keys []string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKeys", ctx, project, keys)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKeys indicates an expected call of GetAllByKeys.
+func (mr *MockBatchToggleDatabaseMockRecorder) GetAllByKeys(ctx, project, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKeys", reflect.TypeOf((*MockBatchToggleDatabase)(nil).GetAllByKeys), ctx, project, keys)
+}
+
+// InsertAll mocks base method.
+func (m *MockBatchToggleDatabase) InsertAll(ctx context.Context, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAll", ctx, toggles, atomic)
+	ret0, _ := ret[0].([]*entity.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAll indicates an expected call of InsertAll.
+func (mr *MockBatchToggleDatabaseMockRecorder) InsertAll(ctx, toggles, atomic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAll", reflect.TypeOf((*MockBatchToggleDatabase)(nil).InsertAll), ctx, toggles, atomic)
+}
+
+// UpdateAll mocks base method.
+func (m *MockBatchToggleDatabase) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAll", ctx, project, env, operations, atomic)
+	ret0, _ := ret[0].([]*entity.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAll indicates an expected call of UpdateAll.
+func (mr *MockBatchToggleDatabaseMockRecorder) UpdateAll(ctx, project, env, operations, atomic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*MockBatchToggleDatabase)(nil).UpdateAll), ctx, project, env, operations, atomic)
+}
+
+// MockBatchToggleCache is a mock of BatchToggleCache interface.
+type MockBatchToggleCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchToggleCacheMockRecorder
+}
+
+// MockBatchToggleCacheMockRecorder is the mock recorder for MockBatchToggleCache.
+type MockBatchToggleCacheMockRecorder struct {
+	mock *MockBatchToggleCache
+}
+
+// NewMockBatchToggleCache creates a new mock instance.
+func NewMockBatchToggleCache(ctrl *gomock.Controller) *MockBatchToggleCache {
+	mock := &MockBatchToggleCache{ctrl: ctrl}
+	mock.recorder = &MockBatchToggleCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchToggleCache) EXPECT() *MockBatchToggleCacheMockRecorder {
+	return m.recorder
+}
+
+// DeleteAll mocks base method.
+func (m *MockBatchToggleCache) DeleteAll(ctx context.Context, toggles []*entity.Toggle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAll", ctx, toggles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll.
+func (mr *MockBatchToggleCacheMockRecorder) DeleteAll(ctx, toggles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockBatchToggleCache)(nil).DeleteAll), ctx, toggles)
+}
+
+// SetAll mocks base method.
+func (m *MockBatchToggleCache) SetAll(ctx context.Context, toggles []*entity.Toggle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAll", ctx, toggles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAll indicates an expected call of SetAll.
+func (mr *MockBatchToggleCacheMockRecorder) SetAll(ctx, toggles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAll", reflect.TypeOf((*MockBatchToggleCache)(nil).SetAll), ctx, toggles)
+}
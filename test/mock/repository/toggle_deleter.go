@@ -37,32 +37,61 @@ func (m *MockDeleteToggleDatabase) EXPECT() *MockDeleteToggleDatabaseMockRecorde
 }
 
 // Delete mocks base method.
-func (m *MockDeleteToggleDatabase) Delete(ctx context.Context, key string) error {
+func (m *MockDeleteToggleDatabase) Delete(ctx context.Context, project, key string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret := m.ctrl.Call(m, "Delete", ctx, project, key, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDeleteToggleDatabaseMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+func (mr *MockDeleteToggleDatabaseMockRecorder) Delete(ctx, project, key, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteToggleDatabase)(nil).Delete), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteToggleDatabase)(nil).Delete), ctx, project, key, version)
 }
 
-// GetByKey mocks base method.
-func (m *MockDeleteToggleDatabase) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
+// GetAllByKey mocks base method.
+func (m *MockDeleteToggleDatabase) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
-	ret0, _ := ret[0].(*entity.Toggle)
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByKey indicates an expected call of GetByKey.
-func (mr *MockDeleteToggleDatabaseMockRecorder) GetByKey(ctx, key interface{}) *gomock.Call {
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockDeleteToggleDatabaseMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockDeleteToggleDatabase)(nil).GetByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockDeleteToggleDatabase)(nil).GetAllByKey), ctx, project, key)
+}
+
+// GetAllDeletedByKey mocks base method.
+func (m *MockDeleteToggleDatabase) GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDeletedByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDeletedByKey indicates an expected call of GetAllDeletedByKey.
+func (mr *MockDeleteToggleDatabaseMockRecorder) GetAllDeletedByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeletedByKey", reflect.TypeOf((*MockDeleteToggleDatabase)(nil).GetAllDeletedByKey), ctx, project, key)
+}
+
+// Purge mocks base method.
+func (m *MockDeleteToggleDatabase) Purge(ctx context.Context, project, key string, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, project, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDeleteToggleDatabaseMockRecorder) Purge(ctx, project, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDeleteToggleDatabase)(nil).Purge), ctx, project, key, version)
 }
 
 // MockDeleteToggleCache is a mock of DeleteToggleCache interface.
@@ -89,15 +118,15 @@ func (m *MockDeleteToggleCache) EXPECT() *MockDeleteToggleCacheMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockDeleteToggleCache) Delete(ctx context.Context, key string) error {
+func (m *MockDeleteToggleCache) Delete(ctx context.Context, project, env, key string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret := m.ctrl.Call(m, "Delete", ctx, project, env, key)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockDeleteToggleCacheMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+func (mr *MockDeleteToggleCacheMockRecorder) Delete(ctx, project, env, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteToggleCache)(nil).Delete), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeleteToggleCache)(nil).Delete), ctx, project, env, key)
 }
@@ -36,34 +36,49 @@ func (m *MockGetToggleDatabase) EXPECT() *MockGetToggleDatabaseMockRecorder {
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockGetToggleDatabase) Count(ctx context.Context, project, env string, filter *entity.ToggleFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, project, env, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockGetToggleDatabaseMockRecorder) Count(ctx, project, env, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockGetToggleDatabase)(nil).Count), ctx, project, env, filter)
+}
+
 // GetAll mocks base method.
-func (m *MockGetToggleDatabase) GetAll(ctx context.Context, limit uint) ([]*entity.Toggle, error) {
+func (m *MockGetToggleDatabase) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, limit)
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
 	ret0, _ := ret[0].([]*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockGetToggleDatabaseMockRecorder) GetAll(ctx, limit interface{}) *gomock.Call {
+func (mr *MockGetToggleDatabaseMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggleDatabase)(nil).GetAll), ctx, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggleDatabase)(nil).GetAll), ctx, project, env, filter, page)
 }
 
 // GetByKey mocks base method.
-func (m *MockGetToggleDatabase) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
+func (m *MockGetToggleDatabase) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
 	ret0, _ := ret[0].(*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByKey indicates an expected call of GetByKey.
-func (mr *MockGetToggleDatabaseMockRecorder) GetByKey(ctx, key interface{}) *gomock.Call {
+func (mr *MockGetToggleDatabaseMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetToggleDatabase)(nil).GetByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetToggleDatabase)(nil).GetByKey), ctx, project, env, key)
 }
 
 // MockGetToggleCache is a mock of GetToggleCache interface.
@@ -90,18 +105,18 @@ func (m *MockGetToggleCache) EXPECT() *MockGetToggleCacheMockRecorder {
 }
 
 // Get mocks base method.
-func (m *MockGetToggleCache) Get(ctx context.Context, key string) (*entity.Toggle, error) {
+func (m *MockGetToggleCache) Get(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret := m.ctrl.Call(m, "Get", ctx, project, env, key)
 	ret0, _ := ret[0].(*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockGetToggleCacheMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+func (mr *MockGetToggleCacheMockRecorder) Get(ctx, project, env, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetToggleCache)(nil).Get), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGetToggleCache)(nil).Get), ctx, project, env, key)
 }
 
 // Set mocks base method.
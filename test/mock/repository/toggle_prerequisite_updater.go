@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/repository/toggle_prerequisite_updater.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdatePrerequisitesDatabase is a mock of UpdatePrerequisitesDatabase interface.
+type MockUpdatePrerequisitesDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePrerequisitesDatabaseMockRecorder
+}
+
+// MockUpdatePrerequisitesDatabaseMockRecorder is the mock recorder for MockUpdatePrerequisitesDatabase.
+type MockUpdatePrerequisitesDatabaseMockRecorder struct {
+	mock *MockUpdatePrerequisitesDatabase
+}
+
+// NewMockUpdatePrerequisitesDatabase creates a new mock instance.
+func NewMockUpdatePrerequisitesDatabase(ctrl *gomock.Controller) *MockUpdatePrerequisitesDatabase {
+	mock := &MockUpdatePrerequisitesDatabase{ctrl: ctrl}
+	mock.recorder = &MockUpdatePrerequisitesDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePrerequisitesDatabase) EXPECT() *MockUpdatePrerequisitesDatabaseMockRecorder {
+	return m.recorder
+}
+
+// GetAllByKey mocks base method.
+func (m *MockUpdatePrerequisitesDatabase) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockUpdatePrerequisitesDatabaseMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockUpdatePrerequisitesDatabase)(nil).GetAllByKey), ctx, project, key)
+}
+
+// UpdatePrerequisites mocks base method.
+func (m *MockUpdatePrerequisitesDatabase) UpdatePrerequisites(ctx context.Context, project, key string, prerequisites []*entity.Prerequisite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrerequisites", ctx, project, key, prerequisites)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrerequisites indicates an expected call of UpdatePrerequisites.
+func (mr *MockUpdatePrerequisitesDatabaseMockRecorder) UpdatePrerequisites(ctx, project, key, prerequisites interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrerequisites", reflect.TypeOf((*MockUpdatePrerequisitesDatabase)(nil).UpdatePrerequisites), ctx, project, key, prerequisites)
+}
+
+// MockUpdatePrerequisitesCache is a mock of UpdatePrerequisitesCache interface.
+type MockUpdatePrerequisitesCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePrerequisitesCacheMockRecorder
+}
+
+// MockUpdatePrerequisitesCacheMockRecorder is the mock recorder for MockUpdatePrerequisitesCache.
+type MockUpdatePrerequisitesCacheMockRecorder struct {
+	mock *MockUpdatePrerequisitesCache
+}
+
+// NewMockUpdatePrerequisitesCache creates a new mock instance.
+func NewMockUpdatePrerequisitesCache(ctrl *gomock.Controller) *MockUpdatePrerequisitesCache {
+	mock := &MockUpdatePrerequisitesCache{ctrl: ctrl}
+	mock.recorder = &MockUpdatePrerequisitesCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePrerequisitesCache) EXPECT() *MockUpdatePrerequisitesCacheMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUpdatePrerequisitesCache) Delete(ctx context.Context, project, env, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, project, env, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUpdatePrerequisitesCacheMockRecorder) Delete(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUpdatePrerequisitesCache)(nil).Delete), ctx, project, env, key)
+}
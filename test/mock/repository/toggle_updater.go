@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
 )
 
 // MockUpdateToggleDatabase is a mock of UpdateToggleDatabase interface.
@@ -34,18 +35,93 @@ func (m *MockUpdateToggleDatabase) EXPECT() *MockUpdateToggleDatabaseMockRecorde
 	return m.recorder
 }
 
-// UpdateIsEnabled mocks base method.
-func (m *MockUpdateToggleDatabase) UpdateIsEnabled(ctx context.Context, key string, value bool) error {
+// GetAllByKey mocks base method.
+func (m *MockUpdateToggleDatabase) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockUpdateToggleDatabaseMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).GetAllByKey), ctx, project, key)
+}
+
+// GetByKey mocks base method.
+func (m *MockUpdateToggleDatabase) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
+	ret0, _ := ret[0].(*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockUpdateToggleDatabaseMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).GetByKey), ctx, project, env, key)
+}
+
+// Update mocks base method.
+func (m *MockUpdateToggleDatabase) Update(ctx context.Context, toggle *entity.Toggle) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateIsEnabled", ctx, key, value)
+	ret := m.ctrl.Call(m, "Update", ctx, toggle)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Update indicates an expected call of Update.
+func (mr *MockUpdateToggleDatabaseMockRecorder) Update(ctx, toggle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).Update), ctx, toggle)
+}
+
+// UpdateIsEnabled mocks base method.
+func (m *MockUpdateToggleDatabase) UpdateIsEnabled(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIsEnabled", ctx, project, env, key, value, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateIsEnabled indicates an expected call of UpdateIsEnabled.
-func (mr *MockUpdateToggleDatabaseMockRecorder) UpdateIsEnabled(ctx, key, value interface{}) *gomock.Call {
+func (mr *MockUpdateToggleDatabaseMockRecorder) UpdateIsEnabled(ctx, project, env, key, value, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsEnabled", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).UpdateIsEnabled), ctx, project, env, key, value, version)
+}
+
+// UpdateRollout mocks base method.
+func (m *MockUpdateToggleDatabase) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRollout", ctx, project, env, key, rollout, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRollout indicates an expected call of UpdateRollout.
+func (mr *MockUpdateToggleDatabaseMockRecorder) UpdateRollout(ctx, project, env, key, rollout, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRollout", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).UpdateRollout), ctx, project, env, key, rollout, version)
+}
+
+// UpdateRules mocks base method.
+func (m *MockUpdateToggleDatabase) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRules", ctx, project, env, key, rules, defaultValue, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRules indicates an expected call of UpdateRules.
+func (mr *MockUpdateToggleDatabaseMockRecorder) UpdateRules(ctx, project, env, key, rules, defaultValue, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsEnabled", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).UpdateIsEnabled), ctx, key, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRules", reflect.TypeOf((*MockUpdateToggleDatabase)(nil).UpdateRules), ctx, project, env, key, rules, defaultValue, version)
 }
 
 // MockUpdateToggleCache is a mock of UpdateToggleCache interface.
@@ -71,16 +147,16 @@ func (m *MockUpdateToggleCache) EXPECT() *MockUpdateToggleCacheMockRecorder {
 	return m.recorder
 }
 
-// SetIsEnabled mocks base method.
-func (m *MockUpdateToggleCache) SetIsEnabled(ctx context.Context, key string, value bool) error {
+// Set mocks base method.
+func (m *MockUpdateToggleCache) Set(ctx context.Context, toggle *entity.Toggle) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetIsEnabled", ctx, key, value)
+	ret := m.ctrl.Call(m, "Set", ctx, toggle)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetIsEnabled indicates an expected call of SetIsEnabled.
-func (mr *MockUpdateToggleCacheMockRecorder) SetIsEnabled(ctx, key, value interface{}) *gomock.Call {
+// Set indicates an expected call of Set.
+func (mr *MockUpdateToggleCacheMockRecorder) Set(ctx, toggle interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsEnabled", reflect.TypeOf((*MockUpdateToggleCache)(nil).SetIsEnabled), ctx, key, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockUpdateToggleCache)(nil).Set), ctx, toggle)
 }
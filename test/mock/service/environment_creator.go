@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/environment_creator.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockCreateEnvironment is a mock of CreateEnvironment interface.
+type MockCreateEnvironment struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateEnvironmentMockRecorder
+}
+
+// MockCreateEnvironmentMockRecorder is the mock recorder for MockCreateEnvironment.
+type MockCreateEnvironmentMockRecorder struct {
+	mock *MockCreateEnvironment
+}
+
+// NewMockCreateEnvironment creates a new mock instance.
+func NewMockCreateEnvironment(ctrl *gomock.Controller) *MockCreateEnvironment {
+	mock := &MockCreateEnvironment{ctrl: ctrl}
+	mock.recorder = &MockCreateEnvironmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateEnvironment) EXPECT() *MockCreateEnvironmentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateEnvironment) Create(ctx context.Context, env *entity.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateEnvironmentMockRecorder) Create(ctx, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateEnvironment)(nil).Create), ctx, env)
+}
+
+// MockCreateEnvironmentRepository is a mock of CreateEnvironmentRepository interface.
+type MockCreateEnvironmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateEnvironmentRepositoryMockRecorder
+}
+
+// MockCreateEnvironmentRepositoryMockRecorder is the mock recorder for MockCreateEnvironmentRepository.
+type MockCreateEnvironmentRepositoryMockRecorder struct {
+	mock *MockCreateEnvironmentRepository
+}
+
+// NewMockCreateEnvironmentRepository creates a new mock instance.
+func NewMockCreateEnvironmentRepository(ctrl *gomock.Controller) *MockCreateEnvironmentRepository {
+	mock := &MockCreateEnvironmentRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateEnvironmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateEnvironmentRepository) EXPECT() *MockCreateEnvironmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateEnvironmentRepository) Insert(ctx context.Context, env *entity.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateEnvironmentRepositoryMockRecorder) Insert(ctx, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateEnvironmentRepository)(nil).Insert), ctx, env)
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/environment_getter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockGetEnvironment is a mock of GetEnvironment interface.
+type MockGetEnvironment struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetEnvironmentMockRecorder
+}
+
+// MockGetEnvironmentMockRecorder is the mock recorder for MockGetEnvironment.
+type MockGetEnvironmentMockRecorder struct {
+	mock *MockGetEnvironment
+}
+
+// NewMockGetEnvironment creates a new mock instance.
+func NewMockGetEnvironment(ctrl *gomock.Controller) *MockGetEnvironment {
+	mock := &MockGetEnvironment{ctrl: ctrl}
+	mock.recorder = &MockGetEnvironmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetEnvironment) EXPECT() *MockGetEnvironmentMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetEnvironment) GetAll(ctx context.Context) ([]*entity.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entity.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetEnvironmentMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetEnvironment)(nil).GetAll), ctx)
+}
+
+// MockGetEnvironmentRepository is a mock of GetEnvironmentRepository interface.
+type MockGetEnvironmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetEnvironmentRepositoryMockRecorder
+}
+
+// MockGetEnvironmentRepositoryMockRecorder is the mock recorder for MockGetEnvironmentRepository.
+type MockGetEnvironmentRepositoryMockRecorder struct {
+	mock *MockGetEnvironmentRepository
+}
+
+// NewMockGetEnvironmentRepository creates a new mock instance.
+func NewMockGetEnvironmentRepository(ctrl *gomock.Controller) *MockGetEnvironmentRepository {
+	mock := &MockGetEnvironmentRepository{ctrl: ctrl}
+	mock.recorder = &MockGetEnvironmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetEnvironmentRepository) EXPECT() *MockGetEnvironmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetEnvironmentRepository) GetAll(ctx context.Context) ([]*entity.Environment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entity.Environment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetEnvironmentRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetEnvironmentRepository)(nil).GetAll), ctx)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/evaluation_reporter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockReportEvaluation is a mock of ReportEvaluation interface.
+type MockReportEvaluation struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportEvaluationMockRecorder
+}
+
+// MockReportEvaluationMockRecorder is the mock recorder for MockReportEvaluation.
+type MockReportEvaluationMockRecorder struct {
+	mock *MockReportEvaluation
+}
+
+// NewMockReportEvaluation creates a new mock instance.
+func NewMockReportEvaluation(ctrl *gomock.Controller) *MockReportEvaluation {
+	mock := &MockReportEvaluation{ctrl: ctrl}
+	mock.recorder = &MockReportEvaluationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportEvaluation) EXPECT() *MockReportEvaluationMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockReportEvaluation) Report(ctx context.Context, project, env string, counts []*entity.EvaluationCount) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, project, env, counts)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockReportEvaluationMockRecorder) Report(ctx, project, env, counts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockReportEvaluation)(nil).Report), ctx, project, env, counts)
+}
+
+// MockReportEvaluationRepository is a mock of ReportEvaluationRepository interface.
+type MockReportEvaluationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportEvaluationRepositoryMockRecorder
+}
+
+// MockReportEvaluationRepositoryMockRecorder is the mock recorder for MockReportEvaluationRepository.
+type MockReportEvaluationRepositoryMockRecorder struct {
+	mock *MockReportEvaluationRepository
+}
+
+// NewMockReportEvaluationRepository creates a new mock instance.
+func NewMockReportEvaluationRepository(ctrl *gomock.Controller) *MockReportEvaluationRepository {
+	mock := &MockReportEvaluationRepository{ctrl: ctrl}
+	mock.recorder = &MockReportEvaluationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportEvaluationRepository) EXPECT() *MockReportEvaluationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Increment mocks base method.
+func (m *MockReportEvaluationRepository) Increment(ctx context.Context, project, env string, counts []*entity.EvaluationCount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, project, env, counts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockReportEvaluationRepositoryMockRecorder) Increment(ctx, project, env, counts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockReportEvaluationRepository)(nil).Increment), ctx, project, env, counts)
+}
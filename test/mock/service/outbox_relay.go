@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/outbox_relay.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+	togglev1 "github.com/indrasaputra/toggle/proto/indrasaputra/toggle/v1"
+)
+
+// MockTogglePublisher is a mock of TogglePublisher interface.
+type MockTogglePublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockTogglePublisherMockRecorder
+}
+
+// MockTogglePublisherMockRecorder is the mock recorder for MockTogglePublisher.
+type MockTogglePublisherMockRecorder struct {
+	mock *MockTogglePublisher
+}
+
+// NewMockTogglePublisher creates a new mock instance.
+func NewMockTogglePublisher(ctrl *gomock.Controller) *MockTogglePublisher {
+	mock := &MockTogglePublisher{ctrl: ctrl}
+	mock.recorder = &MockTogglePublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTogglePublisher) EXPECT() *MockTogglePublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockTogglePublisher) Publish(ctx context.Context, event *togglev1.ToggleEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockTogglePublisherMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockTogglePublisher)(nil).Publish), ctx, event)
+}
+
+// MockRelayOutbox is a mock of RelayOutbox interface.
+type MockRelayOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayOutboxMockRecorder
+}
+
+// MockRelayOutboxMockRecorder is the mock recorder for MockRelayOutbox.
+type MockRelayOutboxMockRecorder struct {
+	mock *MockRelayOutbox
+}
+
+// NewMockRelayOutbox creates a new mock instance.
+func NewMockRelayOutbox(ctrl *gomock.Controller) *MockRelayOutbox {
+	mock := &MockRelayOutbox{ctrl: ctrl}
+	mock.recorder = &MockRelayOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayOutbox) EXPECT() *MockRelayOutboxMockRecorder {
+	return m.recorder
+}
+
+// Relay mocks base method.
+func (m *MockRelayOutbox) Relay(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Relay", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relay indicates an expected call of Relay.
+func (mr *MockRelayOutboxMockRecorder) Relay(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockRelayOutbox)(nil).Relay), ctx)
+}
+
+// MockRelayOutboxRepository is a mock of RelayOutboxRepository interface.
+type MockRelayOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayOutboxRepositoryMockRecorder
+}
+
+// MockRelayOutboxRepositoryMockRecorder is the mock recorder for MockRelayOutboxRepository.
+type MockRelayOutboxRepositoryMockRecorder struct {
+	mock *MockRelayOutboxRepository
+}
+
+// NewMockRelayOutboxRepository creates a new mock instance.
+func NewMockRelayOutboxRepository(ctrl *gomock.Controller) *MockRelayOutboxRepository {
+	mock := &MockRelayOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockRelayOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayOutboxRepository) EXPECT() *MockRelayOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimPending mocks base method.
+func (m *MockRelayOutboxRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPending", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPending indicates an expected call of ClaimPending.
+func (mr *MockRelayOutboxRepositoryMockRecorder) ClaimPending(ctx, now, lease, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPending", reflect.TypeOf((*MockRelayOutboxRepository)(nil).ClaimPending), ctx, now, lease, limit)
+}
+
+// DeadLetter mocks base method.
+func (m *MockRelayOutboxRepository) DeadLetter(ctx context.Context, id int64, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", ctx, id, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockRelayOutboxRepositoryMockRecorder) DeadLetter(ctx, id, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockRelayOutboxRepository)(nil).DeadLetter), ctx, id, reason)
+}
+
+// Delete mocks base method.
+func (m *MockRelayOutboxRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRelayOutboxRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRelayOutboxRepository)(nil).Delete), ctx, id)
+}
+
+// Release mocks base method.
+func (m *MockRelayOutboxRepository) Release(ctx context.Context, id int64, availableAt time.Time, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, id, availableAt, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockRelayOutboxRepositoryMockRecorder) Release(ctx, id, availableAt, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockRelayOutboxRepository)(nil).Release), ctx, id, availableAt, reason)
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/prerequisite.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockPrerequisiteRepository is a mock of PrerequisiteRepository interface.
+type MockPrerequisiteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrerequisiteRepositoryMockRecorder
+}
+
+// MockPrerequisiteRepositoryMockRecorder is the mock recorder for MockPrerequisiteRepository.
+type MockPrerequisiteRepositoryMockRecorder struct {
+	mock *MockPrerequisiteRepository
+}
+
+// NewMockPrerequisiteRepository creates a new mock instance.
+func NewMockPrerequisiteRepository(ctrl *gomock.Controller) *MockPrerequisiteRepository {
+	mock := &MockPrerequisiteRepository{ctrl: ctrl}
+	mock.recorder = &MockPrerequisiteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrerequisiteRepository) EXPECT() *MockPrerequisiteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllPrerequisites mocks base method.
+func (m *MockPrerequisiteRepository) GetAllPrerequisites(ctx context.Context, project string) (map[string][]*entity.Prerequisite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPrerequisites", ctx, project)
+	ret0, _ := ret[0].(map[string][]*entity.Prerequisite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPrerequisites indicates an expected call of GetAllPrerequisites.
+func (mr *MockPrerequisiteRepositoryMockRecorder) GetAllPrerequisites(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPrerequisites", reflect.TypeOf((*MockPrerequisiteRepository)(nil).GetAllPrerequisites), ctx, project)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/project_creator.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockCreateProject is a mock of CreateProject interface.
+type MockCreateProject struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateProjectMockRecorder
+}
+
+// MockCreateProjectMockRecorder is the mock recorder for MockCreateProject.
+type MockCreateProjectMockRecorder struct {
+	mock *MockCreateProject
+}
+
+// NewMockCreateProject creates a new mock instance.
+func NewMockCreateProject(ctrl *gomock.Controller) *MockCreateProject {
+	mock := &MockCreateProject{ctrl: ctrl}
+	mock.recorder = &MockCreateProjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateProject) EXPECT() *MockCreateProjectMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateProject) Create(ctx context.Context, project *entity.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateProjectMockRecorder) Create(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateProject)(nil).Create), ctx, project)
+}
+
+// MockCreateProjectRepository is a mock of CreateProjectRepository interface.
+type MockCreateProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateProjectRepositoryMockRecorder
+}
+
+// MockCreateProjectRepositoryMockRecorder is the mock recorder for MockCreateProjectRepository.
+type MockCreateProjectRepositoryMockRecorder struct {
+	mock *MockCreateProjectRepository
+}
+
+// NewMockCreateProjectRepository creates a new mock instance.
+func NewMockCreateProjectRepository(ctrl *gomock.Controller) *MockCreateProjectRepository {
+	mock := &MockCreateProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateProjectRepository) EXPECT() *MockCreateProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateProjectRepository) Insert(ctx context.Context, project *entity.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateProjectRepositoryMockRecorder) Insert(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateProjectRepository)(nil).Insert), ctx, project)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/project_deleter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeleteProject is a mock of DeleteProject interface.
+type MockDeleteProject struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteProjectMockRecorder
+}
+
+// MockDeleteProjectMockRecorder is the mock recorder for MockDeleteProject.
+type MockDeleteProjectMockRecorder struct {
+	mock *MockDeleteProject
+}
+
+// NewMockDeleteProject creates a new mock instance.
+func NewMockDeleteProject(ctrl *gomock.Controller) *MockDeleteProject {
+	mock := &MockDeleteProject{ctrl: ctrl}
+	mock.recorder = &MockDeleteProjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteProject) EXPECT() *MockDeleteProjectMockRecorder {
+	return m.recorder
+}
+
+// DeleteByName mocks base method.
+func (m *MockDeleteProject) DeleteByName(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByName", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByName indicates an expected call of DeleteByName.
+func (mr *MockDeleteProjectMockRecorder) DeleteByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByName", reflect.TypeOf((*MockDeleteProject)(nil).DeleteByName), ctx, name)
+}
+
+// MockDeleteProjectRepository is a mock of DeleteProjectRepository interface.
+type MockDeleteProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteProjectRepositoryMockRecorder
+}
+
+// MockDeleteProjectRepositoryMockRecorder is the mock recorder for MockDeleteProjectRepository.
+type MockDeleteProjectRepositoryMockRecorder struct {
+	mock *MockDeleteProjectRepository
+}
+
+// NewMockDeleteProjectRepository creates a new mock instance.
+func NewMockDeleteProjectRepository(ctrl *gomock.Controller) *MockDeleteProjectRepository {
+	mock := &MockDeleteProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockDeleteProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteProjectRepository) EXPECT() *MockDeleteProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByName mocks base method.
+func (m *MockDeleteProjectRepository) DeleteByName(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByName", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByName indicates an expected call of DeleteByName.
+func (mr *MockDeleteProjectRepositoryMockRecorder) DeleteByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByName", reflect.TypeOf((*MockDeleteProjectRepository)(nil).DeleteByName), ctx, name)
+}
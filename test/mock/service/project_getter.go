@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/project_getter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockGetProject is a mock of GetProject interface.
+type MockGetProject struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetProjectMockRecorder
+}
+
+// MockGetProjectMockRecorder is the mock recorder for MockGetProject.
+type MockGetProjectMockRecorder struct {
+	mock *MockGetProject
+}
+
+// NewMockGetProject creates a new mock instance.
+func NewMockGetProject(ctrl *gomock.Controller) *MockGetProject {
+	mock := &MockGetProject{ctrl: ctrl}
+	mock.recorder = &MockGetProjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetProject) EXPECT() *MockGetProjectMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetProject) GetAll(ctx context.Context) ([]*entity.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entity.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetProjectMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetProject)(nil).GetAll), ctx)
+}
+
+// GetByName mocks base method.
+func (m *MockGetProject) GetByName(ctx context.Context, name string) (*entity.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*entity.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockGetProjectMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockGetProject)(nil).GetByName), ctx, name)
+}
+
+// MockGetProjectRepository is a mock of GetProjectRepository interface.
+type MockGetProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetProjectRepositoryMockRecorder
+}
+
+// MockGetProjectRepositoryMockRecorder is the mock recorder for MockGetProjectRepository.
+type MockGetProjectRepositoryMockRecorder struct {
+	mock *MockGetProjectRepository
+}
+
+// NewMockGetProjectRepository creates a new mock instance.
+func NewMockGetProjectRepository(ctrl *gomock.Controller) *MockGetProjectRepository {
+	mock := &MockGetProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockGetProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetProjectRepository) EXPECT() *MockGetProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetProjectRepository) GetAll(ctx context.Context) ([]*entity.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entity.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetProjectRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetProjectRepository)(nil).GetAll), ctx)
+}
+
+// GetByName mocks base method.
+func (m *MockGetProjectRepository) GetByName(ctx context.Context, name string) (*entity.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*entity.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName.
+func (mr *MockGetProjectRepositoryMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockGetProjectRepository)(nil).GetByName), ctx, name)
+}
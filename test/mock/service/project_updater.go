@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/project_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdateProject is a mock of UpdateProject interface.
+type MockUpdateProject struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateProjectMockRecorder
+}
+
+// MockUpdateProjectMockRecorder is the mock recorder for MockUpdateProject.
+type MockUpdateProjectMockRecorder struct {
+	mock *MockUpdateProject
+}
+
+// NewMockUpdateProject creates a new mock instance.
+func NewMockUpdateProject(ctrl *gomock.Controller) *MockUpdateProject {
+	mock := &MockUpdateProject{ctrl: ctrl}
+	mock.recorder = &MockUpdateProjectMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateProject) EXPECT() *MockUpdateProjectMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateProject) Update(ctx context.Context, project *entity.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateProjectMockRecorder) Update(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateProject)(nil).Update), ctx, project)
+}
+
+// MockUpdateProjectRepository is a mock of UpdateProjectRepository interface.
+type MockUpdateProjectRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateProjectRepositoryMockRecorder
+}
+
+// MockUpdateProjectRepositoryMockRecorder is the mock recorder for MockUpdateProjectRepository.
+type MockUpdateProjectRepositoryMockRecorder struct {
+	mock *MockUpdateProjectRepository
+}
+
+// NewMockUpdateProjectRepository creates a new mock instance.
+func NewMockUpdateProjectRepository(ctrl *gomock.Controller) *MockUpdateProjectRepository {
+	mock := &MockUpdateProjectRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateProjectRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateProjectRepository) EXPECT() *MockUpdateProjectRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateProjectRepository) Update(ctx context.Context, project *entity.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, project)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateProjectRepositoryMockRecorder) Update(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateProjectRepository)(nil).Update), ctx, project)
+}
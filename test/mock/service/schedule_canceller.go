@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/schedule_canceller.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCancelSchedule is a mock of CancelSchedule interface.
+type MockCancelSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelScheduleMockRecorder
+}
+
+// MockCancelScheduleMockRecorder is the mock recorder for MockCancelSchedule.
+type MockCancelScheduleMockRecorder struct {
+	mock *MockCancelSchedule
+}
+
+// NewMockCancelSchedule creates a new mock instance.
+func NewMockCancelSchedule(ctrl *gomock.Controller) *MockCancelSchedule {
+	mock := &MockCancelSchedule{ctrl: ctrl}
+	mock.recorder = &MockCancelScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelSchedule) EXPECT() *MockCancelScheduleMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockCancelSchedule) Cancel(ctx context.Context, project, env, key string, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, project, env, key, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockCancelScheduleMockRecorder) Cancel(ctx, project, env, key, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockCancelSchedule)(nil).Cancel), ctx, project, env, key, id)
+}
+
+// MockCancelScheduleRepository is a mock of CancelScheduleRepository interface.
+type MockCancelScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCancelScheduleRepositoryMockRecorder
+}
+
+// MockCancelScheduleRepositoryMockRecorder is the mock recorder for MockCancelScheduleRepository.
+type MockCancelScheduleRepositoryMockRecorder struct {
+	mock *MockCancelScheduleRepository
+}
+
+// NewMockCancelScheduleRepository creates a new mock instance.
+func NewMockCancelScheduleRepository(ctrl *gomock.Controller) *MockCancelScheduleRepository {
+	mock := &MockCancelScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockCancelScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCancelScheduleRepository) EXPECT() *MockCancelScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeletePending mocks base method.
+func (m *MockCancelScheduleRepository) DeletePending(ctx context.Context, project, env, key string, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePending", ctx, project, env, key, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePending indicates an expected call of DeletePending.
+func (mr *MockCancelScheduleRepositoryMockRecorder) DeletePending(ctx, project, env, key, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePending", reflect.TypeOf((*MockCancelScheduleRepository)(nil).DeletePending), ctx, project, env, key, id)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/schedule_creator.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockCreateSchedule is a mock of CreateSchedule interface.
+type MockCreateSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateScheduleMockRecorder
+}
+
+// MockCreateScheduleMockRecorder is the mock recorder for MockCreateSchedule.
+type MockCreateScheduleMockRecorder struct {
+	mock *MockCreateSchedule
+}
+
+// NewMockCreateSchedule creates a new mock instance.
+func NewMockCreateSchedule(ctrl *gomock.Controller) *MockCreateSchedule {
+	mock := &MockCreateSchedule{ctrl: ctrl}
+	mock.recorder = &MockCreateScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSchedule) EXPECT() *MockCreateScheduleMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateSchedule) Create(ctx context.Context, schedule *entity.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateScheduleMockRecorder) Create(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateSchedule)(nil).Create), ctx, schedule)
+}
+
+// MockCreateScheduleRepository is a mock of CreateScheduleRepository interface.
+type MockCreateScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateScheduleRepositoryMockRecorder
+}
+
+// MockCreateScheduleRepositoryMockRecorder is the mock recorder for MockCreateScheduleRepository.
+type MockCreateScheduleRepositoryMockRecorder struct {
+	mock *MockCreateScheduleRepository
+}
+
+// NewMockCreateScheduleRepository creates a new mock instance.
+func NewMockCreateScheduleRepository(ctrl *gomock.Controller) *MockCreateScheduleRepository {
+	mock := &MockCreateScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateScheduleRepository) EXPECT() *MockCreateScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateScheduleRepository) Insert(ctx context.Context, schedule *entity.Schedule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateScheduleRepositoryMockRecorder) Insert(ctx, schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateScheduleRepository)(nil).Insert), ctx, schedule)
+}
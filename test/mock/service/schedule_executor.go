@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/schedule_executor.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockExecuteSchedule is a mock of ExecuteSchedule interface.
+type MockExecuteSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecuteScheduleMockRecorder
+}
+
+// MockExecuteScheduleMockRecorder is the mock recorder for MockExecuteSchedule.
+type MockExecuteScheduleMockRecorder struct {
+	mock *MockExecuteSchedule
+}
+
+// NewMockExecuteSchedule creates a new mock instance.
+func NewMockExecuteSchedule(ctrl *gomock.Controller) *MockExecuteSchedule {
+	mock := &MockExecuteSchedule{ctrl: ctrl}
+	mock.recorder = &MockExecuteScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecuteSchedule) EXPECT() *MockExecuteScheduleMockRecorder {
+	return m.recorder
+}
+
+// ExecuteDue mocks base method.
+func (m *MockExecuteSchedule) ExecuteDue(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteDue", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteDue indicates an expected call of ExecuteDue.
+func (mr *MockExecuteScheduleMockRecorder) ExecuteDue(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDue", reflect.TypeOf((*MockExecuteSchedule)(nil).ExecuteDue), ctx)
+}
+
+// MockExecuteScheduleRepository is a mock of ExecuteScheduleRepository interface.
+type MockExecuteScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExecuteScheduleRepositoryMockRecorder
+}
+
+// MockExecuteScheduleRepositoryMockRecorder is the mock recorder for MockExecuteScheduleRepository.
+type MockExecuteScheduleRepositoryMockRecorder struct {
+	mock *MockExecuteScheduleRepository
+}
+
+// NewMockExecuteScheduleRepository creates a new mock instance.
+func NewMockExecuteScheduleRepository(ctrl *gomock.Controller) *MockExecuteScheduleRepository {
+	mock := &MockExecuteScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockExecuteScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExecuteScheduleRepository) EXPECT() *MockExecuteScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockExecuteScheduleRepository) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockExecuteScheduleRepositoryMockRecorder) ClaimDue(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockExecuteScheduleRepository)(nil).ClaimDue), ctx, now, limit)
+}
+
+// MarkFailed mocks base method.
+func (m *MockExecuteScheduleRepository) MarkFailed(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkFailed", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkFailed indicates an expected call of MarkFailed.
+func (mr *MockExecuteScheduleRepositoryMockRecorder) MarkFailed(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkFailed", reflect.TypeOf((*MockExecuteScheduleRepository)(nil).MarkFailed), ctx, id)
+}
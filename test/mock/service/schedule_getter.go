@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/schedule_getter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockGetSchedule is a mock of GetSchedule interface.
+type MockGetSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetScheduleMockRecorder
+}
+
+// MockGetScheduleMockRecorder is the mock recorder for MockGetSchedule.
+type MockGetScheduleMockRecorder struct {
+	mock *MockGetSchedule
+}
+
+// NewMockGetSchedule creates a new mock instance.
+func NewMockGetSchedule(ctrl *gomock.Controller) *MockGetSchedule {
+	mock := &MockGetSchedule{ctrl: ctrl}
+	mock.recorder = &MockGetScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSchedule) EXPECT() *MockGetScheduleMockRecorder {
+	return m.recorder
+}
+
+// GetAllPending mocks base method.
+func (m *MockGetSchedule) GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPending", ctx, project, env, key)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPending indicates an expected call of GetAllPending.
+func (mr *MockGetScheduleMockRecorder) GetAllPending(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPending", reflect.TypeOf((*MockGetSchedule)(nil).GetAllPending), ctx, project, env, key)
+}
+
+// MockGetScheduleRepository is a mock of GetScheduleRepository interface.
+type MockGetScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetScheduleRepositoryMockRecorder
+}
+
+// MockGetScheduleRepositoryMockRecorder is the mock recorder for MockGetScheduleRepository.
+type MockGetScheduleRepositoryMockRecorder struct {
+	mock *MockGetScheduleRepository
+}
+
+// NewMockGetScheduleRepository creates a new mock instance.
+func NewMockGetScheduleRepository(ctrl *gomock.Controller) *MockGetScheduleRepository {
+	mock := &MockGetScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockGetScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetScheduleRepository) EXPECT() *MockGetScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllPending mocks base method.
+func (m *MockGetScheduleRepository) GetAllPending(ctx context.Context, project, env, key string) ([]*entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPending", ctx, project, env, key)
+	ret0, _ := ret[0].([]*entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPending indicates an expected call of GetAllPending.
+func (mr *MockGetScheduleRepositoryMockRecorder) GetAllPending(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPending", reflect.TypeOf((*MockGetScheduleRepository)(nil).GetAllPending), ctx, project, env, key)
+}
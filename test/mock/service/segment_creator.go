@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/segment_creator.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockCreateSegment is a mock of CreateSegment interface.
+type MockCreateSegment struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSegmentMockRecorder
+}
+
+// MockCreateSegmentMockRecorder is the mock recorder for MockCreateSegment.
+type MockCreateSegmentMockRecorder struct {
+	mock *MockCreateSegment
+}
+
+// NewMockCreateSegment creates a new mock instance.
+func NewMockCreateSegment(ctrl *gomock.Controller) *MockCreateSegment {
+	mock := &MockCreateSegment{ctrl: ctrl}
+	mock.recorder = &MockCreateSegmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSegment) EXPECT() *MockCreateSegmentMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCreateSegment) Create(ctx context.Context, segment *entity.Segment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, segment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCreateSegmentMockRecorder) Create(ctx, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCreateSegment)(nil).Create), ctx, segment)
+}
+
+// MockCreateSegmentRepository is a mock of CreateSegmentRepository interface.
+type MockCreateSegmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateSegmentRepositoryMockRecorder
+}
+
+// MockCreateSegmentRepositoryMockRecorder is the mock recorder for MockCreateSegmentRepository.
+type MockCreateSegmentRepositoryMockRecorder struct {
+	mock *MockCreateSegmentRepository
+}
+
+// NewMockCreateSegmentRepository creates a new mock instance.
+func NewMockCreateSegmentRepository(ctrl *gomock.Controller) *MockCreateSegmentRepository {
+	mock := &MockCreateSegmentRepository{ctrl: ctrl}
+	mock.recorder = &MockCreateSegmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateSegmentRepository) EXPECT() *MockCreateSegmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockCreateSegmentRepository) Insert(ctx context.Context, segment *entity.Segment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, segment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockCreateSegmentRepositoryMockRecorder) Insert(ctx, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockCreateSegmentRepository)(nil).Insert), ctx, segment)
+}
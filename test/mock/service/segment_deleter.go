@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/segment_deleter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDeleteSegment is a mock of DeleteSegment interface.
+type MockDeleteSegment struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSegmentMockRecorder
+}
+
+// MockDeleteSegmentMockRecorder is the mock recorder for MockDeleteSegment.
+type MockDeleteSegmentMockRecorder struct {
+	mock *MockDeleteSegment
+}
+
+// NewMockDeleteSegment creates a new mock instance.
+func NewMockDeleteSegment(ctrl *gomock.Controller) *MockDeleteSegment {
+	mock := &MockDeleteSegment{ctrl: ctrl}
+	mock.recorder = &MockDeleteSegmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSegment) EXPECT() *MockDeleteSegmentMockRecorder {
+	return m.recorder
+}
+
+// DeleteByKey mocks base method.
+func (m *MockDeleteSegment) DeleteByKey(ctx context.Context, project, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, project, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockDeleteSegmentMockRecorder) DeleteByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockDeleteSegment)(nil).DeleteByKey), ctx, project, key)
+}
+
+// MockDeleteSegmentRepository is a mock of DeleteSegmentRepository interface.
+type MockDeleteSegmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteSegmentRepositoryMockRecorder
+}
+
+// MockDeleteSegmentRepositoryMockRecorder is the mock recorder for MockDeleteSegmentRepository.
+type MockDeleteSegmentRepositoryMockRecorder struct {
+	mock *MockDeleteSegmentRepository
+}
+
+// NewMockDeleteSegmentRepository creates a new mock instance.
+func NewMockDeleteSegmentRepository(ctrl *gomock.Controller) *MockDeleteSegmentRepository {
+	mock := &MockDeleteSegmentRepository{ctrl: ctrl}
+	mock.recorder = &MockDeleteSegmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteSegmentRepository) EXPECT() *MockDeleteSegmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteByKey mocks base method.
+func (m *MockDeleteSegmentRepository) DeleteByKey(ctx context.Context, project, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, project, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockDeleteSegmentRepositoryMockRecorder) DeleteByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockDeleteSegmentRepository)(nil).DeleteByKey), ctx, project, key)
+}
+
+// IsReferenced mocks base method.
+func (m *MockDeleteSegmentRepository) IsReferenced(ctx context.Context, project, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsReferenced", ctx, project, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsReferenced indicates an expected call of IsReferenced.
+func (mr *MockDeleteSegmentRepositoryMockRecorder) IsReferenced(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReferenced", reflect.TypeOf((*MockDeleteSegmentRepository)(nil).IsReferenced), ctx, project, key)
+}
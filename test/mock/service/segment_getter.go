@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/segment_getter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockGetSegment is a mock of GetSegment interface.
+type MockGetSegment struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSegmentMockRecorder
+}
+
+// MockGetSegmentMockRecorder is the mock recorder for MockGetSegment.
+type MockGetSegmentMockRecorder struct {
+	mock *MockGetSegment
+}
+
+// NewMockGetSegment creates a new mock instance.
+func NewMockGetSegment(ctrl *gomock.Controller) *MockGetSegment {
+	mock := &MockGetSegment{ctrl: ctrl}
+	mock.recorder = &MockGetSegmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSegment) EXPECT() *MockGetSegmentMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetSegment) GetAll(ctx context.Context, project string) ([]*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project)
+	ret0, _ := ret[0].([]*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetSegmentMockRecorder) GetAll(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetSegment)(nil).GetAll), ctx, project)
+}
+
+// GetByKey mocks base method.
+func (m *MockGetSegment) GetByKey(ctx context.Context, project, key string) (*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, key)
+	ret0, _ := ret[0].(*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockGetSegmentMockRecorder) GetByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetSegment)(nil).GetByKey), ctx, project, key)
+}
+
+// MockGetSegmentRepository is a mock of GetSegmentRepository interface.
+type MockGetSegmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSegmentRepositoryMockRecorder
+}
+
+// MockGetSegmentRepositoryMockRecorder is the mock recorder for MockGetSegmentRepository.
+type MockGetSegmentRepositoryMockRecorder struct {
+	mock *MockGetSegmentRepository
+}
+
+// NewMockGetSegmentRepository creates a new mock instance.
+func NewMockGetSegmentRepository(ctrl *gomock.Controller) *MockGetSegmentRepository {
+	mock := &MockGetSegmentRepository{ctrl: ctrl}
+	mock.recorder = &MockGetSegmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSegmentRepository) EXPECT() *MockGetSegmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetSegmentRepository) GetAll(ctx context.Context, project string) ([]*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project)
+	ret0, _ := ret[0].([]*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetSegmentRepositoryMockRecorder) GetAll(ctx, project interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetSegmentRepository)(nil).GetAll), ctx, project)
+}
+
+// GetByKey mocks base method.
+func (m *MockGetSegmentRepository) GetByKey(ctx context.Context, project, key string) (*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, key)
+	ret0, _ := ret[0].(*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockGetSegmentRepositoryMockRecorder) GetByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetSegmentRepository)(nil).GetByKey), ctx, project, key)
+}
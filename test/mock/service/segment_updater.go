@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/segment_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdateSegment is a mock of UpdateSegment interface.
+type MockUpdateSegment struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateSegmentMockRecorder
+}
+
+// MockUpdateSegmentMockRecorder is the mock recorder for MockUpdateSegment.
+type MockUpdateSegmentMockRecorder struct {
+	mock *MockUpdateSegment
+}
+
+// NewMockUpdateSegment creates a new mock instance.
+func NewMockUpdateSegment(ctrl *gomock.Controller) *MockUpdateSegment {
+	mock := &MockUpdateSegment{ctrl: ctrl}
+	mock.recorder = &MockUpdateSegmentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateSegment) EXPECT() *MockUpdateSegmentMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateSegment) Update(ctx context.Context, segment *entity.Segment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, segment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateSegmentMockRecorder) Update(ctx, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateSegment)(nil).Update), ctx, segment)
+}
+
+// MockUpdateSegmentRepository is a mock of UpdateSegmentRepository interface.
+type MockUpdateSegmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateSegmentRepositoryMockRecorder
+}
+
+// MockUpdateSegmentRepositoryMockRecorder is the mock recorder for MockUpdateSegmentRepository.
+type MockUpdateSegmentRepositoryMockRecorder struct {
+	mock *MockUpdateSegmentRepository
+}
+
+// NewMockUpdateSegmentRepository creates a new mock instance.
+func NewMockUpdateSegmentRepository(ctrl *gomock.Controller) *MockUpdateSegmentRepository {
+	mock := &MockUpdateSegmentRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateSegmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateSegmentRepository) EXPECT() *MockUpdateSegmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateSegmentRepository) Update(ctx context.Context, segment *entity.Segment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, segment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateSegmentRepositoryMockRecorder) Update(ctx, segment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateSegmentRepository)(nil).Update), ctx, segment)
+}
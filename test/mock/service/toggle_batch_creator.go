@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_batch_creator.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockBatchCreateToggle is a mock of BatchCreateToggle interface.
+type MockBatchCreateToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchCreateToggleMockRecorder
+}
+
+// MockBatchCreateToggleMockRecorder is the mock recorder for MockBatchCreateToggle.
+type MockBatchCreateToggleMockRecorder struct {
+	mock *MockBatchCreateToggle
+}
+
+// NewMockBatchCreateToggle creates a new mock instance.
+func NewMockBatchCreateToggle(ctrl *gomock.Controller) *MockBatchCreateToggle {
+	mock := &MockBatchCreateToggle{ctrl: ctrl}
+	mock.recorder = &MockBatchCreateToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchCreateToggle) EXPECT() *MockBatchCreateToggleMockRecorder {
+	return m.recorder
+}
+
+// CreateAll mocks base method.
+func (m *MockBatchCreateToggle) CreateAll(ctx context.Context, project, env string, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, project, env, toggles, atomic)
+	ret0, _ := ret[0].([]*entity.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockBatchCreateToggleMockRecorder) CreateAll(ctx, project, env, toggles, atomic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockBatchCreateToggle)(nil).CreateAll), ctx, project, env, toggles, atomic)
+}
+
+// MockBatchCreateToggleRepository is a mock of BatchCreateToggleRepository interface.
+type MockBatchCreateToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchCreateToggleRepositoryMockRecorder
+}
+
+// MockBatchCreateToggleRepositoryMockRecorder is the mock recorder for MockBatchCreateToggleRepository.
+type MockBatchCreateToggleRepositoryMockRecorder struct {
+	mock *MockBatchCreateToggleRepository
+}
+
+// NewMockBatchCreateToggleRepository creates a new mock instance.
+func NewMockBatchCreateToggleRepository(ctrl *gomock.Controller) *MockBatchCreateToggleRepository {
+	mock := &MockBatchCreateToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockBatchCreateToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchCreateToggleRepository) EXPECT() *MockBatchCreateToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// InsertAll mocks base method.
+func (m *MockBatchCreateToggleRepository) InsertAll(ctx context.Context, toggles []*entity.Toggle, atomic bool) ([]*entity.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAll", ctx, toggles, atomic)
+	ret0, _ := ret[0].([]*entity.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAll indicates an expected call of InsertAll.
+func (mr *MockBatchCreateToggleRepositoryMockRecorder) InsertAll(ctx, toggles, atomic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAll", reflect.TypeOf((*MockBatchCreateToggleRepository)(nil).InsertAll), ctx, toggles, atomic)
+}
@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_batch_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockBatchUpdateToggle is a mock of BatchUpdateToggle interface.
+type MockBatchUpdateToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchUpdateToggleMockRecorder
+}
+
+// MockBatchUpdateToggleMockRecorder is the mock recorder for MockBatchUpdateToggle.
+type MockBatchUpdateToggleMockRecorder struct {
+	mock *MockBatchUpdateToggle
+}
+
+// NewMockBatchUpdateToggle creates a new mock instance.
+func NewMockBatchUpdateToggle(ctrl *gomock.Controller) *MockBatchUpdateToggle {
+	mock := &MockBatchUpdateToggle{ctrl: ctrl}
+	mock.recorder = &MockBatchUpdateToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchUpdateToggle) EXPECT() *MockBatchUpdateToggleMockRecorder {
+	return m.recorder
+}
+
+// UpdateAll mocks base method.
+func (m *MockBatchUpdateToggle) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAll", ctx, project, env, operations, atomic)
+	ret0, _ := ret[0].([]*entity.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAll indicates an expected call of UpdateAll.
+func (mr *MockBatchUpdateToggleMockRecorder) UpdateAll(ctx, project, env, operations, atomic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*MockBatchUpdateToggle)(nil).UpdateAll), ctx, project, env, operations, atomic)
+}
+
+// MockBatchUpdateToggleRepository is a mock of BatchUpdateToggleRepository interface.
+type MockBatchUpdateToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchUpdateToggleRepositoryMockRecorder
+}
+
+// MockBatchUpdateToggleRepositoryMockRecorder is the mock recorder for MockBatchUpdateToggleRepository.
+type MockBatchUpdateToggleRepositoryMockRecorder struct {
+	mock *MockBatchUpdateToggleRepository
+}
+
+// NewMockBatchUpdateToggleRepository creates a new mock instance.
+func NewMockBatchUpdateToggleRepository(ctrl *gomock.Controller) *MockBatchUpdateToggleRepository {
+	mock := &MockBatchUpdateToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockBatchUpdateToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchUpdateToggleRepository) EXPECT() *MockBatchUpdateToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllByKey mocks base method.
+func (m *MockBatchUpdateToggleRepository) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockBatchUpdateToggleRepositoryMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockBatchUpdateToggleRepository)(nil).GetAllByKey), ctx, project, key)
+}
+
+// UpdateAll mocks base method.
+func (m *MockBatchUpdateToggleRepository) UpdateAll(ctx context.Context, project, env string, operations []*entity.ToggleOperation, atomic bool) ([]*entity.BatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAll", ctx, project, env, operations, atomic)
+	ret0, _ := ret[0].([]*entity.BatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAll indicates an expected call of UpdateAll.
+func (mr *MockBatchUpdateToggleRepositoryMockRecorder) UpdateAll(ctx, project, env, operations, atomic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAll", reflect.TypeOf((*MockBatchUpdateToggleRepository)(nil).UpdateAll), ctx, project, env, operations, atomic)
+}
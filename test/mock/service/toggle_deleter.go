@@ -37,17 +37,31 @@ func (m *MockDeleteToggle) EXPECT() *MockDeleteToggleMockRecorder {
 }
 
 // DeleteByKey mocks base method.
-func (m *MockDeleteToggle) DeleteByKey(ctx context.Context, key string) error {
+func (m *MockDeleteToggle) DeleteByKey(ctx context.Context, project, env, key string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByKey", ctx, key)
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, project, env, key, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByKey indicates an expected call of DeleteByKey.
-func (mr *MockDeleteToggleMockRecorder) DeleteByKey(ctx, key interface{}) *gomock.Call {
+func (mr *MockDeleteToggleMockRecorder) DeleteByKey(ctx, project, env, key, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockDeleteToggle)(nil).DeleteByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockDeleteToggle)(nil).DeleteByKey), ctx, project, env, key, version)
+}
+
+// PurgeByKey mocks base method.
+func (m *MockDeleteToggle) PurgeByKey(ctx context.Context, project, env, key string, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByKey", ctx, project, env, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByKey indicates an expected call of PurgeByKey.
+func (mr *MockDeleteToggleMockRecorder) PurgeByKey(ctx, project, env, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByKey", reflect.TypeOf((*MockDeleteToggle)(nil).PurgeByKey), ctx, project, env, key, version)
 }
 
 // MockDeleteToggleRepository is a mock of DeleteToggleRepository interface.
@@ -74,30 +88,59 @@ func (m *MockDeleteToggleRepository) EXPECT() *MockDeleteToggleRepositoryMockRec
 }
 
 // DeleteByKey mocks base method.
-func (m *MockDeleteToggleRepository) DeleteByKey(ctx context.Context, key string) error {
+func (m *MockDeleteToggleRepository) DeleteByKey(ctx context.Context, project, key string, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByKey", ctx, key)
+	ret := m.ctrl.Call(m, "DeleteByKey", ctx, project, key, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteByKey indicates an expected call of DeleteByKey.
-func (mr *MockDeleteToggleRepositoryMockRecorder) DeleteByKey(ctx, key interface{}) *gomock.Call {
+func (mr *MockDeleteToggleRepositoryMockRecorder) DeleteByKey(ctx, project, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockDeleteToggleRepository)(nil).DeleteByKey), ctx, project, key, version)
+}
+
+// GetAllByKey mocks base method.
+func (m *MockDeleteToggleRepository) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockDeleteToggleRepositoryMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockDeleteToggleRepository)(nil).DeleteByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockDeleteToggleRepository)(nil).GetAllByKey), ctx, project, key)
 }
 
-// GetByKey mocks base method.
-func (m *MockDeleteToggleRepository) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
+// GetAllDeletedByKey mocks base method.
+func (m *MockDeleteToggleRepository) GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
-	ret0, _ := ret[0].(*entity.Toggle)
+	ret := m.ctrl.Call(m, "GetAllDeletedByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetByKey indicates an expected call of GetByKey.
-func (mr *MockDeleteToggleRepositoryMockRecorder) GetByKey(ctx, key interface{}) *gomock.Call {
+// GetAllDeletedByKey indicates an expected call of GetAllDeletedByKey.
+func (mr *MockDeleteToggleRepositoryMockRecorder) GetAllDeletedByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeletedByKey", reflect.TypeOf((*MockDeleteToggleRepository)(nil).GetAllDeletedByKey), ctx, project, key)
+}
+
+// PurgeByKey mocks base method.
+func (m *MockDeleteToggleRepository) PurgeByKey(ctx context.Context, project, key string, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByKey", ctx, project, key, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByKey indicates an expected call of PurgeByKey.
+func (mr *MockDeleteToggleRepositoryMockRecorder) PurgeByKey(ctx, project, key, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockDeleteToggleRepository)(nil).GetByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByKey", reflect.TypeOf((*MockDeleteToggleRepository)(nil).PurgeByKey), ctx, project, key, version)
 }
@@ -35,17 +35,18 @@ func (m *MockDisableToggle) EXPECT() *MockDisableToggleMockRecorder {
 }
 
 // Disable mocks base method.
-func (m *MockDisableToggle) Disable(ctx context.Context, key string) error {
+func (m *MockDisableToggle) Disable(ctx context.Context, project, env, key string, version int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Disable", ctx, key)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Disable", ctx, project, env, key, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Disable indicates an expected call of Disable.
-func (mr *MockDisableToggleMockRecorder) Disable(ctx, key interface{}) *gomock.Call {
+func (mr *MockDisableToggleMockRecorder) Disable(ctx, project, env, key, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockDisableToggle)(nil).Disable), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockDisableToggle)(nil).Disable), ctx, project, env, key, version)
 }
 
 // MockDisableToggleRepository is a mock of DisableToggleRepository interface.
@@ -72,15 +73,16 @@ func (m *MockDisableToggleRepository) EXPECT() *MockDisableToggleRepositoryMockR
 }
 
 // Disable mocks base method.
-func (m *MockDisableToggleRepository) Disable(ctx context.Context, key string, value bool) error {
+func (m *MockDisableToggleRepository) Disable(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Disable", ctx, key, value)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Disable", ctx, project, env, key, value, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Disable indicates an expected call of Disable.
-func (mr *MockDisableToggleRepositoryMockRecorder) Disable(ctx, key, value interface{}) *gomock.Call {
+func (mr *MockDisableToggleRepositoryMockRecorder) Disable(ctx, project, env, key, value, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockDisableToggleRepository)(nil).Disable), ctx, key, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disable", reflect.TypeOf((*MockDisableToggleRepository)(nil).Disable), ctx, project, env, key, value, version)
 }
@@ -35,17 +35,18 @@ func (m *MockEnableToggle) EXPECT() *MockEnableToggleMockRecorder {
 }
 
 // Enable mocks base method.
-func (m *MockEnableToggle) Enable(ctx context.Context, key string) error {
+func (m *MockEnableToggle) Enable(ctx context.Context, project, env, key string, version int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Enable", ctx, key)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Enable", ctx, project, env, key, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Enable indicates an expected call of Enable.
-func (mr *MockEnableToggleMockRecorder) Enable(ctx, key interface{}) *gomock.Call {
+func (mr *MockEnableToggleMockRecorder) Enable(ctx, project, env, key, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockEnableToggle)(nil).Enable), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockEnableToggle)(nil).Enable), ctx, project, env, key, version)
 }
 
 // MockEnableToggleRepository is a mock of EnableToggleRepository interface.
@@ -72,15 +73,16 @@ func (m *MockEnableToggleRepository) EXPECT() *MockEnableToggleRepositoryMockRec
 }
 
 // Enable mocks base method.
-func (m *MockEnableToggleRepository) Enable(ctx context.Context, key string, value bool) error {
+func (m *MockEnableToggleRepository) Enable(ctx context.Context, project, env, key string, value bool, version int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Enable", ctx, key, value)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Enable", ctx, project, env, key, value, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Enable indicates an expected call of Enable.
-func (mr *MockEnableToggleRepositoryMockRecorder) Enable(ctx, key, value interface{}) *gomock.Call {
+func (mr *MockEnableToggleRepositoryMockRecorder) Enable(ctx, project, env, key, value, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockEnableToggleRepository)(nil).Enable), ctx, key, value)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enable", reflect.TypeOf((*MockEnableToggleRepository)(nil).Enable), ctx, project, env, key, value, version)
 }
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_evaluator.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockEvaluateToggle is a mock of EvaluateToggle interface.
+type MockEvaluateToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluateToggleMockRecorder
+}
+
+// MockEvaluateToggleMockRecorder is the mock recorder for MockEvaluateToggle.
+type MockEvaluateToggleMockRecorder struct {
+	mock *MockEvaluateToggle
+}
+
+// NewMockEvaluateToggle creates a new mock instance.
+func NewMockEvaluateToggle(ctrl *gomock.Controller) *MockEvaluateToggle {
+	mock := &MockEvaluateToggle{ctrl: ctrl}
+	mock.recorder = &MockEvaluateToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluateToggle) EXPECT() *MockEvaluateToggleMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockEvaluateToggle) Evaluate(ctx context.Context, project, env, key string, evalCtx map[string]string) (*entity.Evaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, project, env, key, evalCtx)
+	ret0, _ := ret[0].(*entity.Evaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockEvaluateToggleMockRecorder) Evaluate(ctx, project, env, key, evalCtx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockEvaluateToggle)(nil).Evaluate), ctx, project, env, key, evalCtx)
+}
+
+// MockEvaluateToggleRepository is a mock of EvaluateToggleRepository interface.
+type MockEvaluateToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluateToggleRepositoryMockRecorder
+}
+
+// MockEvaluateToggleRepositoryMockRecorder is the mock recorder for MockEvaluateToggleRepository.
+type MockEvaluateToggleRepositoryMockRecorder struct {
+	mock *MockEvaluateToggleRepository
+}
+
+// NewMockEvaluateToggleRepository creates a new mock instance.
+func NewMockEvaluateToggleRepository(ctrl *gomock.Controller) *MockEvaluateToggleRepository {
+	mock := &MockEvaluateToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockEvaluateToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluateToggleRepository) EXPECT() *MockEvaluateToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByKey mocks base method.
+func (m *MockEvaluateToggleRepository) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
+	ret0, _ := ret[0].(*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockEvaluateToggleRepositoryMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockEvaluateToggleRepository)(nil).GetByKey), ctx, project, env, key)
+}
+
+// MockEvaluateSegmentRepository is a mock of EvaluateSegmentRepository interface.
+type MockEvaluateSegmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluateSegmentRepositoryMockRecorder
+}
+
+// MockEvaluateSegmentRepositoryMockRecorder is the mock recorder for MockEvaluateSegmentRepository.
+type MockEvaluateSegmentRepositoryMockRecorder struct {
+	mock *MockEvaluateSegmentRepository
+}
+
+// NewMockEvaluateSegmentRepository creates a new mock instance.
+func NewMockEvaluateSegmentRepository(ctrl *gomock.Controller) *MockEvaluateSegmentRepository {
+	mock := &MockEvaluateSegmentRepository{ctrl: ctrl}
+	mock.recorder = &MockEvaluateSegmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluateSegmentRepository) EXPECT() *MockEvaluateSegmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByKeys mocks base method.
+func (m *MockEvaluateSegmentRepository) GetByKeys(ctx context.Context, project string, keys []string) ([]*entity.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKeys", ctx, project, keys)
+	ret0, _ := ret[0].([]*entity.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKeys indicates an expected call of GetByKeys.
+func (mr *MockEvaluateSegmentRepositoryMockRecorder) GetByKeys(ctx, project, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKeys", reflect.TypeOf((*MockEvaluateSegmentRepository)(nil).GetByKeys), ctx, project, keys)
+}
@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_expiry_notifier.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockNotifyExpiredToggle is a mock of NotifyExpiredToggle interface.
+type MockNotifyExpiredToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifyExpiredToggleMockRecorder
+}
+
+// MockNotifyExpiredToggleMockRecorder is the mock recorder for MockNotifyExpiredToggle.
+type MockNotifyExpiredToggleMockRecorder struct {
+	mock *MockNotifyExpiredToggle
+}
+
+// NewMockNotifyExpiredToggle creates a new mock instance.
+func NewMockNotifyExpiredToggle(ctrl *gomock.Controller) *MockNotifyExpiredToggle {
+	mock := &MockNotifyExpiredToggle{ctrl: ctrl}
+	mock.recorder = &MockNotifyExpiredToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifyExpiredToggle) EXPECT() *MockNotifyExpiredToggleMockRecorder {
+	return m.recorder
+}
+
+// NotifyExpired mocks base method.
+func (m *MockNotifyExpiredToggle) NotifyExpired(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyExpired", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyExpired indicates an expected call of NotifyExpired.
+func (mr *MockNotifyExpiredToggleMockRecorder) NotifyExpired(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyExpired", reflect.TypeOf((*MockNotifyExpiredToggle)(nil).NotifyExpired), ctx)
+}
+
+// MockNotifyExpiredToggleRepository is a mock of NotifyExpiredToggleRepository interface.
+type MockNotifyExpiredToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotifyExpiredToggleRepositoryMockRecorder
+}
+
+// MockNotifyExpiredToggleRepositoryMockRecorder is the mock recorder for MockNotifyExpiredToggleRepository.
+type MockNotifyExpiredToggleRepositoryMockRecorder struct {
+	mock *MockNotifyExpiredToggleRepository
+}
+
+// NewMockNotifyExpiredToggleRepository creates a new mock instance.
+func NewMockNotifyExpiredToggleRepository(ctrl *gomock.Controller) *MockNotifyExpiredToggleRepository {
+	mock := &MockNotifyExpiredToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockNotifyExpiredToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotifyExpiredToggleRepository) EXPECT() *MockNotifyExpiredToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimExpired mocks base method.
+func (m *MockNotifyExpiredToggleRepository) ClaimExpired(ctx context.Context, now time.Time, limit int) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimExpired", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimExpired indicates an expected call of ClaimExpired.
+func (mr *MockNotifyExpiredToggleRepositoryMockRecorder) ClaimExpired(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimExpired", reflect.TypeOf((*MockNotifyExpiredToggleRepository)(nil).ClaimExpired), ctx, now, limit)
+}
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_exporter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockExportToggle is a mock of ExportToggle interface.
+type MockExportToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportToggleMockRecorder
+}
+
+// MockExportToggleMockRecorder is the mock recorder for MockExportToggle.
+type MockExportToggleMockRecorder struct {
+	mock *MockExportToggle
+}
+
+// NewMockExportToggle creates a new mock instance.
+func NewMockExportToggle(ctrl *gomock.Controller) *MockExportToggle {
+	mock := &MockExportToggle{ctrl: ctrl}
+	mock.recorder = &MockExportToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportToggle) EXPECT() *MockExportToggleMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockExportToggle) Export(ctx context.Context, project, env string) (*entity.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, project, env)
+	ret0, _ := ret[0].(*entity.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockExportToggleMockRecorder) Export(ctx, project, env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockExportToggle)(nil).Export), ctx, project, env)
+}
+
+// MockExportToggleRepository is a mock of ExportToggleRepository interface.
+type MockExportToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportToggleRepositoryMockRecorder
+}
+
+// MockExportToggleRepositoryMockRecorder is the mock recorder for MockExportToggleRepository.
+type MockExportToggleRepositoryMockRecorder struct {
+	mock *MockExportToggleRepository
+}
+
+// NewMockExportToggleRepository creates a new mock instance.
+func NewMockExportToggleRepository(ctrl *gomock.Controller) *MockExportToggleRepository {
+	mock := &MockExportToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockExportToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportToggleRepository) EXPECT() *MockExportToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockExportToggleRepository) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockExportToggleRepositoryMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockExportToggleRepository)(nil).GetAll), ctx, project, env, filter, page)
+}
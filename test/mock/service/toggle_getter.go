@@ -37,33 +37,33 @@ func (m *MockGetToggle) EXPECT() *MockGetToggleMockRecorder {
 }
 
 // GetAll mocks base method.
-func (m *MockGetToggle) GetAll(ctx context.Context) ([]*entity.Toggle, error) {
+func (m *MockGetToggle) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.PageRequest) (*entity.TogglePage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]*entity.Toggle)
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
+	ret0, _ := ret[0].(*entity.TogglePage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockGetToggleMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+func (mr *MockGetToggleMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggle)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggle)(nil).GetAll), ctx, project, env, filter, page)
 }
 
 // GetByKey mocks base method.
-func (m *MockGetToggle) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
+func (m *MockGetToggle) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
 	ret0, _ := ret[0].(*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByKey indicates an expected call of GetByKey.
-func (mr *MockGetToggleMockRecorder) GetByKey(ctx, key interface{}) *gomock.Call {
+func (mr *MockGetToggleMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetToggle)(nil).GetByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetToggle)(nil).GetByKey), ctx, project, env, key)
 }
 
 // MockGetToggleRepository is a mock of GetToggleRepository interface.
@@ -89,32 +89,47 @@ func (m *MockGetToggleRepository) EXPECT() *MockGetToggleRepositoryMockRecorder
 	return m.recorder
 }
 
+// Count mocks base method.
+func (m *MockGetToggleRepository) Count(ctx context.Context, project, env string, filter *entity.ToggleFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, project, env, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockGetToggleRepositoryMockRecorder) Count(ctx, project, env, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockGetToggleRepository)(nil).Count), ctx, project, env, filter)
+}
+
 // GetAll mocks base method.
-func (m *MockGetToggleRepository) GetAll(ctx context.Context) ([]*entity.Toggle, error) {
+func (m *MockGetToggleRepository) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
 	ret0, _ := ret[0].([]*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockGetToggleRepositoryMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+func (mr *MockGetToggleRepositoryMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggleRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggleRepository)(nil).GetAll), ctx, project, env, filter, page)
 }
 
 // GetByKey mocks base method.
-func (m *MockGetToggleRepository) GetByKey(ctx context.Context, key string) (*entity.Toggle, error) {
+func (m *MockGetToggleRepository) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
 	ret0, _ := ret[0].(*entity.Toggle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByKey indicates an expected call of GetByKey.
-func (mr *MockGetToggleRepositoryMockRecorder) GetByKey(ctx, key interface{}) *gomock.Call {
+func (mr *MockGetToggleRepositoryMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetToggleRepository)(nil).GetByKey), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockGetToggleRepository)(nil).GetByKey), ctx, project, env, key)
 }
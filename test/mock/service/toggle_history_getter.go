@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_history_getter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockGetToggleHistory is a mock of GetToggleHistory interface.
+type MockGetToggleHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetToggleHistoryMockRecorder
+}
+
+// MockGetToggleHistoryMockRecorder is the mock recorder for MockGetToggleHistory.
+type MockGetToggleHistoryMockRecorder struct {
+	mock *MockGetToggleHistory
+}
+
+// NewMockGetToggleHistory creates a new mock instance.
+func NewMockGetToggleHistory(ctrl *gomock.Controller) *MockGetToggleHistory {
+	mock := &MockGetToggleHistory{ctrl: ctrl}
+	mock.recorder = &MockGetToggleHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetToggleHistory) EXPECT() *MockGetToggleHistoryMockRecorder {
+	return m.recorder
+}
+
+// GetHistory mocks base method.
+func (m *MockGetToggleHistory) GetHistory(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.PageRequest) (*entity.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, project, filter, page)
+	ret0, _ := ret[0].(*entity.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockGetToggleHistoryMockRecorder) GetHistory(ctx, project, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockGetToggleHistory)(nil).GetHistory), ctx, project, filter, page)
+}
+
+// MockGetToggleHistoryRepository is a mock of GetToggleHistoryRepository interface.
+type MockGetToggleHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetToggleHistoryRepositoryMockRecorder
+}
+
+// MockGetToggleHistoryRepositoryMockRecorder is the mock recorder for MockGetToggleHistoryRepository.
+type MockGetToggleHistoryRepositoryMockRecorder struct {
+	mock *MockGetToggleHistoryRepository
+}
+
+// NewMockGetToggleHistoryRepository creates a new mock instance.
+func NewMockGetToggleHistoryRepository(ctrl *gomock.Controller) *MockGetToggleHistoryRepository {
+	mock := &MockGetToggleHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockGetToggleHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetToggleHistoryRepository) EXPECT() *MockGetToggleHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetToggleHistoryRepository) GetAll(ctx context.Context, project string, filter *entity.AuditFilter, page *entity.AuditPagination) ([]*entity.Audit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, filter, page)
+	ret0, _ := ret[0].([]*entity.Audit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetToggleHistoryRepositoryMockRecorder) GetAll(ctx, project, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggleHistoryRepository)(nil).GetAll), ctx, project, filter, page)
+}
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_importer.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockImportToggle is a mock of ImportToggle interface.
+type MockImportToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportToggleMockRecorder
+}
+
+// MockImportToggleMockRecorder is the mock recorder for MockImportToggle.
+type MockImportToggleMockRecorder struct {
+	mock *MockImportToggle
+}
+
+// NewMockImportToggle creates a new mock instance.
+func NewMockImportToggle(ctrl *gomock.Controller) *MockImportToggle {
+	mock := &MockImportToggle{ctrl: ctrl}
+	mock.recorder = &MockImportToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportToggle) EXPECT() *MockImportToggleMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method.
+func (m *MockImportToggle) Import(ctx context.Context, project, env string, snapshot *entity.Snapshot, options *entity.ImportOptions) ([]*entity.ImportChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, project, env, snapshot, options)
+	ret0, _ := ret[0].([]*entity.ImportChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockImportToggleMockRecorder) Import(ctx, project, env, snapshot, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockImportToggle)(nil).Import), ctx, project, env, snapshot, options)
+}
+
+// MockImportToggleRepository is a mock of ImportToggleRepository interface.
+type MockImportToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportToggleRepositoryMockRecorder
+}
+
+// MockImportToggleRepositoryMockRecorder is the mock recorder for MockImportToggleRepository.
+type MockImportToggleRepositoryMockRecorder struct {
+	mock *MockImportToggleRepository
+}
+
+// NewMockImportToggleRepository creates a new mock instance.
+func NewMockImportToggleRepository(ctrl *gomock.Controller) *MockImportToggleRepository {
+	mock := &MockImportToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockImportToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportToggleRepository) EXPECT() *MockImportToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockImportToggleRepository) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockImportToggleRepositoryMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockImportToggleRepository)(nil).GetAll), ctx, project, env, filter, page)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_prerequisite_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdateTogglePrerequisites is a mock of UpdateTogglePrerequisites interface.
+type MockUpdateTogglePrerequisites struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateTogglePrerequisitesMockRecorder
+}
+
+// MockUpdateTogglePrerequisitesMockRecorder is the mock recorder for MockUpdateTogglePrerequisites.
+type MockUpdateTogglePrerequisitesMockRecorder struct {
+	mock *MockUpdateTogglePrerequisites
+}
+
+// NewMockUpdateTogglePrerequisites creates a new mock instance.
+func NewMockUpdateTogglePrerequisites(ctrl *gomock.Controller) *MockUpdateTogglePrerequisites {
+	mock := &MockUpdateTogglePrerequisites{ctrl: ctrl}
+	mock.recorder = &MockUpdateTogglePrerequisitesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateTogglePrerequisites) EXPECT() *MockUpdateTogglePrerequisitesMockRecorder {
+	return m.recorder
+}
+
+// UpdatePrerequisites mocks base method.
+func (m *MockUpdateTogglePrerequisites) UpdatePrerequisites(ctx context.Context, project, env, key string, prerequisites []*entity.Prerequisite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrerequisites", ctx, project, env, key, prerequisites)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrerequisites indicates an expected call of UpdatePrerequisites.
+func (mr *MockUpdateTogglePrerequisitesMockRecorder) UpdatePrerequisites(ctx, project, env, key, prerequisites interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrerequisites", reflect.TypeOf((*MockUpdateTogglePrerequisites)(nil).UpdatePrerequisites), ctx, project, env, key, prerequisites)
+}
+
+// MockUpdatePrerequisitesRepository is a mock of UpdatePrerequisitesRepository interface.
+type MockUpdatePrerequisitesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdatePrerequisitesRepositoryMockRecorder
+}
+
+// MockUpdatePrerequisitesRepositoryMockRecorder is the mock recorder for MockUpdatePrerequisitesRepository.
+type MockUpdatePrerequisitesRepositoryMockRecorder struct {
+	mock *MockUpdatePrerequisitesRepository
+}
+
+// NewMockUpdatePrerequisitesRepository creates a new mock instance.
+func NewMockUpdatePrerequisitesRepository(ctrl *gomock.Controller) *MockUpdatePrerequisitesRepository {
+	mock := &MockUpdatePrerequisitesRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdatePrerequisitesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdatePrerequisitesRepository) EXPECT() *MockUpdatePrerequisitesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllByKey mocks base method.
+func (m *MockUpdatePrerequisitesRepository) GetAllByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByKey indicates an expected call of GetAllByKey.
+func (mr *MockUpdatePrerequisitesRepositoryMockRecorder) GetAllByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByKey", reflect.TypeOf((*MockUpdatePrerequisitesRepository)(nil).GetAllByKey), ctx, project, key)
+}
+
+// UpdatePrerequisites mocks base method.
+func (m *MockUpdatePrerequisitesRepository) UpdatePrerequisites(ctx context.Context, project, key string, prerequisites []*entity.Prerequisite) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrerequisites", ctx, project, key, prerequisites)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrerequisites indicates an expected call of UpdatePrerequisites.
+func (mr *MockUpdatePrerequisitesRepositoryMockRecorder) UpdatePrerequisites(ctx, project, key, prerequisites interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrerequisites", reflect.TypeOf((*MockUpdatePrerequisitesRepository)(nil).UpdatePrerequisites), ctx, project, key, prerequisites)
+}
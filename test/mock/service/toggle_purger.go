@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_purger.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockPurgeDeletedToggle is a mock of PurgeDeletedToggle interface.
+type MockPurgeDeletedToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgeDeletedToggleMockRecorder
+}
+
+// MockPurgeDeletedToggleMockRecorder is the mock recorder for MockPurgeDeletedToggle.
+type MockPurgeDeletedToggleMockRecorder struct {
+	mock *MockPurgeDeletedToggle
+}
+
+// NewMockPurgeDeletedToggle creates a new mock instance.
+func NewMockPurgeDeletedToggle(ctrl *gomock.Controller) *MockPurgeDeletedToggle {
+	mock := &MockPurgeDeletedToggle{ctrl: ctrl}
+	mock.recorder = &MockPurgeDeletedToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurgeDeletedToggle) EXPECT() *MockPurgeDeletedToggleMockRecorder {
+	return m.recorder
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockPurgeDeletedToggle) PurgeDeleted(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockPurgeDeletedToggleMockRecorder) PurgeDeleted(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockPurgeDeletedToggle)(nil).PurgeDeleted), ctx)
+}
+
+// MockPurgeDeletedToggleRepository is a mock of PurgeDeletedToggleRepository interface.
+type MockPurgeDeletedToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPurgeDeletedToggleRepositoryMockRecorder
+}
+
+// MockPurgeDeletedToggleRepositoryMockRecorder is the mock recorder for MockPurgeDeletedToggleRepository.
+type MockPurgeDeletedToggleRepositoryMockRecorder struct {
+	mock *MockPurgeDeletedToggleRepository
+}
+
+// NewMockPurgeDeletedToggleRepository creates a new mock instance.
+func NewMockPurgeDeletedToggleRepository(ctrl *gomock.Controller) *MockPurgeDeletedToggleRepository {
+	mock := &MockPurgeDeletedToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockPurgeDeletedToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPurgeDeletedToggleRepository) EXPECT() *MockPurgeDeletedToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDeleted mocks base method.
+func (m *MockPurgeDeletedToggleRepository) ClaimDeleted(ctx context.Context, deletedBefore time.Time, limit int) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDeleted", ctx, deletedBefore, limit)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDeleted indicates an expected call of ClaimDeleted.
+func (mr *MockPurgeDeletedToggleRepositoryMockRecorder) ClaimDeleted(ctx, deletedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDeleted", reflect.TypeOf((*MockPurgeDeletedToggleRepository)(nil).ClaimDeleted), ctx, deletedBefore, limit)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_restorer.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockRestoreToggle is a mock of RestoreToggle interface.
+type MockRestoreToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreToggleMockRecorder
+}
+
+// MockRestoreToggleMockRecorder is the mock recorder for MockRestoreToggle.
+type MockRestoreToggleMockRecorder struct {
+	mock *MockRestoreToggle
+}
+
+// NewMockRestoreToggle creates a new mock instance.
+func NewMockRestoreToggle(ctrl *gomock.Controller) *MockRestoreToggle {
+	mock := &MockRestoreToggle{ctrl: ctrl}
+	mock.recorder = &MockRestoreToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestoreToggle) EXPECT() *MockRestoreToggleMockRecorder {
+	return m.recorder
+}
+
+// Restore mocks base method.
+func (m *MockRestoreToggle) Restore(ctx context.Context, project, env, key string, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, project, env, key, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRestoreToggleMockRecorder) Restore(ctx, project, env, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRestoreToggle)(nil).Restore), ctx, project, env, key, version)
+}
+
+// MockRestoreToggleRepository is a mock of RestoreToggleRepository interface.
+type MockRestoreToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRestoreToggleRepositoryMockRecorder
+}
+
+// MockRestoreToggleRepositoryMockRecorder is the mock recorder for MockRestoreToggleRepository.
+type MockRestoreToggleRepositoryMockRecorder struct {
+	mock *MockRestoreToggleRepository
+}
+
+// NewMockRestoreToggleRepository creates a new mock instance.
+func NewMockRestoreToggleRepository(ctrl *gomock.Controller) *MockRestoreToggleRepository {
+	mock := &MockRestoreToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockRestoreToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRestoreToggleRepository) EXPECT() *MockRestoreToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAllDeletedByKey mocks base method.
+func (m *MockRestoreToggleRepository) GetAllDeletedByKey(ctx context.Context, project, key string) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDeletedByKey", ctx, project, key)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDeletedByKey indicates an expected call of GetAllDeletedByKey.
+func (mr *MockRestoreToggleRepositoryMockRecorder) GetAllDeletedByKey(ctx, project, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeletedByKey", reflect.TypeOf((*MockRestoreToggleRepository)(nil).GetAllDeletedByKey), ctx, project, key)
+}
+
+// Restore mocks base method.
+func (m *MockRestoreToggleRepository) Restore(ctx context.Context, project, key string, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, project, key, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRestoreToggleRepositoryMockRecorder) Restore(ctx, project, key, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRestoreToggleRepository)(nil).Restore), ctx, project, key, version)
+}
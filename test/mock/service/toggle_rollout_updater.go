@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_rollout_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdateToggleRollout is a mock of UpdateToggleRollout interface.
+type MockUpdateToggleRollout struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateToggleRolloutMockRecorder
+}
+
+// MockUpdateToggleRolloutMockRecorder is the mock recorder for MockUpdateToggleRollout.
+type MockUpdateToggleRolloutMockRecorder struct {
+	mock *MockUpdateToggleRollout
+}
+
+// NewMockUpdateToggleRollout creates a new mock instance.
+func NewMockUpdateToggleRollout(ctrl *gomock.Controller) *MockUpdateToggleRollout {
+	mock := &MockUpdateToggleRollout{ctrl: ctrl}
+	mock.recorder = &MockUpdateToggleRolloutMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateToggleRollout) EXPECT() *MockUpdateToggleRolloutMockRecorder {
+	return m.recorder
+}
+
+// UpdateRollout mocks base method.
+func (m *MockUpdateToggleRollout) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRollout", ctx, project, env, key, rollout, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRollout indicates an expected call of UpdateRollout.
+func (mr *MockUpdateToggleRolloutMockRecorder) UpdateRollout(ctx, project, env, key, rollout, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRollout", reflect.TypeOf((*MockUpdateToggleRollout)(nil).UpdateRollout), ctx, project, env, key, rollout, version)
+}
+
+// MockUpdateRolloutRepository is a mock of UpdateRolloutRepository interface.
+type MockUpdateRolloutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRolloutRepositoryMockRecorder
+}
+
+// MockUpdateRolloutRepositoryMockRecorder is the mock recorder for MockUpdateRolloutRepository.
+type MockUpdateRolloutRepositoryMockRecorder struct {
+	mock *MockUpdateRolloutRepository
+}
+
+// NewMockUpdateRolloutRepository creates a new mock instance.
+func NewMockUpdateRolloutRepository(ctrl *gomock.Controller) *MockUpdateRolloutRepository {
+	mock := &MockUpdateRolloutRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateRolloutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRolloutRepository) EXPECT() *MockUpdateRolloutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// UpdateRollout mocks base method.
+func (m *MockUpdateRolloutRepository) UpdateRollout(ctx context.Context, project, env, key string, rollout *entity.Rollout, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRollout", ctx, project, env, key, rollout, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRollout indicates an expected call of UpdateRollout.
+func (mr *MockUpdateRolloutRepositoryMockRecorder) UpdateRollout(ctx, project, env, key, rollout, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRollout", reflect.TypeOf((*MockUpdateRolloutRepository)(nil).UpdateRollout), ctx, project, env, key, rollout, version)
+}
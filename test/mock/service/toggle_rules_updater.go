@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_rules_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdateToggleRules is a mock of UpdateToggleRules interface.
+type MockUpdateToggleRules struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateToggleRulesMockRecorder
+}
+
+// MockUpdateToggleRulesMockRecorder is the mock recorder for MockUpdateToggleRules.
+type MockUpdateToggleRulesMockRecorder struct {
+	mock *MockUpdateToggleRules
+}
+
+// NewMockUpdateToggleRules creates a new mock instance.
+func NewMockUpdateToggleRules(ctrl *gomock.Controller) *MockUpdateToggleRules {
+	mock := &MockUpdateToggleRules{ctrl: ctrl}
+	mock.recorder = &MockUpdateToggleRulesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateToggleRules) EXPECT() *MockUpdateToggleRulesMockRecorder {
+	return m.recorder
+}
+
+// UpdateRules mocks base method.
+func (m *MockUpdateToggleRules) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRules", ctx, project, env, key, rules, defaultValue, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRules indicates an expected call of UpdateRules.
+func (mr *MockUpdateToggleRulesMockRecorder) UpdateRules(ctx, project, env, key, rules, defaultValue, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRules", reflect.TypeOf((*MockUpdateToggleRules)(nil).UpdateRules), ctx, project, env, key, rules, defaultValue, version)
+}
+
+// MockUpdateRulesRepository is a mock of UpdateRulesRepository interface.
+type MockUpdateRulesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateRulesRepositoryMockRecorder
+}
+
+// MockUpdateRulesRepositoryMockRecorder is the mock recorder for MockUpdateRulesRepository.
+type MockUpdateRulesRepositoryMockRecorder struct {
+	mock *MockUpdateRulesRepository
+}
+
+// NewMockUpdateRulesRepository creates a new mock instance.
+func NewMockUpdateRulesRepository(ctrl *gomock.Controller) *MockUpdateRulesRepository {
+	mock := &MockUpdateRulesRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateRulesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateRulesRepository) EXPECT() *MockUpdateRulesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByKey mocks base method.
+func (m *MockUpdateRulesRepository) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
+	ret0, _ := ret[0].(*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockUpdateRulesRepositoryMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockUpdateRulesRepository)(nil).GetByKey), ctx, project, env, key)
+}
+
+// UpdateRules mocks base method.
+func (m *MockUpdateRulesRepository) UpdateRules(ctx context.Context, project, env, key string, rules []*entity.Rule, defaultValue bool, version int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRules", ctx, project, env, key, rules, defaultValue, version)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRules indicates an expected call of UpdateRules.
+func (mr *MockUpdateRulesRepositoryMockRecorder) UpdateRules(ctx, project, env, key, rules, defaultValue, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRules", reflect.TypeOf((*MockUpdateRulesRepository)(nil).UpdateRules), ctx, project, env, key, rules, defaultValue, version)
+}
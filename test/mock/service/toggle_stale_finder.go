@@ -0,0 +1,91 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_stale_finder.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockFindStaleToggle is a mock of FindStaleToggle interface.
+type MockFindStaleToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockFindStaleToggleMockRecorder
+}
+
+// MockFindStaleToggleMockRecorder is the mock recorder for MockFindStaleToggle.
+type MockFindStaleToggleMockRecorder struct {
+	mock *MockFindStaleToggle
+}
+
+// NewMockFindStaleToggle creates a new mock instance.
+func NewMockFindStaleToggle(ctrl *gomock.Controller) *MockFindStaleToggle {
+	mock := &MockFindStaleToggle{ctrl: ctrl}
+	mock.recorder = &MockFindStaleToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFindStaleToggle) EXPECT() *MockFindStaleToggleMockRecorder {
+	return m.recorder
+}
+
+// GetStale mocks base method.
+func (m *MockFindStaleToggle) GetStale(ctx context.Context, project, env string, staleAfter time.Duration) ([]*entity.StaleToggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStale", ctx, project, env, staleAfter)
+	ret0, _ := ret[0].([]*entity.StaleToggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStale indicates an expected call of GetStale.
+func (mr *MockFindStaleToggleMockRecorder) GetStale(ctx, project, env, staleAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStale", reflect.TypeOf((*MockFindStaleToggle)(nil).GetStale), ctx, project, env, staleAfter)
+}
+
+// MockFindStaleToggleRepository is a mock of FindStaleToggleRepository interface.
+type MockFindStaleToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFindStaleToggleRepositoryMockRecorder
+}
+
+// MockFindStaleToggleRepositoryMockRecorder is the mock recorder for MockFindStaleToggleRepository.
+type MockFindStaleToggleRepositoryMockRecorder struct {
+	mock *MockFindStaleToggleRepository
+}
+
+// NewMockFindStaleToggleRepository creates a new mock instance.
+func NewMockFindStaleToggleRepository(ctrl *gomock.Controller) *MockFindStaleToggleRepository {
+	mock := &MockFindStaleToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockFindStaleToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFindStaleToggleRepository) EXPECT() *MockFindStaleToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockFindStaleToggleRepository) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockFindStaleToggleRepositoryMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockFindStaleToggleRepository)(nil).GetAll), ctx, project, env, filter, page)
+}
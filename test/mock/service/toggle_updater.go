@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_updater.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockUpdateToggle is a mock of UpdateToggle interface.
+type MockUpdateToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateToggleMockRecorder
+}
+
+// MockUpdateToggleMockRecorder is the mock recorder for MockUpdateToggle.
+type MockUpdateToggleMockRecorder struct {
+	mock *MockUpdateToggle
+}
+
+// NewMockUpdateToggle creates a new mock instance.
+func NewMockUpdateToggle(ctrl *gomock.Controller) *MockUpdateToggle {
+	mock := &MockUpdateToggle{ctrl: ctrl}
+	mock.recorder = &MockUpdateToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateToggle) EXPECT() *MockUpdateToggleMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockUpdateToggle) Update(ctx context.Context, project, env, key string, toggle *entity.Toggle, mask []string, version int64) (*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, project, env, key, toggle, mask, version)
+	ret0, _ := ret[0].(*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateToggleMockRecorder) Update(ctx, project, env, key, toggle, mask, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateToggle)(nil).Update), ctx, project, env, key, toggle, mask, version)
+}
+
+// MockUpdateToggleRepository is a mock of UpdateToggleRepository interface.
+type MockUpdateToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateToggleRepositoryMockRecorder
+}
+
+// MockUpdateToggleRepositoryMockRecorder is the mock recorder for MockUpdateToggleRepository.
+type MockUpdateToggleRepositoryMockRecorder struct {
+	mock *MockUpdateToggleRepository
+}
+
+// NewMockUpdateToggleRepository creates a new mock instance.
+func NewMockUpdateToggleRepository(ctrl *gomock.Controller) *MockUpdateToggleRepository {
+	mock := &MockUpdateToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockUpdateToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateToggleRepository) EXPECT() *MockUpdateToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByKey mocks base method.
+func (m *MockUpdateToggleRepository) GetByKey(ctx context.Context, project, env, key string) (*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, project, env, key)
+	ret0, _ := ret[0].(*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockUpdateToggleRepositoryMockRecorder) GetByKey(ctx, project, env, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockUpdateToggleRepository)(nil).GetByKey), ctx, project, env, key)
+}
+
+// Update mocks base method.
+func (m *MockUpdateToggleRepository) Update(ctx context.Context, toggle *entity.Toggle) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, toggle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUpdateToggleRepositoryMockRecorder) Update(ctx, toggle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUpdateToggleRepository)(nil).Update), ctx, toggle)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_usage_getter.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	entity "github.com/indrasaputra/toggle/entity"
+)
+
+// MockGetToggleUsage is a mock of GetToggleUsage interface.
+type MockGetToggleUsage struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetToggleUsageMockRecorder
+}
+
+// MockGetToggleUsageMockRecorder is the mock recorder for MockGetToggleUsage.
+type MockGetToggleUsageMockRecorder struct {
+	mock *MockGetToggleUsage
+}
+
+// NewMockGetToggleUsage creates a new mock instance.
+func NewMockGetToggleUsage(ctrl *gomock.Controller) *MockGetToggleUsage {
+	mock := &MockGetToggleUsage{ctrl: ctrl}
+	mock.recorder = &MockGetToggleUsageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetToggleUsage) EXPECT() *MockGetToggleUsageMockRecorder {
+	return m.recorder
+}
+
+// GetUsage mocks base method.
+func (m *MockGetToggleUsage) GetUsage(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.ToggleUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, project, env, keys, since)
+	ret0, _ := ret[0].([]*entity.ToggleUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockGetToggleUsageMockRecorder) GetUsage(ctx, project, env, keys, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockGetToggleUsage)(nil).GetUsage), ctx, project, env, keys, since)
+}
+
+// MockGetToggleUsageRepository is a mock of GetToggleUsageRepository interface.
+type MockGetToggleUsageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetToggleUsageRepositoryMockRecorder
+}
+
+// MockGetToggleUsageRepositoryMockRecorder is the mock recorder for MockGetToggleUsageRepository.
+type MockGetToggleUsageRepositoryMockRecorder struct {
+	mock *MockGetToggleUsageRepository
+}
+
+// NewMockGetToggleUsageRepository creates a new mock instance.
+func NewMockGetToggleUsageRepository(ctrl *gomock.Controller) *MockGetToggleUsageRepository {
+	mock := &MockGetToggleUsageRepository{ctrl: ctrl}
+	mock.recorder = &MockGetToggleUsageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetToggleUsageRepository) EXPECT() *MockGetToggleUsageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockGetToggleUsageRepository) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockGetToggleUsageRepositoryMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockGetToggleUsageRepository)(nil).GetAll), ctx, project, env, filter, page)
+}
+
+// MockGetEvaluationCountRepository is a mock of GetEvaluationCountRepository interface.
+type MockGetEvaluationCountRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetEvaluationCountRepositoryMockRecorder
+}
+
+// MockGetEvaluationCountRepositoryMockRecorder is the mock recorder for MockGetEvaluationCountRepository.
+type MockGetEvaluationCountRepositoryMockRecorder struct {
+	mock *MockGetEvaluationCountRepository
+}
+
+// NewMockGetEvaluationCountRepository creates a new mock instance.
+func NewMockGetEvaluationCountRepository(ctrl *gomock.Controller) *MockGetEvaluationCountRepository {
+	mock := &MockGetEvaluationCountRepository{ctrl: ctrl}
+	mock.recorder = &MockGetEvaluationCountRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetEvaluationCountRepository) EXPECT() *MockGetEvaluationCountRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Sum mocks base method.
+func (m *MockGetEvaluationCountRepository) Sum(ctx context.Context, project, env string, keys []string, since time.Time) ([]*entity.EvaluationCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sum", ctx, project, env, keys, since)
+	ret0, _ := ret[0].([]*entity.EvaluationCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sum indicates an expected call of Sum.
+func (mr *MockGetEvaluationCountRepositoryMockRecorder) Sum(ctx, project, env, keys, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sum", reflect.TypeOf((*MockGetEvaluationCountRepository)(nil).Sum), ctx, project, env, keys, since)
+}
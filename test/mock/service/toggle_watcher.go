@@ -0,0 +1,191 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./service/toggle_watcher.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+
+	entity "github.com/indrasaputra/toggle/entity"
+	service "github.com/indrasaputra/toggle/service"
+)
+
+// MockWatchToggle is a mock of WatchToggle interface.
+type MockWatchToggle struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchToggleMockRecorder
+}
+
+// MockWatchToggleMockRecorder is the mock recorder for MockWatchToggle.
+type MockWatchToggleMockRecorder struct {
+	mock *MockWatchToggle
+}
+
+// NewMockWatchToggle creates a new mock instance.
+func NewMockWatchToggle(ctrl *gomock.Controller) *MockWatchToggle {
+	mock := &MockWatchToggle{ctrl: ctrl}
+	mock.recorder = &MockWatchToggleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchToggle) EXPECT() *MockWatchToggleMockRecorder {
+	return m.recorder
+}
+
+// Watch mocks base method.
+func (m *MockWatchToggle) Watch(ctx context.Context, project, env string, filter *entity.WatchFilter, token string, send func(*entity.WatchUpdate) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, project, env, filter, token, send)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockWatchToggleMockRecorder) Watch(ctx, project, env, filter, token, send interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockWatchToggle)(nil).Watch), ctx, project, env, filter, token, send)
+}
+
+// MockToggleSubscription is a mock of ToggleSubscription interface.
+type MockToggleSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockToggleSubscriptionMockRecorder
+}
+
+// MockToggleSubscriptionMockRecorder is the mock recorder for MockToggleSubscription.
+type MockToggleSubscriptionMockRecorder struct {
+	mock *MockToggleSubscription
+}
+
+// NewMockToggleSubscription creates a new mock instance.
+func NewMockToggleSubscription(ctrl *gomock.Controller) *MockToggleSubscription {
+	mock := &MockToggleSubscription{ctrl: ctrl}
+	mock.recorder = &MockToggleSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToggleSubscription) EXPECT() *MockToggleSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockToggleSubscription) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockToggleSubscriptionMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockToggleSubscription)(nil).Close))
+}
+
+// Events mocks base method.
+func (m *MockToggleSubscription) Events() <-chan *entity.BroadcastEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].(<-chan *entity.BroadcastEvent)
+	return ret0
+}
+
+// Events indicates an expected call of Events.
+func (mr *MockToggleSubscriptionMockRecorder) Events() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MockToggleSubscription)(nil).Events))
+}
+
+// Token mocks base method.
+func (m *MockToggleSubscription) Token() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockToggleSubscriptionMockRecorder) Token() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockToggleSubscription)(nil).Token))
+}
+
+// MockToggleBroadcaster is a mock of ToggleBroadcaster interface.
+type MockToggleBroadcaster struct {
+	ctrl     *gomock.Controller
+	recorder *MockToggleBroadcasterMockRecorder
+}
+
+// MockToggleBroadcasterMockRecorder is the mock recorder for MockToggleBroadcaster.
+type MockToggleBroadcasterMockRecorder struct {
+	mock *MockToggleBroadcaster
+}
+
+// NewMockToggleBroadcaster creates a new mock instance.
+func NewMockToggleBroadcaster(ctrl *gomock.Controller) *MockToggleBroadcaster {
+	mock := &MockToggleBroadcaster{ctrl: ctrl}
+	mock.recorder = &MockToggleBroadcasterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockToggleBroadcaster) EXPECT() *MockToggleBroadcasterMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockToggleBroadcaster) Subscribe(token string) (service.ToggleSubscription, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", token)
+	ret0, _ := ret[0].(service.ToggleSubscription)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockToggleBroadcasterMockRecorder) Subscribe(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockToggleBroadcaster)(nil).Subscribe), token)
+}
+
+// MockWatchToggleRepository is a mock of WatchToggleRepository interface.
+type MockWatchToggleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchToggleRepositoryMockRecorder
+}
+
+// MockWatchToggleRepositoryMockRecorder is the mock recorder for MockWatchToggleRepository.
+type MockWatchToggleRepositoryMockRecorder struct {
+	mock *MockWatchToggleRepository
+}
+
+// NewMockWatchToggleRepository creates a new mock instance.
+func NewMockWatchToggleRepository(ctrl *gomock.Controller) *MockWatchToggleRepository {
+	mock := &MockWatchToggleRepository{ctrl: ctrl}
+	mock.recorder = &MockWatchToggleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchToggleRepository) EXPECT() *MockWatchToggleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockWatchToggleRepository) GetAll(ctx context.Context, project, env string, filter *entity.ToggleFilter, page *entity.TogglePagination) ([]*entity.Toggle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, project, env, filter, page)
+	ret0, _ := ret[0].([]*entity.Toggle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWatchToggleRepositoryMockRecorder) GetAll(ctx, project, env, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWatchToggleRepository)(nil).GetAll), ctx, project, env, filter, page)
+}